@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/panyam/turnengine/games/weewar/assets"
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+	weewar "github.com/panyam/turnengine/games/weewar/lib"
+	"github.com/panyam/turnengine/games/weewar/services"
+)
+
+// Admin tool for maintaining locally stored games
+//
+// Usage:
+//
+//	weewar-admin verify [--storage <dir>] <gameId> [<gameId>...]
+//	weewar-admin lint [--json] [--strict] [<rules.json>]
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "verify":
+		err = verifyCommand(os.Args[2:])
+	case "lint":
+		err = lintCommand(os.Args[2:])
+	case "help", "-h", "--help":
+		usage()
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command: %s\n\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `Usage: weewar-admin <command> [options]
+
+Commands:
+  verify <gameId>...   Replay games from their move history and report the first divergence
+  lint [<rules.json>]  Check a rules file (defaults to the shipped rules) for inconsistencies
+`)
+}
+
+// verifyCommand replays each game and reports whether it matches its saved history and state
+func verifyCommand(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	storageDir := fs.String("storage", "", "Games storage directory (defaults to the dev storage directory)")
+	rulesDir := fs.String("rules", "", "Archived rules directory (defaults to the dev rules directory)")
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		return fmt.Errorf("at least one game ID is required")
+	}
+
+	if *storageDir != "" {
+		services.GAMES_STORAGE_DIR = *storageDir
+	}
+	if *rulesDir != "" {
+		services.RULES_STORAGE_DIR = *rulesDir
+	}
+	svc := services.NewFSGamesService()
+
+	failed := 0
+	for _, gameId := range fs.Args() {
+		resp, err := svc.VerifyGame(context.Background(), &v1.VerifyGameRequest{GameId: gameId})
+		if err != nil {
+			fmt.Printf("%s: ERROR %v\n", gameId, err)
+			failed++
+			continue
+		}
+		if resp.Verified {
+			fmt.Printf("%s: OK (%d groups, %d moves replayed)\n", gameId, resp.GroupsReplayed, resp.MovesReplayed)
+			continue
+		}
+
+		failed++
+		d := resp.Divergence
+		fmt.Printf("%s: DIVERGED after %d groups, %d moves\n", gameId, resp.GroupsReplayed, resp.MovesReplayed)
+		fmt.Printf("  group=%d move=%d change=%d sequence=%d\n", d.GroupIndex, d.MoveIndex, d.ChangeIndex, d.SequenceNum)
+		fmt.Printf("  reason: %s\n", d.Reason)
+		if d.ExpectedChange != nil {
+			fmt.Printf("  recorded: %s\n", protojson.Format(d.ExpectedChange))
+		}
+		if d.ActualChange != nil {
+			fmt.Printf("  replayed: %s\n", protojson.Format(d.ActualChange))
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d games failed verification", failed, fs.NArg())
+	}
+	return nil
+}
+
+// lintCommand checks a rule set for inconsistencies and fails if it has errors (or warnings when
+// strict) so it can gate rules changes in CI
+func lintCommand(args []string) error {
+	fs := flag.NewFlagSet("lint", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "Print the report as JSON")
+	strict := fs.Bool("strict", false, "Fail on warnings as well as errors")
+	fs.Parse(args)
+	if fs.NArg() > 1 {
+		return fmt.Errorf("at most one rules file can be linted")
+	}
+
+	name, data := weewar.DefaultRulesName, assets.RulesDataJSON
+	if fs.NArg() == 1 {
+		var err error
+		if data, err = os.ReadFile(fs.Arg(0)); err != nil {
+			return err
+		}
+		name, _, _ = strings.Cut(strings.TrimSuffix(filepath.Base(fs.Arg(0)), ".json"), "@")
+	}
+	rulesEngine, err := weewar.LoadRulesEngineFromJSON(data)
+	if err != nil {
+		return err
+	}
+	report := rulesEngine.Lint()
+	report.RulesID = weewar.RulesID(name, data)
+
+	if *asJSON {
+		out, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+	} else {
+		for _, issue := range report.Issues {
+			fmt.Printf("%-7s %-24s %-16s %s\n", issue.Severity, issue.Check, issue.Subject, issue.Message)
+		}
+		fmt.Printf("%s: %d errors, %d warnings, %d infos\n", report.RulesID, report.Errors, report.Warnings, report.Infos)
+	}
+
+	if report.Failed(*strict) {
+		return fmt.Errorf("rules %s failed lint with %d errors and %d warnings", report.RulesID, report.Errors, report.Warnings)
+	}
+	return nil
+}
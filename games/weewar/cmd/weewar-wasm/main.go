@@ -28,6 +28,7 @@ func main() {
 	// Wire service implementations to generated WASM exports
 	exports := &weewar_v1_services.Weewar_v1_servicesServicesExports{
 		GamesService:  wasmGamesService,
+		RulesService:  services.NewRulesService(),
 		UsersService:  services.NewUsersService(),
 		WorldsService: wasmWorldsService,
 	}
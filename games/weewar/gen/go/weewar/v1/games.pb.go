@@ -234,9 +234,13 @@ func (x *ListGamesResponse) GetPagination() *PaginationResponse {
 }
 
 type GetGameRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"` // Optional, defaults to default_version
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"` // Optional, defaults to default_version
+	// *
+	// Player whose view of the game's state and history to return.  When fog of
+	// war is enabled and no player is given only the game's metadata is returned.
+	Player        int32 `protobuf:"varint,3,opt,name=player,proto3" json:"player,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -285,6 +289,13 @@ func (x *GetGameRequest) GetVersion() string {
 	return ""
 }
 
+func (x *GetGameRequest) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
 type GetGameResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
@@ -899,9 +910,15 @@ type ProcessMovesRequest struct {
 	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 	// *
 	// List of moves to add
-	Moves         []*GameMove `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Moves []*GameMove `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves,omitempty"`
+	// *
+	// If > 0, the moves are only processed if this is the sequence number of the
+	// last move in the game.  This lets clients detect they are submitting moves
+	// against a stale game state.  Sequence numbers start at 1 so 0 skips the check
+	// and -1 requires that no moves have been made yet.
+	ExpectedLastSequenceNum int64 `protobuf:"varint,4,opt,name=expected_last_sequence_num,json=expectedLastSequenceNum,proto3" json:"expected_last_sequence_num,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *ProcessMovesRequest) Reset() {
@@ -948,6 +965,13 @@ func (x *ProcessMovesRequest) GetMoves() []*GameMove {
 	return nil
 }
 
+func (x *ProcessMovesRequest) GetExpectedLastSequenceNum() int64 {
+	if x != nil {
+		return x.ExpectedLastSequenceNum
+	}
+	return 0
+}
+
 // *
 // Response after adding moves to game.
 type ProcessMovesResponse struct {
@@ -1013,30 +1037,30 @@ func (x *ProcessMovesResponse) GetChanges() []*WorldChange {
 }
 
 // *
-// Request to get the game's latest state
-type GetGameStateRequest struct {
+// Request to verify a game by replaying its move history
+type VerifyGameRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// *
-	// Game ID to add moves to
+	// Game ID to verify
 	GameId        string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGameStateRequest) Reset() {
-	*x = GetGameStateRequest{}
+func (x *VerifyGameRequest) Reset() {
+	*x = VerifyGameRequest{}
 	mi := &file_weewar_v1_games_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameStateRequest) String() string {
+func (x *VerifyGameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameStateRequest) ProtoMessage() {}
+func (*VerifyGameRequest) ProtoMessage() {}
 
-func (x *GetGameStateRequest) ProtoReflect() protoreflect.Message {
+func (x *VerifyGameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_weewar_v1_games_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1048,12 +1072,12 @@ func (x *GetGameStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameStateRequest.ProtoReflect.Descriptor instead.
-func (*GetGameStateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyGameRequest.ProtoReflect.Descriptor instead.
+func (*VerifyGameRequest) Descriptor() ([]byte, []int) {
 	return file_weewar_v1_games_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetGameStateRequest) GetGameId() string {
+func (x *VerifyGameRequest) GetGameId() string {
 	if x != nil {
 		return x.GameId
 	}
@@ -1061,28 +1085,33 @@ func (x *GetGameStateRequest) GetGameId() string {
 }
 
 // *
-// Response holding latest game state
-type GetGameStateResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	State         *GameState             `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+// Result of replaying a game's move history
+type VerifyGameResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the replay matched the recorded history and the saved game state
+	Verified       bool  `protobuf:"varint,1,opt,name=verified,proto3" json:"verified,omitempty"`
+	GroupsReplayed int32 `protobuf:"varint,2,opt,name=groups_replayed,json=groupsReplayed,proto3" json:"groups_replayed,omitempty"`
+	MovesReplayed  int32 `protobuf:"varint,3,opt,name=moves_replayed,json=movesReplayed,proto3" json:"moves_replayed,omitempty"`
+	// Where the replay first differed from the recording (unset if verified)
+	Divergence    *GameDivergence `protobuf:"bytes,4,opt,name=divergence,proto3" json:"divergence,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGameStateResponse) Reset() {
-	*x = GetGameStateResponse{}
+func (x *VerifyGameResponse) Reset() {
+	*x = VerifyGameResponse{}
 	mi := &file_weewar_v1_games_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameStateResponse) String() string {
+func (x *VerifyGameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameStateResponse) ProtoMessage() {}
+func (*VerifyGameResponse) ProtoMessage() {}
 
-func (x *GetGameStateResponse) ProtoReflect() protoreflect.Message {
+func (x *VerifyGameResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_weewar_v1_games_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1094,49 +1123,76 @@ func (x *GetGameStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameStateResponse.ProtoReflect.Descriptor instead.
-func (*GetGameStateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyGameResponse.ProtoReflect.Descriptor instead.
+func (*VerifyGameResponse) Descriptor() ([]byte, []int) {
 	return file_weewar_v1_games_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetGameStateResponse) GetState() *GameState {
+func (x *VerifyGameResponse) GetVerified() bool {
 	if x != nil {
-		return x.State
+		return x.Verified
+	}
+	return false
+}
+
+func (x *VerifyGameResponse) GetGroupsReplayed() int32 {
+	if x != nil {
+		return x.GroupsReplayed
+	}
+	return 0
+}
+
+func (x *VerifyGameResponse) GetMovesReplayed() int32 {
+	if x != nil {
+		return x.MovesReplayed
+	}
+	return 0
+}
+
+func (x *VerifyGameResponse) GetDivergence() *GameDivergence {
+	if x != nil {
+		return x.Divergence
 	}
 	return nil
 }
 
 // *
-// Request to list moves for a game
-type ListMovesRequest struct {
+// The first point where a replayed game differs from its recorded history
+type GameDivergence struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// *
-	// Game ID to add moves to
-	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	// Offset of the move to begin fetching from in reverse order from "latest".
-	// 0 => start from now
-	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	// *
-	// Limit to last N moves (from offset).  if <= 0 return all moves
-	LastN         int32 `protobuf:"varint,3,opt,name=last_n,json=lastN,proto3" json:"last_n,omitempty"`
+	// Index of the move group in the history.  Equal to the number of groups if
+	// only the final game state differs.
+	GroupIndex int32 `protobuf:"varint,1,opt,name=group_index,json=groupIndex,proto3" json:"group_index,omitempty"`
+	// Index of the move in the group (-1 if not specific to a move)
+	MoveIndex int32 `protobuf:"varint,2,opt,name=move_index,json=moveIndex,proto3" json:"move_index,omitempty"`
+	// Index of the change in the move's result (-1 if not specific to a change)
+	ChangeIndex int32 `protobuf:"varint,3,opt,name=change_index,json=changeIndex,proto3" json:"change_index,omitempty"`
+	// Sequence number of the move
+	SequenceNum int64 `protobuf:"varint,4,opt,name=sequence_num,json=sequenceNum,proto3" json:"sequence_num,omitempty"`
+	// Human readable description of the difference
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The recorded change
+	ExpectedChange *WorldChange `protobuf:"bytes,6,opt,name=expected_change,json=expectedChange,proto3" json:"expected_change,omitempty"`
+	// The recomputed change
+	ActualChange  *WorldChange `protobuf:"bytes,7,opt,name=actual_change,json=actualChange,proto3" json:"actual_change,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMovesRequest) Reset() {
-	*x = ListMovesRequest{}
+func (x *GameDivergence) Reset() {
+	*x = GameDivergence{}
 	mi := &file_weewar_v1_games_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMovesRequest) String() string {
+func (x *GameDivergence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMovesRequest) ProtoMessage() {}
+func (*GameDivergence) ProtoMessage() {}
 
-func (x *ListMovesRequest) ProtoReflect() protoreflect.Message {
+func (x *GameDivergence) ProtoReflect() protoreflect.Message {
 	mi := &file_weewar_v1_games_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1148,57 +1204,91 @@ func (x *ListMovesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMovesRequest.ProtoReflect.Descriptor instead.
-func (*ListMovesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GameDivergence.ProtoReflect.Descriptor instead.
+func (*GameDivergence) Descriptor() ([]byte, []int) {
 	return file_weewar_v1_games_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListMovesRequest) GetGameId() string {
+func (x *GameDivergence) GetGroupIndex() int32 {
 	if x != nil {
-		return x.GameId
+		return x.GroupIndex
 	}
-	return ""
+	return 0
 }
 
-func (x *ListMovesRequest) GetOffset() int32 {
+func (x *GameDivergence) GetMoveIndex() int32 {
 	if x != nil {
-		return x.Offset
+		return x.MoveIndex
 	}
 	return 0
 }
 
-func (x *ListMovesRequest) GetLastN() int32 {
+func (x *GameDivergence) GetChangeIndex() int32 {
 	if x != nil {
-		return x.LastN
+		return x.ChangeIndex
+	}
+	return 0
+}
+
+func (x *GameDivergence) GetSequenceNum() int64 {
+	if x != nil {
+		return x.SequenceNum
 	}
 	return 0
 }
 
+func (x *GameDivergence) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GameDivergence) GetExpectedChange() *WorldChange {
+	if x != nil {
+		return x.ExpectedChange
+	}
+	return nil
+}
+
+func (x *GameDivergence) GetActualChange() *WorldChange {
+	if x != nil {
+		return x.ActualChange
+	}
+	return nil
+}
+
 // *
-// Response after adding moves to game.
-type ListMovesResponse struct {
+// Request to undo the latest moves in a game.
+//
+// Only move groups whose results are all non-permanent can be undone and
+// undoing never crosses a turn boundary.
+type UndoMovesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether there are more moves before this
-	HasMore       bool             `protobuf:"varint,1,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
-	MoveGroups    []*GameMoveGroup `protobuf:"bytes,2,rep,name=move_groups,json=moveGroups,proto3" json:"move_groups,omitempty"`
+	// *
+	// Game ID to undo moves in
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// *
+	// Number of trailing move groups to undo.  if <= 0 the last group is undone
+	Count         int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMovesResponse) Reset() {
-	*x = ListMovesResponse{}
+func (x *UndoMovesRequest) Reset() {
+	*x = UndoMovesRequest{}
 	mi := &file_weewar_v1_games_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMovesResponse) String() string {
+func (x *UndoMovesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMovesResponse) ProtoMessage() {}
+func (*UndoMovesRequest) ProtoMessage() {}
 
-func (x *ListMovesResponse) ProtoReflect() protoreflect.Message {
+func (x *UndoMovesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_weewar_v1_games_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1210,50 +1300,53 @@ func (x *ListMovesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMovesResponse.ProtoReflect.Descriptor instead.
-func (*ListMovesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UndoMovesRequest.ProtoReflect.Descriptor instead.
+func (*UndoMovesRequest) Descriptor() ([]byte, []int) {
 	return file_weewar_v1_games_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListMovesResponse) GetHasMore() bool {
+func (x *UndoMovesRequest) GetGameId() string {
 	if x != nil {
-		return x.HasMore
+		return x.GameId
 	}
-	return false
+	return ""
 }
 
-func (x *ListMovesResponse) GetMoveGroups() []*GameMoveGroup {
+func (x *UndoMovesRequest) GetCount() int32 {
 	if x != nil {
-		return x.MoveGroups
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
 // *
-// Request to get all available options at a position
-type GetOptionsAtRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	Q             int32                  `protobuf:"varint,2,opt,name=q,proto3" json:"q,omitempty"`
-	R             int32                  `protobuf:"varint,3,opt,name=r,proto3" json:"r,omitempty"`
+// Response after undoing moves.
+type UndoMovesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// *
+	// The move groups that were undone and removed from the history, latest first
+	UndoneGroups []*GameMoveGroup `protobuf:"bytes,1,rep,name=undone_groups,json=undoneGroups,proto3" json:"undone_groups,omitempty"`
+	// *
+	// The inverse changes that were applied to the world, in the order they were applied
+	Changes       []*WorldChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOptionsAtRequest) Reset() {
-	*x = GetOptionsAtRequest{}
+func (x *UndoMovesResponse) Reset() {
+	*x = UndoMovesResponse{}
 	mi := &file_weewar_v1_games_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOptionsAtRequest) String() string {
+func (x *UndoMovesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOptionsAtRequest) ProtoMessage() {}
+func (*UndoMovesResponse) ProtoMessage() {}
 
-func (x *GetOptionsAtRequest) ProtoReflect() protoreflect.Message {
+func (x *UndoMovesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_weewar_v1_games_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1265,57 +1358,58 @@ func (x *GetOptionsAtRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOptionsAtRequest.ProtoReflect.Descriptor instead.
-func (*GetOptionsAtRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UndoMovesResponse.ProtoReflect.Descriptor instead.
+func (*UndoMovesResponse) Descriptor() ([]byte, []int) {
 	return file_weewar_v1_games_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetOptionsAtRequest) GetGameId() string {
-	if x != nil {
-		return x.GameId
-	}
-	return ""
-}
-
-func (x *GetOptionsAtRequest) GetQ() int32 {
+func (x *UndoMovesResponse) GetUndoneGroups() []*GameMoveGroup {
 	if x != nil {
-		return x.Q
+		return x.UndoneGroups
 	}
-	return 0
+	return nil
 }
 
-func (x *GetOptionsAtRequest) GetR() int32 {
+func (x *UndoMovesResponse) GetChanges() []*WorldChange {
 	if x != nil {
-		return x.R
+		return x.Changes
 	}
-	return 0
+	return nil
 }
 
 // *
-// Response with all available options at a position
-type GetOptionsAtResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Options         []*GameOption          `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
-	CurrentPlayer   int32                  `protobuf:"varint,2,opt,name=current_player,json=currentPlayer,proto3" json:"current_player,omitempty"`       // debug: current player in game
-	GameInitialized bool                   `protobuf:"varint,3,opt,name=game_initialized,json=gameInitialized,proto3" json:"game_initialized,omitempty"` // debug: whether game is properly initialized
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// Request to subscribe to a game's updates.
+type SubscribeGameRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// *
+	// Game ID to subscribe to
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// *
+	// Sequence number of the last move the client has seen.  Move groups in the
+	// history with later moves are sent before live updates.  0 replays the
+	// whole history.
+	FromSequenceNum int64 `protobuf:"varint,2,opt,name=from_sequence_num,json=fromSequenceNum,proto3" json:"from_sequence_num,omitempty"`
+	// *
+	// Player whose view the updates are filtered to when fog of war is enabled
+	Player        int32 `protobuf:"varint,3,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOptionsAtResponse) Reset() {
-	*x = GetOptionsAtResponse{}
+func (x *SubscribeGameRequest) Reset() {
+	*x = SubscribeGameRequest{}
 	mi := &file_weewar_v1_games_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOptionsAtResponse) String() string {
+func (x *SubscribeGameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOptionsAtResponse) ProtoMessage() {}
+func (*SubscribeGameRequest) ProtoMessage() {}
 
-func (x *GetOptionsAtResponse) ProtoReflect() protoreflect.Message {
+func (x *SubscribeGameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_weewar_v1_games_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1327,62 +1421,60 @@ func (x *GetOptionsAtResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOptionsAtResponse.ProtoReflect.Descriptor instead.
-func (*GetOptionsAtResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeGameRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeGameRequest) Descriptor() ([]byte, []int) {
 	return file_weewar_v1_games_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetOptionsAtResponse) GetOptions() []*GameOption {
+func (x *SubscribeGameRequest) GetGameId() string {
 	if x != nil {
-		return x.Options
+		return x.GameId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetOptionsAtResponse) GetCurrentPlayer() int32 {
+func (x *SubscribeGameRequest) GetFromSequenceNum() int64 {
 	if x != nil {
-		return x.CurrentPlayer
+		return x.FromSequenceNum
 	}
 	return 0
 }
 
-func (x *GetOptionsAtResponse) GetGameInitialized() bool {
+func (x *SubscribeGameRequest) GetPlayer() int32 {
 	if x != nil {
-		return x.GameInitialized
+		return x.Player
 	}
-	return false
+	return 0
 }
 
 // *
-// A single game option available at a position
-type GameOption struct {
+// An update to a subscribed game.  Exactly one of the fields is set.
+type SubscribeGameResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to OptionType:
-	//
-	//	*GameOption_Move
-	//	*GameOption_Attack
-	//	*GameOption_EndTurn
-	//	*GameOption_Build
-	//	*GameOption_Capture
-	OptionType    isGameOption_OptionType `protobuf_oneof:"option_type"`
+	// *
+	// A move group that was committed to the game
+	MoveGroup *GameMoveGroup `protobuf:"bytes,1,opt,name=move_group,json=moveGroup,proto3" json:"move_group,omitempty"`
+	// *
+	// Move groups that were undone.  Clients should apply the inverse changes.
+	Undo          *UndoMovesResponse `protobuf:"bytes,2,opt,name=undo,proto3" json:"undo,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameOption) Reset() {
-	*x = GameOption{}
+func (x *SubscribeGameResponse) Reset() {
+	*x = SubscribeGameResponse{}
 	mi := &file_weewar_v1_games_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameOption) String() string {
+func (x *SubscribeGameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameOption) ProtoMessage() {}
+func (*SubscribeGameResponse) ProtoMessage() {}
 
-func (x *GameOption) ProtoReflect() protoreflect.Message {
+func (x *SubscribeGameResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_weewar_v1_games_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1394,120 +1486,1191 @@ func (x *GameOption) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameOption.ProtoReflect.Descriptor instead.
-func (*GameOption) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeGameResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeGameResponse) Descriptor() ([]byte, []int) {
 	return file_weewar_v1_games_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GameOption) GetOptionType() isGameOption_OptionType {
+func (x *SubscribeGameResponse) GetMoveGroup() *GameMoveGroup {
 	if x != nil {
-		return x.OptionType
+		return x.MoveGroup
 	}
 	return nil
 }
 
-func (x *GameOption) GetMove() *MoveOption {
+func (x *SubscribeGameResponse) GetUndo() *UndoMovesResponse {
 	if x != nil {
-		if x, ok := x.OptionType.(*GameOption_Move); ok {
-			return x.Move
-		}
+		return x.Undo
 	}
 	return nil
 }
 
-func (x *GameOption) GetAttack() *AttackOption {
-	if x != nil {
-		if x, ok := x.OptionType.(*GameOption_Attack); ok {
-			return x.Attack
-		}
-	}
-	return nil
+// *
+// Request to get the game's latest state
+type GetGameStateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// *
+	// Game ID to add moves to
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// *
+	// Player whose view of the game state to return.
+	// Required when fog of war is enabled.
+	Player        int32 `protobuf:"varint,2,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameOption) GetEndTurn() *EndTurnOption {
-	if x != nil {
-		if x, ok := x.OptionType.(*GameOption_EndTurn); ok {
-			return x.EndTurn
-		}
-	}
-	return nil
+func (x *GetGameStateRequest) Reset() {
+	*x = GetGameStateRequest{}
+	mi := &file_weewar_v1_games_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameOption) GetBuild() *BuildUnitOption {
-	if x != nil {
-		if x, ok := x.OptionType.(*GameOption_Build); ok {
-			return x.Build
-		}
-	}
-	return nil
+func (x *GetGameStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GameOption) GetCapture() *CaptureBuildingOption {
+func (*GetGameStateRequest) ProtoMessage() {}
+
+func (x *GetGameStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_games_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameStateRequest.ProtoReflect.Descriptor instead.
+func (*GetGameStateRequest) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_games_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetGameStateRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GetGameStateRequest) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+// *
+// Response holding latest game state
+type GetGameStateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	State *GameState             `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// Seconds left in the current turn or -1 if turns are not timed
+	TurnTimeRemaining int32 `protobuf:"varint,2,opt,name=turn_time_remaining,json=turnTimeRemaining,proto3" json:"turn_time_remaining,omitempty"`
+	// Each player's progress towards the game's victory conditions
+	VictoryProgress []*VictoryProgress `protobuf:"bytes,3,rep,name=victory_progress,json=victoryProgress,proto3" json:"victory_progress,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetGameStateResponse) Reset() {
+	*x = GetGameStateResponse{}
+	mi := &file_weewar_v1_games_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameStateResponse) ProtoMessage() {}
+
+func (x *GetGameStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_games_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameStateResponse.ProtoReflect.Descriptor instead.
+func (*GetGameStateResponse) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_games_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetGameStateResponse) GetState() *GameState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *GetGameStateResponse) GetTurnTimeRemaining() int32 {
+	if x != nil {
+		return x.TurnTimeRemaining
+	}
+	return 0
+}
+
+func (x *GetGameStateResponse) GetVictoryProgress() []*VictoryProgress {
+	if x != nil {
+		return x.VictoryProgress
+	}
+	return nil
+}
+
+// *
+// Request to list moves for a game
+type ListMovesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// *
+	// Game ID to add moves to
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Offset of the move to begin fetching from in reverse order from "latest".
+	// 0 => start from now
+	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// *
+	// Limit to last N moves (from offset).  if <= 0 return all moves
+	LastN int32 `protobuf:"varint,3,opt,name=last_n,json=lastN,proto3" json:"last_n,omitempty"`
+	// *
+	// Player whose view of the moves to return.
+	// Required when fog of war is enabled.
+	Player        int32 `protobuf:"varint,4,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMovesRequest) Reset() {
+	*x = ListMovesRequest{}
+	mi := &file_weewar_v1_games_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMovesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMovesRequest) ProtoMessage() {}
+
+func (x *ListMovesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_games_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMovesRequest.ProtoReflect.Descriptor instead.
+func (*ListMovesRequest) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_games_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListMovesRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ListMovesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListMovesRequest) GetLastN() int32 {
+	if x != nil {
+		return x.LastN
+	}
+	return 0
+}
+
+func (x *ListMovesRequest) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+// *
+// Response after adding moves to game.
+type ListMovesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether there are more moves before this
+	HasMore       bool             `protobuf:"varint,1,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	MoveGroups    []*GameMoveGroup `protobuf:"bytes,2,rep,name=move_groups,json=moveGroups,proto3" json:"move_groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMovesResponse) Reset() {
+	*x = ListMovesResponse{}
+	mi := &file_weewar_v1_games_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMovesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMovesResponse) ProtoMessage() {}
+
+func (x *ListMovesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_games_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMovesResponse.ProtoReflect.Descriptor instead.
+func (*ListMovesResponse) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_games_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListMovesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListMovesResponse) GetMoveGroups() []*GameMoveGroup {
+	if x != nil {
+		return x.MoveGroups
+	}
+	return nil
+}
+
+// *
+// Request to get all available options at a position
+type GetOptionsAtRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Q             int32                  `protobuf:"varint,2,opt,name=q,proto3" json:"q,omitempty"`
+	R             int32                  `protobuf:"varint,3,opt,name=r,proto3" json:"r,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOptionsAtRequest) Reset() {
+	*x = GetOptionsAtRequest{}
+	mi := &file_weewar_v1_games_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOptionsAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOptionsAtRequest) ProtoMessage() {}
+
+func (x *GetOptionsAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_games_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOptionsAtRequest.ProtoReflect.Descriptor instead.
+func (*GetOptionsAtRequest) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_games_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetOptionsAtRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GetOptionsAtRequest) GetQ() int32 {
+	if x != nil {
+		return x.Q
+	}
+	return 0
+}
+
+func (x *GetOptionsAtRequest) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+// *
+// Response with all available options at a position
+type GetOptionsAtResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Options         []*GameOption          `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	CurrentPlayer   int32                  `protobuf:"varint,2,opt,name=current_player,json=currentPlayer,proto3" json:"current_player,omitempty"`       // debug: current player in game
+	GameInitialized bool                   `protobuf:"varint,3,opt,name=game_initialized,json=gameInitialized,proto3" json:"game_initialized,omitempty"` // debug: whether game is properly initialized
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetOptionsAtResponse) Reset() {
+	*x = GetOptionsAtResponse{}
+	mi := &file_weewar_v1_games_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOptionsAtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOptionsAtResponse) ProtoMessage() {}
+
+func (x *GetOptionsAtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_games_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOptionsAtResponse.ProtoReflect.Descriptor instead.
+func (*GetOptionsAtResponse) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_games_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetOptionsAtResponse) GetOptions() []*GameOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *GetOptionsAtResponse) GetCurrentPlayer() int32 {
+	if x != nil {
+		return x.CurrentPlayer
+	}
+	return 0
+}
+
+func (x *GetOptionsAtResponse) GetGameInitialized() bool {
+	if x != nil {
+		return x.GameInitialized
+	}
+	return false
+}
+
+// *
+// Request for the cheapest path between two positions
+type GetPathRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	FromQ         int32                  `protobuf:"varint,2,opt,name=from_q,json=fromQ,proto3" json:"from_q,omitempty"`
+	FromR         int32                  `protobuf:"varint,3,opt,name=from_r,json=fromR,proto3" json:"from_r,omitempty"`
+	ToQ           int32                  `protobuf:"varint,4,opt,name=to_q,json=toQ,proto3" json:"to_q,omitempty"`
+	ToR           int32                  `protobuf:"varint,5,opt,name=to_r,json=toR,proto3" json:"to_r,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPathRequest) Reset() {
+	*x = GetPathRequest{}
+	mi := &file_weewar_v1_games_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPathRequest) ProtoMessage() {}
+
+func (x *GetPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_games_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPathRequest.ProtoReflect.Descriptor instead.
+func (*GetPathRequest) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_games_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetPathRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GetPathRequest) GetFromQ() int32 {
+	if x != nil {
+		return x.FromQ
+	}
+	return 0
+}
+
+func (x *GetPathRequest) GetFromR() int32 {
+	if x != nil {
+		return x.FromR
+	}
+	return 0
+}
+
+func (x *GetPathRequest) GetToQ() int32 {
+	if x != nil {
+		return x.ToQ
+	}
+	return 0
+}
+
+func (x *GetPathRequest) GetToR() int32 {
+	if x != nil {
+		return x.ToR
+	}
+	return 0
+}
+
+// *
+// The cheapest path for a unit between two positions
+type GetPathResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Steps after the starting position in the order they are moved through
+	Steps []*PathStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	// Total terrain cost of the path
+	TotalCost float64 `protobuf:"fixed64,2,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	// Movement points the move is charged (the total cost rounded)
+	MovementCost int32 `protobuf:"varint,3,opt,name=movement_cost,json=movementCost,proto3" json:"movement_cost,omitempty"`
+	// Whether the unit has enough movement left to make the move this turn
+	Reachable bool `protobuf:"varint,4,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	// Ready-to-use action object for ProcessMoves
+	Action        *MoveUnitAction `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPathResponse) Reset() {
+	*x = GetPathResponse{}
+	mi := &file_weewar_v1_games_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPathResponse) ProtoMessage() {}
+
+func (x *GetPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_games_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPathResponse.ProtoReflect.Descriptor instead.
+func (*GetPathResponse) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_games_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetPathResponse) GetSteps() []*PathStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *GetPathResponse) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *GetPathResponse) GetMovementCost() int32 {
+	if x != nil {
+		return x.MovementCost
+	}
+	return 0
+}
+
+func (x *GetPathResponse) GetReachable() bool {
+	if x != nil {
+		return x.Reachable
+	}
+	return false
+}
+
+func (x *GetPathResponse) GetAction() *MoveUnitAction {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+// *
+// A single step of a path
+type PathStep struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Q     int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R     int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	// Cost of entering this hex
+	Cost float64 `protobuf:"fixed64,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	// Cost of the path up to and including this hex
+	TotalCost     float64 `protobuf:"fixed64,4,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PathStep) Reset() {
+	*x = PathStep{}
+	mi := &file_weewar_v1_games_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PathStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathStep) ProtoMessage() {}
+
+func (x *PathStep) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_games_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathStep.ProtoReflect.Descriptor instead.
+func (*PathStep) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_games_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PathStep) GetQ() int32 {
+	if x != nil {
+		return x.Q
+	}
+	return 0
+}
+
+func (x *PathStep) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *PathStep) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *PathStep) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+// *
+// A single game option available at a position
+type GameOption struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to OptionType:
+	//
+	//	*GameOption_Move
+	//	*GameOption_Attack
+	//	*GameOption_EndTurn
+	//	*GameOption_Build
+	//	*GameOption_Capture
+	//	*GameOption_Load
+	//	*GameOption_Unload
+	//	*GameOption_Heal
+	//	*GameOption_ModifyTerrain
+	OptionType    isGameOption_OptionType `protobuf_oneof:"option_type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameOption) Reset() {
+	*x = GameOption{}
+	mi := &file_weewar_v1_games_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameOption) ProtoMessage() {}
+
+func (x *GameOption) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_games_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameOption.ProtoReflect.Descriptor instead.
+func (*GameOption) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_games_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GameOption) GetOptionType() isGameOption_OptionType {
+	if x != nil {
+		return x.OptionType
+	}
+	return nil
+}
+
+func (x *GameOption) GetMove() *MoveOption {
+	if x != nil {
+		if x, ok := x.OptionType.(*GameOption_Move); ok {
+			return x.Move
+		}
+	}
+	return nil
+}
+
+func (x *GameOption) GetAttack() *AttackOption {
+	if x != nil {
+		if x, ok := x.OptionType.(*GameOption_Attack); ok {
+			return x.Attack
+		}
+	}
+	return nil
+}
+
+func (x *GameOption) GetEndTurn() *EndTurnOption {
+	if x != nil {
+		if x, ok := x.OptionType.(*GameOption_EndTurn); ok {
+			return x.EndTurn
+		}
+	}
+	return nil
+}
+
+func (x *GameOption) GetBuild() *BuildUnitOption {
+	if x != nil {
+		if x, ok := x.OptionType.(*GameOption_Build); ok {
+			return x.Build
+		}
+	}
+	return nil
+}
+
+func (x *GameOption) GetCapture() *CaptureBuildingOption {
+	if x != nil {
+		if x, ok := x.OptionType.(*GameOption_Capture); ok {
+			return x.Capture
+		}
+	}
+	return nil
+}
+
+func (x *GameOption) GetLoad() *LoadUnitOption {
+	if x != nil {
+		if x, ok := x.OptionType.(*GameOption_Load); ok {
+			return x.Load
+		}
+	}
+	return nil
+}
+
+func (x *GameOption) GetUnload() *UnloadUnitOption {
+	if x != nil {
+		if x, ok := x.OptionType.(*GameOption_Unload); ok {
+			return x.Unload
+		}
+	}
+	return nil
+}
+
+func (x *GameOption) GetHeal() *HealUnitOption {
+	if x != nil {
+		if x, ok := x.OptionType.(*GameOption_Heal); ok {
+			return x.Heal
+		}
+	}
+	return nil
+}
+
+func (x *GameOption) GetModifyTerrain() *ModifyTerrainOption {
+	if x != nil {
+		if x, ok := x.OptionType.(*GameOption_ModifyTerrain); ok {
+			return x.ModifyTerrain
+		}
+	}
+	return nil
+}
+
+type isGameOption_OptionType interface {
+	isGameOption_OptionType()
+}
+
+type GameOption_Move struct {
+	Move *MoveOption `protobuf:"bytes,1,opt,name=move,proto3,oneof"`
+}
+
+type GameOption_Attack struct {
+	Attack *AttackOption `protobuf:"bytes,2,opt,name=attack,proto3,oneof"`
+}
+
+type GameOption_EndTurn struct {
+	EndTurn *EndTurnOption `protobuf:"bytes,3,opt,name=end_turn,json=endTurn,proto3,oneof"`
+}
+
+type GameOption_Build struct {
+	Build *BuildUnitOption `protobuf:"bytes,4,opt,name=build,proto3,oneof"`
+}
+
+type GameOption_Capture struct {
+	Capture *CaptureBuildingOption `protobuf:"bytes,5,opt,name=capture,proto3,oneof"`
+}
+
+type GameOption_Load struct {
+	Load *LoadUnitOption `protobuf:"bytes,6,opt,name=load,proto3,oneof"`
+}
+
+type GameOption_Unload struct {
+	Unload *UnloadUnitOption `protobuf:"bytes,7,opt,name=unload,proto3,oneof"`
+}
+
+type GameOption_Heal struct {
+	Heal *HealUnitOption `protobuf:"bytes,8,opt,name=heal,proto3,oneof"`
+}
+
+type GameOption_ModifyTerrain struct {
+	ModifyTerrain *ModifyTerrainOption `protobuf:"bytes,9,opt,name=modify_terrain,json=modifyTerrain,proto3,oneof"`
+}
+
+func (*GameOption_Move) isGameOption_OptionType() {}
+
+func (*GameOption_Attack) isGameOption_OptionType() {}
+
+func (*GameOption_EndTurn) isGameOption_OptionType() {}
+
+func (*GameOption_Build) isGameOption_OptionType() {}
+
+func (*GameOption_Capture) isGameOption_OptionType() {}
+
+func (*GameOption_Load) isGameOption_OptionType() {}
+
+func (*GameOption_Unload) isGameOption_OptionType() {}
+
+func (*GameOption_Heal) isGameOption_OptionType() {}
+
+func (*GameOption_ModifyTerrain) isGameOption_OptionType() {}
+
+// *
+// Option to end the current turn
+type EndTurnOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndTurnOption) Reset() {
+	*x = EndTurnOption{}
+	mi := &file_weewar_v1_games_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndTurnOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndTurnOption) ProtoMessage() {}
+
+func (x *EndTurnOption) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_games_proto_msgTypes[34]
 	if x != nil {
-		if x, ok := x.OptionType.(*GameOption_Capture); ok {
-			return x.Capture
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndTurnOption.ProtoReflect.Descriptor instead.
+func (*EndTurnOption) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_games_proto_rawDescGZIP(), []int{34}
+}
+
+// *
+// Option to move to a specific coordinate
+type MoveOption struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Q            int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R            int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	MovementCost int32                  `protobuf:"varint,3,opt,name=movement_cost,json=movementCost,proto3" json:"movement_cost,omitempty"`
+	// Ready-to-use action object for ProcessMoves
+	Action        *MoveUnitAction `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveOption) Reset() {
+	*x = MoveOption{}
+	mi := &file_weewar_v1_games_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveOption) ProtoMessage() {}
+
+func (x *MoveOption) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_games_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveOption.ProtoReflect.Descriptor instead.
+func (*MoveOption) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_games_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MoveOption) GetQ() int32 {
+	if x != nil {
+		return x.Q
+	}
+	return 0
+}
+
+func (x *MoveOption) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *MoveOption) GetMovementCost() int32 {
+	if x != nil {
+		return x.MovementCost
+	}
+	return 0
+}
+
+func (x *MoveOption) GetAction() *MoveUnitAction {
+	if x != nil {
+		return x.Action
 	}
 	return nil
 }
 
-type isGameOption_OptionType interface {
-	isGameOption_OptionType()
+// *
+// A possible attack target
+type AttackOption struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Q     int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R     int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	// Target unit type and health
+	TargetUnitType   int32 `protobuf:"varint,3,opt,name=target_unit_type,json=targetUnitType,proto3" json:"target_unit_type,omitempty"`
+	TargetUnitHealth int32 `protobuf:"varint,4,opt,name=target_unit_health,json=targetUnitHealth,proto3" json:"target_unit_health,omitempty"`
+	CanAttack        bool  `protobuf:"varint,5,opt,name=can_attack,json=canAttack,proto3" json:"can_attack,omitempty"`
+	DamageEstimate   int32 `protobuf:"varint,6,opt,name=damage_estimate,json=damageEstimate,proto3" json:"damage_estimate,omitempty"` // Estimated damage this attack would deal
+	// Ready-to-use action object for ProcessMoves
+	Action *AttackUnitAction `protobuf:"bytes,7,opt,name=action,proto3" json:"action,omitempty"`
+	// Predicted outcome of the attack with the target's counter attack
+	ExpectedDamageDealt float64 `protobuf:"fixed64,8,opt,name=expected_damage_dealt,json=expectedDamageDealt,proto3" json:"expected_damage_dealt,omitempty"`
+	ExpectedDamageTaken float64 `protobuf:"fixed64,9,opt,name=expected_damage_taken,json=expectedDamageTaken,proto3" json:"expected_damage_taken,omitempty"`
+	KillProbability     float64 `protobuf:"fixed64,10,opt,name=kill_probability,json=killProbability,proto3" json:"kill_probability,omitempty"` // Chance the target is destroyed
+	LossProbability     float64 `protobuf:"fixed64,11,opt,name=loss_probability,json=lossProbability,proto3" json:"loss_probability,omitempty"` // Chance the attacking unit is destroyed
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-type GameOption_Move struct {
-	Move *MoveOption `protobuf:"bytes,1,opt,name=move,proto3,oneof"`
+func (x *AttackOption) Reset() {
+	*x = AttackOption{}
+	mi := &file_weewar_v1_games_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type GameOption_Attack struct {
-	Attack *AttackOption `protobuf:"bytes,2,opt,name=attack,proto3,oneof"`
+func (x *AttackOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type GameOption_EndTurn struct {
-	EndTurn *EndTurnOption `protobuf:"bytes,3,opt,name=end_turn,json=endTurn,proto3,oneof"`
+func (*AttackOption) ProtoMessage() {}
+
+func (x *AttackOption) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_games_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type GameOption_Build struct {
-	Build *BuildUnitOption `protobuf:"bytes,4,opt,name=build,proto3,oneof"`
+// Deprecated: Use AttackOption.ProtoReflect.Descriptor instead.
+func (*AttackOption) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_games_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AttackOption) GetQ() int32 {
+	if x != nil {
+		return x.Q
+	}
+	return 0
+}
+
+func (x *AttackOption) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *AttackOption) GetTargetUnitType() int32 {
+	if x != nil {
+		return x.TargetUnitType
+	}
+	return 0
+}
+
+func (x *AttackOption) GetTargetUnitHealth() int32 {
+	if x != nil {
+		return x.TargetUnitHealth
+	}
+	return 0
+}
+
+func (x *AttackOption) GetCanAttack() bool {
+	if x != nil {
+		return x.CanAttack
+	}
+	return false
+}
+
+func (x *AttackOption) GetDamageEstimate() int32 {
+	if x != nil {
+		return x.DamageEstimate
+	}
+	return 0
+}
+
+func (x *AttackOption) GetAction() *AttackUnitAction {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *AttackOption) GetExpectedDamageDealt() float64 {
+	if x != nil {
+		return x.ExpectedDamageDealt
+	}
+	return 0
+}
+
+func (x *AttackOption) GetExpectedDamageTaken() float64 {
+	if x != nil {
+		return x.ExpectedDamageTaken
+	}
+	return 0
+}
+
+func (x *AttackOption) GetKillProbability() float64 {
+	if x != nil {
+		return x.KillProbability
+	}
+	return 0
+}
+
+func (x *AttackOption) GetLossProbability() float64 {
+	if x != nil {
+		return x.LossProbability
+	}
+	return 0
+}
+
+// *
+// An option to build a unit (at a city tile)
+type BuildUnitOption struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Q         int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R         int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	TileType  int32                  `protobuf:"varint,3,opt,name=tile_type,json=tileType,proto3" json:"tile_type,omitempty"`
+	BuildCost int32                  `protobuf:"varint,4,opt,name=build_cost,json=buildCost,proto3" json:"build_cost,omitempty"`
+	UnitType  int32                  `protobuf:"varint,5,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"`
+	// Ready-to-use action object for ProcessMoves
+	Action        *BuildUnitAction `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildUnitOption) Reset() {
+	*x = BuildUnitOption{}
+	mi := &file_weewar_v1_games_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildUnitOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildUnitOption) ProtoMessage() {}
+
+func (x *BuildUnitOption) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_games_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildUnitOption.ProtoReflect.Descriptor instead.
+func (*BuildUnitOption) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_games_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BuildUnitOption) GetQ() int32 {
+	if x != nil {
+		return x.Q
+	}
+	return 0
+}
+
+func (x *BuildUnitOption) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *BuildUnitOption) GetTileType() int32 {
+	if x != nil {
+		return x.TileType
+	}
+	return 0
 }
 
-type GameOption_Capture struct {
-	Capture *CaptureBuildingOption `protobuf:"bytes,5,opt,name=capture,proto3,oneof"`
+func (x *BuildUnitOption) GetBuildCost() int32 {
+	if x != nil {
+		return x.BuildCost
+	}
+	return 0
 }
 
-func (*GameOption_Move) isGameOption_OptionType() {}
-
-func (*GameOption_Attack) isGameOption_OptionType() {}
-
-func (*GameOption_EndTurn) isGameOption_OptionType() {}
-
-func (*GameOption_Build) isGameOption_OptionType() {}
+func (x *BuildUnitOption) GetUnitType() int32 {
+	if x != nil {
+		return x.UnitType
+	}
+	return 0
+}
 
-func (*GameOption_Capture) isGameOption_OptionType() {}
+func (x *BuildUnitOption) GetAction() *BuildUnitAction {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
 
 // *
-// Option to end the current turn
-type EndTurnOption struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// A move where a unit can capture a building
+type CaptureBuildingOption struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Q        int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R        int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	TileType int32                  `protobuf:"varint,3,opt,name=tile_type,json=tileType,proto3" json:"tile_type,omitempty"`
+	// Turns of capture progress so far and turns needed to complete the capture
+	CaptureProgress int32 `protobuf:"varint,4,opt,name=capture_progress,json=captureProgress,proto3" json:"capture_progress,omitempty"`
+	CaptureTurns    int32 `protobuf:"varint,5,opt,name=capture_turns,json=captureTurns,proto3" json:"capture_turns,omitempty"`
+	// Ready-to-use action object for ProcessMoves
+	Action        *CaptureBuildingAction `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EndTurnOption) Reset() {
-	*x = EndTurnOption{}
-	mi := &file_weewar_v1_games_proto_msgTypes[24]
+func (x *CaptureBuildingOption) Reset() {
+	*x = CaptureBuildingOption{}
+	mi := &file_weewar_v1_games_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EndTurnOption) String() string {
+func (x *CaptureBuildingOption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EndTurnOption) ProtoMessage() {}
+func (*CaptureBuildingOption) ProtoMessage() {}
 
-func (x *EndTurnOption) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_games_proto_msgTypes[24]
+func (x *CaptureBuildingOption) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_games_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1518,39 +2681,89 @@ func (x *EndTurnOption) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EndTurnOption.ProtoReflect.Descriptor instead.
-func (*EndTurnOption) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_games_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use CaptureBuildingOption.ProtoReflect.Descriptor instead.
+func (*CaptureBuildingOption) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_games_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CaptureBuildingOption) GetQ() int32 {
+	if x != nil {
+		return x.Q
+	}
+	return 0
+}
+
+func (x *CaptureBuildingOption) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *CaptureBuildingOption) GetTileType() int32 {
+	if x != nil {
+		return x.TileType
+	}
+	return 0
+}
+
+func (x *CaptureBuildingOption) GetCaptureProgress() int32 {
+	if x != nil {
+		return x.CaptureProgress
+	}
+	return 0
+}
+
+func (x *CaptureBuildingOption) GetCaptureTurns() int32 {
+	if x != nil {
+		return x.CaptureTurns
+	}
+	return 0
+}
+
+func (x *CaptureBuildingOption) GetAction() *CaptureBuildingAction {
+	if x != nil {
+		return x.Action
+	}
+	return nil
 }
 
 // *
-// Option to move to a specific coordinate
-type MoveOption struct {
-	state        protoimpl.MessageState `protogen:"open.v1"`
-	Q            int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
-	R            int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
-	MovementCost int32                  `protobuf:"varint,3,opt,name=movement_cost,json=movementCost,proto3" json:"movement_cost,omitempty"`
+// A terrain action the unit can work on at a hex
+type ModifyTerrainOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Q             int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R             int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	TerrainAction string                 `protobuf:"bytes,3,opt,name=terrain_action,json=terrainAction,proto3" json:"terrain_action,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// Terrain type the tile will turn into
+	NewTileType int32 `protobuf:"varint,5,opt,name=new_tile_type,json=newTileType,proto3" json:"new_tile_type,omitempty"`
+	// Turns of work done so far and turns needed to complete the change
+	Progress int32 `protobuf:"varint,6,opt,name=progress,proto3" json:"progress,omitempty"`
+	Turns    int32 `protobuf:"varint,7,opt,name=turns,proto3" json:"turns,omitempty"`
+	// Coins charged to start the work (0 if work is already under way)
+	Coins int32 `protobuf:"varint,8,opt,name=coins,proto3" json:"coins,omitempty"`
 	// Ready-to-use action object for ProcessMoves
-	Action        *MoveUnitAction `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Action        *ModifyTerrainAction `protobuf:"bytes,9,opt,name=action,proto3" json:"action,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MoveOption) Reset() {
-	*x = MoveOption{}
-	mi := &file_weewar_v1_games_proto_msgTypes[25]
+func (x *ModifyTerrainOption) Reset() {
+	*x = ModifyTerrainOption{}
+	mi := &file_weewar_v1_games_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveOption) String() string {
+func (x *ModifyTerrainOption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveOption) ProtoMessage() {}
+func (*ModifyTerrainOption) ProtoMessage() {}
 
-func (x *MoveOption) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_games_proto_msgTypes[25]
+func (x *ModifyTerrainOption) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_games_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1561,33 +2774,68 @@ func (x *MoveOption) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveOption.ProtoReflect.Descriptor instead.
-func (*MoveOption) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_games_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ModifyTerrainOption.ProtoReflect.Descriptor instead.
+func (*ModifyTerrainOption) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_games_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *MoveOption) GetQ() int32 {
+func (x *ModifyTerrainOption) GetQ() int32 {
 	if x != nil {
 		return x.Q
 	}
 	return 0
 }
 
-func (x *MoveOption) GetR() int32 {
+func (x *ModifyTerrainOption) GetR() int32 {
 	if x != nil {
 		return x.R
 	}
 	return 0
 }
 
-func (x *MoveOption) GetMovementCost() int32 {
+func (x *ModifyTerrainOption) GetTerrainAction() string {
 	if x != nil {
-		return x.MovementCost
+		return x.TerrainAction
+	}
+	return ""
+}
+
+func (x *ModifyTerrainOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ModifyTerrainOption) GetNewTileType() int32 {
+	if x != nil {
+		return x.NewTileType
 	}
 	return 0
 }
 
-func (x *MoveOption) GetAction() *MoveUnitAction {
+func (x *ModifyTerrainOption) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *ModifyTerrainOption) GetTurns() int32 {
+	if x != nil {
+		return x.Turns
+	}
+	return 0
+}
+
+func (x *ModifyTerrainOption) GetCoins() int32 {
+	if x != nil {
+		return x.Coins
+	}
+	return 0
+}
+
+func (x *ModifyTerrainOption) GetAction() *ModifyTerrainAction {
 	if x != nil {
 		return x.Action
 	}
@@ -1595,37 +2843,34 @@ func (x *MoveOption) GetAction() *MoveUnitAction {
 }
 
 // *
-// A possible attack target
-type AttackOption struct {
+// An adjacent transport the unit can board
+type LoadUnitOption struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	Q     int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
-	R     int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
-	// Target unit type and health
-	TargetUnitType   int32 `protobuf:"varint,3,opt,name=target_unit_type,json=targetUnitType,proto3" json:"target_unit_type,omitempty"`
-	TargetUnitHealth int32 `protobuf:"varint,4,opt,name=target_unit_health,json=targetUnitHealth,proto3" json:"target_unit_health,omitempty"`
-	CanAttack        bool  `protobuf:"varint,5,opt,name=can_attack,json=canAttack,proto3" json:"can_attack,omitempty"`
-	DamageEstimate   int32 `protobuf:"varint,6,opt,name=damage_estimate,json=damageEstimate,proto3" json:"damage_estimate,omitempty"` // Estimated damage this attack would deal
+	// Position and type of the transport
+	Q                 int32 `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R                 int32 `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	TransportUnitType int32 `protobuf:"varint,3,opt,name=transport_unit_type,json=transportUnitType,proto3" json:"transport_unit_type,omitempty"`
 	// Ready-to-use action object for ProcessMoves
-	Action        *AttackUnitAction `protobuf:"bytes,7,opt,name=action,proto3" json:"action,omitempty"`
+	Action        *LoadUnitAction `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttackOption) Reset() {
-	*x = AttackOption{}
-	mi := &file_weewar_v1_games_proto_msgTypes[26]
+func (x *LoadUnitOption) Reset() {
+	*x = LoadUnitOption{}
+	mi := &file_weewar_v1_games_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttackOption) String() string {
+func (x *LoadUnitOption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttackOption) ProtoMessage() {}
+func (*LoadUnitOption) ProtoMessage() {}
 
-func (x *AttackOption) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_games_proto_msgTypes[26]
+func (x *LoadUnitOption) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_games_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1636,54 +2881,33 @@ func (x *AttackOption) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttackOption.ProtoReflect.Descriptor instead.
-func (*AttackOption) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_games_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use LoadUnitOption.ProtoReflect.Descriptor instead.
+func (*LoadUnitOption) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_games_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *AttackOption) GetQ() int32 {
+func (x *LoadUnitOption) GetQ() int32 {
 	if x != nil {
 		return x.Q
 	}
 	return 0
 }
 
-func (x *AttackOption) GetR() int32 {
+func (x *LoadUnitOption) GetR() int32 {
 	if x != nil {
 		return x.R
 	}
 	return 0
 }
 
-func (x *AttackOption) GetTargetUnitType() int32 {
-	if x != nil {
-		return x.TargetUnitType
-	}
-	return 0
-}
-
-func (x *AttackOption) GetTargetUnitHealth() int32 {
-	if x != nil {
-		return x.TargetUnitHealth
-	}
-	return 0
-}
-
-func (x *AttackOption) GetCanAttack() bool {
-	if x != nil {
-		return x.CanAttack
-	}
-	return false
-}
-
-func (x *AttackOption) GetDamageEstimate() int32 {
+func (x *LoadUnitOption) GetTransportUnitType() int32 {
 	if x != nil {
-		return x.DamageEstimate
+		return x.TransportUnitType
 	}
 	return 0
 }
 
-func (x *AttackOption) GetAction() *AttackUnitAction {
+func (x *LoadUnitOption) GetAction() *LoadUnitAction {
 	if x != nil {
 		return x.Action
 	}
@@ -1691,32 +2915,35 @@ func (x *AttackOption) GetAction() *AttackUnitAction {
 }
 
 // *
-// An option to build a unit (at a city tile)
-type BuildUnitOption struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Q             int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
-	R             int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
-	TileType      int32                  `protobuf:"varint,3,opt,name=tile_type,json=tileType,proto3" json:"tile_type,omitempty"`
-	BuildCost     int32                  `protobuf:"varint,4,opt,name=build_cost,json=buildCost,proto3" json:"build_cost,omitempty"`
+// A hex a transport can unload one of its carried units to
+type UnloadUnitOption struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Q     int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R     int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	// Index and type of the carried unit
+	CargoIndex int32 `protobuf:"varint,3,opt,name=cargo_index,json=cargoIndex,proto3" json:"cargo_index,omitempty"`
+	UnitType   int32 `protobuf:"varint,4,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"`
+	// Ready-to-use action object for ProcessMoves
+	Action        *UnloadUnitAction `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuildUnitOption) Reset() {
-	*x = BuildUnitOption{}
-	mi := &file_weewar_v1_games_proto_msgTypes[27]
+func (x *UnloadUnitOption) Reset() {
+	*x = UnloadUnitOption{}
+	mi := &file_weewar_v1_games_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildUnitOption) String() string {
+func (x *UnloadUnitOption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildUnitOption) ProtoMessage() {}
+func (*UnloadUnitOption) ProtoMessage() {}
 
-func (x *BuildUnitOption) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_games_proto_msgTypes[27]
+func (x *UnloadUnitOption) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_games_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1727,65 +2954,76 @@ func (x *BuildUnitOption) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildUnitOption.ProtoReflect.Descriptor instead.
-func (*BuildUnitOption) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_games_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use UnloadUnitOption.ProtoReflect.Descriptor instead.
+func (*UnloadUnitOption) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_games_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *BuildUnitOption) GetQ() int32 {
+func (x *UnloadUnitOption) GetQ() int32 {
 	if x != nil {
 		return x.Q
 	}
 	return 0
 }
 
-func (x *BuildUnitOption) GetR() int32 {
+func (x *UnloadUnitOption) GetR() int32 {
 	if x != nil {
 		return x.R
 	}
 	return 0
 }
 
-func (x *BuildUnitOption) GetTileType() int32 {
+func (x *UnloadUnitOption) GetCargoIndex() int32 {
 	if x != nil {
-		return x.TileType
+		return x.CargoIndex
 	}
 	return 0
 }
 
-func (x *BuildUnitOption) GetBuildCost() int32 {
+func (x *UnloadUnitOption) GetUnitType() int32 {
 	if x != nil {
-		return x.BuildCost
+		return x.UnitType
 	}
 	return 0
 }
 
+func (x *UnloadUnitOption) GetAction() *UnloadUnitAction {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
 // *
-// A move where a unit can capture a building
-type CaptureBuildingOption struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Q             int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
-	R             int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
-	TileType      int32                  `protobuf:"varint,3,opt,name=tile_type,json=tileType,proto3" json:"tile_type,omitempty"`
+// Option to heal an adjacent friendly unit
+type HealUnitOption struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Q        int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R        int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	UnitType int32                  `protobuf:"varint,3,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"`
+	// Health the target would regain
+	Amount int32 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Ready-to-use action object for ProcessMoves
+	Action        *HealUnitAction `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CaptureBuildingOption) Reset() {
-	*x = CaptureBuildingOption{}
-	mi := &file_weewar_v1_games_proto_msgTypes[28]
+func (x *HealUnitOption) Reset() {
+	*x = HealUnitOption{}
+	mi := &file_weewar_v1_games_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CaptureBuildingOption) String() string {
+func (x *HealUnitOption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CaptureBuildingOption) ProtoMessage() {}
+func (*HealUnitOption) ProtoMessage() {}
 
-func (x *CaptureBuildingOption) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_games_proto_msgTypes[28]
+func (x *HealUnitOption) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_games_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1796,32 +3034,46 @@ func (x *CaptureBuildingOption) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CaptureBuildingOption.ProtoReflect.Descriptor instead.
-func (*CaptureBuildingOption) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_games_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use HealUnitOption.ProtoReflect.Descriptor instead.
+func (*HealUnitOption) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_games_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *CaptureBuildingOption) GetQ() int32 {
+func (x *HealUnitOption) GetQ() int32 {
 	if x != nil {
 		return x.Q
 	}
 	return 0
 }
 
-func (x *CaptureBuildingOption) GetR() int32 {
+func (x *HealUnitOption) GetR() int32 {
 	if x != nil {
 		return x.R
 	}
 	return 0
 }
 
-func (x *CaptureBuildingOption) GetTileType() int32 {
+func (x *HealUnitOption) GetUnitType() int32 {
 	if x != nil {
-		return x.TileType
+		return x.UnitType
+	}
+	return 0
+}
+
+func (x *HealUnitOption) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
 	}
 	return 0
 }
 
+func (x *HealUnitOption) GetAction() *HealUnitAction {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
 var File_weewar_v1_games_proto protoreflect.FileDescriptor
 
 const file_weewar_v1_games_proto_rawDesc = "" +
@@ -1847,10 +3099,11 @@ const file_weewar_v1_games_proto_rawDesc = "" +
 	"\x05items\x18\x01 \x03(\v2\x0f.weewar.v1.GameR\x05items\x12=\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x1d.weewar.v1.PaginationResponseR\n" +
-	"pagination\":\n" +
+	"pagination\"R\n" +
 	"\x0eGetGameRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\"\x98\x01\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x16\n" +
+	"\x06player\x18\x03 \x01(\x05R\x06player\"\x98\x01\n" +
 	"\x0fGetGameResponse\x12#\n" +
 	"\x04game\x18\x01 \x01(\v2\x0f.weewar.v1.GameR\x04game\x12*\n" +
 	"\x05state\x18\x02 \x01(\v2\x14.weewar.v1.GameStateR\x05state\x124\n" +
@@ -1894,21 +3147,59 @@ const file_weewar_v1_games_proto_rawDesc = "" +
 	"\ffield_errors\x18\x03 \x03(\v2..weewar.v1.CreateGameResponse.FieldErrorsEntryR\vfieldErrors\x1a>\n" +
 	"\x10FieldErrorsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Y\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x96\x01\n" +
 	"\x13ProcessMovesRequest\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12)\n" +
-	"\x05moves\x18\x03 \x03(\v2\x13.weewar.v1.GameMoveR\x05moves\"\x86\x01\n" +
+	"\x05moves\x18\x03 \x03(\v2\x13.weewar.v1.GameMoveR\x05moves\x12;\n" +
+	"\x1aexpected_last_sequence_num\x18\x04 \x01(\x03R\x17expectedLastSequenceNum\"\x86\x01\n" +
 	"\x14ProcessMovesResponse\x12<\n" +
 	"\fmove_results\x18\x01 \x03(\v2\x19.weewar.v1.GameMoveResultR\vmoveResults\x120\n" +
-	"\achanges\x18\x02 \x03(\v2\x16.weewar.v1.WorldChangeR\achanges\".\n" +
+	"\achanges\x18\x02 \x03(\v2\x16.weewar.v1.WorldChangeR\achanges\",\n" +
+	"\x11VerifyGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\"\xbb\x01\n" +
+	"\x12VerifyGameResponse\x12\x1a\n" +
+	"\bverified\x18\x01 \x01(\bR\bverified\x12'\n" +
+	"\x0fgroups_replayed\x18\x02 \x01(\x05R\x0egroupsReplayed\x12%\n" +
+	"\x0emoves_replayed\x18\x03 \x01(\x05R\rmovesReplayed\x129\n" +
+	"\n" +
+	"divergence\x18\x04 \x01(\v2\x19.weewar.v1.GameDivergenceR\n" +
+	"divergence\"\xac\x02\n" +
+	"\x0eGameDivergence\x12\x1f\n" +
+	"\vgroup_index\x18\x01 \x01(\x05R\n" +
+	"groupIndex\x12\x1d\n" +
+	"\n" +
+	"move_index\x18\x02 \x01(\x05R\tmoveIndex\x12!\n" +
+	"\fchange_index\x18\x03 \x01(\x05R\vchangeIndex\x12!\n" +
+	"\fsequence_num\x18\x04 \x01(\x03R\vsequenceNum\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12?\n" +
+	"\x0fexpected_change\x18\x06 \x01(\v2\x16.weewar.v1.WorldChangeR\x0eexpectedChange\x12;\n" +
+	"\ractual_change\x18\a \x01(\v2\x16.weewar.v1.WorldChangeR\factualChange\"A\n" +
+	"\x10UndoMovesRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x84\x01\n" +
+	"\x11UndoMovesResponse\x12=\n" +
+	"\rundone_groups\x18\x01 \x03(\v2\x18.weewar.v1.GameMoveGroupR\fundoneGroups\x120\n" +
+	"\achanges\x18\x02 \x03(\v2\x16.weewar.v1.WorldChangeR\achanges\"s\n" +
+	"\x14SubscribeGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12*\n" +
+	"\x11from_sequence_num\x18\x02 \x01(\x03R\x0ffromSequenceNum\x12\x16\n" +
+	"\x06player\x18\x03 \x01(\x05R\x06player\"\x82\x01\n" +
+	"\x15SubscribeGameResponse\x127\n" +
+	"\n" +
+	"move_group\x18\x01 \x01(\v2\x18.weewar.v1.GameMoveGroupR\tmoveGroup\x120\n" +
+	"\x04undo\x18\x02 \x01(\v2\x1c.weewar.v1.UndoMovesResponseR\x04undo\"F\n" +
 	"\x13GetGameStateRequest\x12\x17\n" +
-	"\agame_id\x18\x01 \x01(\tR\x06gameId\"B\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x16\n" +
+	"\x06player\x18\x02 \x01(\x05R\x06player\"\xb9\x01\n" +
 	"\x14GetGameStateResponse\x12*\n" +
-	"\x05state\x18\x01 \x01(\v2\x14.weewar.v1.GameStateR\x05state\"Z\n" +
+	"\x05state\x18\x01 \x01(\v2\x14.weewar.v1.GameStateR\x05state\x12.\n" +
+	"\x13turn_time_remaining\x18\x02 \x01(\x05R\x11turnTimeRemaining\x12E\n" +
+	"\x10victory_progress\x18\x03 \x03(\v2\x1a.weewar.v1.VictoryProgressR\x0fvictoryProgress\"r\n" +
 	"\x10ListMovesRequest\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x15\n" +
-	"\x06last_n\x18\x03 \x01(\x05R\x05lastN\"i\n" +
+	"\x06last_n\x18\x03 \x01(\x05R\x05lastN\x12\x16\n" +
+	"\x06player\x18\x04 \x01(\x05R\x06player\"i\n" +
 	"\x11ListMovesResponse\x12\x19\n" +
 	"\bhas_more\x18\x01 \x01(\bR\ahasMore\x129\n" +
 	"\vmove_groups\x18\x02 \x03(\v2\x18.weewar.v1.GameMoveGroupR\n" +
@@ -1920,14 +3211,37 @@ const file_weewar_v1_games_proto_rawDesc = "" +
 	"\x14GetOptionsAtResponse\x12/\n" +
 	"\aoptions\x18\x01 \x03(\v2\x15.weewar.v1.GameOptionR\aoptions\x12%\n" +
 	"\x0ecurrent_player\x18\x02 \x01(\x05R\rcurrentPlayer\x12)\n" +
-	"\x10game_initialized\x18\x03 \x01(\bR\x0fgameInitialized\"\xa4\x02\n" +
+	"\x10game_initialized\x18\x03 \x01(\bR\x0fgameInitialized\"}\n" +
+	"\x0eGetPathRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x15\n" +
+	"\x06from_q\x18\x02 \x01(\x05R\x05fromQ\x12\x15\n" +
+	"\x06from_r\x18\x03 \x01(\x05R\x05fromR\x12\x11\n" +
+	"\x04to_q\x18\x04 \x01(\x05R\x03toQ\x12\x11\n" +
+	"\x04to_r\x18\x05 \x01(\x05R\x03toR\"\xd1\x01\n" +
+	"\x0fGetPathResponse\x12)\n" +
+	"\x05steps\x18\x01 \x03(\v2\x13.weewar.v1.PathStepR\x05steps\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\x02 \x01(\x01R\ttotalCost\x12#\n" +
+	"\rmovement_cost\x18\x03 \x01(\x05R\fmovementCost\x12\x1c\n" +
+	"\treachable\x18\x04 \x01(\bR\treachable\x121\n" +
+	"\x06action\x18\x05 \x01(\v2\x19.weewar.v1.MoveUnitActionR\x06action\"Y\n" +
+	"\bPathStep\x12\f\n" +
+	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
+	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x12\n" +
+	"\x04cost\x18\x03 \x01(\x01R\x04cost\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\x04 \x01(\x01R\ttotalCost\"\x86\x04\n" +
 	"\n" +
 	"GameOption\x12+\n" +
 	"\x04move\x18\x01 \x01(\v2\x15.weewar.v1.MoveOptionH\x00R\x04move\x121\n" +
 	"\x06attack\x18\x02 \x01(\v2\x17.weewar.v1.AttackOptionH\x00R\x06attack\x125\n" +
 	"\bend_turn\x18\x03 \x01(\v2\x18.weewar.v1.EndTurnOptionH\x00R\aendTurn\x122\n" +
 	"\x05build\x18\x04 \x01(\v2\x1a.weewar.v1.BuildUnitOptionH\x00R\x05build\x12<\n" +
-	"\acapture\x18\x05 \x01(\v2 .weewar.v1.CaptureBuildingOptionH\x00R\acaptureB\r\n" +
+	"\acapture\x18\x05 \x01(\v2 .weewar.v1.CaptureBuildingOptionH\x00R\acapture\x12/\n" +
+	"\x04load\x18\x06 \x01(\v2\x19.weewar.v1.LoadUnitOptionH\x00R\x04load\x125\n" +
+	"\x06unload\x18\a \x01(\v2\x1b.weewar.v1.UnloadUnitOptionH\x00R\x06unload\x12/\n" +
+	"\x04heal\x18\b \x01(\v2\x19.weewar.v1.HealUnitOptionH\x00R\x04heal\x12G\n" +
+	"\x0emodify_terrain\x18\t \x01(\v2\x1e.weewar.v1.ModifyTerrainOptionH\x00R\rmodifyTerrainB\r\n" +
 	"\voption_type\"\x0f\n" +
 	"\rEndTurnOption\"\x80\x01\n" +
 	"\n" +
@@ -1935,7 +3249,7 @@ const file_weewar_v1_games_proto_rawDesc = "" +
 	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
 	"\x01r\x18\x02 \x01(\x05R\x01r\x12#\n" +
 	"\rmovement_cost\x18\x03 \x01(\x05R\fmovementCost\x121\n" +
-	"\x06action\x18\x04 \x01(\v2\x19.weewar.v1.MoveUnitActionR\x06action\"\xff\x01\n" +
+	"\x06action\x18\x04 \x01(\v2\x19.weewar.v1.MoveUnitActionR\x06action\"\xbd\x03\n" +
 	"\fAttackOption\x12\f\n" +
 	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
 	"\x01r\x18\x02 \x01(\x05R\x01r\x12(\n" +
@@ -1944,17 +3258,55 @@ const file_weewar_v1_games_proto_rawDesc = "" +
 	"\n" +
 	"can_attack\x18\x05 \x01(\bR\tcanAttack\x12'\n" +
 	"\x0fdamage_estimate\x18\x06 \x01(\x05R\x0edamageEstimate\x123\n" +
-	"\x06action\x18\a \x01(\v2\x1b.weewar.v1.AttackUnitActionR\x06action\"i\n" +
+	"\x06action\x18\a \x01(\v2\x1b.weewar.v1.AttackUnitActionR\x06action\x122\n" +
+	"\x15expected_damage_dealt\x18\b \x01(\x01R\x13expectedDamageDealt\x122\n" +
+	"\x15expected_damage_taken\x18\t \x01(\x01R\x13expectedDamageTaken\x12)\n" +
+	"\x10kill_probability\x18\n" +
+	" \x01(\x01R\x0fkillProbability\x12)\n" +
+	"\x10loss_probability\x18\v \x01(\x01R\x0flossProbability\"\xba\x01\n" +
 	"\x0fBuildUnitOption\x12\f\n" +
 	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
 	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n" +
 	"\ttile_type\x18\x03 \x01(\x05R\btileType\x12\x1d\n" +
 	"\n" +
-	"build_cost\x18\x04 \x01(\x05R\tbuildCost\"P\n" +
+	"build_cost\x18\x04 \x01(\x05R\tbuildCost\x12\x1b\n" +
+	"\tunit_type\x18\x05 \x01(\x05R\bunitType\x122\n" +
+	"\x06action\x18\x06 \x01(\v2\x1a.weewar.v1.BuildUnitActionR\x06action\"\xda\x01\n" +
 	"\x15CaptureBuildingOption\x12\f\n" +
 	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
 	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n" +
-	"\ttile_type\x18\x03 \x01(\x05R\btileType2\xab\b\n" +
+	"\ttile_type\x18\x03 \x01(\x05R\btileType\x12)\n" +
+	"\x10capture_progress\x18\x04 \x01(\x05R\x0fcaptureProgress\x12#\n" +
+	"\rcapture_turns\x18\x05 \x01(\x05R\fcaptureTurns\x128\n" +
+	"\x06action\x18\x06 \x01(\v2 .weewar.v1.CaptureBuildingActionR\x06action\"\x90\x02\n" +
+	"\x13ModifyTerrainOption\x12\f\n" +
+	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
+	"\x01r\x18\x02 \x01(\x05R\x01r\x12%\n" +
+	"\x0eterrain_action\x18\x03 \x01(\tR\rterrainAction\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\"\n" +
+	"\rnew_tile_type\x18\x05 \x01(\x05R\vnewTileType\x12\x1a\n" +
+	"\bprogress\x18\x06 \x01(\x05R\bprogress\x12\x14\n" +
+	"\x05turns\x18\a \x01(\x05R\x05turns\x12\x14\n" +
+	"\x05coins\x18\b \x01(\x05R\x05coins\x126\n" +
+	"\x06action\x18\t \x01(\v2\x1e.weewar.v1.ModifyTerrainActionR\x06action\"\x8f\x01\n" +
+	"\x0eLoadUnitOption\x12\f\n" +
+	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
+	"\x01r\x18\x02 \x01(\x05R\x01r\x12.\n" +
+	"\x13transport_unit_type\x18\x03 \x01(\x05R\x11transportUnitType\x121\n" +
+	"\x06action\x18\x04 \x01(\v2\x19.weewar.v1.LoadUnitActionR\x06action\"\xa1\x01\n" +
+	"\x10UnloadUnitOption\x12\f\n" +
+	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
+	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x1f\n" +
+	"\vcargo_index\x18\x03 \x01(\x05R\n" +
+	"cargoIndex\x12\x1b\n" +
+	"\tunit_type\x18\x04 \x01(\x05R\bunitType\x123\n" +
+	"\x06action\x18\x05 \x01(\v2\x1b.weewar.v1.UnloadUnitActionR\x06action\"\x94\x01\n" +
+	"\x0eHealUnitOption\x12\f\n" +
+	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
+	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n" +
+	"\tunit_type\x18\x03 \x01(\x05R\bunitType\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x05R\x06amount\x121\n" +
+	"\x06action\x18\x05 \x01(\v2\x19.weewar.v1.HealUnitActionR\x06action2\x8f\f\n" +
 	"\fGamesService\x12_\n" +
 	"\n" +
 	"CreateGame\x12\x1c.weewar.v1.CreateGameRequest\x1a\x1d.weewar.v1.CreateGameResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/games\x12_\n" +
@@ -1968,7 +3320,12 @@ const file_weewar_v1_games_proto_rawDesc = "" +
 	"\fGetGameState\x12\x1e.weewar.v1.GetGameStateRequest\x1a\x1f.weewar.v1.GetGameStateResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/games/{game_id}/state\x12i\n" +
 	"\tListMoves\x12\x1b.weewar.v1.ListMovesRequest\x1a\x1c.weewar.v1.ListMovesResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/games/{game_id}/moves\x12u\n" +
 	"\fProcessMoves\x12\x1e.weewar.v1.ProcessMovesRequest\x1a\x1f.weewar.v1.ProcessMovesResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/games/{game_id}/moves\x12|\n" +
-	"\fGetOptionsAt\x12\x1e.weewar.v1.GetOptionsAtRequest\x1a\x1f.weewar.v1.GetOptionsAtResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/games/{game_id}/options/{q}/{r}B\x9c\x01\n" +
+	"\fGetOptionsAt\x12\x1e.weewar.v1.GetOptionsAtRequest\x1a\x1f.weewar.v1.GetOptionsAtResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/games/{game_id}/options/{q}/{r}\x12\x82\x01\n" +
+	"\aGetPath\x12\x19.weewar.v1.GetPathRequest\x1a\x1a.weewar.v1.GetPathResponse\"@\x82\xd3\xe4\x93\x02:\x128/v1/games/{game_id}/path/{from_q}/{from_r}/{to_q}/{to_r}\x12m\n" +
+	"\n" +
+	"VerifyGame\x12\x1c.weewar.v1.VerifyGameRequest\x1a\x1d.weewar.v1.VerifyGameResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/games/{game_id}/verify\x12q\n" +
+	"\tUndoMoves\x12\x1b.weewar.v1.UndoMovesRequest\x1a\x1c.weewar.v1.UndoMovesResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/games/{game_id}/moves/undo\x12{\n" +
+	"\rSubscribeGame\x12\x1f.weewar.v1.SubscribeGameRequest\x1a .weewar.v1.SubscribeGameResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/games/{game_id}/subscribe0\x01B\x9c\x01\n" +
 	"\rcom.weewar.v1B\n" +
 	"GamesProtoP\x01Z:github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1\xa2\x02\x03WXX\xaa\x02\tWeewar.V1\xca\x02\tWeewar\\V1\xe2\x02\x15Weewar\\V1\\GPBMetadata\xea\x02\n" +
 	"Weewar::V1b\x06proto3"
@@ -1985,7 +3342,7 @@ func file_weewar_v1_games_proto_rawDescGZIP() []byte {
 	return file_weewar_v1_games_proto_rawDescData
 }
 
-var file_weewar_v1_games_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_weewar_v1_games_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_weewar_v1_games_proto_goTypes = []any{
 	(*GameInfo)(nil),               // 0: weewar.v1.GameInfo
 	(*ListGamesRequest)(nil),       // 1: weewar.v1.ListGamesRequest
@@ -2004,89 +3361,138 @@ var file_weewar_v1_games_proto_goTypes = []any{
 	(*CreateGameResponse)(nil),     // 14: weewar.v1.CreateGameResponse
 	(*ProcessMovesRequest)(nil),    // 15: weewar.v1.ProcessMovesRequest
 	(*ProcessMovesResponse)(nil),   // 16: weewar.v1.ProcessMovesResponse
-	(*GetGameStateRequest)(nil),    // 17: weewar.v1.GetGameStateRequest
-	(*GetGameStateResponse)(nil),   // 18: weewar.v1.GetGameStateResponse
-	(*ListMovesRequest)(nil),       // 19: weewar.v1.ListMovesRequest
-	(*ListMovesResponse)(nil),      // 20: weewar.v1.ListMovesResponse
-	(*GetOptionsAtRequest)(nil),    // 21: weewar.v1.GetOptionsAtRequest
-	(*GetOptionsAtResponse)(nil),   // 22: weewar.v1.GetOptionsAtResponse
-	(*GameOption)(nil),             // 23: weewar.v1.GameOption
-	(*EndTurnOption)(nil),          // 24: weewar.v1.EndTurnOption
-	(*MoveOption)(nil),             // 25: weewar.v1.MoveOption
-	(*AttackOption)(nil),           // 26: weewar.v1.AttackOption
-	(*BuildUnitOption)(nil),        // 27: weewar.v1.BuildUnitOption
-	(*CaptureBuildingOption)(nil),  // 28: weewar.v1.CaptureBuildingOption
-	nil,                            // 29: weewar.v1.GetGamesResponse.GamesEntry
-	nil,                            // 30: weewar.v1.CreateGameResponse.FieldErrorsEntry
-	(*Pagination)(nil),             // 31: weewar.v1.Pagination
-	(*Game)(nil),                   // 32: weewar.v1.Game
-	(*PaginationResponse)(nil),     // 33: weewar.v1.PaginationResponse
-	(*GameState)(nil),              // 34: weewar.v1.GameState
-	(*GameMoveHistory)(nil),        // 35: weewar.v1.GameMoveHistory
-	(*fieldmaskpb.FieldMask)(nil),  // 36: google.protobuf.FieldMask
-	(*GameMove)(nil),               // 37: weewar.v1.GameMove
-	(*GameMoveResult)(nil),         // 38: weewar.v1.GameMoveResult
-	(*WorldChange)(nil),            // 39: weewar.v1.WorldChange
-	(*GameMoveGroup)(nil),          // 40: weewar.v1.GameMoveGroup
-	(*MoveUnitAction)(nil),         // 41: weewar.v1.MoveUnitAction
-	(*AttackUnitAction)(nil),       // 42: weewar.v1.AttackUnitAction
+	(*VerifyGameRequest)(nil),      // 17: weewar.v1.VerifyGameRequest
+	(*VerifyGameResponse)(nil),     // 18: weewar.v1.VerifyGameResponse
+	(*GameDivergence)(nil),         // 19: weewar.v1.GameDivergence
+	(*UndoMovesRequest)(nil),       // 20: weewar.v1.UndoMovesRequest
+	(*UndoMovesResponse)(nil),      // 21: weewar.v1.UndoMovesResponse
+	(*SubscribeGameRequest)(nil),   // 22: weewar.v1.SubscribeGameRequest
+	(*SubscribeGameResponse)(nil),  // 23: weewar.v1.SubscribeGameResponse
+	(*GetGameStateRequest)(nil),    // 24: weewar.v1.GetGameStateRequest
+	(*GetGameStateResponse)(nil),   // 25: weewar.v1.GetGameStateResponse
+	(*ListMovesRequest)(nil),       // 26: weewar.v1.ListMovesRequest
+	(*ListMovesResponse)(nil),      // 27: weewar.v1.ListMovesResponse
+	(*GetOptionsAtRequest)(nil),    // 28: weewar.v1.GetOptionsAtRequest
+	(*GetOptionsAtResponse)(nil),   // 29: weewar.v1.GetOptionsAtResponse
+	(*GetPathRequest)(nil),         // 30: weewar.v1.GetPathRequest
+	(*GetPathResponse)(nil),        // 31: weewar.v1.GetPathResponse
+	(*PathStep)(nil),               // 32: weewar.v1.PathStep
+	(*GameOption)(nil),             // 33: weewar.v1.GameOption
+	(*EndTurnOption)(nil),          // 34: weewar.v1.EndTurnOption
+	(*MoveOption)(nil),             // 35: weewar.v1.MoveOption
+	(*AttackOption)(nil),           // 36: weewar.v1.AttackOption
+	(*BuildUnitOption)(nil),        // 37: weewar.v1.BuildUnitOption
+	(*CaptureBuildingOption)(nil),  // 38: weewar.v1.CaptureBuildingOption
+	(*ModifyTerrainOption)(nil),    // 39: weewar.v1.ModifyTerrainOption
+	(*LoadUnitOption)(nil),         // 40: weewar.v1.LoadUnitOption
+	(*UnloadUnitOption)(nil),       // 41: weewar.v1.UnloadUnitOption
+	(*HealUnitOption)(nil),         // 42: weewar.v1.HealUnitOption
+	nil,                            // 43: weewar.v1.GetGamesResponse.GamesEntry
+	nil,                            // 44: weewar.v1.CreateGameResponse.FieldErrorsEntry
+	(*Pagination)(nil),             // 45: weewar.v1.Pagination
+	(*Game)(nil),                   // 46: weewar.v1.Game
+	(*PaginationResponse)(nil),     // 47: weewar.v1.PaginationResponse
+	(*GameState)(nil),              // 48: weewar.v1.GameState
+	(*GameMoveHistory)(nil),        // 49: weewar.v1.GameMoveHistory
+	(*fieldmaskpb.FieldMask)(nil),  // 50: google.protobuf.FieldMask
+	(*GameMove)(nil),               // 51: weewar.v1.GameMove
+	(*GameMoveResult)(nil),         // 52: weewar.v1.GameMoveResult
+	(*WorldChange)(nil),            // 53: weewar.v1.WorldChange
+	(*GameMoveGroup)(nil),          // 54: weewar.v1.GameMoveGroup
+	(*VictoryProgress)(nil),        // 55: weewar.v1.VictoryProgress
+	(*MoveUnitAction)(nil),         // 56: weewar.v1.MoveUnitAction
+	(*AttackUnitAction)(nil),       // 57: weewar.v1.AttackUnitAction
+	(*BuildUnitAction)(nil),        // 58: weewar.v1.BuildUnitAction
+	(*CaptureBuildingAction)(nil),  // 59: weewar.v1.CaptureBuildingAction
+	(*ModifyTerrainAction)(nil),    // 60: weewar.v1.ModifyTerrainAction
+	(*LoadUnitAction)(nil),         // 61: weewar.v1.LoadUnitAction
+	(*UnloadUnitAction)(nil),       // 62: weewar.v1.UnloadUnitAction
+	(*HealUnitAction)(nil),         // 63: weewar.v1.HealUnitAction
 }
 var file_weewar_v1_games_proto_depIdxs = []int32{
-	31, // 0: weewar.v1.ListGamesRequest.pagination:type_name -> weewar.v1.Pagination
-	32, // 1: weewar.v1.ListGamesResponse.items:type_name -> weewar.v1.Game
-	33, // 2: weewar.v1.ListGamesResponse.pagination:type_name -> weewar.v1.PaginationResponse
-	32, // 3: weewar.v1.GetGameResponse.game:type_name -> weewar.v1.Game
-	34, // 4: weewar.v1.GetGameResponse.state:type_name -> weewar.v1.GameState
-	35, // 5: weewar.v1.GetGameResponse.history:type_name -> weewar.v1.GameMoveHistory
-	32, // 6: weewar.v1.UpdateGameRequest.new_game:type_name -> weewar.v1.Game
-	34, // 7: weewar.v1.UpdateGameRequest.new_state:type_name -> weewar.v1.GameState
-	35, // 8: weewar.v1.UpdateGameRequest.new_history:type_name -> weewar.v1.GameMoveHistory
-	36, // 9: weewar.v1.UpdateGameRequest.update_mask:type_name -> google.protobuf.FieldMask
-	32, // 10: weewar.v1.UpdateGameResponse.game:type_name -> weewar.v1.Game
-	29, // 11: weewar.v1.GetGamesResponse.games:type_name -> weewar.v1.GetGamesResponse.GamesEntry
-	32, // 12: weewar.v1.CreateGameRequest.game:type_name -> weewar.v1.Game
-	32, // 13: weewar.v1.CreateGameResponse.game:type_name -> weewar.v1.Game
-	34, // 14: weewar.v1.CreateGameResponse.game_state:type_name -> weewar.v1.GameState
-	30, // 15: weewar.v1.CreateGameResponse.field_errors:type_name -> weewar.v1.CreateGameResponse.FieldErrorsEntry
-	37, // 16: weewar.v1.ProcessMovesRequest.moves:type_name -> weewar.v1.GameMove
-	38, // 17: weewar.v1.ProcessMovesResponse.move_results:type_name -> weewar.v1.GameMoveResult
-	39, // 18: weewar.v1.ProcessMovesResponse.changes:type_name -> weewar.v1.WorldChange
-	34, // 19: weewar.v1.GetGameStateResponse.state:type_name -> weewar.v1.GameState
-	40, // 20: weewar.v1.ListMovesResponse.move_groups:type_name -> weewar.v1.GameMoveGroup
-	23, // 21: weewar.v1.GetOptionsAtResponse.options:type_name -> weewar.v1.GameOption
-	25, // 22: weewar.v1.GameOption.move:type_name -> weewar.v1.MoveOption
-	26, // 23: weewar.v1.GameOption.attack:type_name -> weewar.v1.AttackOption
-	24, // 24: weewar.v1.GameOption.end_turn:type_name -> weewar.v1.EndTurnOption
-	27, // 25: weewar.v1.GameOption.build:type_name -> weewar.v1.BuildUnitOption
-	28, // 26: weewar.v1.GameOption.capture:type_name -> weewar.v1.CaptureBuildingOption
-	41, // 27: weewar.v1.MoveOption.action:type_name -> weewar.v1.MoveUnitAction
-	42, // 28: weewar.v1.AttackOption.action:type_name -> weewar.v1.AttackUnitAction
-	32, // 29: weewar.v1.GetGamesResponse.GamesEntry.value:type_name -> weewar.v1.Game
-	13, // 30: weewar.v1.GamesService.CreateGame:input_type -> weewar.v1.CreateGameRequest
-	11, // 31: weewar.v1.GamesService.GetGames:input_type -> weewar.v1.GetGamesRequest
-	1,  // 32: weewar.v1.GamesService.ListGames:input_type -> weewar.v1.ListGamesRequest
-	3,  // 33: weewar.v1.GamesService.GetGame:input_type -> weewar.v1.GetGameRequest
-	9,  // 34: weewar.v1.GamesService.DeleteGame:input_type -> weewar.v1.DeleteGameRequest
-	7,  // 35: weewar.v1.GamesService.UpdateGame:input_type -> weewar.v1.UpdateGameRequest
-	17, // 36: weewar.v1.GamesService.GetGameState:input_type -> weewar.v1.GetGameStateRequest
-	19, // 37: weewar.v1.GamesService.ListMoves:input_type -> weewar.v1.ListMovesRequest
-	15, // 38: weewar.v1.GamesService.ProcessMoves:input_type -> weewar.v1.ProcessMovesRequest
-	21, // 39: weewar.v1.GamesService.GetOptionsAt:input_type -> weewar.v1.GetOptionsAtRequest
-	14, // 40: weewar.v1.GamesService.CreateGame:output_type -> weewar.v1.CreateGameResponse
-	12, // 41: weewar.v1.GamesService.GetGames:output_type -> weewar.v1.GetGamesResponse
-	2,  // 42: weewar.v1.GamesService.ListGames:output_type -> weewar.v1.ListGamesResponse
-	4,  // 43: weewar.v1.GamesService.GetGame:output_type -> weewar.v1.GetGameResponse
-	10, // 44: weewar.v1.GamesService.DeleteGame:output_type -> weewar.v1.DeleteGameResponse
-	8,  // 45: weewar.v1.GamesService.UpdateGame:output_type -> weewar.v1.UpdateGameResponse
-	18, // 46: weewar.v1.GamesService.GetGameState:output_type -> weewar.v1.GetGameStateResponse
-	20, // 47: weewar.v1.GamesService.ListMoves:output_type -> weewar.v1.ListMovesResponse
-	16, // 48: weewar.v1.GamesService.ProcessMoves:output_type -> weewar.v1.ProcessMovesResponse
-	22, // 49: weewar.v1.GamesService.GetOptionsAt:output_type -> weewar.v1.GetOptionsAtResponse
-	40, // [40:50] is the sub-list for method output_type
-	30, // [30:40] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	45, // 0: weewar.v1.ListGamesRequest.pagination:type_name -> weewar.v1.Pagination
+	46, // 1: weewar.v1.ListGamesResponse.items:type_name -> weewar.v1.Game
+	47, // 2: weewar.v1.ListGamesResponse.pagination:type_name -> weewar.v1.PaginationResponse
+	46, // 3: weewar.v1.GetGameResponse.game:type_name -> weewar.v1.Game
+	48, // 4: weewar.v1.GetGameResponse.state:type_name -> weewar.v1.GameState
+	49, // 5: weewar.v1.GetGameResponse.history:type_name -> weewar.v1.GameMoveHistory
+	46, // 6: weewar.v1.UpdateGameRequest.new_game:type_name -> weewar.v1.Game
+	48, // 7: weewar.v1.UpdateGameRequest.new_state:type_name -> weewar.v1.GameState
+	49, // 8: weewar.v1.UpdateGameRequest.new_history:type_name -> weewar.v1.GameMoveHistory
+	50, // 9: weewar.v1.UpdateGameRequest.update_mask:type_name -> google.protobuf.FieldMask
+	46, // 10: weewar.v1.UpdateGameResponse.game:type_name -> weewar.v1.Game
+	43, // 11: weewar.v1.GetGamesResponse.games:type_name -> weewar.v1.GetGamesResponse.GamesEntry
+	46, // 12: weewar.v1.CreateGameRequest.game:type_name -> weewar.v1.Game
+	46, // 13: weewar.v1.CreateGameResponse.game:type_name -> weewar.v1.Game
+	48, // 14: weewar.v1.CreateGameResponse.game_state:type_name -> weewar.v1.GameState
+	44, // 15: weewar.v1.CreateGameResponse.field_errors:type_name -> weewar.v1.CreateGameResponse.FieldErrorsEntry
+	51, // 16: weewar.v1.ProcessMovesRequest.moves:type_name -> weewar.v1.GameMove
+	52, // 17: weewar.v1.ProcessMovesResponse.move_results:type_name -> weewar.v1.GameMoveResult
+	53, // 18: weewar.v1.ProcessMovesResponse.changes:type_name -> weewar.v1.WorldChange
+	19, // 19: weewar.v1.VerifyGameResponse.divergence:type_name -> weewar.v1.GameDivergence
+	53, // 20: weewar.v1.GameDivergence.expected_change:type_name -> weewar.v1.WorldChange
+	53, // 21: weewar.v1.GameDivergence.actual_change:type_name -> weewar.v1.WorldChange
+	54, // 22: weewar.v1.UndoMovesResponse.undone_groups:type_name -> weewar.v1.GameMoveGroup
+	53, // 23: weewar.v1.UndoMovesResponse.changes:type_name -> weewar.v1.WorldChange
+	54, // 24: weewar.v1.SubscribeGameResponse.move_group:type_name -> weewar.v1.GameMoveGroup
+	21, // 25: weewar.v1.SubscribeGameResponse.undo:type_name -> weewar.v1.UndoMovesResponse
+	48, // 26: weewar.v1.GetGameStateResponse.state:type_name -> weewar.v1.GameState
+	55, // 27: weewar.v1.GetGameStateResponse.victory_progress:type_name -> weewar.v1.VictoryProgress
+	54, // 28: weewar.v1.ListMovesResponse.move_groups:type_name -> weewar.v1.GameMoveGroup
+	33, // 29: weewar.v1.GetOptionsAtResponse.options:type_name -> weewar.v1.GameOption
+	32, // 30: weewar.v1.GetPathResponse.steps:type_name -> weewar.v1.PathStep
+	56, // 31: weewar.v1.GetPathResponse.action:type_name -> weewar.v1.MoveUnitAction
+	35, // 32: weewar.v1.GameOption.move:type_name -> weewar.v1.MoveOption
+	36, // 33: weewar.v1.GameOption.attack:type_name -> weewar.v1.AttackOption
+	34, // 34: weewar.v1.GameOption.end_turn:type_name -> weewar.v1.EndTurnOption
+	37, // 35: weewar.v1.GameOption.build:type_name -> weewar.v1.BuildUnitOption
+	38, // 36: weewar.v1.GameOption.capture:type_name -> weewar.v1.CaptureBuildingOption
+	40, // 37: weewar.v1.GameOption.load:type_name -> weewar.v1.LoadUnitOption
+	41, // 38: weewar.v1.GameOption.unload:type_name -> weewar.v1.UnloadUnitOption
+	42, // 39: weewar.v1.GameOption.heal:type_name -> weewar.v1.HealUnitOption
+	39, // 40: weewar.v1.GameOption.modify_terrain:type_name -> weewar.v1.ModifyTerrainOption
+	56, // 41: weewar.v1.MoveOption.action:type_name -> weewar.v1.MoveUnitAction
+	57, // 42: weewar.v1.AttackOption.action:type_name -> weewar.v1.AttackUnitAction
+	58, // 43: weewar.v1.BuildUnitOption.action:type_name -> weewar.v1.BuildUnitAction
+	59, // 44: weewar.v1.CaptureBuildingOption.action:type_name -> weewar.v1.CaptureBuildingAction
+	60, // 45: weewar.v1.ModifyTerrainOption.action:type_name -> weewar.v1.ModifyTerrainAction
+	61, // 46: weewar.v1.LoadUnitOption.action:type_name -> weewar.v1.LoadUnitAction
+	62, // 47: weewar.v1.UnloadUnitOption.action:type_name -> weewar.v1.UnloadUnitAction
+	63, // 48: weewar.v1.HealUnitOption.action:type_name -> weewar.v1.HealUnitAction
+	46, // 49: weewar.v1.GetGamesResponse.GamesEntry.value:type_name -> weewar.v1.Game
+	13, // 50: weewar.v1.GamesService.CreateGame:input_type -> weewar.v1.CreateGameRequest
+	11, // 51: weewar.v1.GamesService.GetGames:input_type -> weewar.v1.GetGamesRequest
+	1,  // 52: weewar.v1.GamesService.ListGames:input_type -> weewar.v1.ListGamesRequest
+	3,  // 53: weewar.v1.GamesService.GetGame:input_type -> weewar.v1.GetGameRequest
+	9,  // 54: weewar.v1.GamesService.DeleteGame:input_type -> weewar.v1.DeleteGameRequest
+	7,  // 55: weewar.v1.GamesService.UpdateGame:input_type -> weewar.v1.UpdateGameRequest
+	24, // 56: weewar.v1.GamesService.GetGameState:input_type -> weewar.v1.GetGameStateRequest
+	26, // 57: weewar.v1.GamesService.ListMoves:input_type -> weewar.v1.ListMovesRequest
+	15, // 58: weewar.v1.GamesService.ProcessMoves:input_type -> weewar.v1.ProcessMovesRequest
+	28, // 59: weewar.v1.GamesService.GetOptionsAt:input_type -> weewar.v1.GetOptionsAtRequest
+	30, // 60: weewar.v1.GamesService.GetPath:input_type -> weewar.v1.GetPathRequest
+	17, // 61: weewar.v1.GamesService.VerifyGame:input_type -> weewar.v1.VerifyGameRequest
+	20, // 62: weewar.v1.GamesService.UndoMoves:input_type -> weewar.v1.UndoMovesRequest
+	22, // 63: weewar.v1.GamesService.SubscribeGame:input_type -> weewar.v1.SubscribeGameRequest
+	14, // 64: weewar.v1.GamesService.CreateGame:output_type -> weewar.v1.CreateGameResponse
+	12, // 65: weewar.v1.GamesService.GetGames:output_type -> weewar.v1.GetGamesResponse
+	2,  // 66: weewar.v1.GamesService.ListGames:output_type -> weewar.v1.ListGamesResponse
+	4,  // 67: weewar.v1.GamesService.GetGame:output_type -> weewar.v1.GetGameResponse
+	10, // 68: weewar.v1.GamesService.DeleteGame:output_type -> weewar.v1.DeleteGameResponse
+	8,  // 69: weewar.v1.GamesService.UpdateGame:output_type -> weewar.v1.UpdateGameResponse
+	25, // 70: weewar.v1.GamesService.GetGameState:output_type -> weewar.v1.GetGameStateResponse
+	27, // 71: weewar.v1.GamesService.ListMoves:output_type -> weewar.v1.ListMovesResponse
+	16, // 72: weewar.v1.GamesService.ProcessMoves:output_type -> weewar.v1.ProcessMovesResponse
+	29, // 73: weewar.v1.GamesService.GetOptionsAt:output_type -> weewar.v1.GetOptionsAtResponse
+	31, // 74: weewar.v1.GamesService.GetPath:output_type -> weewar.v1.GetPathResponse
+	18, // 75: weewar.v1.GamesService.VerifyGame:output_type -> weewar.v1.VerifyGameResponse
+	21, // 76: weewar.v1.GamesService.UndoMoves:output_type -> weewar.v1.UndoMovesResponse
+	23, // 77: weewar.v1.GamesService.SubscribeGame:output_type -> weewar.v1.SubscribeGameResponse
+	64, // [64:78] is the sub-list for method output_type
+	50, // [50:64] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
 }
 
 func init() { file_weewar_v1_games_proto_init() }
@@ -2095,12 +3501,16 @@ func file_weewar_v1_games_proto_init() {
 		return
 	}
 	file_weewar_v1_models_proto_init()
-	file_weewar_v1_games_proto_msgTypes[23].OneofWrappers = []any{
+	file_weewar_v1_games_proto_msgTypes[33].OneofWrappers = []any{
 		(*GameOption_Move)(nil),
 		(*GameOption_Attack)(nil),
 		(*GameOption_EndTurn)(nil),
 		(*GameOption_Build)(nil),
 		(*GameOption_Capture)(nil),
+		(*GameOption_Load)(nil),
+		(*GameOption_Unload)(nil),
+		(*GameOption_Heal)(nil),
+		(*GameOption_ModifyTerrain)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2108,7 +3518,7 @@ func file_weewar_v1_games_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_weewar_v1_games_proto_rawDesc), len(file_weewar_v1_games_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   31,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
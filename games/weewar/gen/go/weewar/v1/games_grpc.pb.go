@@ -19,16 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GamesService_CreateGame_FullMethodName   = "/weewar.v1.GamesService/CreateGame"
-	GamesService_GetGames_FullMethodName     = "/weewar.v1.GamesService/GetGames"
-	GamesService_ListGames_FullMethodName    = "/weewar.v1.GamesService/ListGames"
-	GamesService_GetGame_FullMethodName      = "/weewar.v1.GamesService/GetGame"
-	GamesService_DeleteGame_FullMethodName   = "/weewar.v1.GamesService/DeleteGame"
-	GamesService_UpdateGame_FullMethodName   = "/weewar.v1.GamesService/UpdateGame"
-	GamesService_GetGameState_FullMethodName = "/weewar.v1.GamesService/GetGameState"
-	GamesService_ListMoves_FullMethodName    = "/weewar.v1.GamesService/ListMoves"
-	GamesService_ProcessMoves_FullMethodName = "/weewar.v1.GamesService/ProcessMoves"
-	GamesService_GetOptionsAt_FullMethodName = "/weewar.v1.GamesService/GetOptionsAt"
+	GamesService_CreateGame_FullMethodName    = "/weewar.v1.GamesService/CreateGame"
+	GamesService_GetGames_FullMethodName      = "/weewar.v1.GamesService/GetGames"
+	GamesService_ListGames_FullMethodName     = "/weewar.v1.GamesService/ListGames"
+	GamesService_GetGame_FullMethodName       = "/weewar.v1.GamesService/GetGame"
+	GamesService_DeleteGame_FullMethodName    = "/weewar.v1.GamesService/DeleteGame"
+	GamesService_UpdateGame_FullMethodName    = "/weewar.v1.GamesService/UpdateGame"
+	GamesService_GetGameState_FullMethodName  = "/weewar.v1.GamesService/GetGameState"
+	GamesService_ListMoves_FullMethodName     = "/weewar.v1.GamesService/ListMoves"
+	GamesService_ProcessMoves_FullMethodName  = "/weewar.v1.GamesService/ProcessMoves"
+	GamesService_GetOptionsAt_FullMethodName  = "/weewar.v1.GamesService/GetOptionsAt"
+	GamesService_GetPath_FullMethodName       = "/weewar.v1.GamesService/GetPath"
+	GamesService_VerifyGame_FullMethodName    = "/weewar.v1.GamesService/VerifyGame"
+	GamesService_UndoMoves_FullMethodName     = "/weewar.v1.GamesService/UndoMoves"
+	GamesService_SubscribeGame_FullMethodName = "/weewar.v1.GamesService/SubscribeGame"
 )
 
 // GamesServiceClient is the client API for GamesService service.
@@ -45,7 +49,7 @@ type GamesServiceClient interface {
 	GetGames(ctx context.Context, in *GetGamesRequest, opts ...grpc.CallOption) (*GetGamesResponse, error)
 	// ListGames returns all available games
 	ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error)
-	// GetGame returns a specific game with metadata
+	// GetGame returns a specific game with metadata, its state and history
 	GetGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (*GetGameResponse, error)
 	// *
 	// Delete a particular game
@@ -58,6 +62,17 @@ type GamesServiceClient interface {
 	ListMoves(ctx context.Context, in *ListMovesRequest, opts ...grpc.CallOption) (*ListMovesResponse, error)
 	ProcessMoves(ctx context.Context, in *ProcessMovesRequest, opts ...grpc.CallOption) (*ProcessMovesResponse, error)
 	GetOptionsAt(ctx context.Context, in *GetOptionsAtRequest, opts ...grpc.CallOption) (*GetOptionsAtResponse, error)
+	// Returns the cheapest path for the unit at (from_q, from_r) to reach (to_q, to_r)
+	// along with the cost of each step
+	GetPath(ctx context.Context, in *GetPathRequest, opts ...grpc.CallOption) (*GetPathResponse, error)
+	// Admin: Replays a game's move history from its initial state and reports the
+	// first place where recomputed changes differ from the recorded ones
+	VerifyGame(ctx context.Context, in *VerifyGameRequest, opts ...grpc.CallOption) (*VerifyGameResponse, error)
+	// Undo the latest non-permanent move groups in the current turn
+	UndoMoves(ctx context.Context, in *UndoMovesRequest, opts ...grpc.CallOption) (*UndoMovesResponse, error)
+	// Streams move groups as they are committed to a game.  Groups already in the
+	// history after from_sequence_num are sent first so clients can resume.
+	SubscribeGame(ctx context.Context, in *SubscribeGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SubscribeGameResponse], error)
 }
 
 type gamesServiceClient struct {
@@ -168,6 +183,55 @@ func (c *gamesServiceClient) GetOptionsAt(ctx context.Context, in *GetOptionsAtR
 	return out, nil
 }
 
+func (c *gamesServiceClient) GetPath(ctx context.Context, in *GetPathRequest, opts ...grpc.CallOption) (*GetPathResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPathResponse)
+	err := c.cc.Invoke(ctx, GamesService_GetPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesServiceClient) VerifyGame(ctx context.Context, in *VerifyGameRequest, opts ...grpc.CallOption) (*VerifyGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyGameResponse)
+	err := c.cc.Invoke(ctx, GamesService_VerifyGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesServiceClient) UndoMoves(ctx context.Context, in *UndoMovesRequest, opts ...grpc.CallOption) (*UndoMovesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UndoMovesResponse)
+	err := c.cc.Invoke(ctx, GamesService_UndoMoves_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesServiceClient) SubscribeGame(ctx context.Context, in *SubscribeGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SubscribeGameResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GamesService_ServiceDesc.Streams[0], GamesService_SubscribeGame_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeGameRequest, SubscribeGameResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GamesService_SubscribeGameClient = grpc.ServerStreamingClient[SubscribeGameResponse]
+
 // GamesServiceServer is the server API for GamesService service.
 // All implementations should embed UnimplementedGamesServiceServer
 // for forward compatibility.
@@ -182,7 +246,7 @@ type GamesServiceServer interface {
 	GetGames(context.Context, *GetGamesRequest) (*GetGamesResponse, error)
 	// ListGames returns all available games
 	ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error)
-	// GetGame returns a specific game with metadata
+	// GetGame returns a specific game with metadata, its state and history
 	GetGame(context.Context, *GetGameRequest) (*GetGameResponse, error)
 	// *
 	// Delete a particular game
@@ -195,6 +259,17 @@ type GamesServiceServer interface {
 	ListMoves(context.Context, *ListMovesRequest) (*ListMovesResponse, error)
 	ProcessMoves(context.Context, *ProcessMovesRequest) (*ProcessMovesResponse, error)
 	GetOptionsAt(context.Context, *GetOptionsAtRequest) (*GetOptionsAtResponse, error)
+	// Returns the cheapest path for the unit at (from_q, from_r) to reach (to_q, to_r)
+	// along with the cost of each step
+	GetPath(context.Context, *GetPathRequest) (*GetPathResponse, error)
+	// Admin: Replays a game's move history from its initial state and reports the
+	// first place where recomputed changes differ from the recorded ones
+	VerifyGame(context.Context, *VerifyGameRequest) (*VerifyGameResponse, error)
+	// Undo the latest non-permanent move groups in the current turn
+	UndoMoves(context.Context, *UndoMovesRequest) (*UndoMovesResponse, error)
+	// Streams move groups as they are committed to a game.  Groups already in the
+	// history after from_sequence_num are sent first so clients can resume.
+	SubscribeGame(*SubscribeGameRequest, grpc.ServerStreamingServer[SubscribeGameResponse]) error
 }
 
 // UnimplementedGamesServiceServer should be embedded to have
@@ -234,6 +309,18 @@ func (UnimplementedGamesServiceServer) ProcessMoves(context.Context, *ProcessMov
 func (UnimplementedGamesServiceServer) GetOptionsAt(context.Context, *GetOptionsAtRequest) (*GetOptionsAtResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOptionsAt not implemented")
 }
+func (UnimplementedGamesServiceServer) GetPath(context.Context, *GetPathRequest) (*GetPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPath not implemented")
+}
+func (UnimplementedGamesServiceServer) VerifyGame(context.Context, *VerifyGameRequest) (*VerifyGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyGame not implemented")
+}
+func (UnimplementedGamesServiceServer) UndoMoves(context.Context, *UndoMovesRequest) (*UndoMovesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoMoves not implemented")
+}
+func (UnimplementedGamesServiceServer) SubscribeGame(*SubscribeGameRequest, grpc.ServerStreamingServer[SubscribeGameResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeGame not implemented")
+}
 func (UnimplementedGamesServiceServer) testEmbeddedByValue() {}
 
 // UnsafeGamesServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -434,6 +521,71 @@ func _GamesService_GetOptionsAt_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GamesService_GetPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).GetPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_GetPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).GetPath(ctx, req.(*GetPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GamesService_VerifyGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).VerifyGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_VerifyGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).VerifyGame(ctx, req.(*VerifyGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GamesService_UndoMoves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoMovesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).UndoMoves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_UndoMoves_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).UndoMoves(ctx, req.(*UndoMovesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GamesService_SubscribeGame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeGameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GamesServiceServer).SubscribeGame(m, &grpc.GenericServerStream[SubscribeGameRequest, SubscribeGameResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GamesService_SubscribeGameServer = grpc.ServerStreamingServer[SubscribeGameResponse]
+
 // GamesService_ServiceDesc is the grpc.ServiceDesc for GamesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -481,7 +633,25 @@ var GamesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOptionsAt",
 			Handler:    _GamesService_GetOptionsAt_Handler,
 		},
+		{
+			MethodName: "GetPath",
+			Handler:    _GamesService_GetPath_Handler,
+		},
+		{
+			MethodName: "VerifyGame",
+			Handler:    _GamesService_VerifyGame_Handler,
+		},
+		{
+			MethodName: "UndoMoves",
+			Handler:    _GamesService_UndoMoves_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeGame",
+			Handler:       _GamesService_SubscribeGame_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "weewar/v1/games.proto",
 }
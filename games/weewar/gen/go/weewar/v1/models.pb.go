@@ -460,9 +460,17 @@ type Tile struct {
 	R        int32 `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
 	TileType int32 `protobuf:"varint,3,opt,name=tile_type,json=tileType,proto3" json:"tile_type,omitempty"` // Tile type
 	// Whether the tile itself belongs to a player
-	Player        int32 `protobuf:"varint,4,opt,name=player,proto3" json:"player,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Player int32 `protobuf:"varint,4,opt,name=player,proto3" json:"player,omitempty"`
+	// Player currently capturing this tile (0 = not being captured)
+	CapturePlayer int32 `protobuf:"varint,5,opt,name=capture_player,json=capturePlayer,proto3" json:"capture_player,omitempty"`
+	// Number of turns of capture progress made by the capturing player
+	CaptureProgress int32 `protobuf:"varint,6,opt,name=capture_progress,json=captureProgress,proto3" json:"capture_progress,omitempty"`
+	// Terrain action being worked on this tile ("" = none)
+	TerrainAction string `protobuf:"bytes,7,opt,name=terrain_action,json=terrainAction,proto3" json:"terrain_action,omitempty"`
+	// Number of turns of work done on the terrain action
+	TerrainActionProgress int32 `protobuf:"varint,8,opt,name=terrain_action_progress,json=terrainActionProgress,proto3" json:"terrain_action_progress,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *Tile) Reset() {
@@ -523,6 +531,34 @@ func (x *Tile) GetPlayer() int32 {
 	return 0
 }
 
+func (x *Tile) GetCapturePlayer() int32 {
+	if x != nil {
+		return x.CapturePlayer
+	}
+	return 0
+}
+
+func (x *Tile) GetCaptureProgress() int32 {
+	if x != nil {
+		return x.CaptureProgress
+	}
+	return 0
+}
+
+func (x *Tile) GetTerrainAction() string {
+	if x != nil {
+		return x.TerrainAction
+	}
+	return ""
+}
+
+func (x *Tile) GetTerrainActionProgress() int32 {
+	if x != nil {
+		return x.TerrainActionProgress
+	}
+	return 0
+}
+
 type Unit struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Q and R in Cubed coordinates
@@ -531,11 +567,18 @@ type Unit struct {
 	Player   int32 `protobuf:"varint,3,opt,name=player,proto3" json:"player,omitempty"`
 	UnitType int32 `protobuf:"varint,4,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"`
 	// Runtime state fields
-	AvailableHealth int32 `protobuf:"varint,5,opt,name=available_health,json=availableHealth,proto3" json:"available_health,omitempty"` // Current health points
-	DistanceLeft    int32 `protobuf:"varint,6,opt,name=distance_left,json=distanceLeft,proto3" json:"distance_left,omitempty"`          // Movement points remaining this turn
-	TurnCounter     int32 `protobuf:"varint,7,opt,name=turn_counter,json=turnCounter,proto3" json:"turn_counter,omitempty"`             // Which turn this unit was created/last acted
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	AvailableHealth  int32 `protobuf:"varint,5,opt,name=available_health,json=availableHealth,proto3" json:"available_health,omitempty"`     // Current health points
+	DistanceLeft     int32 `protobuf:"varint,6,opt,name=distance_left,json=distanceLeft,proto3" json:"distance_left,omitempty"`              // Movement points remaining this turn
+	TurnCounter      int32 `protobuf:"varint,7,opt,name=turn_counter,json=turnCounter,proto3" json:"turn_counter,omitempty"`                 // Which turn this unit was created/last acted
+	HasMoved         bool  `protobuf:"varint,8,opt,name=has_moved,json=hasMoved,proto3" json:"has_moved,omitempty"`                          // Whether the unit has moved this turn
+	HasAttacked      bool  `protobuf:"varint,9,opt,name=has_attacked,json=hasAttacked,proto3" json:"has_attacked,omitempty"`                 // Whether the unit has attacked this turn
+	ActionsRemaining int32 `protobuf:"varint,10,opt,name=actions_remaining,json=actionsRemaining,proto3" json:"actions_remaining,omitempty"` // Attacks/actions the unit can still take this turn
+	// Units being carried by this unit if it is a transport.  Carried units are not on
+	// the map (their coordinates are only meaningful once unloaded) and are destroyed
+	// along with their transport.
+	Cargo         []*Unit `protobuf:"bytes,11,rep,name=cargo,proto3" json:"cargo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Unit) Reset() {
@@ -617,17 +660,50 @@ func (x *Unit) GetTurnCounter() int32 {
 	return 0
 }
 
+func (x *Unit) GetHasMoved() bool {
+	if x != nil {
+		return x.HasMoved
+	}
+	return false
+}
+
+func (x *Unit) GetHasAttacked() bool {
+	if x != nil {
+		return x.HasAttacked
+	}
+	return false
+}
+
+func (x *Unit) GetActionsRemaining() int32 {
+	if x != nil {
+		return x.ActionsRemaining
+	}
+	return 0
+}
+
+func (x *Unit) GetCargo() []*Unit {
+	if x != nil {
+		return x.Cargo
+	}
+	return nil
+}
+
 // Rules engine terrain definition
 type TerrainDefinition struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                            // Terrain type ID
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                         // Display name (e.g., "Grass", "Mountain")
-	BaseMoveCost  float64                `protobuf:"fixed64,3,opt,name=base_move_cost,json=baseMoveCost,proto3" json:"base_move_cost,omitempty"` // Base movement cost
-	DefenseBonus  float64                `protobuf:"fixed64,4,opt,name=defense_bonus,json=defenseBonus,proto3" json:"defense_bonus,omitempty"`   // Defense bonus multiplier (0.0 to 1.0)
-	Type          int32                  `protobuf:"varint,5,opt,name=type,proto3" json:"type,omitempty"`                                        // Terrain category type
-	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`                           // Human-readable description
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                      // Terrain type ID
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                   // Display name (e.g., "Grass", "Mountain")
+	BaseMoveCost   float64                `protobuf:"fixed64,3,opt,name=base_move_cost,json=baseMoveCost,proto3" json:"base_move_cost,omitempty"`           // Base movement cost
+	DefenseBonus   float64                `protobuf:"fixed64,4,opt,name=defense_bonus,json=defenseBonus,proto3" json:"defense_bonus,omitempty"`             // Defense bonus multiplier (0.0 to 1.0)
+	Type           int32                  `protobuf:"varint,5,opt,name=type,proto3" json:"type,omitempty"`                                                  // Terrain category type
+	Description    string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`                                     // Human-readable description
+	BuildableUnits []int32                `protobuf:"varint,7,rep,packed,name=buildable_units,json=buildableUnits,proto3" json:"buildable_units,omitempty"` // Unit type IDs that can be built on this terrain
+	CaptureTurns   int32                  `protobuf:"varint,8,opt,name=capture_turns,json=captureTurns,proto3" json:"capture_turns,omitempty"`              // Turns needed to capture this terrain (0 = cannot be captured)
+	RepairAmount   int32                  `protobuf:"varint,9,opt,name=repair_amount,json=repairAmount,proto3" json:"repair_amount,omitempty"`              // Health restored each turn to units standing on their owner's tile (0 = no repair)
+	RepairClasses  []string               `protobuf:"bytes,10,rep,name=repair_classes,json=repairClasses,proto3" json:"repair_classes,omitempty"`           // Unit classes repaired here (empty = all)
+	RepairCost     float64                `protobuf:"fixed64,11,opt,name=repair_cost,json=repairCost,proto3" json:"repair_cost,omitempty"`                  // Fraction of the unit's coins charged to repair its full health (0 = free)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *TerrainDefinition) Reset() {
@@ -702,17 +778,63 @@ func (x *TerrainDefinition) GetDescription() string {
 	return ""
 }
 
+func (x *TerrainDefinition) GetBuildableUnits() []int32 {
+	if x != nil {
+		return x.BuildableUnits
+	}
+	return nil
+}
+
+func (x *TerrainDefinition) GetCaptureTurns() int32 {
+	if x != nil {
+		return x.CaptureTurns
+	}
+	return 0
+}
+
+func (x *TerrainDefinition) GetRepairAmount() int32 {
+	if x != nil {
+		return x.RepairAmount
+	}
+	return 0
+}
+
+func (x *TerrainDefinition) GetRepairClasses() []string {
+	if x != nil {
+		return x.RepairClasses
+	}
+	return nil
+}
+
+func (x *TerrainDefinition) GetRepairCost() float64 {
+	if x != nil {
+		return x.RepairCost
+	}
+	return 0
+}
+
 // Rules engine unit definition
 type UnitDefinition struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                               // Unit type ID
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                            // Display name (e.g., "Infantry", "Tank")
-	MovementPoints int32                  `protobuf:"varint,3,opt,name=movement_points,json=movementPoints,proto3" json:"movement_points,omitempty"` // Maximum movement per turn
-	AttackRange    int32                  `protobuf:"varint,4,opt,name=attack_range,json=attackRange,proto3" json:"attack_range,omitempty"`          // Attack range in tiles
-	Health         int32                  `protobuf:"varint,5,opt,name=health,proto3" json:"health,omitempty"`                                       // Maximum health points
-	Properties     []string               `protobuf:"bytes,6,rep,name=properties,proto3" json:"properties,omitempty"`                                // Special properties/abilities
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                // Unit type ID
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                             // Display name (e.g., "Infantry", "Tank")
+	MovementPoints     int32                  `protobuf:"varint,3,opt,name=movement_points,json=movementPoints,proto3" json:"movement_points,omitempty"`                  // Maximum movement per turn
+	AttackRange        int32                  `protobuf:"varint,4,opt,name=attack_range,json=attackRange,proto3" json:"attack_range,omitempty"`                           // Attack range in tiles
+	Health             int32                  `protobuf:"varint,5,opt,name=health,proto3" json:"health,omitempty"`                                                        // Maximum health points
+	Properties         []string               `protobuf:"bytes,6,rep,name=properties,proto3" json:"properties,omitempty"`                                                 // Special properties/abilities
+	Coins              int32                  `protobuf:"varint,7,opt,name=coins,proto3" json:"coins,omitempty"`                                                          // Cost in coins to build this unit
+	CanCapture         bool                   `protobuf:"varint,8,opt,name=can_capture,json=canCapture,proto3" json:"can_capture,omitempty"`                              // Whether this unit can capture buildings
+	SightRange         int32                  `protobuf:"varint,9,opt,name=sight_range,json=sightRange,proto3" json:"sight_range,omitempty"`                              // How far this unit can see (used for fog of war)
+	UnitClass          string                 `protobuf:"bytes,10,opt,name=unit_class,json=unitClass,proto3" json:"unit_class,omitempty"`                                 // Movement class ("land", "naval" or "air") used by the movement rules
+	CanMoveAfterAttack bool                   `protobuf:"varint,11,opt,name=can_move_after_attack,json=canMoveAfterAttack,proto3" json:"can_move_after_attack,omitempty"` // Whether the unit can use its remaining movement after attacking
+	ActionsPerTurn     int32                  `protobuf:"varint,12,opt,name=actions_per_turn,json=actionsPerTurn,proto3" json:"actions_per_turn,omitempty"`               // Attacks/actions the unit can take each turn (0 = 1)
+	MinAttackRange     int32                  `protobuf:"varint,13,opt,name=min_attack_range,json=minAttackRange,proto3" json:"min_attack_range,omitempty"`               // Closest distance the unit can attack at (0 = 1, adjacent units)
+	IndirectFire       bool                   `protobuf:"varint,14,opt,name=indirect_fire,json=indirectFire,proto3" json:"indirect_fire,omitempty"`                       // Whether the unit's attacks are ranged fire that cannot be countered
+	TransportCapacity  int32                  `protobuf:"varint,15,opt,name=transport_capacity,json=transportCapacity,proto3" json:"transport_capacity,omitempty"`        // Units it can carry if it has the "transport" property (0 = 1)
+	CargoClasses       []string               `protobuf:"bytes,16,rep,name=cargo_classes,json=cargoClasses,proto3" json:"cargo_classes,omitempty"`                        // Unit classes a transport can carry (empty = any)
+	HealAmount         int32                  `protobuf:"varint,17,opt,name=heal_amount,json=healAmount,proto3" json:"heal_amount,omitempty"`                             // Health restored to an adjacent friendly unit as an action (0 = cannot heal)
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *UnitDefinition) Reset() {
@@ -787,29 +909,112 @@ func (x *UnitDefinition) GetProperties() []string {
 	return nil
 }
 
-// Movement cost matrix for unit types on terrain types
-type MovementMatrix struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Map of unit_id -> (terrain_id -> movement_cost)
-	Costs         map[int32]*TerrainCostMap `protobuf:"bytes,1,rep,name=costs,proto3" json:"costs,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UnitDefinition) GetCoins() int32 {
+	if x != nil {
+		return x.Coins
+	}
+	return 0
 }
 
-func (x *MovementMatrix) Reset() {
-	*x = MovementMatrix{}
+func (x *UnitDefinition) GetCanCapture() bool {
+	if x != nil {
+		return x.CanCapture
+	}
+	return false
+}
+
+func (x *UnitDefinition) GetSightRange() int32 {
+	if x != nil {
+		return x.SightRange
+	}
+	return 0
+}
+
+func (x *UnitDefinition) GetUnitClass() string {
+	if x != nil {
+		return x.UnitClass
+	}
+	return ""
+}
+
+func (x *UnitDefinition) GetCanMoveAfterAttack() bool {
+	if x != nil {
+		return x.CanMoveAfterAttack
+	}
+	return false
+}
+
+func (x *UnitDefinition) GetActionsPerTurn() int32 {
+	if x != nil {
+		return x.ActionsPerTurn
+	}
+	return 0
+}
+
+func (x *UnitDefinition) GetMinAttackRange() int32 {
+	if x != nil {
+		return x.MinAttackRange
+	}
+	return 0
+}
+
+func (x *UnitDefinition) GetIndirectFire() bool {
+	if x != nil {
+		return x.IndirectFire
+	}
+	return false
+}
+
+func (x *UnitDefinition) GetTransportCapacity() int32 {
+	if x != nil {
+		return x.TransportCapacity
+	}
+	return 0
+}
+
+func (x *UnitDefinition) GetCargoClasses() []string {
+	if x != nil {
+		return x.CargoClasses
+	}
+	return nil
+}
+
+func (x *UnitDefinition) GetHealAmount() int32 {
+	if x != nil {
+		return x.HealAmount
+	}
+	return 0
+}
+
+// Rules that constrain how units move around other units
+type MovementRules struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether units can move through hexes held by their own and allied units.
+	// Units can never end a move on another unit.
+	PassThroughAllies bool `protobuf:"varint,1,opt,name=pass_through_allies,json=passThroughAllies,proto3" json:"pass_through_allies,omitempty"`
+	// Unit classes that exert a zone of control.  An enemy unit entering a hex
+	// next to one of these units must stop there.
+	ZocUnitClasses []string `protobuf:"bytes,2,rep,name=zoc_unit_classes,json=zocUnitClasses,proto3" json:"zoc_unit_classes,omitempty"`
+	// Unit classes that ignore zones of control (eg "air")
+	ZocImmuneClasses []string `protobuf:"bytes,3,rep,name=zoc_immune_classes,json=zocImmuneClasses,proto3" json:"zoc_immune_classes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MovementRules) Reset() {
+	*x = MovementRules{}
 	mi := &file_weewar_v1_models_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MovementMatrix) String() string {
+func (x *MovementRules) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MovementMatrix) ProtoMessage() {}
+func (*MovementRules) ProtoMessage() {}
 
-func (x *MovementMatrix) ProtoReflect() protoreflect.Message {
+func (x *MovementRules) ProtoReflect() protoreflect.Message {
 	mi := &file_weewar_v1_models_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -821,40 +1026,60 @@ func (x *MovementMatrix) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MovementMatrix.ProtoReflect.Descriptor instead.
-func (*MovementMatrix) Descriptor() ([]byte, []int) {
+// Deprecated: Use MovementRules.ProtoReflect.Descriptor instead.
+func (*MovementRules) Descriptor() ([]byte, []int) {
 	return file_weewar_v1_models_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *MovementMatrix) GetCosts() map[int32]*TerrainCostMap {
+func (x *MovementRules) GetPassThroughAllies() bool {
 	if x != nil {
-		return x.Costs
+		return x.PassThroughAllies
+	}
+	return false
+}
+
+func (x *MovementRules) GetZocUnitClasses() []string {
+	if x != nil {
+		return x.ZocUnitClasses
 	}
 	return nil
 }
 
-type TerrainCostMap struct {
+func (x *MovementRules) GetZocImmuneClasses() []string {
+	if x != nil {
+		return x.ZocImmuneClasses
+	}
+	return nil
+}
+
+// Rules that adjust the damage units deal in combat beyond the attack matrix.  An
+// attacker's damage is always scaled by its remaining health and reduced by the
+// defense bonus of the defender's terrain.
+type CombatRules struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Map of terrain_id -> movement_cost
-	TerrainCosts  map[int32]float64 `protobuf:"bytes,1,rep,name=terrain_costs,json=terrainCosts,proto3" json:"terrain_costs,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Damage multipliers keyed by attacker unit class.  Missing entries leave the
+	// damage unchanged.
+	ClassModifiers map[string]*ClassDamageModifiers `protobuf:"bytes,1,rep,name=class_modifiers,json=classModifiers,proto3" json:"class_modifiers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Defender unit classes that get no defense bonus from terrain (eg "air")
+	TerrainDefenseIgnoredClasses []string `protobuf:"bytes,2,rep,name=terrain_defense_ignored_classes,json=terrainDefenseIgnoredClasses,proto3" json:"terrain_defense_ignored_classes,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
 }
 
-func (x *TerrainCostMap) Reset() {
-	*x = TerrainCostMap{}
+func (x *CombatRules) Reset() {
+	*x = CombatRules{}
 	mi := &file_weewar_v1_models_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TerrainCostMap) String() string {
+func (x *CombatRules) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TerrainCostMap) ProtoMessage() {}
+func (*CombatRules) ProtoMessage() {}
 
-func (x *TerrainCostMap) ProtoReflect() protoreflect.Message {
+func (x *CombatRules) ProtoReflect() protoreflect.Message {
 	mi := &file_weewar_v1_models_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -866,59 +1091,48 @@ func (x *TerrainCostMap) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TerrainCostMap.ProtoReflect.Descriptor instead.
-func (*TerrainCostMap) Descriptor() ([]byte, []int) {
+// Deprecated: Use CombatRules.ProtoReflect.Descriptor instead.
+func (*CombatRules) Descriptor() ([]byte, []int) {
 	return file_weewar_v1_models_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *TerrainCostMap) GetTerrainCosts() map[int32]float64 {
+func (x *CombatRules) GetClassModifiers() map[string]*ClassDamageModifiers {
 	if x != nil {
-		return x.TerrainCosts
+		return x.ClassModifiers
 	}
 	return nil
 }
 
-// Describes a game and its metadata
-type Game struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	// Unique ID for the game
-	Id string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
-	// User who started/created the game
-	CreatorId string `protobuf:"bytes,4,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	// The world this game was created from
-	WorldId string `protobuf:"bytes,5,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
-	// Name if items have names
-	Name string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	// Description if game has a description
-	Description string `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	// Some tags
-	Tags []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
-	// A possible image url
-	ImageUrl string `protobuf:"bytes,9,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	// Difficulty - example attribute
-	Difficulty string `protobuf:"bytes,10,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
-	// Game configuration
-	Config        *GameConfiguration `protobuf:"bytes,11,opt,name=config,proto3" json:"config,omitempty"`
+func (x *CombatRules) GetTerrainDefenseIgnoredClasses() []string {
+	if x != nil {
+		return x.TerrainDefenseIgnoredClasses
+	}
+	return nil
+}
+
+// Actions units can take to change terrain during play (eg building bridges or laying roads)
+type TerrainActionRules struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Terrain actions keyed by their ID
+	Actions       map[string]*TerrainAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Game) Reset() {
-	*x = Game{}
+func (x *TerrainActionRules) Reset() {
+	*x = TerrainActionRules{}
 	mi := &file_weewar_v1_models_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Game) String() string {
+func (x *TerrainActionRules) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Game) ProtoMessage() {}
+func (*TerrainActionRules) ProtoMessage() {}
 
-func (x *Game) ProtoReflect() protoreflect.Message {
+func (x *TerrainActionRules) ProtoReflect() protoreflect.Message {
 	mi := &file_weewar_v1_models_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -930,113 +1144,176 @@ func (x *Game) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Game.ProtoReflect.Descriptor instead.
-func (*Game) Descriptor() ([]byte, []int) {
+// Deprecated: Use TerrainActionRules.ProtoReflect.Descriptor instead.
+func (*TerrainActionRules) Descriptor() ([]byte, []int) {
 	return file_weewar_v1_models_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Game) GetCreatedAt() *timestamppb.Timestamp {
+func (x *TerrainActionRules) GetActions() map[string]*TerrainAction {
 	if x != nil {
-		return x.CreatedAt
+		return x.Actions
 	}
 	return nil
 }
 
-func (x *Game) GetUpdatedAt() *timestamppb.Timestamp {
+// A change units with the right property can make to a tile over one or more turns
+type TerrainAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Terrain the action can be used on -> terrain it turns into
+	TerrainChanges map[int32]int32 `protobuf:"bytes,3,rep,name=terrain_changes,json=terrainChanges,proto3" json:"terrain_changes,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Turns of work needed to complete the change (0 = 1)
+	Turns int32 `protobuf:"varint,4,opt,name=turns,proto3" json:"turns,omitempty"`
+	// Coins paid when work on a tile starts (0 = free)
+	Coins int32 `protobuf:"varint,5,opt,name=coins,proto3" json:"coins,omitempty"`
+	// Unit property needed to perform the action (eg "engineer")
+	UnitProperty  string `protobuf:"bytes,6,opt,name=unit_property,json=unitProperty,proto3" json:"unit_property,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerrainAction) Reset() {
+	*x = TerrainAction{}
+	mi := &file_weewar_v1_models_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerrainAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerrainAction) ProtoMessage() {}
+
+func (x *TerrainAction) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[12]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Game) GetId() string {
+// Deprecated: Use TerrainAction.ProtoReflect.Descriptor instead.
+func (*TerrainAction) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TerrainAction) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Game) GetCreatorId() string {
+func (x *TerrainAction) GetName() string {
 	if x != nil {
-		return x.CreatorId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Game) GetWorldId() string {
+func (x *TerrainAction) GetTerrainChanges() map[int32]int32 {
 	if x != nil {
-		return x.WorldId
+		return x.TerrainChanges
 	}
-	return ""
+	return nil
 }
 
-func (x *Game) GetName() string {
+func (x *TerrainAction) GetTurns() int32 {
 	if x != nil {
-		return x.Name
+		return x.Turns
 	}
-	return ""
+	return 0
 }
 
-func (x *Game) GetDescription() string {
+func (x *TerrainAction) GetCoins() int32 {
 	if x != nil {
-		return x.Description
+		return x.Coins
 	}
-	return ""
+	return 0
 }
 
-func (x *Game) GetTags() []string {
+func (x *TerrainAction) GetUnitProperty() string {
 	if x != nil {
-		return x.Tags
+		return x.UnitProperty
 	}
-	return nil
+	return ""
 }
 
-func (x *Game) GetImageUrl() string {
-	if x != nil {
-		return x.ImageUrl
-	}
-	return ""
+// Damage multipliers an attacker class applies to each defender unit class
+type ClassDamageModifiers struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DefenderClasses map[string]float64     `protobuf:"bytes,1,rep,name=defender_classes,json=defenderClasses,proto3" json:"defender_classes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *Game) GetDifficulty() string {
+func (x *ClassDamageModifiers) Reset() {
+	*x = ClassDamageModifiers{}
+	mi := &file_weewar_v1_models_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassDamageModifiers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassDamageModifiers) ProtoMessage() {}
+
+func (x *ClassDamageModifiers) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[13]
 	if x != nil {
-		return x.Difficulty
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Game) GetConfig() *GameConfiguration {
+// Deprecated: Use ClassDamageModifiers.ProtoReflect.Descriptor instead.
+func (*ClassDamageModifiers) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ClassDamageModifiers) GetDefenderClasses() map[string]float64 {
 	if x != nil {
-		return x.Config
+		return x.DefenderClasses
 	}
 	return nil
 }
 
-type GameConfiguration struct {
+// Movement cost matrix for unit types on terrain types
+type MovementMatrix struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Player configuration
-	Players []*GamePlayer `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
-	// Game settings
-	Settings      *GameSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	// Map of unit_id -> (terrain_id -> movement_cost)
+	Costs         map[int32]*TerrainCostMap `protobuf:"bytes,1,rep,name=costs,proto3" json:"costs,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameConfiguration) Reset() {
-	*x = GameConfiguration{}
-	mi := &file_weewar_v1_models_proto_msgTypes[12]
+func (x *MovementMatrix) Reset() {
+	*x = MovementMatrix{}
+	mi := &file_weewar_v1_models_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameConfiguration) String() string {
+func (x *MovementMatrix) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameConfiguration) ProtoMessage() {}
+func (*MovementMatrix) ProtoMessage() {}
 
-func (x *GameConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_models_proto_msgTypes[12]
+func (x *MovementMatrix) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1047,54 +1324,41 @@ func (x *GameConfiguration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameConfiguration.ProtoReflect.Descriptor instead.
-func (*GameConfiguration) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_models_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *GameConfiguration) GetPlayers() []*GamePlayer {
-	if x != nil {
-		return x.Players
-	}
-	return nil
+// Deprecated: Use MovementMatrix.ProtoReflect.Descriptor instead.
+func (*MovementMatrix) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GameConfiguration) GetSettings() *GameSettings {
+func (x *MovementMatrix) GetCosts() map[int32]*TerrainCostMap {
 	if x != nil {
-		return x.Settings
+		return x.Costs
 	}
 	return nil
 }
 
-type GamePlayer struct {
+type TerrainCostMap struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Player ID (1-based)
-	PlayerId int32 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	// Player type
-	PlayerType string `protobuf:"bytes,2,opt,name=player_type,json=playerType,proto3" json:"player_type,omitempty"` // "human", "ai", "open"
-	// Player color
-	Color string `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
-	// Team ID (0 = no team, 1+ = team number)
-	TeamId        int32 `protobuf:"varint,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// Map of terrain_id -> movement_cost
+	TerrainCosts  map[int32]float64 `protobuf:"bytes,1,rep,name=terrain_costs,json=terrainCosts,proto3" json:"terrain_costs,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GamePlayer) Reset() {
-	*x = GamePlayer{}
-	mi := &file_weewar_v1_models_proto_msgTypes[13]
+func (x *TerrainCostMap) Reset() {
+	*x = TerrainCostMap{}
+	mi := &file_weewar_v1_models_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GamePlayer) String() string {
+func (x *TerrainCostMap) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GamePlayer) ProtoMessage() {}
+func (*TerrainCostMap) ProtoMessage() {}
 
-func (x *GamePlayer) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_models_proto_msgTypes[13]
+func (x *TerrainCostMap) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1105,68 +1369,42 @@ func (x *GamePlayer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GamePlayer.ProtoReflect.Descriptor instead.
-func (*GamePlayer) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_models_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *GamePlayer) GetPlayerId() int32 {
-	if x != nil {
-		return x.PlayerId
-	}
-	return 0
-}
-
-func (x *GamePlayer) GetPlayerType() string {
-	if x != nil {
-		return x.PlayerType
-	}
-	return ""
-}
-
-func (x *GamePlayer) GetColor() string {
-	if x != nil {
-		return x.Color
-	}
-	return ""
+// Deprecated: Use TerrainCostMap.ProtoReflect.Descriptor instead.
+func (*TerrainCostMap) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GamePlayer) GetTeamId() int32 {
+func (x *TerrainCostMap) GetTerrainCosts() map[int32]float64 {
 	if x != nil {
-		return x.TeamId
+		return x.TerrainCosts
 	}
-	return 0
+	return nil
 }
 
-type GameSettings struct {
+// Attack matrix of the damage each unit type deals to each other unit type
+type AttackMatrix struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of allowed unit type IDs
-	AllowedUnits []int32 `protobuf:"varint,1,rep,packed,name=allowed_units,json=allowedUnits,proto3" json:"allowed_units,omitempty"`
-	// Turn time limit in seconds (0 = no limit)
-	TurnTimeLimit int32 `protobuf:"varint,2,opt,name=turn_time_limit,json=turnTimeLimit,proto3" json:"turn_time_limit,omitempty"`
-	// Team mode
-	TeamMode string `protobuf:"bytes,3,opt,name=team_mode,json=teamMode,proto3" json:"team_mode,omitempty"` // "ffa" or "teams"
-	// Maximum number of turns (0 = unlimited)
-	MaxTurns      int32 `protobuf:"varint,4,opt,name=max_turns,json=maxTurns,proto3" json:"max_turns,omitempty"`
+	// Map of attacker unit_id -> (defender unit_id -> damage distribution)
+	Attacks       map[int32]*DefenderDamageMap `protobuf:"bytes,1,rep,name=attacks,proto3" json:"attacks,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameSettings) Reset() {
-	*x = GameSettings{}
-	mi := &file_weewar_v1_models_proto_msgTypes[14]
+func (x *AttackMatrix) Reset() {
+	*x = AttackMatrix{}
+	mi := &file_weewar_v1_models_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameSettings) String() string {
+func (x *AttackMatrix) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameSettings) ProtoMessage() {}
+func (*AttackMatrix) ProtoMessage() {}
 
-func (x *GameSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_models_proto_msgTypes[14]
+func (x *AttackMatrix) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1177,68 +1415,89 @@ func (x *GameSettings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameSettings.ProtoReflect.Descriptor instead.
-func (*GameSettings) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_models_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use AttackMatrix.ProtoReflect.Descriptor instead.
+func (*AttackMatrix) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GameSettings) GetAllowedUnits() []int32 {
+func (x *AttackMatrix) GetAttacks() map[int32]*DefenderDamageMap {
 	if x != nil {
-		return x.AllowedUnits
+		return x.Attacks
 	}
 	return nil
 }
 
-func (x *GameSettings) GetTurnTimeLimit() int32 {
-	if x != nil {
-		return x.TurnTimeLimit
-	}
-	return 0
+type DefenderDamageMap struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Map of defender unit_id -> damage distribution.  Missing defenders cannot be attacked.
+	DefenderDamages map[int32]*DamageDistribution `protobuf:"bytes,1,rep,name=defender_damages,json=defenderDamages,proto3" json:"defender_damages,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GameSettings) GetTeamMode() string {
+func (x *DefenderDamageMap) Reset() {
+	*x = DefenderDamageMap{}
+	mi := &file_weewar_v1_models_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefenderDamageMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefenderDamageMap) ProtoMessage() {}
+
+func (x *DefenderDamageMap) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[17]
 	if x != nil {
-		return x.TeamMode
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GameSettings) GetMaxTurns() int32 {
+// Deprecated: Use DefenderDamageMap.ProtoReflect.Descriptor instead.
+func (*DefenderDamageMap) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DefenderDamageMap) GetDefenderDamages() map[int32]*DamageDistribution {
 	if x != nil {
-		return x.MaxTurns
+		return x.DefenderDamages
 	}
-	return 0
+	return nil
 }
 
-// Holds the game's Active/Current state (eg world state)
-type GameState struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	// ID of the game whos state is being tracked
-	GameId        string `protobuf:"bytes,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	TurnCounter   int32  `protobuf:"varint,4,opt,name=turn_counter,json=turnCounter,proto3" json:"turn_counter,omitempty"`
-	CurrentPlayer int32  `protobuf:"varint,5,opt,name=current_player,json=currentPlayer,proto3" json:"current_player,omitempty"`
-	// Current world state
-	WorldData     *WorldData `protobuf:"bytes,6,opt,name=world_data,json=worldData,proto3" json:"world_data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Distribution of the damage an attack deals
+type DamageDistribution struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MinDamage      int32                  `protobuf:"varint,1,opt,name=min_damage,json=minDamage,proto3" json:"min_damage,omitempty"`
+	MaxDamage      int32                  `protobuf:"varint,2,opt,name=max_damage,json=maxDamage,proto3" json:"max_damage,omitempty"`
+	DamageBuckets  []*DamageBucket        `protobuf:"bytes,3,rep,name=damage_buckets,json=damageBuckets,proto3" json:"damage_buckets,omitempty"`
+	ExpectedDamage float64                `protobuf:"fixed64,4,opt,name=expected_damage,json=expectedDamage,proto3" json:"expected_damage,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GameState) Reset() {
-	*x = GameState{}
-	mi := &file_weewar_v1_models_proto_msgTypes[15]
+func (x *DamageDistribution) Reset() {
+	*x = DamageDistribution{}
+	mi := &file_weewar_v1_models_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameState) String() string {
+func (x *DamageDistribution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameState) ProtoMessage() {}
+func (*DamageDistribution) ProtoMessage() {}
 
-func (x *GameState) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_models_proto_msgTypes[15]
+func (x *DamageDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1249,72 +1508,63 @@ func (x *GameState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameState.ProtoReflect.Descriptor instead.
-func (*GameState) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_models_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *GameState) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
+// Deprecated: Use DamageDistribution.ProtoReflect.Descriptor instead.
+func (*DamageDistribution) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GameState) GetGameId() string {
+func (x *DamageDistribution) GetMinDamage() int32 {
 	if x != nil {
-		return x.GameId
+		return x.MinDamage
 	}
-	return ""
+	return 0
 }
 
-func (x *GameState) GetTurnCounter() int32 {
+func (x *DamageDistribution) GetMaxDamage() int32 {
 	if x != nil {
-		return x.TurnCounter
+		return x.MaxDamage
 	}
 	return 0
 }
 
-func (x *GameState) GetCurrentPlayer() int32 {
+func (x *DamageDistribution) GetDamageBuckets() []*DamageBucket {
 	if x != nil {
-		return x.CurrentPlayer
+		return x.DamageBuckets
 	}
-	return 0
+	return nil
 }
 
-func (x *GameState) GetWorldData() *WorldData {
+func (x *DamageDistribution) GetExpectedDamage() float64 {
 	if x != nil {
-		return x.WorldData
+		return x.ExpectedDamage
 	}
-	return nil
+	return 0
 }
 
-// Holds the game's move history (can be used as a replay log)
-type GameMoveHistory struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Move history for the game
-	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	// Each entry in our history is a "group" of moves
-	Groups        []*GameMoveGroup `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+// A damage value and the weight of it being rolled
+type DamageBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Damage        int32                  `protobuf:"varint,1,opt,name=damage,proto3" json:"damage,omitempty"`
+	Weight        float64                `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameMoveHistory) Reset() {
-	*x = GameMoveHistory{}
-	mi := &file_weewar_v1_models_proto_msgTypes[16]
+func (x *DamageBucket) Reset() {
+	*x = DamageBucket{}
+	mi := &file_weewar_v1_models_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameMoveHistory) String() string {
+func (x *DamageBucket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameMoveHistory) ProtoMessage() {}
+func (*DamageBucket) ProtoMessage() {}
 
-func (x *GameMoveHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_models_proto_msgTypes[16]
+func (x *DamageBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1325,56 +1575,2332 @@ func (x *GameMoveHistory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameMoveHistory.ProtoReflect.Descriptor instead.
-func (*GameMoveHistory) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_models_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use DamageBucket.ProtoReflect.Descriptor instead.
+func (*DamageBucket) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GameMoveHistory) GetGameId() string {
+func (x *DamageBucket) GetDamage() int32 {
 	if x != nil {
-		return x.GameId
+		return x.Damage
+	}
+	return 0
+}
+
+func (x *DamageBucket) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// A complete rule set as loaded by the rules engine
+type RuleSet struct {
+	state          protoimpl.MessageState       `protogen:"open.v1"`
+	Units          map[int32]*UnitDefinition    `protobuf:"bytes,1,rep,name=units,proto3" json:"units,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Terrains       map[int32]*TerrainDefinition `protobuf:"bytes,2,rep,name=terrains,proto3" json:"terrains,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MovementMatrix *MovementMatrix              `protobuf:"bytes,3,opt,name=movement_matrix,json=movementMatrix,proto3" json:"movement_matrix,omitempty"`
+	AttackMatrix   *AttackMatrix                `protobuf:"bytes,4,opt,name=attack_matrix,json=attackMatrix,proto3" json:"attack_matrix,omitempty"`
+	MovementRules  *MovementRules               `protobuf:"bytes,5,opt,name=movement_rules,json=movementRules,proto3" json:"movement_rules,omitempty"`
+	CombatRules    *CombatRules                 `protobuf:"bytes,6,opt,name=combat_rules,json=combatRules,proto3" json:"combat_rules,omitempty"`
+	TerrainActions *TerrainActionRules          `protobuf:"bytes,7,opt,name=terrain_actions,json=terrainActions,proto3" json:"terrain_actions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RuleSet) Reset() {
+	*x = RuleSet{}
+	mi := &file_weewar_v1_models_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleSet) ProtoMessage() {}
+
+func (x *RuleSet) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleSet.ProtoReflect.Descriptor instead.
+func (*RuleSet) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RuleSet) GetUnits() map[int32]*UnitDefinition {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+func (x *RuleSet) GetTerrains() map[int32]*TerrainDefinition {
+	if x != nil {
+		return x.Terrains
+	}
+	return nil
+}
+
+func (x *RuleSet) GetMovementMatrix() *MovementMatrix {
+	if x != nil {
+		return x.MovementMatrix
+	}
+	return nil
+}
+
+func (x *RuleSet) GetAttackMatrix() *AttackMatrix {
+	if x != nil {
+		return x.AttackMatrix
+	}
+	return nil
+}
+
+func (x *RuleSet) GetMovementRules() *MovementRules {
+	if x != nil {
+		return x.MovementRules
+	}
+	return nil
+}
+
+func (x *RuleSet) GetCombatRules() *CombatRules {
+	if x != nil {
+		return x.CombatRules
+	}
+	return nil
+}
+
+func (x *RuleSet) GetTerrainActions() *TerrainActionRules {
+	if x != nil {
+		return x.TerrainActions
+	}
+	return nil
+}
+
+// Describes a game and its metadata
+type Game struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Unique ID for the game
+	Id string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// User who started/created the game
+	CreatorId string `protobuf:"bytes,4,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	// The world this game was created from
+	WorldId string `protobuf:"bytes,5,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	// Name if items have names
+	Name string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	// Description if game has a description
+	Description string `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	// Some tags
+	Tags []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	// A possible image url
+	ImageUrl string `protobuf:"bytes,9,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	// Difficulty - example attribute
+	Difficulty string `protobuf:"bytes,10,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	// Game configuration
+	Config *GameConfiguration `protobuf:"bytes,11,opt,name=config,proto3" json:"config,omitempty"`
+	// ID of the rule set the game is played under ("" = the default rules, for games
+	// created before rules were versioned)
+	RulesId       string `protobuf:"bytes,12,opt,name=rules_id,json=rulesId,proto3" json:"rules_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Game) Reset() {
+	*x = Game{}
+	mi := &file_weewar_v1_models_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Game) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Game) ProtoMessage() {}
+
+func (x *Game) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Game.ProtoReflect.Descriptor instead.
+func (*Game) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Game) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Game) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Game) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GameMoveHistory) GetGroups() []*GameMoveGroup {
+func (x *Game) GetCreatorId() string {
 	if x != nil {
-		return x.Groups
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *Game) GetWorldId() string {
+	if x != nil {
+		return x.WorldId
+	}
+	return ""
+}
+
+func (x *Game) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Game) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Game) GetTags() []string {
+	if x != nil {
+		return x.Tags
 	}
 	return nil
 }
 
-// A move group - we can allow X moves in one "tick"
-type GameMoveGroup struct {
+func (x *Game) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Game) GetDifficulty() string {
+	if x != nil {
+		return x.Difficulty
+	}
+	return ""
+}
+
+func (x *Game) GetConfig() *GameConfiguration {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *Game) GetRulesId() string {
+	if x != nil {
+		return x.RulesId
+	}
+	return ""
+}
+
+type GameConfiguration struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Player configuration
+	Players []*GamePlayer `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	// Game settings
+	Settings      *GameSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameConfiguration) Reset() {
+	*x = GameConfiguration{}
+	mi := &file_weewar_v1_models_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameConfiguration) ProtoMessage() {}
+
+func (x *GameConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameConfiguration.ProtoReflect.Descriptor instead.
+func (*GameConfiguration) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GameConfiguration) GetPlayers() []*GamePlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameConfiguration) GetSettings() *GameSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type GamePlayer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Player ID (1-based)
+	PlayerId int32 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// Player type
+	PlayerType string `protobuf:"bytes,2,opt,name=player_type,json=playerType,proto3" json:"player_type,omitempty"` // "human", "ai", "open"
+	// Player color
+	Color string `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	// Team ID (0 = no team, 1+ = team number)
+	TeamId        int32 `protobuf:"varint,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GamePlayer) Reset() {
+	*x = GamePlayer{}
+	mi := &file_weewar_v1_models_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GamePlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GamePlayer) ProtoMessage() {}
+
+func (x *GamePlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GamePlayer.ProtoReflect.Descriptor instead.
+func (*GamePlayer) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GamePlayer) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *GamePlayer) GetPlayerType() string {
+	if x != nil {
+		return x.PlayerType
+	}
+	return ""
+}
+
+func (x *GamePlayer) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *GamePlayer) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+type GameSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of allowed unit type IDs
+	AllowedUnits []int32 `protobuf:"varint,1,rep,packed,name=allowed_units,json=allowedUnits,proto3" json:"allowed_units,omitempty"`
+	// Turn time limit in seconds (0 = no limit)
+	TurnTimeLimit int32 `protobuf:"varint,2,opt,name=turn_time_limit,json=turnTimeLimit,proto3" json:"turn_time_limit,omitempty"`
+	// Team mode
+	TeamMode string `protobuf:"bytes,3,opt,name=team_mode,json=teamMode,proto3" json:"team_mode,omitempty"` // "ffa" or "teams"
+	// Maximum number of turns (0 = unlimited)
+	MaxTurns int32 `protobuf:"varint,4,opt,name=max_turns,json=maxTurns,proto3" json:"max_turns,omitempty"`
+	// Coin economy settings
+	Coins *CoinSettings `protobuf:"bytes,5,opt,name=coins,proto3" json:"coins,omitempty"`
+	// Whether players can only see what their units and buildings can see
+	FogOfWar bool `protobuf:"varint,6,opt,name=fog_of_war,json=fogOfWar,proto3" json:"fog_of_war,omitempty"`
+	// How the game is won
+	Victory *VictorySettings `protobuf:"bytes,7,opt,name=victory,proto3" json:"victory,omitempty"`
+	// Changes to the default rules for this game
+	RulesOverlay  *RulesOverlay `protobuf:"bytes,8,opt,name=rules_overlay,json=rulesOverlay,proto3" json:"rules_overlay,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameSettings) Reset() {
+	*x = GameSettings{}
+	mi := &file_weewar_v1_models_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameSettings) ProtoMessage() {}
+
+func (x *GameSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameSettings.ProtoReflect.Descriptor instead.
+func (*GameSettings) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GameSettings) GetAllowedUnits() []int32 {
+	if x != nil {
+		return x.AllowedUnits
+	}
+	return nil
+}
+
+func (x *GameSettings) GetTurnTimeLimit() int32 {
+	if x != nil {
+		return x.TurnTimeLimit
+	}
+	return 0
+}
+
+func (x *GameSettings) GetTeamMode() string {
+	if x != nil {
+		return x.TeamMode
+	}
+	return ""
+}
+
+func (x *GameSettings) GetMaxTurns() int32 {
+	if x != nil {
+		return x.MaxTurns
+	}
+	return 0
+}
+
+func (x *GameSettings) GetCoins() *CoinSettings {
+	if x != nil {
+		return x.Coins
+	}
+	return nil
+}
+
+func (x *GameSettings) GetFogOfWar() bool {
+	if x != nil {
+		return x.FogOfWar
+	}
+	return false
+}
+
+func (x *GameSettings) GetVictory() *VictorySettings {
+	if x != nil {
+		return x.Victory
+	}
+	return nil
+}
+
+func (x *GameSettings) GetRulesOverlay() *RulesOverlay {
+	if x != nil {
+		return x.RulesOverlay
+	}
+	return nil
+}
+
+// Per game changes applied on top of the default rules
+type RulesOverlay struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unit type IDs that cannot be built in this game
+	DisabledUnits []int32 `protobuf:"varint,1,rep,packed,name=disabled_units,json=disabledUnits,proto3" json:"disabled_units,omitempty"`
+	// Build costs keyed by unit type ID, replacing the unit's default cost
+	UnitCoins map[int32]int32 `protobuf:"bytes,2,rep,name=unit_coins,json=unitCoins,proto3" json:"unit_coins,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Damage multipliers keyed by attacker unit type ID
+	DamageMultipliers map[int32]float64 `protobuf:"bytes,3,rep,name=damage_multipliers,json=damageMultipliers,proto3" json:"damage_multipliers,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RulesOverlay) Reset() {
+	*x = RulesOverlay{}
+	mi := &file_weewar_v1_models_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RulesOverlay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RulesOverlay) ProtoMessage() {}
+
+func (x *RulesOverlay) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RulesOverlay.ProtoReflect.Descriptor instead.
+func (*RulesOverlay) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RulesOverlay) GetDisabledUnits() []int32 {
+	if x != nil {
+		return x.DisabledUnits
+	}
+	return nil
+}
+
+func (x *RulesOverlay) GetUnitCoins() map[int32]int32 {
+	if x != nil {
+		return x.UnitCoins
+	}
+	return nil
+}
+
+func (x *RulesOverlay) GetDamageMultipliers() map[int32]float64 {
+	if x != nil {
+		return x.DamageMultipliers
+	}
+	return nil
+}
+
+// The conditions under which a game is won.  The game ends as soon as any
+// enabled condition is met.  If none are enabled the last player with units
+// left wins.
+type VictorySettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Win by being the last player with units left
+	Elimination bool `protobuf:"varint,1,opt,name=elimination,proto3" json:"elimination,omitempty"`
+	// Win by owning this many bases (0 = disabled)
+	CaptureBases int32 `protobuf:"varint,2,opt,name=capture_bases,json=captureBases,proto3" json:"capture_bases,omitempty"`
+	// Tile type of the players' headquarters.  Win by owning every headquarters
+	// on the map (0 = disabled)
+	HeadquartersTileType int32 `protobuf:"varint,3,opt,name=headquarters_tile_type,json=headquartersTileType,proto3" json:"headquarters_tile_type,omitempty"`
+	// Win by having the highest score when GameSettings.max_turns is reached
+	ScoreAtMaxTurns bool `protobuf:"varint,4,opt,name=score_at_max_turns,json=scoreAtMaxTurns,proto3" json:"score_at_max_turns,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VictorySettings) Reset() {
+	*x = VictorySettings{}
+	mi := &file_weewar_v1_models_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VictorySettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VictorySettings) ProtoMessage() {}
+
+func (x *VictorySettings) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VictorySettings.ProtoReflect.Descriptor instead.
+func (*VictorySettings) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *VictorySettings) GetElimination() bool {
+	if x != nil {
+		return x.Elimination
+	}
+	return false
+}
+
+func (x *VictorySettings) GetCaptureBases() int32 {
+	if x != nil {
+		return x.CaptureBases
+	}
+	return 0
+}
+
+func (x *VictorySettings) GetHeadquartersTileType() int32 {
+	if x != nil {
+		return x.HeadquartersTileType
+	}
+	return 0
+}
+
+func (x *VictorySettings) GetScoreAtMaxTurns() bool {
+	if x != nil {
+		return x.ScoreAtMaxTurns
+	}
+	return false
+}
+
+// How close a player is to meeting each of a game's victory conditions
+type VictoryProgress struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Player int32                  `protobuf:"varint,1,opt,name=player,proto3" json:"player,omitempty"`
+	// Victory condition name -> progress (0 to 1)
+	Progress      map[string]float64 `protobuf:"bytes,2,rep,name=progress,proto3" json:"progress,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VictoryProgress) Reset() {
+	*x = VictoryProgress{}
+	mi := &file_weewar_v1_models_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VictoryProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VictoryProgress) ProtoMessage() {}
+
+func (x *VictoryProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VictoryProgress.ProtoReflect.Descriptor instead.
+func (*VictoryProgress) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *VictoryProgress) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+func (x *VictoryProgress) GetProgress() map[string]float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+// Describes how players earn coins over the course of a game
+type CoinSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Coins each player starts the game with
+	StartOfGame int32 `protobuf:"varint,1,opt,name=start_of_game,json=startOfGame,proto3" json:"start_of_game,omitempty"`
+	// Coins credited to a player at the start of each of their turns
+	PerTurn int32 `protobuf:"varint,2,opt,name=per_turn,json=perTurn,proto3" json:"per_turn,omitempty"`
+	// Additional coins credited per base owned at the start of each turn
+	PerBase       int32 `protobuf:"varint,3,opt,name=per_base,json=perBase,proto3" json:"per_base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoinSettings) Reset() {
+	*x = CoinSettings{}
+	mi := &file_weewar_v1_models_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoinSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoinSettings) ProtoMessage() {}
+
+func (x *CoinSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoinSettings.ProtoReflect.Descriptor instead.
+func (*CoinSettings) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CoinSettings) GetStartOfGame() int32 {
+	if x != nil {
+		return x.StartOfGame
+	}
+	return 0
+}
+
+func (x *CoinSettings) GetPerTurn() int32 {
+	if x != nil {
+		return x.PerTurn
+	}
+	return 0
+}
+
+func (x *CoinSettings) GetPerBase() int32 {
+	if x != nil {
+		return x.PerBase
+	}
+	return 0
+}
+
+// Holds the game's Active/Current state (eg world state)
+type GameState struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// ID of the game whos state is being tracked
+	GameId        string `protobuf:"bytes,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	TurnCounter   int32  `protobuf:"varint,4,opt,name=turn_counter,json=turnCounter,proto3" json:"turn_counter,omitempty"`
+	CurrentPlayer int32  `protobuf:"varint,5,opt,name=current_player,json=currentPlayer,proto3" json:"current_player,omitempty"`
+	// Current world state
+	WorldData *WorldData `protobuf:"bytes,6,opt,name=world_data,json=worldData,proto3" json:"world_data,omitempty"`
+	// Coins currently held by each player (player ID -> coins)
+	PlayerCoins map[int32]int32 `protobuf:"bytes,7,rep,name=player_coins,json=playerCoins,proto3" json:"player_coins,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Sequence number of the last move processed in this game (0 if no moves yet).
+	// Sequence numbers are assigned by the server and never reused.
+	LastSequenceNum int64 `protobuf:"varint,8,opt,name=last_sequence_num,json=lastSequenceNum,proto3" json:"last_sequence_num,omitempty"`
+	// Seed of the game's random stream (eg for combat) and how many values have
+	// been drawn from it so far.  Together they let the exact stream be restored
+	// when the game is reloaded or replayed.  They are kept on the server and
+	// cleared in responses so players cannot predict the outcome of their moves.
+	RngSeed     int64 `protobuf:"varint,9,opt,name=rng_seed,json=rngSeed,proto3" json:"rng_seed,omitempty"`
+	RngPosition int64 `protobuf:"varint,10,opt,name=rng_position,json=rngPosition,proto3" json:"rng_position,omitempty"`
+	// When the current player's turn started and when it ends if the game has a
+	// turn time limit (unset otherwise).  Once the deadline passes the server ends
+	// the turn on the player's behalf.
+	TurnStartedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=turn_started_at,json=turnStartedAt,proto3" json:"turn_started_at,omitempty"`
+	TurnDeadline  *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=turn_deadline,json=turnDeadline,proto3" json:"turn_deadline,omitempty"`
+	// Player that won the game (0 while the game is in progress) and the victory
+	// condition that decided it
+	Winner           int32  `protobuf:"varint,13,opt,name=winner,proto3" json:"winner,omitempty"`
+	VictoryCondition string `protobuf:"bytes,14,opt,name=victory_condition,json=victoryCondition,proto3" json:"victory_condition,omitempty"`
+	// All the players that won - the winner and their teammates in team games
+	Winners       []int32 `protobuf:"varint,15,rep,packed,name=winners,proto3" json:"winners,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameState) Reset() {
+	*x = GameState{}
+	mi := &file_weewar_v1_models_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameState) ProtoMessage() {}
+
+func (x *GameState) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameState.ProtoReflect.Descriptor instead.
+func (*GameState) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GameState) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GameState) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameState) GetTurnCounter() int32 {
+	if x != nil {
+		return x.TurnCounter
+	}
+	return 0
+}
+
+func (x *GameState) GetCurrentPlayer() int32 {
+	if x != nil {
+		return x.CurrentPlayer
+	}
+	return 0
+}
+
+func (x *GameState) GetWorldData() *WorldData {
+	if x != nil {
+		return x.WorldData
+	}
+	return nil
+}
+
+func (x *GameState) GetPlayerCoins() map[int32]int32 {
+	if x != nil {
+		return x.PlayerCoins
+	}
+	return nil
+}
+
+func (x *GameState) GetLastSequenceNum() int64 {
+	if x != nil {
+		return x.LastSequenceNum
+	}
+	return 0
+}
+
+func (x *GameState) GetRngSeed() int64 {
+	if x != nil {
+		return x.RngSeed
+	}
+	return 0
+}
+
+func (x *GameState) GetRngPosition() int64 {
+	if x != nil {
+		return x.RngPosition
+	}
+	return 0
+}
+
+func (x *GameState) GetTurnStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TurnStartedAt
+	}
+	return nil
+}
+
+func (x *GameState) GetTurnDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TurnDeadline
+	}
+	return nil
+}
+
+func (x *GameState) GetWinner() int32 {
+	if x != nil {
+		return x.Winner
+	}
+	return 0
+}
+
+func (x *GameState) GetVictoryCondition() string {
+	if x != nil {
+		return x.VictoryCondition
+	}
+	return ""
+}
+
+func (x *GameState) GetWinners() []int32 {
+	if x != nil {
+		return x.Winners
+	}
+	return nil
+}
+
+// Holds the game's move history (can be used as a replay log)
+type GameMoveHistory struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Move history for the game
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Each entry in our history is a "group" of moves
+	Groups []*GameMoveGroup `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	// State of the game before any moves were made.  Replaying all the groups
+	// from this state reproduces the game's current state.
+	InitialState  *GameState `protobuf:"bytes,3,opt,name=initial_state,json=initialState,proto3" json:"initial_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameMoveHistory) Reset() {
+	*x = GameMoveHistory{}
+	mi := &file_weewar_v1_models_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameMoveHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameMoveHistory) ProtoMessage() {}
+
+func (x *GameMoveHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameMoveHistory.ProtoReflect.Descriptor instead.
+func (*GameMoveHistory) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GameMoveHistory) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameMoveHistory) GetGroups() []*GameMoveGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GameMoveHistory) GetInitialState() *GameState {
+	if x != nil {
+		return x.InitialState
+	}
+	return nil
+}
+
+// A move group - we can allow X moves in one "tick"
+type GameMoveGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// When the moves happened (or were submitted)
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	// *
+	// List of moves to add -
+	Moves []*GameMove `protobuf:"bytes,4,rep,name=moves,proto3" json:"moves,omitempty"`
+	// Each game move result stores the result of the individual Move in the request.
+	// ie move_results[i] = ResultOfProcessing(ProcessMoveRequest.moves[i])
+	MoveResults   []*GameMoveResult `protobuf:"bytes,5,rep,name=move_results,json=moveResults,proto3" json:"move_results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameMoveGroup) Reset() {
+	*x = GameMoveGroup{}
+	mi := &file_weewar_v1_models_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameMoveGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameMoveGroup) ProtoMessage() {}
+
+func (x *GameMoveGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameMoveGroup.ProtoReflect.Descriptor instead.
+func (*GameMoveGroup) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GameMoveGroup) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *GameMoveGroup) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *GameMoveGroup) GetMoves() []*GameMove {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+func (x *GameMoveGroup) GetMoveResults() []*GameMoveResult {
+	if x != nil {
+		return x.MoveResults
+	}
+	return nil
+}
+
+// *
+// Represents a single move which can be one of many actions in the game
+type GameMove struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Player    int32                  `protobuf:"varint,1,opt,name=player,proto3" json:"player,omitempty"` // The player making the move
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// A monotonically increasing and unique (within the game) sequence number for the move
+	// This is generated by the server
+	SequenceNum int64 `protobuf:"varint,3,opt,name=sequence_num,json=sequenceNum,proto3" json:"sequence_num,omitempty"`
+	// Types that are valid to be assigned to MoveType:
+	//
+	//	*GameMove_MoveUnit
+	//	*GameMove_AttackUnit
+	//	*GameMove_EndTurn
+	//	*GameMove_BuildUnit
+	//	*GameMove_CaptureBuilding
+	//	*GameMove_LoadUnit
+	//	*GameMove_UnloadUnit
+	//	*GameMove_HealUnit
+	//	*GameMove_ModifyTerrain
+	MoveType      isGameMove_MoveType `protobuf_oneof:"move_type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameMove) Reset() {
+	*x = GameMove{}
+	mi := &file_weewar_v1_models_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameMove) ProtoMessage() {}
+
+func (x *GameMove) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameMove.ProtoReflect.Descriptor instead.
+func (*GameMove) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GameMove) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+func (x *GameMove) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *GameMove) GetSequenceNum() int64 {
+	if x != nil {
+		return x.SequenceNum
+	}
+	return 0
+}
+
+func (x *GameMove) GetMoveType() isGameMove_MoveType {
+	if x != nil {
+		return x.MoveType
+	}
+	return nil
+}
+
+func (x *GameMove) GetMoveUnit() *MoveUnitAction {
+	if x != nil {
+		if x, ok := x.MoveType.(*GameMove_MoveUnit); ok {
+			return x.MoveUnit
+		}
+	}
+	return nil
+}
+
+func (x *GameMove) GetAttackUnit() *AttackUnitAction {
+	if x != nil {
+		if x, ok := x.MoveType.(*GameMove_AttackUnit); ok {
+			return x.AttackUnit
+		}
+	}
+	return nil
+}
+
+func (x *GameMove) GetEndTurn() *EndTurnAction {
+	if x != nil {
+		if x, ok := x.MoveType.(*GameMove_EndTurn); ok {
+			return x.EndTurn
+		}
+	}
+	return nil
+}
+
+func (x *GameMove) GetBuildUnit() *BuildUnitAction {
+	if x != nil {
+		if x, ok := x.MoveType.(*GameMove_BuildUnit); ok {
+			return x.BuildUnit
+		}
+	}
+	return nil
+}
+
+func (x *GameMove) GetCaptureBuilding() *CaptureBuildingAction {
+	if x != nil {
+		if x, ok := x.MoveType.(*GameMove_CaptureBuilding); ok {
+			return x.CaptureBuilding
+		}
+	}
+	return nil
+}
+
+func (x *GameMove) GetLoadUnit() *LoadUnitAction {
+	if x != nil {
+		if x, ok := x.MoveType.(*GameMove_LoadUnit); ok {
+			return x.LoadUnit
+		}
+	}
+	return nil
+}
+
+func (x *GameMove) GetUnloadUnit() *UnloadUnitAction {
+	if x != nil {
+		if x, ok := x.MoveType.(*GameMove_UnloadUnit); ok {
+			return x.UnloadUnit
+		}
+	}
+	return nil
+}
+
+func (x *GameMove) GetHealUnit() *HealUnitAction {
+	if x != nil {
+		if x, ok := x.MoveType.(*GameMove_HealUnit); ok {
+			return x.HealUnit
+		}
+	}
+	return nil
+}
+
+func (x *GameMove) GetModifyTerrain() *ModifyTerrainAction {
+	if x != nil {
+		if x, ok := x.MoveType.(*GameMove_ModifyTerrain); ok {
+			return x.ModifyTerrain
+		}
+	}
+	return nil
+}
+
+type isGameMove_MoveType interface {
+	isGameMove_MoveType()
+}
+
+type GameMove_MoveUnit struct {
+	MoveUnit *MoveUnitAction `protobuf:"bytes,4,opt,name=move_unit,json=moveUnit,proto3,oneof"`
+}
+
+type GameMove_AttackUnit struct {
+	AttackUnit *AttackUnitAction `protobuf:"bytes,5,opt,name=attack_unit,json=attackUnit,proto3,oneof"`
+}
+
+type GameMove_EndTurn struct {
+	EndTurn *EndTurnAction `protobuf:"bytes,6,opt,name=end_turn,json=endTurn,proto3,oneof"`
+}
+
+type GameMove_BuildUnit struct {
+	BuildUnit *BuildUnitAction `protobuf:"bytes,7,opt,name=build_unit,json=buildUnit,proto3,oneof"`
+}
+
+type GameMove_CaptureBuilding struct {
+	CaptureBuilding *CaptureBuildingAction `protobuf:"bytes,8,opt,name=capture_building,json=captureBuilding,proto3,oneof"`
+}
+
+type GameMove_LoadUnit struct {
+	LoadUnit *LoadUnitAction `protobuf:"bytes,9,opt,name=load_unit,json=loadUnit,proto3,oneof"`
+}
+
+type GameMove_UnloadUnit struct {
+	UnloadUnit *UnloadUnitAction `protobuf:"bytes,10,opt,name=unload_unit,json=unloadUnit,proto3,oneof"`
+}
+
+type GameMove_HealUnit struct {
+	HealUnit *HealUnitAction `protobuf:"bytes,11,opt,name=heal_unit,json=healUnit,proto3,oneof"`
+}
+
+type GameMove_ModifyTerrain struct {
+	ModifyTerrain *ModifyTerrainAction `protobuf:"bytes,12,opt,name=modify_terrain,json=modifyTerrain,proto3,oneof"`
+}
+
+func (*GameMove_MoveUnit) isGameMove_MoveType() {}
+
+func (*GameMove_AttackUnit) isGameMove_MoveType() {}
+
+func (*GameMove_EndTurn) isGameMove_MoveType() {}
+
+func (*GameMove_BuildUnit) isGameMove_MoveType() {}
+
+func (*GameMove_CaptureBuilding) isGameMove_MoveType() {}
+
+func (*GameMove_LoadUnit) isGameMove_MoveType() {}
+
+func (*GameMove_UnloadUnit) isGameMove_MoveType() {}
+
+func (*GameMove_HealUnit) isGameMove_MoveType() {}
+
+func (*GameMove_ModifyTerrain) isGameMove_MoveType() {}
+
+// *
+// Represents the result of executing a move
+type GameMoveResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the result is permenant and can be undone.
+	// Just moving a unit for example is not permanent, but attacking a unit
+	// would be (ie a player cannot undo it).
+	IsPermanent bool `protobuf:"varint,1,opt,name=is_permanent,json=isPermanent,proto3" json:"is_permanent,omitempty"`
+	// A monotonically increasing and unique (within the game) sequence number for the move
+	SequenceNum int64 `protobuf:"varint,2,opt,name=sequence_num,json=sequenceNum,proto3" json:"sequence_num,omitempty"`
+	// A set of changes to the world as a result of making this move
+	Changes       []*WorldChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameMoveResult) Reset() {
+	*x = GameMoveResult{}
+	mi := &file_weewar_v1_models_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameMoveResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameMoveResult) ProtoMessage() {}
+
+func (x *GameMoveResult) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameMoveResult.ProtoReflect.Descriptor instead.
+func (*GameMoveResult) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GameMoveResult) GetIsPermanent() bool {
+	if x != nil {
+		return x.IsPermanent
+	}
+	return false
+}
+
+func (x *GameMoveResult) GetSequenceNum() int64 {
+	if x != nil {
+		return x.SequenceNum
+	}
+	return 0
+}
+
+func (x *GameMoveResult) GetChanges() []*WorldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// *
+// A hex coordinate
+type HexCoord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Q             int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R             int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HexCoord) Reset() {
+	*x = HexCoord{}
+	mi := &file_weewar_v1_models_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HexCoord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HexCoord) ProtoMessage() {}
+
+func (x *HexCoord) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HexCoord.ProtoReflect.Descriptor instead.
+func (*HexCoord) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *HexCoord) GetQ() int32 {
+	if x != nil {
+		return x.Q
+	}
+	return 0
+}
+
+func (x *HexCoord) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+// *
+// Move unit from one position to another
+type MoveUnitAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	FromQ int32                  `protobuf:"varint,1,opt,name=from_q,json=fromQ,proto3" json:"from_q,omitempty"`
+	FromR int32                  `protobuf:"varint,2,opt,name=from_r,json=fromR,proto3" json:"from_r,omitempty"`
+	ToQ   int32                  `protobuf:"varint,3,opt,name=to_q,json=toQ,proto3" json:"to_q,omitempty"`
+	ToR   int32                  `protobuf:"varint,4,opt,name=to_r,json=toR,proto3" json:"to_r,omitempty"`
+	// The exact hexes the unit moves through, starting at (from_q, from_r) and ending
+	// at (to_q, to_r).  Each step is validated and charged its terrain cost.  If empty
+	// the cheapest path is used.
+	Path          []*HexCoord `protobuf:"bytes,5,rep,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveUnitAction) Reset() {
+	*x = MoveUnitAction{}
+	mi := &file_weewar_v1_models_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveUnitAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveUnitAction) ProtoMessage() {}
+
+func (x *MoveUnitAction) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveUnitAction.ProtoReflect.Descriptor instead.
+func (*MoveUnitAction) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MoveUnitAction) GetFromQ() int32 {
+	if x != nil {
+		return x.FromQ
+	}
+	return 0
+}
+
+func (x *MoveUnitAction) GetFromR() int32 {
+	if x != nil {
+		return x.FromR
+	}
+	return 0
+}
+
+func (x *MoveUnitAction) GetToQ() int32 {
+	if x != nil {
+		return x.ToQ
+	}
+	return 0
+}
+
+func (x *MoveUnitAction) GetToR() int32 {
+	if x != nil {
+		return x.ToR
+	}
+	return 0
+}
+
+func (x *MoveUnitAction) GetPath() []*HexCoord {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+// *
+// Attack with one unit against another
+type AttackUnitAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttackerQ     int32                  `protobuf:"varint,1,opt,name=attacker_q,json=attackerQ,proto3" json:"attacker_q,omitempty"`
+	AttackerR     int32                  `protobuf:"varint,2,opt,name=attacker_r,json=attackerR,proto3" json:"attacker_r,omitempty"`
+	DefenderQ     int32                  `protobuf:"varint,3,opt,name=defender_q,json=defenderQ,proto3" json:"defender_q,omitempty"`
+	DefenderR     int32                  `protobuf:"varint,4,opt,name=defender_r,json=defenderR,proto3" json:"defender_r,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttackUnitAction) Reset() {
+	*x = AttackUnitAction{}
+	mi := &file_weewar_v1_models_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttackUnitAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttackUnitAction) ProtoMessage() {}
+
+func (x *AttackUnitAction) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttackUnitAction.ProtoReflect.Descriptor instead.
+func (*AttackUnitAction) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AttackUnitAction) GetAttackerQ() int32 {
+	if x != nil {
+		return x.AttackerQ
+	}
+	return 0
+}
+
+func (x *AttackUnitAction) GetAttackerR() int32 {
+	if x != nil {
+		return x.AttackerR
+	}
+	return 0
+}
+
+func (x *AttackUnitAction) GetDefenderQ() int32 {
+	if x != nil {
+		return x.DefenderQ
+	}
+	return 0
+}
+
+func (x *AttackUnitAction) GetDefenderR() int32 {
+	if x != nil {
+		return x.DefenderR
+	}
+	return 0
+}
+
+// *
+// End current player's turn
+type EndTurnAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Set by the server when it ended the turn because the player ran out of time
+	TimedOut      bool `protobuf:"varint,1,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndTurnAction) Reset() {
+	*x = EndTurnAction{}
+	mi := &file_weewar_v1_models_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndTurnAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndTurnAction) ProtoMessage() {}
+
+func (x *EndTurnAction) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndTurnAction.ProtoReflect.Descriptor instead.
+func (*EndTurnAction) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *EndTurnAction) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+// *
+// Build a new unit on a base owned by the player
+type BuildUnitAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Q             int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R             int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	UnitType      int32                  `protobuf:"varint,3,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildUnitAction) Reset() {
+	*x = BuildUnitAction{}
+	mi := &file_weewar_v1_models_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildUnitAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildUnitAction) ProtoMessage() {}
+
+func (x *BuildUnitAction) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildUnitAction.ProtoReflect.Descriptor instead.
+func (*BuildUnitAction) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BuildUnitAction) GetQ() int32 {
+	if x != nil {
+		return x.Q
+	}
+	return 0
+}
+
+func (x *BuildUnitAction) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *BuildUnitAction) GetUnitType() int32 {
+	if x != nil {
+		return x.UnitType
+	}
+	return 0
+}
+
+// *
+// Capture (or continue capturing) the building under a unit
+type CaptureBuildingAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Q             int32                  `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R             int32                  `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptureBuildingAction) Reset() {
+	*x = CaptureBuildingAction{}
+	mi := &file_weewar_v1_models_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureBuildingAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureBuildingAction) ProtoMessage() {}
+
+func (x *CaptureBuildingAction) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureBuildingAction.ProtoReflect.Descriptor instead.
+func (*CaptureBuildingAction) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CaptureBuildingAction) GetQ() int32 {
+	if x != nil {
+		return x.Q
+	}
+	return 0
+}
+
+func (x *CaptureBuildingAction) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+// *
+// A unit works on a terrain action on its own or an adjacent hex
+type ModifyTerrainAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unit performing the action
+	Q int32 `protobuf:"varint,1,opt,name=q,proto3" json:"q,omitempty"`
+	R int32 `protobuf:"varint,2,opt,name=r,proto3" json:"r,omitempty"`
+	// Hex being changed
+	TargetQ int32 `protobuf:"varint,3,opt,name=target_q,json=targetQ,proto3" json:"target_q,omitempty"`
+	TargetR int32 `protobuf:"varint,4,opt,name=target_r,json=targetR,proto3" json:"target_r,omitempty"`
+	// ID of the terrain action in the rules
+	TerrainAction string `protobuf:"bytes,5,opt,name=terrain_action,json=terrainAction,proto3" json:"terrain_action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModifyTerrainAction) Reset() {
+	*x = ModifyTerrainAction{}
+	mi := &file_weewar_v1_models_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyTerrainAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyTerrainAction) ProtoMessage() {}
+
+func (x *ModifyTerrainAction) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyTerrainAction.ProtoReflect.Descriptor instead.
+func (*ModifyTerrainAction) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ModifyTerrainAction) GetQ() int32 {
+	if x != nil {
+		return x.Q
+	}
+	return 0
+}
+
+func (x *ModifyTerrainAction) GetR() int32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *ModifyTerrainAction) GetTargetQ() int32 {
+	if x != nil {
+		return x.TargetQ
+	}
+	return 0
+}
+
+func (x *ModifyTerrainAction) GetTargetR() int32 {
+	if x != nil {
+		return x.TargetR
+	}
+	return 0
+}
+
+func (x *ModifyTerrainAction) GetTerrainAction() string {
+	if x != nil {
+		return x.TerrainAction
+	}
+	return ""
+}
+
+// *
+// Board a unit onto an adjacent transport owned by the same player
+type LoadUnitAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnitQ         int32                  `protobuf:"varint,1,opt,name=unit_q,json=unitQ,proto3" json:"unit_q,omitempty"`
+	UnitR         int32                  `protobuf:"varint,2,opt,name=unit_r,json=unitR,proto3" json:"unit_r,omitempty"`
+	TransportQ    int32                  `protobuf:"varint,3,opt,name=transport_q,json=transportQ,proto3" json:"transport_q,omitempty"`
+	TransportR    int32                  `protobuf:"varint,4,opt,name=transport_r,json=transportR,proto3" json:"transport_r,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadUnitAction) Reset() {
+	*x = LoadUnitAction{}
+	mi := &file_weewar_v1_models_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadUnitAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadUnitAction) ProtoMessage() {}
+
+func (x *LoadUnitAction) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadUnitAction.ProtoReflect.Descriptor instead.
+func (*LoadUnitAction) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LoadUnitAction) GetUnitQ() int32 {
+	if x != nil {
+		return x.UnitQ
+	}
+	return 0
+}
+
+func (x *LoadUnitAction) GetUnitR() int32 {
+	if x != nil {
+		return x.UnitR
+	}
+	return 0
+}
+
+func (x *LoadUnitAction) GetTransportQ() int32 {
+	if x != nil {
+		return x.TransportQ
+	}
+	return 0
+}
+
+func (x *LoadUnitAction) GetTransportR() int32 {
+	if x != nil {
+		return x.TransportR
+	}
+	return 0
+}
+
+// *
+// Unload a carried unit from a transport onto an adjacent empty hex
+type UnloadUnitAction struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TransportQ int32                  `protobuf:"varint,1,opt,name=transport_q,json=transportQ,proto3" json:"transport_q,omitempty"`
+	TransportR int32                  `protobuf:"varint,2,opt,name=transport_r,json=transportR,proto3" json:"transport_r,omitempty"`
+	// Index of the unit in the transport's cargo
+	CargoIndex    int32 `protobuf:"varint,3,opt,name=cargo_index,json=cargoIndex,proto3" json:"cargo_index,omitempty"`
+	ToQ           int32 `protobuf:"varint,4,opt,name=to_q,json=toQ,proto3" json:"to_q,omitempty"`
+	ToR           int32 `protobuf:"varint,5,opt,name=to_r,json=toR,proto3" json:"to_r,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnloadUnitAction) Reset() {
+	*x = UnloadUnitAction{}
+	mi := &file_weewar_v1_models_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnloadUnitAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnloadUnitAction) ProtoMessage() {}
+
+func (x *UnloadUnitAction) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnloadUnitAction.ProtoReflect.Descriptor instead.
+func (*UnloadUnitAction) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UnloadUnitAction) GetTransportQ() int32 {
+	if x != nil {
+		return x.TransportQ
+	}
+	return 0
+}
+
+func (x *UnloadUnitAction) GetTransportR() int32 {
+	if x != nil {
+		return x.TransportR
+	}
+	return 0
+}
+
+func (x *UnloadUnitAction) GetCargoIndex() int32 {
+	if x != nil {
+		return x.CargoIndex
+	}
+	return 0
+}
+
+func (x *UnloadUnitAction) GetToQ() int32 {
+	if x != nil {
+		return x.ToQ
+	}
+	return 0
+}
+
+func (x *UnloadUnitAction) GetToR() int32 {
+	if x != nil {
+		return x.ToR
+	}
+	return 0
+}
+
+// *
+// A healer (eg a Medic) restores health to an adjacent friendly unit
+type HealUnitAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HealerQ       int32                  `protobuf:"varint,1,opt,name=healer_q,json=healerQ,proto3" json:"healer_q,omitempty"`
+	HealerR       int32                  `protobuf:"varint,2,opt,name=healer_r,json=healerR,proto3" json:"healer_r,omitempty"`
+	TargetQ       int32                  `protobuf:"varint,3,opt,name=target_q,json=targetQ,proto3" json:"target_q,omitempty"`
+	TargetR       int32                  `protobuf:"varint,4,opt,name=target_r,json=targetR,proto3" json:"target_r,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealUnitAction) Reset() {
+	*x = HealUnitAction{}
+	mi := &file_weewar_v1_models_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealUnitAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealUnitAction) ProtoMessage() {}
+
+func (x *HealUnitAction) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealUnitAction.ProtoReflect.Descriptor instead.
+func (*HealUnitAction) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *HealUnitAction) GetHealerQ() int32 {
+	if x != nil {
+		return x.HealerQ
+	}
+	return 0
+}
+
+func (x *HealUnitAction) GetHealerR() int32 {
+	if x != nil {
+		return x.HealerR
+	}
+	return 0
+}
+
+func (x *HealUnitAction) GetTargetQ() int32 {
+	if x != nil {
+		return x.TargetQ
+	}
+	return 0
+}
+
+func (x *HealUnitAction) GetTargetR() int32 {
+	if x != nil {
+		return x.TargetR
+	}
+	return 0
+}
+
+// *
+// Represents a change to the game world
+type WorldChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// When did this change happen
+	//
+	// Types that are valid to be assigned to ChangeType:
+	//
+	//	*WorldChange_UnitMoved
+	//	*WorldChange_UnitDamaged
+	//	*WorldChange_UnitKilled
+	//	*WorldChange_PlayerChanged
+	//	*WorldChange_CoinsChanged
+	//	*WorldChange_UnitCreated
+	//	*WorldChange_TileCaptured
+	//	*WorldChange_UnitLoaded
+	//	*WorldChange_UnitUnloaded
+	//	*WorldChange_UnitHealed
+	//	*WorldChange_TileChanged
+	ChangeType    isWorldChange_ChangeType `protobuf_oneof:"change_type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldChange) Reset() {
+	*x = WorldChange{}
+	mi := &file_weewar_v1_models_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldChange) ProtoMessage() {}
+
+func (x *WorldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldChange.ProtoReflect.Descriptor instead.
+func (*WorldChange) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *WorldChange) GetChangeType() isWorldChange_ChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return nil
+}
+
+func (x *WorldChange) GetUnitMoved() *UnitMovedChange {
+	if x != nil {
+		if x, ok := x.ChangeType.(*WorldChange_UnitMoved); ok {
+			return x.UnitMoved
+		}
+	}
+	return nil
+}
+
+func (x *WorldChange) GetUnitDamaged() *UnitDamagedChange {
+	if x != nil {
+		if x, ok := x.ChangeType.(*WorldChange_UnitDamaged); ok {
+			return x.UnitDamaged
+		}
+	}
+	return nil
+}
+
+func (x *WorldChange) GetUnitKilled() *UnitKilledChange {
+	if x != nil {
+		if x, ok := x.ChangeType.(*WorldChange_UnitKilled); ok {
+			return x.UnitKilled
+		}
+	}
+	return nil
+}
+
+func (x *WorldChange) GetPlayerChanged() *PlayerChangedChange {
+	if x != nil {
+		if x, ok := x.ChangeType.(*WorldChange_PlayerChanged); ok {
+			return x.PlayerChanged
+		}
+	}
+	return nil
+}
+
+func (x *WorldChange) GetCoinsChanged() *CoinsChangedChange {
+	if x != nil {
+		if x, ok := x.ChangeType.(*WorldChange_CoinsChanged); ok {
+			return x.CoinsChanged
+		}
+	}
+	return nil
+}
+
+func (x *WorldChange) GetUnitCreated() *UnitCreatedChange {
+	if x != nil {
+		if x, ok := x.ChangeType.(*WorldChange_UnitCreated); ok {
+			return x.UnitCreated
+		}
+	}
+	return nil
+}
+
+func (x *WorldChange) GetTileCaptured() *TileCapturedChange {
+	if x != nil {
+		if x, ok := x.ChangeType.(*WorldChange_TileCaptured); ok {
+			return x.TileCaptured
+		}
+	}
+	return nil
+}
+
+func (x *WorldChange) GetUnitLoaded() *UnitLoadedChange {
+	if x != nil {
+		if x, ok := x.ChangeType.(*WorldChange_UnitLoaded); ok {
+			return x.UnitLoaded
+		}
+	}
+	return nil
+}
+
+func (x *WorldChange) GetUnitUnloaded() *UnitUnloadedChange {
+	if x != nil {
+		if x, ok := x.ChangeType.(*WorldChange_UnitUnloaded); ok {
+			return x.UnitUnloaded
+		}
+	}
+	return nil
+}
+
+func (x *WorldChange) GetUnitHealed() *UnitHealedChange {
+	if x != nil {
+		if x, ok := x.ChangeType.(*WorldChange_UnitHealed); ok {
+			return x.UnitHealed
+		}
+	}
+	return nil
+}
+
+func (x *WorldChange) GetTileChanged() *TileChangedChange {
+	if x != nil {
+		if x, ok := x.ChangeType.(*WorldChange_TileChanged); ok {
+			return x.TileChanged
+		}
+	}
+	return nil
+}
+
+type isWorldChange_ChangeType interface {
+	isWorldChange_ChangeType()
+}
+
+type WorldChange_UnitMoved struct {
+	UnitMoved *UnitMovedChange `protobuf:"bytes,1,opt,name=unit_moved,json=unitMoved,proto3,oneof"`
+}
+
+type WorldChange_UnitDamaged struct {
+	UnitDamaged *UnitDamagedChange `protobuf:"bytes,2,opt,name=unit_damaged,json=unitDamaged,proto3,oneof"`
+}
+
+type WorldChange_UnitKilled struct {
+	UnitKilled *UnitKilledChange `protobuf:"bytes,3,opt,name=unit_killed,json=unitKilled,proto3,oneof"`
+}
+
+type WorldChange_PlayerChanged struct {
+	PlayerChanged *PlayerChangedChange `protobuf:"bytes,4,opt,name=player_changed,json=playerChanged,proto3,oneof"`
+}
+
+type WorldChange_CoinsChanged struct {
+	CoinsChanged *CoinsChangedChange `protobuf:"bytes,5,opt,name=coins_changed,json=coinsChanged,proto3,oneof"`
+}
+
+type WorldChange_UnitCreated struct {
+	UnitCreated *UnitCreatedChange `protobuf:"bytes,6,opt,name=unit_created,json=unitCreated,proto3,oneof"`
+}
+
+type WorldChange_TileCaptured struct {
+	TileCaptured *TileCapturedChange `protobuf:"bytes,7,opt,name=tile_captured,json=tileCaptured,proto3,oneof"`
+}
+
+type WorldChange_UnitLoaded struct {
+	UnitLoaded *UnitLoadedChange `protobuf:"bytes,8,opt,name=unit_loaded,json=unitLoaded,proto3,oneof"`
+}
+
+type WorldChange_UnitUnloaded struct {
+	UnitUnloaded *UnitUnloadedChange `protobuf:"bytes,9,opt,name=unit_unloaded,json=unitUnloaded,proto3,oneof"`
+}
+
+type WorldChange_UnitHealed struct {
+	UnitHealed *UnitHealedChange `protobuf:"bytes,10,opt,name=unit_healed,json=unitHealed,proto3,oneof"`
+}
+
+type WorldChange_TileChanged struct {
+	TileChanged *TileChangedChange `protobuf:"bytes,11,opt,name=tile_changed,json=tileChanged,proto3,oneof"`
+}
+
+func (*WorldChange_UnitMoved) isWorldChange_ChangeType() {}
+
+func (*WorldChange_UnitDamaged) isWorldChange_ChangeType() {}
+
+func (*WorldChange_UnitKilled) isWorldChange_ChangeType() {}
+
+func (*WorldChange_PlayerChanged) isWorldChange_ChangeType() {}
+
+func (*WorldChange_CoinsChanged) isWorldChange_ChangeType() {}
+
+func (*WorldChange_UnitCreated) isWorldChange_ChangeType() {}
+
+func (*WorldChange_TileCaptured) isWorldChange_ChangeType() {}
+
+func (*WorldChange_UnitLoaded) isWorldChange_ChangeType() {}
+
+func (*WorldChange_UnitUnloaded) isWorldChange_ChangeType() {}
+
+func (*WorldChange_UnitHealed) isWorldChange_ChangeType() {}
+
+func (*WorldChange_TileChanged) isWorldChange_ChangeType() {}
+
+// *
+// A unit moved from one position to another
+type UnitMovedChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Complete unit state before the move
+	PreviousUnit *Unit `protobuf:"bytes,6,opt,name=previous_unit,json=previousUnit,proto3" json:"previous_unit,omitempty"`
+	// Complete unit state after the move (includes updated position, distanceLeft, etc.)
+	UpdatedUnit   *Unit `protobuf:"bytes,7,opt,name=updated_unit,json=updatedUnit,proto3" json:"updated_unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnitMovedChange) Reset() {
+	*x = UnitMovedChange{}
+	mi := &file_weewar_v1_models_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnitMovedChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnitMovedChange) ProtoMessage() {}
+
+func (x *UnitMovedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnitMovedChange.ProtoReflect.Descriptor instead.
+func (*UnitMovedChange) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UnitMovedChange) GetPreviousUnit() *Unit {
+	if x != nil {
+		return x.PreviousUnit
+	}
+	return nil
+}
+
+func (x *UnitMovedChange) GetUpdatedUnit() *Unit {
+	if x != nil {
+		return x.UpdatedUnit
+	}
+	return nil
+}
+
+// *
+// A unit was attacked.  The damage may be 0 so an attack that misses still
+// records the attacker using up its action.
+type UnitDamagedChange struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// When the moves happened (or were submitted)
-	StartedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	EndedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
-	// *
-	// List of moves to add -
-	Moves []*GameMove `protobuf:"bytes,4,rep,name=moves,proto3" json:"moves,omitempty"`
-	// Each game move result stores the result of the individual Move in the request.
-	// ie move_results[i] = ResultOfProcessing(ProcessMoveRequest.moves[i])
-	MoveResults   []*GameMoveResult `protobuf:"bytes,5,rep,name=move_results,json=moveResults,proto3" json:"move_results,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Complete unit state before taking damage
+	PreviousUnit *Unit `protobuf:"bytes,6,opt,name=previous_unit,json=previousUnit,proto3" json:"previous_unit,omitempty"`
+	// Complete unit state after taking damage
+	UpdatedUnit *Unit `protobuf:"bytes,7,opt,name=updated_unit,json=updatedUnit,proto3" json:"updated_unit,omitempty"`
+	// Attacking unit state before and after using up its action (not set for the
+	// damage a counter attack deals to the attacker)
+	PreviousAttacker *Unit `protobuf:"bytes,8,opt,name=previous_attacker,json=previousAttacker,proto3" json:"previous_attacker,omitempty"`
+	UpdatedAttacker  *Unit `protobuf:"bytes,9,opt,name=updated_attacker,json=updatedAttacker,proto3" json:"updated_attacker,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GameMoveGroup) Reset() {
-	*x = GameMoveGroup{}
-	mi := &file_weewar_v1_models_proto_msgTypes[17]
+func (x *UnitDamagedChange) Reset() {
+	*x = UnitDamagedChange{}
+	mi := &file_weewar_v1_models_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameMoveGroup) String() string {
+func (x *UnitDamagedChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameMoveGroup) ProtoMessage() {}
+func (*UnitDamagedChange) ProtoMessage() {}
 
-func (x *GameMoveGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_models_proto_msgTypes[17]
+func (x *UnitDamagedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1385,73 +3911,69 @@ func (x *GameMoveGroup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameMoveGroup.ProtoReflect.Descriptor instead.
-func (*GameMoveGroup) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_models_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use UnitDamagedChange.ProtoReflect.Descriptor instead.
+func (*UnitDamagedChange) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GameMoveGroup) GetStartedAt() *timestamppb.Timestamp {
+func (x *UnitDamagedChange) GetPreviousUnit() *Unit {
 	if x != nil {
-		return x.StartedAt
+		return x.PreviousUnit
 	}
 	return nil
 }
 
-func (x *GameMoveGroup) GetEndedAt() *timestamppb.Timestamp {
+func (x *UnitDamagedChange) GetUpdatedUnit() *Unit {
 	if x != nil {
-		return x.EndedAt
+		return x.UpdatedUnit
 	}
 	return nil
 }
 
-func (x *GameMoveGroup) GetMoves() []*GameMove {
+func (x *UnitDamagedChange) GetPreviousAttacker() *Unit {
 	if x != nil {
-		return x.Moves
+		return x.PreviousAttacker
 	}
 	return nil
 }
 
-func (x *GameMoveGroup) GetMoveResults() []*GameMoveResult {
+func (x *UnitDamagedChange) GetUpdatedAttacker() *Unit {
 	if x != nil {
-		return x.MoveResults
+		return x.UpdatedAttacker
 	}
 	return nil
 }
 
 // *
-// Represents a single move which can be one of many actions in the game
-type GameMove struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	Player    int32                  `protobuf:"varint,1,opt,name=player,proto3" json:"player,omitempty"` // The player making the move
-	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// A monotonically increasing and unique (within the game) sequence number for the move
-	// This is generated by the server
-	SequenceNum int64 `protobuf:"varint,3,opt,name=sequence_num,json=sequenceNum,proto3" json:"sequence_num,omitempty"`
-	// Types that are valid to be assigned to MoveType:
-	//
-	//	*GameMove_MoveUnit
-	//	*GameMove_AttackUnit
-	//	*GameMove_EndTurn
-	MoveType      isGameMove_MoveType `protobuf_oneof:"move_type"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// A unit regained health by repairing on a base or being healed
+type UnitHealedChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Complete unit state before healing
+	PreviousUnit *Unit `protobuf:"bytes,1,opt,name=previous_unit,json=previousUnit,proto3" json:"previous_unit,omitempty"`
+	// Complete unit state after healing
+	UpdatedUnit *Unit `protobuf:"bytes,2,opt,name=updated_unit,json=updatedUnit,proto3" json:"updated_unit,omitempty"`
+	// Healing unit state before and after using up its action (not set for repairs)
+	PreviousHealer *Unit `protobuf:"bytes,3,opt,name=previous_healer,json=previousHealer,proto3" json:"previous_healer,omitempty"`
+	UpdatedHealer  *Unit `protobuf:"bytes,4,opt,name=updated_healer,json=updatedHealer,proto3" json:"updated_healer,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GameMove) Reset() {
-	*x = GameMove{}
-	mi := &file_weewar_v1_models_proto_msgTypes[18]
+func (x *UnitHealedChange) Reset() {
+	*x = UnitHealedChange{}
+	mi := &file_weewar_v1_models_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameMove) String() string {
+func (x *UnitHealedChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameMove) ProtoMessage() {}
+func (*UnitHealedChange) ProtoMessage() {}
 
-func (x *GameMove) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_models_proto_msgTypes[18]
+func (x *UnitHealedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1462,119 +3984,64 @@ func (x *GameMove) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameMove.ProtoReflect.Descriptor instead.
-func (*GameMove) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_models_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *GameMove) GetPlayer() int32 {
-	if x != nil {
-		return x.Player
-	}
-	return 0
-}
-
-func (x *GameMove) GetTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Timestamp
-	}
-	return nil
-}
-
-func (x *GameMove) GetSequenceNum() int64 {
-	if x != nil {
-		return x.SequenceNum
-	}
-	return 0
+// Deprecated: Use UnitHealedChange.ProtoReflect.Descriptor instead.
+func (*UnitHealedChange) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GameMove) GetMoveType() isGameMove_MoveType {
+func (x *UnitHealedChange) GetPreviousUnit() *Unit {
 	if x != nil {
-		return x.MoveType
+		return x.PreviousUnit
 	}
 	return nil
 }
 
-func (x *GameMove) GetMoveUnit() *MoveUnitAction {
+func (x *UnitHealedChange) GetUpdatedUnit() *Unit {
 	if x != nil {
-		if x, ok := x.MoveType.(*GameMove_MoveUnit); ok {
-			return x.MoveUnit
-		}
+		return x.UpdatedUnit
 	}
 	return nil
 }
 
-func (x *GameMove) GetAttackUnit() *AttackUnitAction {
+func (x *UnitHealedChange) GetPreviousHealer() *Unit {
 	if x != nil {
-		if x, ok := x.MoveType.(*GameMove_AttackUnit); ok {
-			return x.AttackUnit
-		}
+		return x.PreviousHealer
 	}
 	return nil
 }
 
-func (x *GameMove) GetEndTurn() *EndTurnAction {
+func (x *UnitHealedChange) GetUpdatedHealer() *Unit {
 	if x != nil {
-		if x, ok := x.MoveType.(*GameMove_EndTurn); ok {
-			return x.EndTurn
-		}
+		return x.UpdatedHealer
 	}
 	return nil
 }
 
-type isGameMove_MoveType interface {
-	isGameMove_MoveType()
-}
-
-type GameMove_MoveUnit struct {
-	MoveUnit *MoveUnitAction `protobuf:"bytes,4,opt,name=move_unit,json=moveUnit,proto3,oneof"`
-}
-
-type GameMove_AttackUnit struct {
-	AttackUnit *AttackUnitAction `protobuf:"bytes,5,opt,name=attack_unit,json=attackUnit,proto3,oneof"`
-}
-
-type GameMove_EndTurn struct {
-	EndTurn *EndTurnAction `protobuf:"bytes,6,opt,name=end_turn,json=endTurn,proto3,oneof"`
-}
-
-func (*GameMove_MoveUnit) isGameMove_MoveType() {}
-
-func (*GameMove_AttackUnit) isGameMove_MoveType() {}
-
-func (*GameMove_EndTurn) isGameMove_MoveType() {}
-
 // *
-// Represents the result of executing a move
-type GameMoveResult struct {
+// A unit was killed
+type UnitKilledChange struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether the result is permenant and can be undone.
-	// Just moving a unit for example is not permanent, but attacking a unit
-	// would be (ie a player cannot undo it).
-	IsPermanent bool `protobuf:"varint,1,opt,name=is_permanent,json=isPermanent,proto3" json:"is_permanent,omitempty"`
-	// A monotonically increasing and unique (within the game) sequence number for the move
-	SequenceNum int64 `protobuf:"varint,2,opt,name=sequence_num,json=sequenceNum,proto3" json:"sequence_num,omitempty"`
-	// A set of changes to the world as a result of making this move
-	Changes       []*WorldChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	// Complete unit state before being killed
+	PreviousUnit  *Unit `protobuf:"bytes,6,opt,name=previous_unit,json=previousUnit,proto3" json:"previous_unit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameMoveResult) Reset() {
-	*x = GameMoveResult{}
-	mi := &file_weewar_v1_models_proto_msgTypes[19]
+func (x *UnitKilledChange) Reset() {
+	*x = UnitKilledChange{}
+	mi := &file_weewar_v1_models_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameMoveResult) String() string {
+func (x *UnitKilledChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameMoveResult) ProtoMessage() {}
+func (*UnitKilledChange) ProtoMessage() {}
 
-func (x *GameMoveResult) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_models_proto_msgTypes[19]
+func (x *UnitKilledChange) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1585,59 +4052,47 @@ func (x *GameMoveResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameMoveResult.ProtoReflect.Descriptor instead.
-func (*GameMoveResult) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_models_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *GameMoveResult) GetIsPermanent() bool {
-	if x != nil {
-		return x.IsPermanent
-	}
-	return false
-}
-
-func (x *GameMoveResult) GetSequenceNum() int64 {
-	if x != nil {
-		return x.SequenceNum
-	}
-	return 0
+// Deprecated: Use UnitKilledChange.ProtoReflect.Descriptor instead.
+func (*UnitKilledChange) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GameMoveResult) GetChanges() []*WorldChange {
+func (x *UnitKilledChange) GetPreviousUnit() *Unit {
 	if x != nil {
-		return x.Changes
+		return x.PreviousUnit
 	}
 	return nil
 }
 
 // *
-// Move unit from one position to another
-type MoveUnitAction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FromQ         int32                  `protobuf:"varint,1,opt,name=from_q,json=fromQ,proto3" json:"from_q,omitempty"`
-	FromR         int32                  `protobuf:"varint,2,opt,name=from_r,json=fromR,proto3" json:"from_r,omitempty"`
-	ToQ           int32                  `protobuf:"varint,3,opt,name=to_q,json=toQ,proto3" json:"to_q,omitempty"`
-	ToR           int32                  `protobuf:"varint,4,opt,name=to_r,json=toR,proto3" json:"to_r,omitempty"`
+// Active player changed
+type PlayerChangedChange struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PreviousPlayer int32                  `protobuf:"varint,1,opt,name=previous_player,json=previousPlayer,proto3" json:"previous_player,omitempty"`
+	NewPlayer      int32                  `protobuf:"varint,2,opt,name=new_player,json=newPlayer,proto3" json:"new_player,omitempty"`
+	PreviousTurn   int32                  `protobuf:"varint,3,opt,name=previous_turn,json=previousTurn,proto3" json:"previous_turn,omitempty"`
+	NewTurn        int32                  `protobuf:"varint,4,opt,name=new_turn,json=newTurn,proto3" json:"new_turn,omitempty"`
+	// Units that had their movement/health reset for the new turn
+	ResetUnits    []*Unit `protobuf:"bytes,5,rep,name=reset_units,json=resetUnits,proto3" json:"reset_units,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MoveUnitAction) Reset() {
-	*x = MoveUnitAction{}
-	mi := &file_weewar_v1_models_proto_msgTypes[20]
+func (x *PlayerChangedChange) Reset() {
+	*x = PlayerChangedChange{}
+	mi := &file_weewar_v1_models_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MoveUnitAction) String() string {
+func (x *PlayerChangedChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveUnitAction) ProtoMessage() {}
+func (*PlayerChangedChange) ProtoMessage() {}
 
-func (x *MoveUnitAction) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_models_proto_msgTypes[20]
+func (x *PlayerChangedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1648,66 +4103,72 @@ func (x *MoveUnitAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveUnitAction.ProtoReflect.Descriptor instead.
-func (*MoveUnitAction) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_models_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use PlayerChangedChange.ProtoReflect.Descriptor instead.
+func (*PlayerChangedChange) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *MoveUnitAction) GetFromQ() int32 {
+func (x *PlayerChangedChange) GetPreviousPlayer() int32 {
 	if x != nil {
-		return x.FromQ
+		return x.PreviousPlayer
 	}
 	return 0
 }
 
-func (x *MoveUnitAction) GetFromR() int32 {
+func (x *PlayerChangedChange) GetNewPlayer() int32 {
 	if x != nil {
-		return x.FromR
+		return x.NewPlayer
 	}
 	return 0
 }
 
-func (x *MoveUnitAction) GetToQ() int32 {
+func (x *PlayerChangedChange) GetPreviousTurn() int32 {
 	if x != nil {
-		return x.ToQ
+		return x.PreviousTurn
 	}
 	return 0
 }
 
-func (x *MoveUnitAction) GetToR() int32 {
+func (x *PlayerChangedChange) GetNewTurn() int32 {
 	if x != nil {
-		return x.ToR
+		return x.NewTurn
 	}
 	return 0
 }
 
+func (x *PlayerChangedChange) GetResetUnits() []*Unit {
+	if x != nil {
+		return x.ResetUnits
+	}
+	return nil
+}
+
 // *
-// Attack with one unit against another
-type AttackUnitAction struct {
+// A player's coin balance changed
+type CoinsChangedChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AttackerQ     int32                  `protobuf:"varint,1,opt,name=attacker_q,json=attackerQ,proto3" json:"attacker_q,omitempty"`
-	AttackerR     int32                  `protobuf:"varint,2,opt,name=attacker_r,json=attackerR,proto3" json:"attacker_r,omitempty"`
-	DefenderQ     int32                  `protobuf:"varint,3,opt,name=defender_q,json=defenderQ,proto3" json:"defender_q,omitempty"`
-	DefenderR     int32                  `protobuf:"varint,4,opt,name=defender_r,json=defenderR,proto3" json:"defender_r,omitempty"`
+	Player        int32                  `protobuf:"varint,1,opt,name=player,proto3" json:"player,omitempty"`
+	PreviousCoins int32                  `protobuf:"varint,2,opt,name=previous_coins,json=previousCoins,proto3" json:"previous_coins,omitempty"`
+	NewCoins      int32                  `protobuf:"varint,3,opt,name=new_coins,json=newCoins,proto3" json:"new_coins,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttackUnitAction) Reset() {
-	*x = AttackUnitAction{}
-	mi := &file_weewar_v1_models_proto_msgTypes[21]
+func (x *CoinsChangedChange) Reset() {
+	*x = CoinsChangedChange{}
+	mi := &file_weewar_v1_models_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttackUnitAction) String() string {
+func (x *CoinsChangedChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttackUnitAction) ProtoMessage() {}
+func (*CoinsChangedChange) ProtoMessage() {}
 
-func (x *AttackUnitAction) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_models_proto_msgTypes[21]
+func (x *CoinsChangedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1718,62 +4179,57 @@ func (x *AttackUnitAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttackUnitAction.ProtoReflect.Descriptor instead.
-func (*AttackUnitAction) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_models_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *AttackUnitAction) GetAttackerQ() int32 {
-	if x != nil {
-		return x.AttackerQ
-	}
-	return 0
+// Deprecated: Use CoinsChangedChange.ProtoReflect.Descriptor instead.
+func (*CoinsChangedChange) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *AttackUnitAction) GetAttackerR() int32 {
+func (x *CoinsChangedChange) GetPlayer() int32 {
 	if x != nil {
-		return x.AttackerR
+		return x.Player
 	}
 	return 0
 }
 
-func (x *AttackUnitAction) GetDefenderQ() int32 {
+func (x *CoinsChangedChange) GetPreviousCoins() int32 {
 	if x != nil {
-		return x.DefenderQ
+		return x.PreviousCoins
 	}
 	return 0
 }
 
-func (x *AttackUnitAction) GetDefenderR() int32 {
+func (x *CoinsChangedChange) GetNewCoins() int32 {
 	if x != nil {
-		return x.DefenderR
+		return x.NewCoins
 	}
 	return 0
 }
 
 // *
-// End current player's turn
-type EndTurnAction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// A new unit was created (eg built on a base)
+type UnitCreatedChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Complete state of the newly created unit
+	Unit          *Unit `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EndTurnAction) Reset() {
-	*x = EndTurnAction{}
-	mi := &file_weewar_v1_models_proto_msgTypes[22]
+func (x *UnitCreatedChange) Reset() {
+	*x = UnitCreatedChange{}
+	mi := &file_weewar_v1_models_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EndTurnAction) String() string {
+func (x *UnitCreatedChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EndTurnAction) ProtoMessage() {}
+func (*UnitCreatedChange) ProtoMessage() {}
 
-func (x *EndTurnAction) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_models_proto_msgTypes[22]
+func (x *UnitCreatedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1784,43 +4240,49 @@ func (x *EndTurnAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EndTurnAction.ProtoReflect.Descriptor instead.
-func (*EndTurnAction) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_models_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UnitCreatedChange.ProtoReflect.Descriptor instead.
+func (*UnitCreatedChange) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UnitCreatedChange) GetUnit() *Unit {
+	if x != nil {
+		return x.Unit
+	}
+	return nil
 }
 
 // *
-// Represents a change to the game world
-type WorldChange struct {
+// A tile's capture state changed - either capture progressed, was abandoned
+// or completed (in which case the tile changes owner)
+type TileCapturedChange struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// When did this change happen
-	//
-	// Types that are valid to be assigned to ChangeType:
-	//
-	//	*WorldChange_UnitMoved
-	//	*WorldChange_UnitDamaged
-	//	*WorldChange_UnitKilled
-	//	*WorldChange_PlayerChanged
-	ChangeType    isWorldChange_ChangeType `protobuf_oneof:"change_type"`
+	// Complete tile state before the change
+	PreviousTile *Tile `protobuf:"bytes,1,opt,name=previous_tile,json=previousTile,proto3" json:"previous_tile,omitempty"`
+	// Complete tile state after the change
+	UpdatedTile *Tile `protobuf:"bytes,2,opt,name=updated_tile,json=updatedTile,proto3" json:"updated_tile,omitempty"`
+	// Capturing unit state before and after capturing (not set when capture is abandoned)
+	PreviousUnit  *Unit `protobuf:"bytes,3,opt,name=previous_unit,json=previousUnit,proto3" json:"previous_unit,omitempty"`
+	UpdatedUnit   *Unit `protobuf:"bytes,4,opt,name=updated_unit,json=updatedUnit,proto3" json:"updated_unit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorldChange) Reset() {
-	*x = WorldChange{}
-	mi := &file_weewar_v1_models_proto_msgTypes[23]
+func (x *TileCapturedChange) Reset() {
+	*x = TileCapturedChange{}
+	mi := &file_weewar_v1_models_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorldChange) String() string {
+func (x *TileCapturedChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorldChange) ProtoMessage() {}
+func (*TileCapturedChange) ProtoMessage() {}
 
-func (x *WorldChange) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_models_proto_msgTypes[23]
+func (x *TileCapturedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1831,109 +4293,70 @@ func (x *WorldChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorldChange.ProtoReflect.Descriptor instead.
-func (*WorldChange) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_models_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *WorldChange) GetChangeType() isWorldChange_ChangeType {
-	if x != nil {
-		return x.ChangeType
-	}
-	return nil
+// Deprecated: Use TileCapturedChange.ProtoReflect.Descriptor instead.
+func (*TileCapturedChange) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *WorldChange) GetUnitMoved() *UnitMovedChange {
+func (x *TileCapturedChange) GetPreviousTile() *Tile {
 	if x != nil {
-		if x, ok := x.ChangeType.(*WorldChange_UnitMoved); ok {
-			return x.UnitMoved
-		}
+		return x.PreviousTile
 	}
 	return nil
 }
 
-func (x *WorldChange) GetUnitDamaged() *UnitDamagedChange {
+func (x *TileCapturedChange) GetUpdatedTile() *Tile {
 	if x != nil {
-		if x, ok := x.ChangeType.(*WorldChange_UnitDamaged); ok {
-			return x.UnitDamaged
-		}
+		return x.UpdatedTile
 	}
 	return nil
 }
 
-func (x *WorldChange) GetUnitKilled() *UnitKilledChange {
+func (x *TileCapturedChange) GetPreviousUnit() *Unit {
 	if x != nil {
-		if x, ok := x.ChangeType.(*WorldChange_UnitKilled); ok {
-			return x.UnitKilled
-		}
+		return x.PreviousUnit
 	}
 	return nil
 }
 
-func (x *WorldChange) GetPlayerChanged() *PlayerChangedChange {
+func (x *TileCapturedChange) GetUpdatedUnit() *Unit {
 	if x != nil {
-		if x, ok := x.ChangeType.(*WorldChange_PlayerChanged); ok {
-			return x.PlayerChanged
-		}
+		return x.UpdatedUnit
 	}
 	return nil
 }
 
-type isWorldChange_ChangeType interface {
-	isWorldChange_ChangeType()
-}
-
-type WorldChange_UnitMoved struct {
-	UnitMoved *UnitMovedChange `protobuf:"bytes,1,opt,name=unit_moved,json=unitMoved,proto3,oneof"`
-}
-
-type WorldChange_UnitDamaged struct {
-	UnitDamaged *UnitDamagedChange `protobuf:"bytes,2,opt,name=unit_damaged,json=unitDamaged,proto3,oneof"`
-}
-
-type WorldChange_UnitKilled struct {
-	UnitKilled *UnitKilledChange `protobuf:"bytes,3,opt,name=unit_killed,json=unitKilled,proto3,oneof"`
-}
-
-type WorldChange_PlayerChanged struct {
-	PlayerChanged *PlayerChangedChange `protobuf:"bytes,4,opt,name=player_changed,json=playerChanged,proto3,oneof"`
-}
-
-func (*WorldChange_UnitMoved) isWorldChange_ChangeType() {}
-
-func (*WorldChange_UnitDamaged) isWorldChange_ChangeType() {}
-
-func (*WorldChange_UnitKilled) isWorldChange_ChangeType() {}
-
-func (*WorldChange_PlayerChanged) isWorldChange_ChangeType() {}
-
 // *
-// A unit moved from one position to another
-type UnitMovedChange struct {
+// Work on a terrain action progressed or completed (in which case the tile's
+// terrain type changes)
+type TileChangedChange struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Complete unit state before the move
-	PreviousUnit *Unit `protobuf:"bytes,6,opt,name=previous_unit,json=previousUnit,proto3" json:"previous_unit,omitempty"`
-	// Complete unit state after the move (includes updated position, distanceLeft, etc.)
-	UpdatedUnit   *Unit `protobuf:"bytes,7,opt,name=updated_unit,json=updatedUnit,proto3" json:"updated_unit,omitempty"`
+	// Complete tile state before the change
+	PreviousTile *Tile `protobuf:"bytes,1,opt,name=previous_tile,json=previousTile,proto3" json:"previous_tile,omitempty"`
+	// Complete tile state after the change
+	UpdatedTile *Tile `protobuf:"bytes,2,opt,name=updated_tile,json=updatedTile,proto3" json:"updated_tile,omitempty"`
+	// Working unit state before and after the work
+	PreviousUnit  *Unit `protobuf:"bytes,3,opt,name=previous_unit,json=previousUnit,proto3" json:"previous_unit,omitempty"`
+	UpdatedUnit   *Unit `protobuf:"bytes,4,opt,name=updated_unit,json=updatedUnit,proto3" json:"updated_unit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnitMovedChange) Reset() {
-	*x = UnitMovedChange{}
-	mi := &file_weewar_v1_models_proto_msgTypes[24]
+func (x *TileChangedChange) Reset() {
+	*x = TileChangedChange{}
+	mi := &file_weewar_v1_models_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnitMovedChange) String() string {
+func (x *TileChangedChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnitMovedChange) ProtoMessage() {}
+func (*TileChangedChange) ProtoMessage() {}
 
-func (x *UnitMovedChange) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_models_proto_msgTypes[24]
+func (x *TileChangedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1944,75 +4367,33 @@ func (x *UnitMovedChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnitMovedChange.ProtoReflect.Descriptor instead.
-func (*UnitMovedChange) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_models_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use TileChangedChange.ProtoReflect.Descriptor instead.
+func (*TileChangedChange) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *UnitMovedChange) GetPreviousUnit() *Unit {
+func (x *TileChangedChange) GetPreviousTile() *Tile {
 	if x != nil {
-		return x.PreviousUnit
+		return x.PreviousTile
 	}
 	return nil
 }
 
-func (x *UnitMovedChange) GetUpdatedUnit() *Unit {
+func (x *TileChangedChange) GetUpdatedTile() *Tile {
 	if x != nil {
-		return x.UpdatedUnit
+		return x.UpdatedTile
 	}
 	return nil
 }
 
-// *
-// A unit took damage
-type UnitDamagedChange struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Complete unit state before taking damage
-	PreviousUnit *Unit `protobuf:"bytes,6,opt,name=previous_unit,json=previousUnit,proto3" json:"previous_unit,omitempty"`
-	// Complete unit state after taking damage
-	UpdatedUnit   *Unit `protobuf:"bytes,7,opt,name=updated_unit,json=updatedUnit,proto3" json:"updated_unit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UnitDamagedChange) Reset() {
-	*x = UnitDamagedChange{}
-	mi := &file_weewar_v1_models_proto_msgTypes[25]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UnitDamagedChange) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UnitDamagedChange) ProtoMessage() {}
-
-func (x *UnitDamagedChange) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_models_proto_msgTypes[25]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UnitDamagedChange.ProtoReflect.Descriptor instead.
-func (*UnitDamagedChange) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_models_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *UnitDamagedChange) GetPreviousUnit() *Unit {
+func (x *TileChangedChange) GetPreviousUnit() *Unit {
 	if x != nil {
 		return x.PreviousUnit
 	}
 	return nil
 }
 
-func (x *UnitDamagedChange) GetUpdatedUnit() *Unit {
+func (x *TileChangedChange) GetUpdatedUnit() *Unit {
 	if x != nil {
 		return x.UpdatedUnit
 	}
@@ -2020,30 +4401,33 @@ func (x *UnitDamagedChange) GetUpdatedUnit() *Unit {
 }
 
 // *
-// A unit was killed
-type UnitKilledChange struct {
+// A unit boarded a transport and left the map
+type UnitLoadedChange struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Complete unit state before being killed
-	PreviousUnit  *Unit `protobuf:"bytes,6,opt,name=previous_unit,json=previousUnit,proto3" json:"previous_unit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UnitKilledChange) Reset() {
-	*x = UnitKilledChange{}
-	mi := &file_weewar_v1_models_proto_msgTypes[26]
+	// Complete unit state before boarding (on the map)
+	Unit *Unit `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
+	// Complete transport state before and after the unit boarded
+	PreviousTransport *Unit `protobuf:"bytes,2,opt,name=previous_transport,json=previousTransport,proto3" json:"previous_transport,omitempty"`
+	UpdatedTransport  *Unit `protobuf:"bytes,3,opt,name=updated_transport,json=updatedTransport,proto3" json:"updated_transport,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UnitLoadedChange) Reset() {
+	*x = UnitLoadedChange{}
+	mi := &file_weewar_v1_models_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnitKilledChange) String() string {
+func (x *UnitLoadedChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnitKilledChange) ProtoMessage() {}
+func (*UnitLoadedChange) ProtoMessage() {}
 
-func (x *UnitKilledChange) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_models_proto_msgTypes[26]
+func (x *UnitLoadedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2054,47 +4438,60 @@ func (x *UnitKilledChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnitKilledChange.ProtoReflect.Descriptor instead.
-func (*UnitKilledChange) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_models_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use UnitLoadedChange.ProtoReflect.Descriptor instead.
+func (*UnitLoadedChange) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *UnitKilledChange) GetPreviousUnit() *Unit {
+func (x *UnitLoadedChange) GetUnit() *Unit {
 	if x != nil {
-		return x.PreviousUnit
+		return x.Unit
 	}
 	return nil
 }
 
-// *
-// Active player changed
-type PlayerChangedChange struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	PreviousPlayer int32                  `protobuf:"varint,1,opt,name=previous_player,json=previousPlayer,proto3" json:"previous_player,omitempty"`
-	NewPlayer      int32                  `protobuf:"varint,2,opt,name=new_player,json=newPlayer,proto3" json:"new_player,omitempty"`
-	PreviousTurn   int32                  `protobuf:"varint,3,opt,name=previous_turn,json=previousTurn,proto3" json:"previous_turn,omitempty"`
-	NewTurn        int32                  `protobuf:"varint,4,opt,name=new_turn,json=newTurn,proto3" json:"new_turn,omitempty"`
-	// Units that had their movement/health reset for the new turn
-	ResetUnits    []*Unit `protobuf:"bytes,5,rep,name=reset_units,json=resetUnits,proto3" json:"reset_units,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UnitLoadedChange) GetPreviousTransport() *Unit {
+	if x != nil {
+		return x.PreviousTransport
+	}
+	return nil
 }
 
-func (x *PlayerChangedChange) Reset() {
-	*x = PlayerChangedChange{}
-	mi := &file_weewar_v1_models_proto_msgTypes[27]
+func (x *UnitLoadedChange) GetUpdatedTransport() *Unit {
+	if x != nil {
+		return x.UpdatedTransport
+	}
+	return nil
+}
+
+// *
+// A unit was unloaded from a transport onto the map
+type UnitUnloadedChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Complete unit state after unloading (on the map)
+	Unit *Unit `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
+	// Complete transport state before and after the unit was unloaded
+	PreviousTransport *Unit `protobuf:"bytes,2,opt,name=previous_transport,json=previousTransport,proto3" json:"previous_transport,omitempty"`
+	UpdatedTransport  *Unit `protobuf:"bytes,3,opt,name=updated_transport,json=updatedTransport,proto3" json:"updated_transport,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UnitUnloadedChange) Reset() {
+	*x = UnitUnloadedChange{}
+	mi := &file_weewar_v1_models_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerChangedChange) String() string {
+func (x *UnitUnloadedChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerChangedChange) ProtoMessage() {}
+func (*UnitUnloadedChange) ProtoMessage() {}
 
-func (x *PlayerChangedChange) ProtoReflect() protoreflect.Message {
-	mi := &file_weewar_v1_models_proto_msgTypes[27]
+func (x *UnitUnloadedChange) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_models_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2105,42 +4502,28 @@ func (x *PlayerChangedChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerChangedChange.ProtoReflect.Descriptor instead.
-func (*PlayerChangedChange) Descriptor() ([]byte, []int) {
-	return file_weewar_v1_models_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *PlayerChangedChange) GetPreviousPlayer() int32 {
-	if x != nil {
-		return x.PreviousPlayer
-	}
-	return 0
-}
-
-func (x *PlayerChangedChange) GetNewPlayer() int32 {
-	if x != nil {
-		return x.NewPlayer
-	}
-	return 0
+// Deprecated: Use UnitUnloadedChange.ProtoReflect.Descriptor instead.
+func (*UnitUnloadedChange) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_models_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *PlayerChangedChange) GetPreviousTurn() int32 {
+func (x *UnitUnloadedChange) GetUnit() *Unit {
 	if x != nil {
-		return x.PreviousTurn
+		return x.Unit
 	}
-	return 0
+	return nil
 }
 
-func (x *PlayerChangedChange) GetNewTurn() int32 {
+func (x *UnitUnloadedChange) GetPreviousTransport() *Unit {
 	if x != nil {
-		return x.NewTurn
+		return x.PreviousTransport
 	}
-	return 0
+	return nil
 }
 
-func (x *PlayerChangedChange) GetResetUnits() []*Unit {
+func (x *UnitUnloadedChange) GetUpdatedTransport() *Unit {
 	if x != nil {
-		return x.ResetUnits
+		return x.UpdatedTransport
 	}
 	return nil
 }
@@ -2194,12 +4577,16 @@ const file_weewar_v1_models_proto_rawDesc = "" +
 	" \x01(\v2\x14.weewar.v1.WorldDataR\tworldData\"Y\n" +
 	"\tWorldData\x12%\n" +
 	"\x05tiles\x18\x01 \x03(\v2\x0f.weewar.v1.TileR\x05tiles\x12%\n" +
-	"\x05units\x18\x02 \x03(\v2\x0f.weewar.v1.UnitR\x05units\"W\n" +
+	"\x05units\x18\x02 \x03(\v2\x0f.weewar.v1.UnitR\x05units\"\x88\x02\n" +
 	"\x04Tile\x12\f\n" +
 	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
 	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n" +
 	"\ttile_type\x18\x03 \x01(\x05R\btileType\x12\x16\n" +
-	"\x06player\x18\x04 \x01(\x05R\x06player\"\xca\x01\n" +
+	"\x06player\x18\x04 \x01(\x05R\x06player\x12%\n" +
+	"\x0ecapture_player\x18\x05 \x01(\x05R\rcapturePlayer\x12)\n" +
+	"\x10capture_progress\x18\x06 \x01(\x05R\x0fcaptureProgress\x12%\n" +
+	"\x0eterrain_action\x18\a \x01(\tR\rterrainAction\x126\n" +
+	"\x17terrain_action_progress\x18\b \x01(\x05R\x15terrainActionProgress\"\xde\x02\n" +
 	"\x04Unit\x12\f\n" +
 	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
 	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x16\n" +
@@ -2207,14 +4594,26 @@ const file_weewar_v1_models_proto_rawDesc = "" +
 	"\tunit_type\x18\x04 \x01(\x05R\bunitType\x12)\n" +
 	"\x10available_health\x18\x05 \x01(\x05R\x0favailableHealth\x12#\n" +
 	"\rdistance_left\x18\x06 \x01(\x05R\fdistanceLeft\x12!\n" +
-	"\fturn_counter\x18\a \x01(\x05R\vturnCounter\"\xb8\x01\n" +
+	"\fturn_counter\x18\a \x01(\x05R\vturnCounter\x12\x1b\n" +
+	"\thas_moved\x18\b \x01(\bR\bhasMoved\x12!\n" +
+	"\fhas_attacked\x18\t \x01(\bR\vhasAttacked\x12+\n" +
+	"\x11actions_remaining\x18\n" +
+	" \x01(\x05R\x10actionsRemaining\x12%\n" +
+	"\x05cargo\x18\v \x03(\v2\x0f.weewar.v1.UnitR\x05cargo\"\xf3\x02\n" +
 	"\x11TerrainDefinition\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12$\n" +
 	"\x0ebase_move_cost\x18\x03 \x01(\x01R\fbaseMoveCost\x12#\n" +
 	"\rdefense_bonus\x18\x04 \x01(\x01R\fdefenseBonus\x12\x12\n" +
 	"\x04type\x18\x05 \x01(\x05R\x04type\x12 \n" +
-	"\vdescription\x18\x06 \x01(\tR\vdescription\"\xb8\x01\n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12'\n" +
+	"\x0fbuildable_units\x18\a \x03(\x05R\x0ebuildableUnits\x12#\n" +
+	"\rcapture_turns\x18\b \x01(\x05R\fcaptureTurns\x12#\n" +
+	"\rrepair_amount\x18\t \x01(\x05R\frepairAmount\x12%\n" +
+	"\x0erepair_classes\x18\n" +
+	" \x03(\tR\rrepairClasses\x12\x1f\n" +
+	"\vrepair_cost\x18\v \x01(\x01R\n" +
+	"repairCost\"\xd0\x04\n" +
 	"\x0eUnitDefinition\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12'\n" +
@@ -2223,7 +4622,53 @@ const file_weewar_v1_models_proto_rawDesc = "" +
 	"\x06health\x18\x05 \x01(\x05R\x06health\x12\x1e\n" +
 	"\n" +
 	"properties\x18\x06 \x03(\tR\n" +
-	"properties\"\xa1\x01\n" +
+	"properties\x12\x14\n" +
+	"\x05coins\x18\a \x01(\x05R\x05coins\x12\x1f\n" +
+	"\vcan_capture\x18\b \x01(\bR\n" +
+	"canCapture\x12\x1f\n" +
+	"\vsight_range\x18\t \x01(\x05R\n" +
+	"sightRange\x12\x1d\n" +
+	"\n" +
+	"unit_class\x18\n" +
+	" \x01(\tR\tunitClass\x121\n" +
+	"\x15can_move_after_attack\x18\v \x01(\bR\x12canMoveAfterAttack\x12(\n" +
+	"\x10actions_per_turn\x18\f \x01(\x05R\x0eactionsPerTurn\x12(\n" +
+	"\x10min_attack_range\x18\r \x01(\x05R\x0eminAttackRange\x12#\n" +
+	"\rindirect_fire\x18\x0e \x01(\bR\findirectFire\x12-\n" +
+	"\x12transport_capacity\x18\x0f \x01(\x05R\x11transportCapacity\x12#\n" +
+	"\rcargo_classes\x18\x10 \x03(\tR\fcargoClasses\x12\x1f\n" +
+	"\vheal_amount\x18\x11 \x01(\x05R\n" +
+	"healAmount\"\x97\x01\n" +
+	"\rMovementRules\x12.\n" +
+	"\x13pass_through_allies\x18\x01 \x01(\bR\x11passThroughAllies\x12(\n" +
+	"\x10zoc_unit_classes\x18\x02 \x03(\tR\x0ezocUnitClasses\x12,\n" +
+	"\x12zoc_immune_classes\x18\x03 \x03(\tR\x10zocImmuneClasses\"\x8d\x02\n" +
+	"\vCombatRules\x12S\n" +
+	"\x0fclass_modifiers\x18\x01 \x03(\v2*.weewar.v1.CombatRules.ClassModifiersEntryR\x0eclassModifiers\x12E\n" +
+	"\x1fterrain_defense_ignored_classes\x18\x02 \x03(\tR\x1cterrainDefenseIgnoredClasses\x1ab\n" +
+	"\x13ClassModifiersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x125\n" +
+	"\x05value\x18\x02 \x01(\v2\x1f.weewar.v1.ClassDamageModifiersR\x05value:\x028\x01\"\xb0\x01\n" +
+	"\x12TerrainActionRules\x12D\n" +
+	"\aactions\x18\x01 \x03(\v2*.weewar.v1.TerrainActionRules.ActionsEntryR\aactions\x1aT\n" +
+	"\fActionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12.\n" +
+	"\x05value\x18\x02 \x01(\v2\x18.weewar.v1.TerrainActionR\x05value:\x028\x01\"\x9e\x02\n" +
+	"\rTerrainAction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12U\n" +
+	"\x0fterrain_changes\x18\x03 \x03(\v2,.weewar.v1.TerrainAction.TerrainChangesEntryR\x0eterrainChanges\x12\x14\n" +
+	"\x05turns\x18\x04 \x01(\x05R\x05turns\x12\x14\n" +
+	"\x05coins\x18\x05 \x01(\x05R\x05coins\x12#\n" +
+	"\runit_property\x18\x06 \x01(\tR\funitProperty\x1aA\n" +
+	"\x13TerrainChangesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xbb\x01\n" +
+	"\x14ClassDamageModifiers\x12_\n" +
+	"\x10defender_classes\x18\x01 \x03(\v24.weewar.v1.ClassDamageModifiers.DefenderClassesEntryR\x0fdefenderClasses\x1aB\n" +
+	"\x14DefenderClassesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xa1\x01\n" +
 	"\x0eMovementMatrix\x12:\n" +
 	"\x05costs\x18\x01 \x03(\v2$.weewar.v1.MovementMatrix.CostsEntryR\x05costs\x1aS\n" +
 	"\n" +
@@ -2234,7 +4679,42 @@ const file_weewar_v1_models_proto_rawDesc = "" +
 	"\rterrain_costs\x18\x01 \x03(\v2+.weewar.v1.TerrainCostMap.TerrainCostsEntryR\fterrainCosts\x1a?\n" +
 	"\x11TerrainCostsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x83\x03\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xa8\x01\n" +
+	"\fAttackMatrix\x12>\n" +
+	"\aattacks\x18\x01 \x03(\v2$.weewar.v1.AttackMatrix.AttacksEntryR\aattacks\x1aX\n" +
+	"\fAttacksEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.weewar.v1.DefenderDamageMapR\x05value:\x028\x01\"\xd4\x01\n" +
+	"\x11DefenderDamageMap\x12\\\n" +
+	"\x10defender_damages\x18\x01 \x03(\v21.weewar.v1.DefenderDamageMap.DefenderDamagesEntryR\x0fdefenderDamages\x1aa\n" +
+	"\x14DefenderDamagesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x123\n" +
+	"\x05value\x18\x02 \x01(\v2\x1d.weewar.v1.DamageDistributionR\x05value:\x028\x01\"\xbb\x01\n" +
+	"\x12DamageDistribution\x12\x1d\n" +
+	"\n" +
+	"min_damage\x18\x01 \x01(\x05R\tminDamage\x12\x1d\n" +
+	"\n" +
+	"max_damage\x18\x02 \x01(\x05R\tmaxDamage\x12>\n" +
+	"\x0edamage_buckets\x18\x03 \x03(\v2\x17.weewar.v1.DamageBucketR\rdamageBuckets\x12'\n" +
+	"\x0fexpected_damage\x18\x04 \x01(\x01R\x0eexpectedDamage\">\n" +
+	"\fDamageBucket\x12\x16\n" +
+	"\x06damage\x18\x01 \x01(\x05R\x06damage\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x01R\x06weight\"\xf2\x04\n" +
+	"\aRuleSet\x123\n" +
+	"\x05units\x18\x01 \x03(\v2\x1d.weewar.v1.RuleSet.UnitsEntryR\x05units\x12<\n" +
+	"\bterrains\x18\x02 \x03(\v2 .weewar.v1.RuleSet.TerrainsEntryR\bterrains\x12B\n" +
+	"\x0fmovement_matrix\x18\x03 \x01(\v2\x19.weewar.v1.MovementMatrixR\x0emovementMatrix\x12<\n" +
+	"\rattack_matrix\x18\x04 \x01(\v2\x17.weewar.v1.AttackMatrixR\fattackMatrix\x12?\n" +
+	"\x0emovement_rules\x18\x05 \x01(\v2\x18.weewar.v1.MovementRulesR\rmovementRules\x129\n" +
+	"\fcombat_rules\x18\x06 \x01(\v2\x16.weewar.v1.CombatRulesR\vcombatRules\x12F\n" +
+	"\x0fterrain_actions\x18\a \x01(\v2\x1d.weewar.v1.TerrainActionRulesR\x0eterrainActions\x1aS\n" +
+	"\n" +
+	"UnitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12/\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.weewar.v1.UnitDefinitionR\x05value:\x028\x01\x1aY\n" +
+	"\rTerrainsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.weewar.v1.TerrainDefinitionR\x05value:\x028\x01\"\x9e\x03\n" +
 	"\x04Game\x129\n" +
 	"\n" +
 	"created_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
@@ -2252,7 +4732,8 @@ const file_weewar_v1_models_proto_rawDesc = "" +
 	"difficulty\x18\n" +
 	" \x01(\tR\n" +
 	"difficulty\x124\n" +
-	"\x06config\x18\v \x01(\v2\x1c.weewar.v1.GameConfigurationR\x06config\"y\n" +
+	"\x06config\x18\v \x01(\v2\x1c.weewar.v1.GameConfigurationR\x06config\x12\x19\n" +
+	"\brules_id\x18\f \x01(\tR\arulesId\"y\n" +
 	"\x11GameConfiguration\x12/\n" +
 	"\aplayers\x18\x01 \x03(\v2\x15.weewar.v1.GamePlayerR\aplayers\x123\n" +
 	"\bsettings\x18\x02 \x01(\v2\x17.weewar.v1.GameSettingsR\bsettings\"y\n" +
@@ -2262,12 +4743,43 @@ const file_weewar_v1_models_proto_rawDesc = "" +
 	"\vplayer_type\x18\x02 \x01(\tR\n" +
 	"playerType\x12\x14\n" +
 	"\x05color\x18\x03 \x01(\tR\x05color\x12\x17\n" +
-	"\ateam_id\x18\x04 \x01(\x05R\x06teamId\"\x95\x01\n" +
+	"\ateam_id\x18\x04 \x01(\x05R\x06teamId\"\xd6\x02\n" +
 	"\fGameSettings\x12#\n" +
 	"\rallowed_units\x18\x01 \x03(\x05R\fallowedUnits\x12&\n" +
 	"\x0fturn_time_limit\x18\x02 \x01(\x05R\rturnTimeLimit\x12\x1b\n" +
 	"\tteam_mode\x18\x03 \x01(\tR\bteamMode\x12\x1b\n" +
-	"\tmax_turns\x18\x04 \x01(\x05R\bmaxTurns\"\xde\x01\n" +
+	"\tmax_turns\x18\x04 \x01(\x05R\bmaxTurns\x12-\n" +
+	"\x05coins\x18\x05 \x01(\v2\x17.weewar.v1.CoinSettingsR\x05coins\x12\x1c\n" +
+	"\n" +
+	"fog_of_war\x18\x06 \x01(\bR\bfogOfWar\x124\n" +
+	"\avictory\x18\a \x01(\v2\x1a.weewar.v1.VictorySettingsR\avictory\x12<\n" +
+	"\rrules_overlay\x18\b \x01(\v2\x17.weewar.v1.RulesOverlayR\frulesOverlay\"\xdf\x02\n" +
+	"\fRulesOverlay\x12%\n" +
+	"\x0edisabled_units\x18\x01 \x03(\x05R\rdisabledUnits\x12E\n" +
+	"\n" +
+	"unit_coins\x18\x02 \x03(\v2&.weewar.v1.RulesOverlay.UnitCoinsEntryR\tunitCoins\x12]\n" +
+	"\x12damage_multipliers\x18\x03 \x03(\v2..weewar.v1.RulesOverlay.DamageMultipliersEntryR\x11damageMultipliers\x1a<\n" +
+	"\x0eUnitCoinsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1aD\n" +
+	"\x16DamageMultipliersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xbb\x01\n" +
+	"\x0fVictorySettings\x12 \n" +
+	"\velimination\x18\x01 \x01(\bR\velimination\x12#\n" +
+	"\rcapture_bases\x18\x02 \x01(\x05R\fcaptureBases\x124\n" +
+	"\x16headquarters_tile_type\x18\x03 \x01(\x05R\x14headquartersTileType\x12+\n" +
+	"\x12score_at_max_turns\x18\x04 \x01(\bR\x0fscoreAtMaxTurns\"\xac\x01\n" +
+	"\x0fVictoryProgress\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\x05R\x06player\x12D\n" +
+	"\bprogress\x18\x02 \x03(\v2(.weewar.v1.VictoryProgress.ProgressEntryR\bprogress\x1a;\n" +
+	"\rProgressEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"h\n" +
+	"\fCoinSettings\x12\"\n" +
+	"\rstart_of_game\x18\x01 \x01(\x05R\vstartOfGame\x12\x19\n" +
+	"\bper_turn\x18\x02 \x01(\x05R\aperTurn\x12\x19\n" +
+	"\bper_base\x18\x03 \x01(\x05R\aperBase\"\xb6\x05\n" +
 	"\tGameState\x129\n" +
 	"\n" +
 	"updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x17\n" +
@@ -2275,16 +4787,30 @@ const file_weewar_v1_models_proto_rawDesc = "" +
 	"\fturn_counter\x18\x04 \x01(\x05R\vturnCounter\x12%\n" +
 	"\x0ecurrent_player\x18\x05 \x01(\x05R\rcurrentPlayer\x123\n" +
 	"\n" +
-	"world_data\x18\x06 \x01(\v2\x14.weewar.v1.WorldDataR\tworldData\"\\\n" +
+	"world_data\x18\x06 \x01(\v2\x14.weewar.v1.WorldDataR\tworldData\x12H\n" +
+	"\fplayer_coins\x18\a \x03(\v2%.weewar.v1.GameState.PlayerCoinsEntryR\vplayerCoins\x12*\n" +
+	"\x11last_sequence_num\x18\b \x01(\x03R\x0flastSequenceNum\x12\x19\n" +
+	"\brng_seed\x18\t \x01(\x03R\arngSeed\x12!\n" +
+	"\frng_position\x18\n" +
+	" \x01(\x03R\vrngPosition\x12B\n" +
+	"\x0fturn_started_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\rturnStartedAt\x12?\n" +
+	"\rturn_deadline\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\fturnDeadline\x12\x16\n" +
+	"\x06winner\x18\r \x01(\x05R\x06winner\x12+\n" +
+	"\x11victory_condition\x18\x0e \x01(\tR\x10victoryCondition\x12\x18\n" +
+	"\awinners\x18\x0f \x03(\x05R\awinners\x1a>\n" +
+	"\x10PlayerCoinsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\x97\x01\n" +
 	"\x0fGameMoveHistory\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x120\n" +
-	"\x06groups\x18\x02 \x03(\v2\x18.weewar.v1.GameMoveGroupR\x06groups\"\xea\x01\n" +
+	"\x06groups\x18\x02 \x03(\v2\x18.weewar.v1.GameMoveGroupR\x06groups\x129\n" +
+	"\rinitial_state\x18\x03 \x01(\v2\x14.weewar.v1.GameStateR\finitialState\"\xea\x01\n" +
 	"\rGameMoveGroup\x129\n" +
 	"\n" +
 	"started_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
 	"\bended_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendedAt\x12)\n" +
 	"\x05moves\x18\x04 \x03(\v2\x13.weewar.v1.GameMoveR\x05moves\x12<\n" +
-	"\fmove_results\x18\x05 \x03(\v2\x19.weewar.v1.GameMoveResultR\vmoveResults\"\xbd\x02\n" +
+	"\fmove_results\x18\x05 \x03(\v2\x19.weewar.v1.GameMoveResultR\vmoveResults\"\xc6\x05\n" +
 	"\bGameMove\x12\x16\n" +
 	"\x06player\x18\x01 \x01(\x05R\x06player\x128\n" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12!\n" +
@@ -2292,17 +4818,30 @@ const file_weewar_v1_models_proto_rawDesc = "" +
 	"\tmove_unit\x18\x04 \x01(\v2\x19.weewar.v1.MoveUnitActionH\x00R\bmoveUnit\x12>\n" +
 	"\vattack_unit\x18\x05 \x01(\v2\x1b.weewar.v1.AttackUnitActionH\x00R\n" +
 	"attackUnit\x125\n" +
-	"\bend_turn\x18\x06 \x01(\v2\x18.weewar.v1.EndTurnActionH\x00R\aendTurnB\v\n" +
+	"\bend_turn\x18\x06 \x01(\v2\x18.weewar.v1.EndTurnActionH\x00R\aendTurn\x12;\n" +
+	"\n" +
+	"build_unit\x18\a \x01(\v2\x1a.weewar.v1.BuildUnitActionH\x00R\tbuildUnit\x12M\n" +
+	"\x10capture_building\x18\b \x01(\v2 .weewar.v1.CaptureBuildingActionH\x00R\x0fcaptureBuilding\x128\n" +
+	"\tload_unit\x18\t \x01(\v2\x19.weewar.v1.LoadUnitActionH\x00R\bloadUnit\x12>\n" +
+	"\vunload_unit\x18\n" +
+	" \x01(\v2\x1b.weewar.v1.UnloadUnitActionH\x00R\n" +
+	"unloadUnit\x128\n" +
+	"\theal_unit\x18\v \x01(\v2\x19.weewar.v1.HealUnitActionH\x00R\bhealUnit\x12G\n" +
+	"\x0emodify_terrain\x18\f \x01(\v2\x1e.weewar.v1.ModifyTerrainActionH\x00R\rmodifyTerrainB\v\n" +
 	"\tmove_type\"\x88\x01\n" +
 	"\x0eGameMoveResult\x12!\n" +
 	"\fis_permanent\x18\x01 \x01(\bR\visPermanent\x12!\n" +
 	"\fsequence_num\x18\x02 \x01(\x03R\vsequenceNum\x120\n" +
-	"\achanges\x18\x03 \x03(\v2\x16.weewar.v1.WorldChangeR\achanges\"d\n" +
+	"\achanges\x18\x03 \x03(\v2\x16.weewar.v1.WorldChangeR\achanges\"&\n" +
+	"\bHexCoord\x12\f\n" +
+	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
+	"\x01r\x18\x02 \x01(\x05R\x01r\"\x8d\x01\n" +
 	"\x0eMoveUnitAction\x12\x15\n" +
 	"\x06from_q\x18\x01 \x01(\x05R\x05fromQ\x12\x15\n" +
 	"\x06from_r\x18\x02 \x01(\x05R\x05fromR\x12\x11\n" +
 	"\x04to_q\x18\x03 \x01(\x05R\x03toQ\x12\x11\n" +
-	"\x04to_r\x18\x04 \x01(\x05R\x03toR\"\x8e\x01\n" +
+	"\x04to_r\x18\x04 \x01(\x05R\x03toR\x12'\n" +
+	"\x04path\x18\x05 \x03(\v2\x13.weewar.v1.HexCoordR\x04path\"\x8e\x01\n" +
 	"\x10AttackUnitAction\x12\x1d\n" +
 	"\n" +
 	"attacker_q\x18\x01 \x01(\x05R\tattackerQ\x12\x1d\n" +
@@ -2311,22 +4850,74 @@ const file_weewar_v1_models_proto_rawDesc = "" +
 	"\n" +
 	"defender_q\x18\x03 \x01(\x05R\tdefenderQ\x12\x1d\n" +
 	"\n" +
-	"defender_r\x18\x04 \x01(\x05R\tdefenderR\"\x0f\n" +
-	"\rEndTurnAction\"\xa5\x02\n" +
+	"defender_r\x18\x04 \x01(\x05R\tdefenderR\",\n" +
+	"\rEndTurnAction\x12\x1b\n" +
+	"\ttimed_out\x18\x01 \x01(\bR\btimedOut\"J\n" +
+	"\x0fBuildUnitAction\x12\f\n" +
+	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
+	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n" +
+	"\tunit_type\x18\x03 \x01(\x05R\bunitType\"3\n" +
+	"\x15CaptureBuildingAction\x12\f\n" +
+	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
+	"\x01r\x18\x02 \x01(\x05R\x01r\"\x8e\x01\n" +
+	"\x13ModifyTerrainAction\x12\f\n" +
+	"\x01q\x18\x01 \x01(\x05R\x01q\x12\f\n" +
+	"\x01r\x18\x02 \x01(\x05R\x01r\x12\x19\n" +
+	"\btarget_q\x18\x03 \x01(\x05R\atargetQ\x12\x19\n" +
+	"\btarget_r\x18\x04 \x01(\x05R\atargetR\x12%\n" +
+	"\x0eterrain_action\x18\x05 \x01(\tR\rterrainAction\"\x80\x01\n" +
+	"\x0eLoadUnitAction\x12\x15\n" +
+	"\x06unit_q\x18\x01 \x01(\x05R\x05unitQ\x12\x15\n" +
+	"\x06unit_r\x18\x02 \x01(\x05R\x05unitR\x12\x1f\n" +
+	"\vtransport_q\x18\x03 \x01(\x05R\n" +
+	"transportQ\x12\x1f\n" +
+	"\vtransport_r\x18\x04 \x01(\x05R\n" +
+	"transportR\"\x9b\x01\n" +
+	"\x10UnloadUnitAction\x12\x1f\n" +
+	"\vtransport_q\x18\x01 \x01(\x05R\n" +
+	"transportQ\x12\x1f\n" +
+	"\vtransport_r\x18\x02 \x01(\x05R\n" +
+	"transportR\x12\x1f\n" +
+	"\vcargo_index\x18\x03 \x01(\x05R\n" +
+	"cargoIndex\x12\x11\n" +
+	"\x04to_q\x18\x04 \x01(\x05R\x03toQ\x12\x11\n" +
+	"\x04to_r\x18\x05 \x01(\x05R\x03toR\"|\n" +
+	"\x0eHealUnitAction\x12\x19\n" +
+	"\bhealer_q\x18\x01 \x01(\x05R\ahealerQ\x12\x19\n" +
+	"\bhealer_r\x18\x02 \x01(\x05R\ahealerR\x12\x19\n" +
+	"\btarget_q\x18\x03 \x01(\x05R\atargetQ\x12\x19\n" +
+	"\btarget_r\x18\x04 \x01(\x05R\atargetR\"\xfd\x05\n" +
 	"\vWorldChange\x12;\n" +
 	"\n" +
 	"unit_moved\x18\x01 \x01(\v2\x1a.weewar.v1.UnitMovedChangeH\x00R\tunitMoved\x12A\n" +
 	"\funit_damaged\x18\x02 \x01(\v2\x1c.weewar.v1.UnitDamagedChangeH\x00R\vunitDamaged\x12>\n" +
 	"\vunit_killed\x18\x03 \x01(\v2\x1b.weewar.v1.UnitKilledChangeH\x00R\n" +
 	"unitKilled\x12G\n" +
-	"\x0eplayer_changed\x18\x04 \x01(\v2\x1e.weewar.v1.PlayerChangedChangeH\x00R\rplayerChangedB\r\n" +
+	"\x0eplayer_changed\x18\x04 \x01(\v2\x1e.weewar.v1.PlayerChangedChangeH\x00R\rplayerChanged\x12D\n" +
+	"\rcoins_changed\x18\x05 \x01(\v2\x1d.weewar.v1.CoinsChangedChangeH\x00R\fcoinsChanged\x12A\n" +
+	"\funit_created\x18\x06 \x01(\v2\x1c.weewar.v1.UnitCreatedChangeH\x00R\vunitCreated\x12D\n" +
+	"\rtile_captured\x18\a \x01(\v2\x1d.weewar.v1.TileCapturedChangeH\x00R\ftileCaptured\x12>\n" +
+	"\vunit_loaded\x18\b \x01(\v2\x1b.weewar.v1.UnitLoadedChangeH\x00R\n" +
+	"unitLoaded\x12D\n" +
+	"\runit_unloaded\x18\t \x01(\v2\x1d.weewar.v1.UnitUnloadedChangeH\x00R\funitUnloaded\x12>\n" +
+	"\vunit_healed\x18\n" +
+	" \x01(\v2\x1b.weewar.v1.UnitHealedChangeH\x00R\n" +
+	"unitHealed\x12A\n" +
+	"\ftile_changed\x18\v \x01(\v2\x1c.weewar.v1.TileChangedChangeH\x00R\vtileChangedB\r\n" +
 	"\vchange_type\"{\n" +
 	"\x0fUnitMovedChange\x124\n" +
 	"\rprevious_unit\x18\x06 \x01(\v2\x0f.weewar.v1.UnitR\fpreviousUnit\x122\n" +
-	"\fupdated_unit\x18\a \x01(\v2\x0f.weewar.v1.UnitR\vupdatedUnit\"}\n" +
+	"\fupdated_unit\x18\a \x01(\v2\x0f.weewar.v1.UnitR\vupdatedUnit\"\xf7\x01\n" +
 	"\x11UnitDamagedChange\x124\n" +
 	"\rprevious_unit\x18\x06 \x01(\v2\x0f.weewar.v1.UnitR\fpreviousUnit\x122\n" +
-	"\fupdated_unit\x18\a \x01(\v2\x0f.weewar.v1.UnitR\vupdatedUnit\"H\n" +
+	"\fupdated_unit\x18\a \x01(\v2\x0f.weewar.v1.UnitR\vupdatedUnit\x12<\n" +
+	"\x11previous_attacker\x18\b \x01(\v2\x0f.weewar.v1.UnitR\x10previousAttacker\x12:\n" +
+	"\x10updated_attacker\x18\t \x01(\v2\x0f.weewar.v1.UnitR\x0fupdatedAttacker\"\xee\x01\n" +
+	"\x10UnitHealedChange\x124\n" +
+	"\rprevious_unit\x18\x01 \x01(\v2\x0f.weewar.v1.UnitR\fpreviousUnit\x122\n" +
+	"\fupdated_unit\x18\x02 \x01(\v2\x0f.weewar.v1.UnitR\vupdatedUnit\x128\n" +
+	"\x0fprevious_healer\x18\x03 \x01(\v2\x0f.weewar.v1.UnitR\x0epreviousHealer\x126\n" +
+	"\x0eupdated_healer\x18\x04 \x01(\v2\x0f.weewar.v1.UnitR\rupdatedHealer\"H\n" +
 	"\x10UnitKilledChange\x124\n" +
 	"\rprevious_unit\x18\x06 \x01(\v2\x0f.weewar.v1.UnitR\fpreviousUnit\"\xcf\x01\n" +
 	"\x13PlayerChangedChange\x12'\n" +
@@ -2336,7 +4927,31 @@ const file_weewar_v1_models_proto_rawDesc = "" +
 	"\rprevious_turn\x18\x03 \x01(\x05R\fpreviousTurn\x12\x19\n" +
 	"\bnew_turn\x18\x04 \x01(\x05R\anewTurn\x120\n" +
 	"\vreset_units\x18\x05 \x03(\v2\x0f.weewar.v1.UnitR\n" +
-	"resetUnitsB\x9d\x01\n" +
+	"resetUnits\"p\n" +
+	"\x12CoinsChangedChange\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\x05R\x06player\x12%\n" +
+	"\x0eprevious_coins\x18\x02 \x01(\x05R\rpreviousCoins\x12\x1b\n" +
+	"\tnew_coins\x18\x03 \x01(\x05R\bnewCoins\"8\n" +
+	"\x11UnitCreatedChange\x12#\n" +
+	"\x04unit\x18\x01 \x01(\v2\x0f.weewar.v1.UnitR\x04unit\"\xe8\x01\n" +
+	"\x12TileCapturedChange\x124\n" +
+	"\rprevious_tile\x18\x01 \x01(\v2\x0f.weewar.v1.TileR\fpreviousTile\x122\n" +
+	"\fupdated_tile\x18\x02 \x01(\v2\x0f.weewar.v1.TileR\vupdatedTile\x124\n" +
+	"\rprevious_unit\x18\x03 \x01(\v2\x0f.weewar.v1.UnitR\fpreviousUnit\x122\n" +
+	"\fupdated_unit\x18\x04 \x01(\v2\x0f.weewar.v1.UnitR\vupdatedUnit\"\xe7\x01\n" +
+	"\x11TileChangedChange\x124\n" +
+	"\rprevious_tile\x18\x01 \x01(\v2\x0f.weewar.v1.TileR\fpreviousTile\x122\n" +
+	"\fupdated_tile\x18\x02 \x01(\v2\x0f.weewar.v1.TileR\vupdatedTile\x124\n" +
+	"\rprevious_unit\x18\x03 \x01(\v2\x0f.weewar.v1.UnitR\fpreviousUnit\x122\n" +
+	"\fupdated_unit\x18\x04 \x01(\v2\x0f.weewar.v1.UnitR\vupdatedUnit\"\xb5\x01\n" +
+	"\x10UnitLoadedChange\x12#\n" +
+	"\x04unit\x18\x01 \x01(\v2\x0f.weewar.v1.UnitR\x04unit\x12>\n" +
+	"\x12previous_transport\x18\x02 \x01(\v2\x0f.weewar.v1.UnitR\x11previousTransport\x12<\n" +
+	"\x11updated_transport\x18\x03 \x01(\v2\x0f.weewar.v1.UnitR\x10updatedTransport\"\xb7\x01\n" +
+	"\x12UnitUnloadedChange\x12#\n" +
+	"\x04unit\x18\x01 \x01(\v2\x0f.weewar.v1.UnitR\x04unit\x12>\n" +
+	"\x12previous_transport\x18\x02 \x01(\v2\x0f.weewar.v1.UnitR\x11previousTransport\x12<\n" +
+	"\x11updated_transport\x18\x03 \x01(\v2\x0f.weewar.v1.UnitR\x10updatedTransportB\x9d\x01\n" +
 	"\rcom.weewar.v1B\vModelsProtoP\x01Z:github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1\xa2\x02\x03WXX\xaa\x02\tWeewar.V1\xca\x02\tWeewar\\V1\xe2\x02\x15Weewar\\V1\\GPBMetadata\xea\x02\n" +
 	"Weewar::V1b\x06proto3"
 
@@ -2352,7 +4967,7 @@ func file_weewar_v1_models_proto_rawDescGZIP() []byte {
 	return file_weewar_v1_models_proto_rawDescData
 }
 
-var file_weewar_v1_models_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_weewar_v1_models_proto_msgTypes = make([]protoimpl.MessageInfo, 70)
 var file_weewar_v1_models_proto_goTypes = []any{
 	(*User)(nil),                  // 0: weewar.v1.User
 	(*Pagination)(nil),            // 1: weewar.v1.Pagination
@@ -2363,72 +4978,178 @@ var file_weewar_v1_models_proto_goTypes = []any{
 	(*Unit)(nil),                  // 6: weewar.v1.Unit
 	(*TerrainDefinition)(nil),     // 7: weewar.v1.TerrainDefinition
 	(*UnitDefinition)(nil),        // 8: weewar.v1.UnitDefinition
-	(*MovementMatrix)(nil),        // 9: weewar.v1.MovementMatrix
-	(*TerrainCostMap)(nil),        // 10: weewar.v1.TerrainCostMap
-	(*Game)(nil),                  // 11: weewar.v1.Game
-	(*GameConfiguration)(nil),     // 12: weewar.v1.GameConfiguration
-	(*GamePlayer)(nil),            // 13: weewar.v1.GamePlayer
-	(*GameSettings)(nil),          // 14: weewar.v1.GameSettings
-	(*GameState)(nil),             // 15: weewar.v1.GameState
-	(*GameMoveHistory)(nil),       // 16: weewar.v1.GameMoveHistory
-	(*GameMoveGroup)(nil),         // 17: weewar.v1.GameMoveGroup
-	(*GameMove)(nil),              // 18: weewar.v1.GameMove
-	(*GameMoveResult)(nil),        // 19: weewar.v1.GameMoveResult
-	(*MoveUnitAction)(nil),        // 20: weewar.v1.MoveUnitAction
-	(*AttackUnitAction)(nil),      // 21: weewar.v1.AttackUnitAction
-	(*EndTurnAction)(nil),         // 22: weewar.v1.EndTurnAction
-	(*WorldChange)(nil),           // 23: weewar.v1.WorldChange
-	(*UnitMovedChange)(nil),       // 24: weewar.v1.UnitMovedChange
-	(*UnitDamagedChange)(nil),     // 25: weewar.v1.UnitDamagedChange
-	(*UnitKilledChange)(nil),      // 26: weewar.v1.UnitKilledChange
-	(*PlayerChangedChange)(nil),   // 27: weewar.v1.PlayerChangedChange
-	nil,                           // 28: weewar.v1.MovementMatrix.CostsEntry
-	nil,                           // 29: weewar.v1.TerrainCostMap.TerrainCostsEntry
-	(*timestamppb.Timestamp)(nil), // 30: google.protobuf.Timestamp
+	(*MovementRules)(nil),         // 9: weewar.v1.MovementRules
+	(*CombatRules)(nil),           // 10: weewar.v1.CombatRules
+	(*TerrainActionRules)(nil),    // 11: weewar.v1.TerrainActionRules
+	(*TerrainAction)(nil),         // 12: weewar.v1.TerrainAction
+	(*ClassDamageModifiers)(nil),  // 13: weewar.v1.ClassDamageModifiers
+	(*MovementMatrix)(nil),        // 14: weewar.v1.MovementMatrix
+	(*TerrainCostMap)(nil),        // 15: weewar.v1.TerrainCostMap
+	(*AttackMatrix)(nil),          // 16: weewar.v1.AttackMatrix
+	(*DefenderDamageMap)(nil),     // 17: weewar.v1.DefenderDamageMap
+	(*DamageDistribution)(nil),    // 18: weewar.v1.DamageDistribution
+	(*DamageBucket)(nil),          // 19: weewar.v1.DamageBucket
+	(*RuleSet)(nil),               // 20: weewar.v1.RuleSet
+	(*Game)(nil),                  // 21: weewar.v1.Game
+	(*GameConfiguration)(nil),     // 22: weewar.v1.GameConfiguration
+	(*GamePlayer)(nil),            // 23: weewar.v1.GamePlayer
+	(*GameSettings)(nil),          // 24: weewar.v1.GameSettings
+	(*RulesOverlay)(nil),          // 25: weewar.v1.RulesOverlay
+	(*VictorySettings)(nil),       // 26: weewar.v1.VictorySettings
+	(*VictoryProgress)(nil),       // 27: weewar.v1.VictoryProgress
+	(*CoinSettings)(nil),          // 28: weewar.v1.CoinSettings
+	(*GameState)(nil),             // 29: weewar.v1.GameState
+	(*GameMoveHistory)(nil),       // 30: weewar.v1.GameMoveHistory
+	(*GameMoveGroup)(nil),         // 31: weewar.v1.GameMoveGroup
+	(*GameMove)(nil),              // 32: weewar.v1.GameMove
+	(*GameMoveResult)(nil),        // 33: weewar.v1.GameMoveResult
+	(*HexCoord)(nil),              // 34: weewar.v1.HexCoord
+	(*MoveUnitAction)(nil),        // 35: weewar.v1.MoveUnitAction
+	(*AttackUnitAction)(nil),      // 36: weewar.v1.AttackUnitAction
+	(*EndTurnAction)(nil),         // 37: weewar.v1.EndTurnAction
+	(*BuildUnitAction)(nil),       // 38: weewar.v1.BuildUnitAction
+	(*CaptureBuildingAction)(nil), // 39: weewar.v1.CaptureBuildingAction
+	(*ModifyTerrainAction)(nil),   // 40: weewar.v1.ModifyTerrainAction
+	(*LoadUnitAction)(nil),        // 41: weewar.v1.LoadUnitAction
+	(*UnloadUnitAction)(nil),      // 42: weewar.v1.UnloadUnitAction
+	(*HealUnitAction)(nil),        // 43: weewar.v1.HealUnitAction
+	(*WorldChange)(nil),           // 44: weewar.v1.WorldChange
+	(*UnitMovedChange)(nil),       // 45: weewar.v1.UnitMovedChange
+	(*UnitDamagedChange)(nil),     // 46: weewar.v1.UnitDamagedChange
+	(*UnitHealedChange)(nil),      // 47: weewar.v1.UnitHealedChange
+	(*UnitKilledChange)(nil),      // 48: weewar.v1.UnitKilledChange
+	(*PlayerChangedChange)(nil),   // 49: weewar.v1.PlayerChangedChange
+	(*CoinsChangedChange)(nil),    // 50: weewar.v1.CoinsChangedChange
+	(*UnitCreatedChange)(nil),     // 51: weewar.v1.UnitCreatedChange
+	(*TileCapturedChange)(nil),    // 52: weewar.v1.TileCapturedChange
+	(*TileChangedChange)(nil),     // 53: weewar.v1.TileChangedChange
+	(*UnitLoadedChange)(nil),      // 54: weewar.v1.UnitLoadedChange
+	(*UnitUnloadedChange)(nil),    // 55: weewar.v1.UnitUnloadedChange
+	nil,                           // 56: weewar.v1.CombatRules.ClassModifiersEntry
+	nil,                           // 57: weewar.v1.TerrainActionRules.ActionsEntry
+	nil,                           // 58: weewar.v1.TerrainAction.TerrainChangesEntry
+	nil,                           // 59: weewar.v1.ClassDamageModifiers.DefenderClassesEntry
+	nil,                           // 60: weewar.v1.MovementMatrix.CostsEntry
+	nil,                           // 61: weewar.v1.TerrainCostMap.TerrainCostsEntry
+	nil,                           // 62: weewar.v1.AttackMatrix.AttacksEntry
+	nil,                           // 63: weewar.v1.DefenderDamageMap.DefenderDamagesEntry
+	nil,                           // 64: weewar.v1.RuleSet.UnitsEntry
+	nil,                           // 65: weewar.v1.RuleSet.TerrainsEntry
+	nil,                           // 66: weewar.v1.RulesOverlay.UnitCoinsEntry
+	nil,                           // 67: weewar.v1.RulesOverlay.DamageMultipliersEntry
+	nil,                           // 68: weewar.v1.VictoryProgress.ProgressEntry
+	nil,                           // 69: weewar.v1.GameState.PlayerCoinsEntry
+	(*timestamppb.Timestamp)(nil), // 70: google.protobuf.Timestamp
 }
 var file_weewar_v1_models_proto_depIdxs = []int32{
-	30, // 0: weewar.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	30, // 1: weewar.v1.User.updated_at:type_name -> google.protobuf.Timestamp
-	30, // 2: weewar.v1.World.created_at:type_name -> google.protobuf.Timestamp
-	30, // 3: weewar.v1.World.updated_at:type_name -> google.protobuf.Timestamp
-	4,  // 4: weewar.v1.World.world_data:type_name -> weewar.v1.WorldData
-	5,  // 5: weewar.v1.WorldData.tiles:type_name -> weewar.v1.Tile
-	6,  // 6: weewar.v1.WorldData.units:type_name -> weewar.v1.Unit
-	28, // 7: weewar.v1.MovementMatrix.costs:type_name -> weewar.v1.MovementMatrix.CostsEntry
-	29, // 8: weewar.v1.TerrainCostMap.terrain_costs:type_name -> weewar.v1.TerrainCostMap.TerrainCostsEntry
-	30, // 9: weewar.v1.Game.created_at:type_name -> google.protobuf.Timestamp
-	30, // 10: weewar.v1.Game.updated_at:type_name -> google.protobuf.Timestamp
-	12, // 11: weewar.v1.Game.config:type_name -> weewar.v1.GameConfiguration
-	13, // 12: weewar.v1.GameConfiguration.players:type_name -> weewar.v1.GamePlayer
-	14, // 13: weewar.v1.GameConfiguration.settings:type_name -> weewar.v1.GameSettings
-	30, // 14: weewar.v1.GameState.updated_at:type_name -> google.protobuf.Timestamp
-	4,  // 15: weewar.v1.GameState.world_data:type_name -> weewar.v1.WorldData
-	17, // 16: weewar.v1.GameMoveHistory.groups:type_name -> weewar.v1.GameMoveGroup
-	30, // 17: weewar.v1.GameMoveGroup.started_at:type_name -> google.protobuf.Timestamp
-	30, // 18: weewar.v1.GameMoveGroup.ended_at:type_name -> google.protobuf.Timestamp
-	18, // 19: weewar.v1.GameMoveGroup.moves:type_name -> weewar.v1.GameMove
-	19, // 20: weewar.v1.GameMoveGroup.move_results:type_name -> weewar.v1.GameMoveResult
-	30, // 21: weewar.v1.GameMove.timestamp:type_name -> google.protobuf.Timestamp
-	20, // 22: weewar.v1.GameMove.move_unit:type_name -> weewar.v1.MoveUnitAction
-	21, // 23: weewar.v1.GameMove.attack_unit:type_name -> weewar.v1.AttackUnitAction
-	22, // 24: weewar.v1.GameMove.end_turn:type_name -> weewar.v1.EndTurnAction
-	23, // 25: weewar.v1.GameMoveResult.changes:type_name -> weewar.v1.WorldChange
-	24, // 26: weewar.v1.WorldChange.unit_moved:type_name -> weewar.v1.UnitMovedChange
-	25, // 27: weewar.v1.WorldChange.unit_damaged:type_name -> weewar.v1.UnitDamagedChange
-	26, // 28: weewar.v1.WorldChange.unit_killed:type_name -> weewar.v1.UnitKilledChange
-	27, // 29: weewar.v1.WorldChange.player_changed:type_name -> weewar.v1.PlayerChangedChange
-	6,  // 30: weewar.v1.UnitMovedChange.previous_unit:type_name -> weewar.v1.Unit
-	6,  // 31: weewar.v1.UnitMovedChange.updated_unit:type_name -> weewar.v1.Unit
-	6,  // 32: weewar.v1.UnitDamagedChange.previous_unit:type_name -> weewar.v1.Unit
-	6,  // 33: weewar.v1.UnitDamagedChange.updated_unit:type_name -> weewar.v1.Unit
-	6,  // 34: weewar.v1.UnitKilledChange.previous_unit:type_name -> weewar.v1.Unit
-	6,  // 35: weewar.v1.PlayerChangedChange.reset_units:type_name -> weewar.v1.Unit
-	10, // 36: weewar.v1.MovementMatrix.CostsEntry.value:type_name -> weewar.v1.TerrainCostMap
-	37, // [37:37] is the sub-list for method output_type
-	37, // [37:37] is the sub-list for method input_type
-	37, // [37:37] is the sub-list for extension type_name
-	37, // [37:37] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+	70,  // 0: weewar.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	70,  // 1: weewar.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	70,  // 2: weewar.v1.World.created_at:type_name -> google.protobuf.Timestamp
+	70,  // 3: weewar.v1.World.updated_at:type_name -> google.protobuf.Timestamp
+	4,   // 4: weewar.v1.World.world_data:type_name -> weewar.v1.WorldData
+	5,   // 5: weewar.v1.WorldData.tiles:type_name -> weewar.v1.Tile
+	6,   // 6: weewar.v1.WorldData.units:type_name -> weewar.v1.Unit
+	6,   // 7: weewar.v1.Unit.cargo:type_name -> weewar.v1.Unit
+	56,  // 8: weewar.v1.CombatRules.class_modifiers:type_name -> weewar.v1.CombatRules.ClassModifiersEntry
+	57,  // 9: weewar.v1.TerrainActionRules.actions:type_name -> weewar.v1.TerrainActionRules.ActionsEntry
+	58,  // 10: weewar.v1.TerrainAction.terrain_changes:type_name -> weewar.v1.TerrainAction.TerrainChangesEntry
+	59,  // 11: weewar.v1.ClassDamageModifiers.defender_classes:type_name -> weewar.v1.ClassDamageModifiers.DefenderClassesEntry
+	60,  // 12: weewar.v1.MovementMatrix.costs:type_name -> weewar.v1.MovementMatrix.CostsEntry
+	61,  // 13: weewar.v1.TerrainCostMap.terrain_costs:type_name -> weewar.v1.TerrainCostMap.TerrainCostsEntry
+	62,  // 14: weewar.v1.AttackMatrix.attacks:type_name -> weewar.v1.AttackMatrix.AttacksEntry
+	63,  // 15: weewar.v1.DefenderDamageMap.defender_damages:type_name -> weewar.v1.DefenderDamageMap.DefenderDamagesEntry
+	19,  // 16: weewar.v1.DamageDistribution.damage_buckets:type_name -> weewar.v1.DamageBucket
+	64,  // 17: weewar.v1.RuleSet.units:type_name -> weewar.v1.RuleSet.UnitsEntry
+	65,  // 18: weewar.v1.RuleSet.terrains:type_name -> weewar.v1.RuleSet.TerrainsEntry
+	14,  // 19: weewar.v1.RuleSet.movement_matrix:type_name -> weewar.v1.MovementMatrix
+	16,  // 20: weewar.v1.RuleSet.attack_matrix:type_name -> weewar.v1.AttackMatrix
+	9,   // 21: weewar.v1.RuleSet.movement_rules:type_name -> weewar.v1.MovementRules
+	10,  // 22: weewar.v1.RuleSet.combat_rules:type_name -> weewar.v1.CombatRules
+	11,  // 23: weewar.v1.RuleSet.terrain_actions:type_name -> weewar.v1.TerrainActionRules
+	70,  // 24: weewar.v1.Game.created_at:type_name -> google.protobuf.Timestamp
+	70,  // 25: weewar.v1.Game.updated_at:type_name -> google.protobuf.Timestamp
+	22,  // 26: weewar.v1.Game.config:type_name -> weewar.v1.GameConfiguration
+	23,  // 27: weewar.v1.GameConfiguration.players:type_name -> weewar.v1.GamePlayer
+	24,  // 28: weewar.v1.GameConfiguration.settings:type_name -> weewar.v1.GameSettings
+	28,  // 29: weewar.v1.GameSettings.coins:type_name -> weewar.v1.CoinSettings
+	26,  // 30: weewar.v1.GameSettings.victory:type_name -> weewar.v1.VictorySettings
+	25,  // 31: weewar.v1.GameSettings.rules_overlay:type_name -> weewar.v1.RulesOverlay
+	66,  // 32: weewar.v1.RulesOverlay.unit_coins:type_name -> weewar.v1.RulesOverlay.UnitCoinsEntry
+	67,  // 33: weewar.v1.RulesOverlay.damage_multipliers:type_name -> weewar.v1.RulesOverlay.DamageMultipliersEntry
+	68,  // 34: weewar.v1.VictoryProgress.progress:type_name -> weewar.v1.VictoryProgress.ProgressEntry
+	70,  // 35: weewar.v1.GameState.updated_at:type_name -> google.protobuf.Timestamp
+	4,   // 36: weewar.v1.GameState.world_data:type_name -> weewar.v1.WorldData
+	69,  // 37: weewar.v1.GameState.player_coins:type_name -> weewar.v1.GameState.PlayerCoinsEntry
+	70,  // 38: weewar.v1.GameState.turn_started_at:type_name -> google.protobuf.Timestamp
+	70,  // 39: weewar.v1.GameState.turn_deadline:type_name -> google.protobuf.Timestamp
+	31,  // 40: weewar.v1.GameMoveHistory.groups:type_name -> weewar.v1.GameMoveGroup
+	29,  // 41: weewar.v1.GameMoveHistory.initial_state:type_name -> weewar.v1.GameState
+	70,  // 42: weewar.v1.GameMoveGroup.started_at:type_name -> google.protobuf.Timestamp
+	70,  // 43: weewar.v1.GameMoveGroup.ended_at:type_name -> google.protobuf.Timestamp
+	32,  // 44: weewar.v1.GameMoveGroup.moves:type_name -> weewar.v1.GameMove
+	33,  // 45: weewar.v1.GameMoveGroup.move_results:type_name -> weewar.v1.GameMoveResult
+	70,  // 46: weewar.v1.GameMove.timestamp:type_name -> google.protobuf.Timestamp
+	35,  // 47: weewar.v1.GameMove.move_unit:type_name -> weewar.v1.MoveUnitAction
+	36,  // 48: weewar.v1.GameMove.attack_unit:type_name -> weewar.v1.AttackUnitAction
+	37,  // 49: weewar.v1.GameMove.end_turn:type_name -> weewar.v1.EndTurnAction
+	38,  // 50: weewar.v1.GameMove.build_unit:type_name -> weewar.v1.BuildUnitAction
+	39,  // 51: weewar.v1.GameMove.capture_building:type_name -> weewar.v1.CaptureBuildingAction
+	41,  // 52: weewar.v1.GameMove.load_unit:type_name -> weewar.v1.LoadUnitAction
+	42,  // 53: weewar.v1.GameMove.unload_unit:type_name -> weewar.v1.UnloadUnitAction
+	43,  // 54: weewar.v1.GameMove.heal_unit:type_name -> weewar.v1.HealUnitAction
+	40,  // 55: weewar.v1.GameMove.modify_terrain:type_name -> weewar.v1.ModifyTerrainAction
+	44,  // 56: weewar.v1.GameMoveResult.changes:type_name -> weewar.v1.WorldChange
+	34,  // 57: weewar.v1.MoveUnitAction.path:type_name -> weewar.v1.HexCoord
+	45,  // 58: weewar.v1.WorldChange.unit_moved:type_name -> weewar.v1.UnitMovedChange
+	46,  // 59: weewar.v1.WorldChange.unit_damaged:type_name -> weewar.v1.UnitDamagedChange
+	48,  // 60: weewar.v1.WorldChange.unit_killed:type_name -> weewar.v1.UnitKilledChange
+	49,  // 61: weewar.v1.WorldChange.player_changed:type_name -> weewar.v1.PlayerChangedChange
+	50,  // 62: weewar.v1.WorldChange.coins_changed:type_name -> weewar.v1.CoinsChangedChange
+	51,  // 63: weewar.v1.WorldChange.unit_created:type_name -> weewar.v1.UnitCreatedChange
+	52,  // 64: weewar.v1.WorldChange.tile_captured:type_name -> weewar.v1.TileCapturedChange
+	54,  // 65: weewar.v1.WorldChange.unit_loaded:type_name -> weewar.v1.UnitLoadedChange
+	55,  // 66: weewar.v1.WorldChange.unit_unloaded:type_name -> weewar.v1.UnitUnloadedChange
+	47,  // 67: weewar.v1.WorldChange.unit_healed:type_name -> weewar.v1.UnitHealedChange
+	53,  // 68: weewar.v1.WorldChange.tile_changed:type_name -> weewar.v1.TileChangedChange
+	6,   // 69: weewar.v1.UnitMovedChange.previous_unit:type_name -> weewar.v1.Unit
+	6,   // 70: weewar.v1.UnitMovedChange.updated_unit:type_name -> weewar.v1.Unit
+	6,   // 71: weewar.v1.UnitDamagedChange.previous_unit:type_name -> weewar.v1.Unit
+	6,   // 72: weewar.v1.UnitDamagedChange.updated_unit:type_name -> weewar.v1.Unit
+	6,   // 73: weewar.v1.UnitDamagedChange.previous_attacker:type_name -> weewar.v1.Unit
+	6,   // 74: weewar.v1.UnitDamagedChange.updated_attacker:type_name -> weewar.v1.Unit
+	6,   // 75: weewar.v1.UnitHealedChange.previous_unit:type_name -> weewar.v1.Unit
+	6,   // 76: weewar.v1.UnitHealedChange.updated_unit:type_name -> weewar.v1.Unit
+	6,   // 77: weewar.v1.UnitHealedChange.previous_healer:type_name -> weewar.v1.Unit
+	6,   // 78: weewar.v1.UnitHealedChange.updated_healer:type_name -> weewar.v1.Unit
+	6,   // 79: weewar.v1.UnitKilledChange.previous_unit:type_name -> weewar.v1.Unit
+	6,   // 80: weewar.v1.PlayerChangedChange.reset_units:type_name -> weewar.v1.Unit
+	6,   // 81: weewar.v1.UnitCreatedChange.unit:type_name -> weewar.v1.Unit
+	5,   // 82: weewar.v1.TileCapturedChange.previous_tile:type_name -> weewar.v1.Tile
+	5,   // 83: weewar.v1.TileCapturedChange.updated_tile:type_name -> weewar.v1.Tile
+	6,   // 84: weewar.v1.TileCapturedChange.previous_unit:type_name -> weewar.v1.Unit
+	6,   // 85: weewar.v1.TileCapturedChange.updated_unit:type_name -> weewar.v1.Unit
+	5,   // 86: weewar.v1.TileChangedChange.previous_tile:type_name -> weewar.v1.Tile
+	5,   // 87: weewar.v1.TileChangedChange.updated_tile:type_name -> weewar.v1.Tile
+	6,   // 88: weewar.v1.TileChangedChange.previous_unit:type_name -> weewar.v1.Unit
+	6,   // 89: weewar.v1.TileChangedChange.updated_unit:type_name -> weewar.v1.Unit
+	6,   // 90: weewar.v1.UnitLoadedChange.unit:type_name -> weewar.v1.Unit
+	6,   // 91: weewar.v1.UnitLoadedChange.previous_transport:type_name -> weewar.v1.Unit
+	6,   // 92: weewar.v1.UnitLoadedChange.updated_transport:type_name -> weewar.v1.Unit
+	6,   // 93: weewar.v1.UnitUnloadedChange.unit:type_name -> weewar.v1.Unit
+	6,   // 94: weewar.v1.UnitUnloadedChange.previous_transport:type_name -> weewar.v1.Unit
+	6,   // 95: weewar.v1.UnitUnloadedChange.updated_transport:type_name -> weewar.v1.Unit
+	13,  // 96: weewar.v1.CombatRules.ClassModifiersEntry.value:type_name -> weewar.v1.ClassDamageModifiers
+	12,  // 97: weewar.v1.TerrainActionRules.ActionsEntry.value:type_name -> weewar.v1.TerrainAction
+	15,  // 98: weewar.v1.MovementMatrix.CostsEntry.value:type_name -> weewar.v1.TerrainCostMap
+	17,  // 99: weewar.v1.AttackMatrix.AttacksEntry.value:type_name -> weewar.v1.DefenderDamageMap
+	18,  // 100: weewar.v1.DefenderDamageMap.DefenderDamagesEntry.value:type_name -> weewar.v1.DamageDistribution
+	8,   // 101: weewar.v1.RuleSet.UnitsEntry.value:type_name -> weewar.v1.UnitDefinition
+	7,   // 102: weewar.v1.RuleSet.TerrainsEntry.value:type_name -> weewar.v1.TerrainDefinition
+	103, // [103:103] is the sub-list for method output_type
+	103, // [103:103] is the sub-list for method input_type
+	103, // [103:103] is the sub-list for extension type_name
+	103, // [103:103] is the sub-list for extension extendee
+	0,   // [0:103] is the sub-list for field type_name
 }
 
 func init() { file_weewar_v1_models_proto_init() }
@@ -2436,16 +5157,29 @@ func file_weewar_v1_models_proto_init() {
 	if File_weewar_v1_models_proto != nil {
 		return
 	}
-	file_weewar_v1_models_proto_msgTypes[18].OneofWrappers = []any{
+	file_weewar_v1_models_proto_msgTypes[32].OneofWrappers = []any{
 		(*GameMove_MoveUnit)(nil),
 		(*GameMove_AttackUnit)(nil),
 		(*GameMove_EndTurn)(nil),
-	}
-	file_weewar_v1_models_proto_msgTypes[23].OneofWrappers = []any{
+		(*GameMove_BuildUnit)(nil),
+		(*GameMove_CaptureBuilding)(nil),
+		(*GameMove_LoadUnit)(nil),
+		(*GameMove_UnloadUnit)(nil),
+		(*GameMove_HealUnit)(nil),
+		(*GameMove_ModifyTerrain)(nil),
+	}
+	file_weewar_v1_models_proto_msgTypes[44].OneofWrappers = []any{
 		(*WorldChange_UnitMoved)(nil),
 		(*WorldChange_UnitDamaged)(nil),
 		(*WorldChange_UnitKilled)(nil),
 		(*WorldChange_PlayerChanged)(nil),
+		(*WorldChange_CoinsChanged)(nil),
+		(*WorldChange_UnitCreated)(nil),
+		(*WorldChange_TileCaptured)(nil),
+		(*WorldChange_UnitLoaded)(nil),
+		(*WorldChange_UnitUnloaded)(nil),
+		(*WorldChange_UnitHealed)(nil),
+		(*WorldChange_TileChanged)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2453,7 +5187,7 @@ func file_weewar_v1_models_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_weewar_v1_models_proto_rawDesc), len(file_weewar_v1_models_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   30,
+			NumMessages:   70,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,458 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: weewar/v1/rules.proto
+
+package v1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RulesId       string                 `protobuf:"bytes,1,opt,name=rules_id,json=rulesId,proto3" json:"rules_id,omitempty"` // Rules to return (empty = the default rules)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRulesRequest) Reset() {
+	*x = GetRulesRequest{}
+	mi := &file_weewar_v1_rules_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRulesRequest) ProtoMessage() {}
+
+func (x *GetRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_rules_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetRulesRequest) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_rules_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetRulesRequest) GetRulesId() string {
+	if x != nil {
+		return x.RulesId
+	}
+	return ""
+}
+
+type GetRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RulesId       string                 `protobuf:"bytes,1,opt,name=rules_id,json=rulesId,proto3" json:"rules_id,omitempty"`
+	Rules         *RuleSet               `protobuf:"bytes,2,opt,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRulesResponse) Reset() {
+	*x = GetRulesResponse{}
+	mi := &file_weewar_v1_rules_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRulesResponse) ProtoMessage() {}
+
+func (x *GetRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_rules_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetRulesResponse) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_rules_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRulesResponse) GetRulesId() string {
+	if x != nil {
+		return x.RulesId
+	}
+	return ""
+}
+
+func (x *GetRulesResponse) GetRules() *RuleSet {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type GetUnitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RulesId       string                 `protobuf:"bytes,1,opt,name=rules_id,json=rulesId,proto3" json:"rules_id,omitempty"` // Rules to look the unit up in (empty = the default rules)
+	UnitId        int32                  `protobuf:"varint,2,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnitRequest) Reset() {
+	*x = GetUnitRequest{}
+	mi := &file_weewar_v1_rules_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnitRequest) ProtoMessage() {}
+
+func (x *GetUnitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_rules_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnitRequest.ProtoReflect.Descriptor instead.
+func (*GetUnitRequest) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_rules_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetUnitRequest) GetRulesId() string {
+	if x != nil {
+		return x.RulesId
+	}
+	return ""
+}
+
+func (x *GetUnitRequest) GetUnitId() int32 {
+	if x != nil {
+		return x.UnitId
+	}
+	return 0
+}
+
+type GetUnitResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	RulesId string                 `protobuf:"bytes,1,opt,name=rules_id,json=rulesId,proto3" json:"rules_id,omitempty"`
+	Unit    *UnitDefinition        `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	// Cost of entering each terrain (missing terrains cost their base move cost)
+	MovementCosts *TerrainCostMap `protobuf:"bytes,3,opt,name=movement_costs,json=movementCosts,proto3" json:"movement_costs,omitempty"`
+	// Damage the unit deals to each unit it can attack
+	Attacks       *DefenderDamageMap `protobuf:"bytes,4,opt,name=attacks,proto3" json:"attacks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnitResponse) Reset() {
+	*x = GetUnitResponse{}
+	mi := &file_weewar_v1_rules_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnitResponse) ProtoMessage() {}
+
+func (x *GetUnitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_rules_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnitResponse.ProtoReflect.Descriptor instead.
+func (*GetUnitResponse) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_rules_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetUnitResponse) GetRulesId() string {
+	if x != nil {
+		return x.RulesId
+	}
+	return ""
+}
+
+func (x *GetUnitResponse) GetUnit() *UnitDefinition {
+	if x != nil {
+		return x.Unit
+	}
+	return nil
+}
+
+func (x *GetUnitResponse) GetMovementCosts() *TerrainCostMap {
+	if x != nil {
+		return x.MovementCosts
+	}
+	return nil
+}
+
+func (x *GetUnitResponse) GetAttacks() *DefenderDamageMap {
+	if x != nil {
+		return x.Attacks
+	}
+	return nil
+}
+
+type GetCombatTableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RulesId       string                 `protobuf:"bytes,1,opt,name=rules_id,json=rulesId,proto3" json:"rules_id,omitempty"`           // Rules to return the table for (empty = the default rules)
+	AttackerId    int32                  `protobuf:"varint,2,opt,name=attacker_id,json=attackerId,proto3" json:"attacker_id,omitempty"` // Only attacks by this unit type (0 = all attackers)
+	DefenderId    int32                  `protobuf:"varint,3,opt,name=defender_id,json=defenderId,proto3" json:"defender_id,omitempty"` // Only attacks on this unit type (0 = all defenders)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCombatTableRequest) Reset() {
+	*x = GetCombatTableRequest{}
+	mi := &file_weewar_v1_rules_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCombatTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCombatTableRequest) ProtoMessage() {}
+
+func (x *GetCombatTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_rules_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCombatTableRequest.ProtoReflect.Descriptor instead.
+func (*GetCombatTableRequest) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_rules_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCombatTableRequest) GetRulesId() string {
+	if x != nil {
+		return x.RulesId
+	}
+	return ""
+}
+
+func (x *GetCombatTableRequest) GetAttackerId() int32 {
+	if x != nil {
+		return x.AttackerId
+	}
+	return 0
+}
+
+func (x *GetCombatTableRequest) GetDefenderId() int32 {
+	if x != nil {
+		return x.DefenderId
+	}
+	return 0
+}
+
+type GetCombatTableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RulesId       string                 `protobuf:"bytes,1,opt,name=rules_id,json=rulesId,proto3" json:"rules_id,omitempty"`
+	AttackMatrix  *AttackMatrix          `protobuf:"bytes,2,opt,name=attack_matrix,json=attackMatrix,proto3" json:"attack_matrix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCombatTableResponse) Reset() {
+	*x = GetCombatTableResponse{}
+	mi := &file_weewar_v1_rules_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCombatTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCombatTableResponse) ProtoMessage() {}
+
+func (x *GetCombatTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weewar_v1_rules_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCombatTableResponse.ProtoReflect.Descriptor instead.
+func (*GetCombatTableResponse) Descriptor() ([]byte, []int) {
+	return file_weewar_v1_rules_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCombatTableResponse) GetRulesId() string {
+	if x != nil {
+		return x.RulesId
+	}
+	return ""
+}
+
+func (x *GetCombatTableResponse) GetAttackMatrix() *AttackMatrix {
+	if x != nil {
+		return x.AttackMatrix
+	}
+	return nil
+}
+
+var File_weewar_v1_rules_proto protoreflect.FileDescriptor
+
+const file_weewar_v1_rules_proto_rawDesc = "" +
+	"\n" +
+	"\x15weewar/v1/rules.proto\x12\tweewar.v1\x1a\x16weewar/v1/models.proto\x1a\x1cgoogle/api/annotations.proto\",\n" +
+	"\x0fGetRulesRequest\x12\x19\n" +
+	"\brules_id\x18\x01 \x01(\tR\arulesId\"W\n" +
+	"\x10GetRulesResponse\x12\x19\n" +
+	"\brules_id\x18\x01 \x01(\tR\arulesId\x12(\n" +
+	"\x05rules\x18\x02 \x01(\v2\x12.weewar.v1.RuleSetR\x05rules\"D\n" +
+	"\x0eGetUnitRequest\x12\x19\n" +
+	"\brules_id\x18\x01 \x01(\tR\arulesId\x12\x17\n" +
+	"\aunit_id\x18\x02 \x01(\x05R\x06unitId\"\xd5\x01\n" +
+	"\x0fGetUnitResponse\x12\x19\n" +
+	"\brules_id\x18\x01 \x01(\tR\arulesId\x12-\n" +
+	"\x04unit\x18\x02 \x01(\v2\x19.weewar.v1.UnitDefinitionR\x04unit\x12@\n" +
+	"\x0emovement_costs\x18\x03 \x01(\v2\x19.weewar.v1.TerrainCostMapR\rmovementCosts\x126\n" +
+	"\aattacks\x18\x04 \x01(\v2\x1c.weewar.v1.DefenderDamageMapR\aattacks\"t\n" +
+	"\x15GetCombatTableRequest\x12\x19\n" +
+	"\brules_id\x18\x01 \x01(\tR\arulesId\x12\x1f\n" +
+	"\vattacker_id\x18\x02 \x01(\x05R\n" +
+	"attackerId\x12\x1f\n" +
+	"\vdefender_id\x18\x03 \x01(\x05R\n" +
+	"defenderId\"q\n" +
+	"\x16GetCombatTableResponse\x12\x19\n" +
+	"\brules_id\x18\x01 \x01(\tR\arulesId\x12<\n" +
+	"\rattack_matrix\x18\x02 \x01(\v2\x17.weewar.v1.AttackMatrixR\fattackMatrix2\xbc\x02\n" +
+	"\fRulesService\x12V\n" +
+	"\bGetRules\x12\x1a.weewar.v1.GetRulesRequest\x1a\x1b.weewar.v1.GetRulesResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/rules\x12c\n" +
+	"\aGetUnit\x12\x19.weewar.v1.GetUnitRequest\x1a\x1a.weewar.v1.GetUnitResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/rules/units/{unit_id}\x12o\n" +
+	"\x0eGetCombatTable\x12 .weewar.v1.GetCombatTableRequest\x1a!.weewar.v1.GetCombatTableResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/rules/combatB\x9c\x01\n" +
+	"\rcom.weewar.v1B\n" +
+	"RulesProtoP\x01Z:github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1\xa2\x02\x03WXX\xaa\x02\tWeewar.V1\xca\x02\tWeewar\\V1\xe2\x02\x15Weewar\\V1\\GPBMetadata\xea\x02\n" +
+	"Weewar::V1b\x06proto3"
+
+var (
+	file_weewar_v1_rules_proto_rawDescOnce sync.Once
+	file_weewar_v1_rules_proto_rawDescData []byte
+)
+
+func file_weewar_v1_rules_proto_rawDescGZIP() []byte {
+	file_weewar_v1_rules_proto_rawDescOnce.Do(func() {
+		file_weewar_v1_rules_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_weewar_v1_rules_proto_rawDesc), len(file_weewar_v1_rules_proto_rawDesc)))
+	})
+	return file_weewar_v1_rules_proto_rawDescData
+}
+
+var file_weewar_v1_rules_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_weewar_v1_rules_proto_goTypes = []any{
+	(*GetRulesRequest)(nil),        // 0: weewar.v1.GetRulesRequest
+	(*GetRulesResponse)(nil),       // 1: weewar.v1.GetRulesResponse
+	(*GetUnitRequest)(nil),         // 2: weewar.v1.GetUnitRequest
+	(*GetUnitResponse)(nil),        // 3: weewar.v1.GetUnitResponse
+	(*GetCombatTableRequest)(nil),  // 4: weewar.v1.GetCombatTableRequest
+	(*GetCombatTableResponse)(nil), // 5: weewar.v1.GetCombatTableResponse
+	(*RuleSet)(nil),                // 6: weewar.v1.RuleSet
+	(*UnitDefinition)(nil),         // 7: weewar.v1.UnitDefinition
+	(*TerrainCostMap)(nil),         // 8: weewar.v1.TerrainCostMap
+	(*DefenderDamageMap)(nil),      // 9: weewar.v1.DefenderDamageMap
+	(*AttackMatrix)(nil),           // 10: weewar.v1.AttackMatrix
+}
+var file_weewar_v1_rules_proto_depIdxs = []int32{
+	6,  // 0: weewar.v1.GetRulesResponse.rules:type_name -> weewar.v1.RuleSet
+	7,  // 1: weewar.v1.GetUnitResponse.unit:type_name -> weewar.v1.UnitDefinition
+	8,  // 2: weewar.v1.GetUnitResponse.movement_costs:type_name -> weewar.v1.TerrainCostMap
+	9,  // 3: weewar.v1.GetUnitResponse.attacks:type_name -> weewar.v1.DefenderDamageMap
+	10, // 4: weewar.v1.GetCombatTableResponse.attack_matrix:type_name -> weewar.v1.AttackMatrix
+	0,  // 5: weewar.v1.RulesService.GetRules:input_type -> weewar.v1.GetRulesRequest
+	2,  // 6: weewar.v1.RulesService.GetUnit:input_type -> weewar.v1.GetUnitRequest
+	4,  // 7: weewar.v1.RulesService.GetCombatTable:input_type -> weewar.v1.GetCombatTableRequest
+	1,  // 8: weewar.v1.RulesService.GetRules:output_type -> weewar.v1.GetRulesResponse
+	3,  // 9: weewar.v1.RulesService.GetUnit:output_type -> weewar.v1.GetUnitResponse
+	5,  // 10: weewar.v1.RulesService.GetCombatTable:output_type -> weewar.v1.GetCombatTableResponse
+	8,  // [8:11] is the sub-list for method output_type
+	5,  // [5:8] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_weewar_v1_rules_proto_init() }
+func file_weewar_v1_rules_proto_init() {
+	if File_weewar_v1_rules_proto != nil {
+		return
+	}
+	file_weewar_v1_models_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_weewar_v1_rules_proto_rawDesc), len(file_weewar_v1_rules_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_weewar_v1_rules_proto_goTypes,
+		DependencyIndexes: file_weewar_v1_rules_proto_depIdxs,
+		MessageInfos:      file_weewar_v1_rules_proto_msgTypes,
+	}.Build()
+	File_weewar_v1_rules_proto = out.File
+	file_weewar_v1_rules_proto_goTypes = nil
+	file_weewar_v1_rules_proto_depIdxs = nil
+}
@@ -56,6 +56,15 @@ const (
 	// GamesServiceGetOptionsAtProcedure is the fully-qualified name of the GamesService's GetOptionsAt
 	// RPC.
 	GamesServiceGetOptionsAtProcedure = "/weewar.v1.GamesService/GetOptionsAt"
+	// GamesServiceGetPathProcedure is the fully-qualified name of the GamesService's GetPath RPC.
+	GamesServiceGetPathProcedure = "/weewar.v1.GamesService/GetPath"
+	// GamesServiceVerifyGameProcedure is the fully-qualified name of the GamesService's VerifyGame RPC.
+	GamesServiceVerifyGameProcedure = "/weewar.v1.GamesService/VerifyGame"
+	// GamesServiceUndoMovesProcedure is the fully-qualified name of the GamesService's UndoMoves RPC.
+	GamesServiceUndoMovesProcedure = "/weewar.v1.GamesService/UndoMoves"
+	// GamesServiceSubscribeGameProcedure is the fully-qualified name of the GamesService's
+	// SubscribeGame RPC.
+	GamesServiceSubscribeGameProcedure = "/weewar.v1.GamesService/SubscribeGame"
 )
 
 // GamesServiceClient is a client for the weewar.v1.GamesService service.
@@ -68,7 +77,7 @@ type GamesServiceClient interface {
 	GetGames(context.Context, *connect.Request[v1.GetGamesRequest]) (*connect.Response[v1.GetGamesResponse], error)
 	// ListGames returns all available games
 	ListGames(context.Context, *connect.Request[v1.ListGamesRequest]) (*connect.Response[v1.ListGamesResponse], error)
-	// GetGame returns a specific game with metadata
+	// GetGame returns a specific game with metadata, its state and history
 	GetGame(context.Context, *connect.Request[v1.GetGameRequest]) (*connect.Response[v1.GetGameResponse], error)
 	// *
 	// Delete a particular game
@@ -81,6 +90,17 @@ type GamesServiceClient interface {
 	ListMoves(context.Context, *connect.Request[v1.ListMovesRequest]) (*connect.Response[v1.ListMovesResponse], error)
 	ProcessMoves(context.Context, *connect.Request[v1.ProcessMovesRequest]) (*connect.Response[v1.ProcessMovesResponse], error)
 	GetOptionsAt(context.Context, *connect.Request[v1.GetOptionsAtRequest]) (*connect.Response[v1.GetOptionsAtResponse], error)
+	// Returns the cheapest path for the unit at (from_q, from_r) to reach (to_q, to_r)
+	// along with the cost of each step
+	GetPath(context.Context, *connect.Request[v1.GetPathRequest]) (*connect.Response[v1.GetPathResponse], error)
+	// Admin: Replays a game's move history from its initial state and reports the
+	// first place where recomputed changes differ from the recorded ones
+	VerifyGame(context.Context, *connect.Request[v1.VerifyGameRequest]) (*connect.Response[v1.VerifyGameResponse], error)
+	// Undo the latest non-permanent move groups in the current turn
+	UndoMoves(context.Context, *connect.Request[v1.UndoMovesRequest]) (*connect.Response[v1.UndoMovesResponse], error)
+	// Streams move groups as they are committed to a game.  Groups already in the
+	// history after from_sequence_num are sent first so clients can resume.
+	SubscribeGame(context.Context, *connect.Request[v1.SubscribeGameRequest]) (*connect.ServerStreamForClient[v1.SubscribeGameResponse], error)
 }
 
 // NewGamesServiceClient constructs a client for the weewar.v1.GamesService service. By default, it
@@ -154,21 +174,49 @@ func NewGamesServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(gamesServiceMethods.ByName("GetOptionsAt")),
 			connect.WithClientOptions(opts...),
 		),
+		getPath: connect.NewClient[v1.GetPathRequest, v1.GetPathResponse](
+			httpClient,
+			baseURL+GamesServiceGetPathProcedure,
+			connect.WithSchema(gamesServiceMethods.ByName("GetPath")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyGame: connect.NewClient[v1.VerifyGameRequest, v1.VerifyGameResponse](
+			httpClient,
+			baseURL+GamesServiceVerifyGameProcedure,
+			connect.WithSchema(gamesServiceMethods.ByName("VerifyGame")),
+			connect.WithClientOptions(opts...),
+		),
+		undoMoves: connect.NewClient[v1.UndoMovesRequest, v1.UndoMovesResponse](
+			httpClient,
+			baseURL+GamesServiceUndoMovesProcedure,
+			connect.WithSchema(gamesServiceMethods.ByName("UndoMoves")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeGame: connect.NewClient[v1.SubscribeGameRequest, v1.SubscribeGameResponse](
+			httpClient,
+			baseURL+GamesServiceSubscribeGameProcedure,
+			connect.WithSchema(gamesServiceMethods.ByName("SubscribeGame")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // gamesServiceClient implements GamesServiceClient.
 type gamesServiceClient struct {
-	createGame   *connect.Client[v1.CreateGameRequest, v1.CreateGameResponse]
-	getGames     *connect.Client[v1.GetGamesRequest, v1.GetGamesResponse]
-	listGames    *connect.Client[v1.ListGamesRequest, v1.ListGamesResponse]
-	getGame      *connect.Client[v1.GetGameRequest, v1.GetGameResponse]
-	deleteGame   *connect.Client[v1.DeleteGameRequest, v1.DeleteGameResponse]
-	updateGame   *connect.Client[v1.UpdateGameRequest, v1.UpdateGameResponse]
-	getGameState *connect.Client[v1.GetGameStateRequest, v1.GetGameStateResponse]
-	listMoves    *connect.Client[v1.ListMovesRequest, v1.ListMovesResponse]
-	processMoves *connect.Client[v1.ProcessMovesRequest, v1.ProcessMovesResponse]
-	getOptionsAt *connect.Client[v1.GetOptionsAtRequest, v1.GetOptionsAtResponse]
+	createGame    *connect.Client[v1.CreateGameRequest, v1.CreateGameResponse]
+	getGames      *connect.Client[v1.GetGamesRequest, v1.GetGamesResponse]
+	listGames     *connect.Client[v1.ListGamesRequest, v1.ListGamesResponse]
+	getGame       *connect.Client[v1.GetGameRequest, v1.GetGameResponse]
+	deleteGame    *connect.Client[v1.DeleteGameRequest, v1.DeleteGameResponse]
+	updateGame    *connect.Client[v1.UpdateGameRequest, v1.UpdateGameResponse]
+	getGameState  *connect.Client[v1.GetGameStateRequest, v1.GetGameStateResponse]
+	listMoves     *connect.Client[v1.ListMovesRequest, v1.ListMovesResponse]
+	processMoves  *connect.Client[v1.ProcessMovesRequest, v1.ProcessMovesResponse]
+	getOptionsAt  *connect.Client[v1.GetOptionsAtRequest, v1.GetOptionsAtResponse]
+	getPath       *connect.Client[v1.GetPathRequest, v1.GetPathResponse]
+	verifyGame    *connect.Client[v1.VerifyGameRequest, v1.VerifyGameResponse]
+	undoMoves     *connect.Client[v1.UndoMovesRequest, v1.UndoMovesResponse]
+	subscribeGame *connect.Client[v1.SubscribeGameRequest, v1.SubscribeGameResponse]
 }
 
 // CreateGame calls weewar.v1.GamesService.CreateGame.
@@ -221,6 +269,26 @@ func (c *gamesServiceClient) GetOptionsAt(ctx context.Context, req *connect.Requ
 	return c.getOptionsAt.CallUnary(ctx, req)
 }
 
+// GetPath calls weewar.v1.GamesService.GetPath.
+func (c *gamesServiceClient) GetPath(ctx context.Context, req *connect.Request[v1.GetPathRequest]) (*connect.Response[v1.GetPathResponse], error) {
+	return c.getPath.CallUnary(ctx, req)
+}
+
+// VerifyGame calls weewar.v1.GamesService.VerifyGame.
+func (c *gamesServiceClient) VerifyGame(ctx context.Context, req *connect.Request[v1.VerifyGameRequest]) (*connect.Response[v1.VerifyGameResponse], error) {
+	return c.verifyGame.CallUnary(ctx, req)
+}
+
+// UndoMoves calls weewar.v1.GamesService.UndoMoves.
+func (c *gamesServiceClient) UndoMoves(ctx context.Context, req *connect.Request[v1.UndoMovesRequest]) (*connect.Response[v1.UndoMovesResponse], error) {
+	return c.undoMoves.CallUnary(ctx, req)
+}
+
+// SubscribeGame calls weewar.v1.GamesService.SubscribeGame.
+func (c *gamesServiceClient) SubscribeGame(ctx context.Context, req *connect.Request[v1.SubscribeGameRequest]) (*connect.ServerStreamForClient[v1.SubscribeGameResponse], error) {
+	return c.subscribeGame.CallServerStream(ctx, req)
+}
+
 // GamesServiceHandler is an implementation of the weewar.v1.GamesService service.
 type GamesServiceHandler interface {
 	// *
@@ -231,7 +299,7 @@ type GamesServiceHandler interface {
 	GetGames(context.Context, *connect.Request[v1.GetGamesRequest]) (*connect.Response[v1.GetGamesResponse], error)
 	// ListGames returns all available games
 	ListGames(context.Context, *connect.Request[v1.ListGamesRequest]) (*connect.Response[v1.ListGamesResponse], error)
-	// GetGame returns a specific game with metadata
+	// GetGame returns a specific game with metadata, its state and history
 	GetGame(context.Context, *connect.Request[v1.GetGameRequest]) (*connect.Response[v1.GetGameResponse], error)
 	// *
 	// Delete a particular game
@@ -244,6 +312,17 @@ type GamesServiceHandler interface {
 	ListMoves(context.Context, *connect.Request[v1.ListMovesRequest]) (*connect.Response[v1.ListMovesResponse], error)
 	ProcessMoves(context.Context, *connect.Request[v1.ProcessMovesRequest]) (*connect.Response[v1.ProcessMovesResponse], error)
 	GetOptionsAt(context.Context, *connect.Request[v1.GetOptionsAtRequest]) (*connect.Response[v1.GetOptionsAtResponse], error)
+	// Returns the cheapest path for the unit at (from_q, from_r) to reach (to_q, to_r)
+	// along with the cost of each step
+	GetPath(context.Context, *connect.Request[v1.GetPathRequest]) (*connect.Response[v1.GetPathResponse], error)
+	// Admin: Replays a game's move history from its initial state and reports the
+	// first place where recomputed changes differ from the recorded ones
+	VerifyGame(context.Context, *connect.Request[v1.VerifyGameRequest]) (*connect.Response[v1.VerifyGameResponse], error)
+	// Undo the latest non-permanent move groups in the current turn
+	UndoMoves(context.Context, *connect.Request[v1.UndoMovesRequest]) (*connect.Response[v1.UndoMovesResponse], error)
+	// Streams move groups as they are committed to a game.  Groups already in the
+	// history after from_sequence_num are sent first so clients can resume.
+	SubscribeGame(context.Context, *connect.Request[v1.SubscribeGameRequest], *connect.ServerStream[v1.SubscribeGameResponse]) error
 }
 
 // NewGamesServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -313,6 +392,30 @@ func NewGamesServiceHandler(svc GamesServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(gamesServiceMethods.ByName("GetOptionsAt")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gamesServiceGetPathHandler := connect.NewUnaryHandler(
+		GamesServiceGetPathProcedure,
+		svc.GetPath,
+		connect.WithSchema(gamesServiceMethods.ByName("GetPath")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gamesServiceVerifyGameHandler := connect.NewUnaryHandler(
+		GamesServiceVerifyGameProcedure,
+		svc.VerifyGame,
+		connect.WithSchema(gamesServiceMethods.ByName("VerifyGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gamesServiceUndoMovesHandler := connect.NewUnaryHandler(
+		GamesServiceUndoMovesProcedure,
+		svc.UndoMoves,
+		connect.WithSchema(gamesServiceMethods.ByName("UndoMoves")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gamesServiceSubscribeGameHandler := connect.NewServerStreamHandler(
+		GamesServiceSubscribeGameProcedure,
+		svc.SubscribeGame,
+		connect.WithSchema(gamesServiceMethods.ByName("SubscribeGame")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/weewar.v1.GamesService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case GamesServiceCreateGameProcedure:
@@ -335,6 +438,14 @@ func NewGamesServiceHandler(svc GamesServiceHandler, opts ...connect.HandlerOpti
 			gamesServiceProcessMovesHandler.ServeHTTP(w, r)
 		case GamesServiceGetOptionsAtProcedure:
 			gamesServiceGetOptionsAtHandler.ServeHTTP(w, r)
+		case GamesServiceGetPathProcedure:
+			gamesServiceGetPathHandler.ServeHTTP(w, r)
+		case GamesServiceVerifyGameProcedure:
+			gamesServiceVerifyGameHandler.ServeHTTP(w, r)
+		case GamesServiceUndoMovesProcedure:
+			gamesServiceUndoMovesHandler.ServeHTTP(w, r)
+		case GamesServiceSubscribeGameProcedure:
+			gamesServiceSubscribeGameHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -383,3 +494,19 @@ func (UnimplementedGamesServiceHandler) ProcessMoves(context.Context, *connect.R
 func (UnimplementedGamesServiceHandler) GetOptionsAt(context.Context, *connect.Request[v1.GetOptionsAtRequest]) (*connect.Response[v1.GetOptionsAtResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("weewar.v1.GamesService.GetOptionsAt is not implemented"))
 }
+
+func (UnimplementedGamesServiceHandler) GetPath(context.Context, *connect.Request[v1.GetPathRequest]) (*connect.Response[v1.GetPathResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("weewar.v1.GamesService.GetPath is not implemented"))
+}
+
+func (UnimplementedGamesServiceHandler) VerifyGame(context.Context, *connect.Request[v1.VerifyGameRequest]) (*connect.Response[v1.VerifyGameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("weewar.v1.GamesService.VerifyGame is not implemented"))
+}
+
+func (UnimplementedGamesServiceHandler) UndoMoves(context.Context, *connect.Request[v1.UndoMovesRequest]) (*connect.Response[v1.UndoMovesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("weewar.v1.GamesService.UndoMoves is not implemented"))
+}
+
+func (UnimplementedGamesServiceHandler) SubscribeGame(context.Context, *connect.Request[v1.SubscribeGameRequest], *connect.ServerStream[v1.SubscribeGameResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("weewar.v1.GamesService.SubscribeGame is not implemented"))
+}
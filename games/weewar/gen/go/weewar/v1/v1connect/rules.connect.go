@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: weewar/v1/rules.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// RulesServiceName is the fully-qualified name of the RulesService service.
+	RulesServiceName = "weewar.v1.RulesService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// RulesServiceGetRulesProcedure is the fully-qualified name of the RulesService's GetRules RPC.
+	RulesServiceGetRulesProcedure = "/weewar.v1.RulesService/GetRules"
+	// RulesServiceGetUnitProcedure is the fully-qualified name of the RulesService's GetUnit RPC.
+	RulesServiceGetUnitProcedure = "/weewar.v1.RulesService/GetUnit"
+	// RulesServiceGetCombatTableProcedure is the fully-qualified name of the RulesService's
+	// GetCombatTable RPC.
+	RulesServiceGetCombatTableProcedure = "/weewar.v1.RulesService/GetCombatTable"
+)
+
+// RulesServiceClient is a client for the weewar.v1.RulesService service.
+type RulesServiceClient interface {
+	// GetRules returns a complete rule set
+	GetRules(context.Context, *connect.Request[v1.GetRulesRequest]) (*connect.Response[v1.GetRulesResponse], error)
+	// GetUnit returns a unit's definition with its movement costs and attacks
+	GetUnit(context.Context, *connect.Request[v1.GetUnitRequest]) (*connect.Response[v1.GetUnitResponse], error)
+	// GetCombatTable returns the attack matrix, optionally for a single attacker or defender
+	GetCombatTable(context.Context, *connect.Request[v1.GetCombatTableRequest]) (*connect.Response[v1.GetCombatTableResponse], error)
+}
+
+// NewRulesServiceClient constructs a client for the weewar.v1.RulesService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRulesServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RulesServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	rulesServiceMethods := v1.File_weewar_v1_rules_proto.Services().ByName("RulesService").Methods()
+	return &rulesServiceClient{
+		getRules: connect.NewClient[v1.GetRulesRequest, v1.GetRulesResponse](
+			httpClient,
+			baseURL+RulesServiceGetRulesProcedure,
+			connect.WithSchema(rulesServiceMethods.ByName("GetRules")),
+			connect.WithClientOptions(opts...),
+		),
+		getUnit: connect.NewClient[v1.GetUnitRequest, v1.GetUnitResponse](
+			httpClient,
+			baseURL+RulesServiceGetUnitProcedure,
+			connect.WithSchema(rulesServiceMethods.ByName("GetUnit")),
+			connect.WithClientOptions(opts...),
+		),
+		getCombatTable: connect.NewClient[v1.GetCombatTableRequest, v1.GetCombatTableResponse](
+			httpClient,
+			baseURL+RulesServiceGetCombatTableProcedure,
+			connect.WithSchema(rulesServiceMethods.ByName("GetCombatTable")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// rulesServiceClient implements RulesServiceClient.
+type rulesServiceClient struct {
+	getRules       *connect.Client[v1.GetRulesRequest, v1.GetRulesResponse]
+	getUnit        *connect.Client[v1.GetUnitRequest, v1.GetUnitResponse]
+	getCombatTable *connect.Client[v1.GetCombatTableRequest, v1.GetCombatTableResponse]
+}
+
+// GetRules calls weewar.v1.RulesService.GetRules.
+func (c *rulesServiceClient) GetRules(ctx context.Context, req *connect.Request[v1.GetRulesRequest]) (*connect.Response[v1.GetRulesResponse], error) {
+	return c.getRules.CallUnary(ctx, req)
+}
+
+// GetUnit calls weewar.v1.RulesService.GetUnit.
+func (c *rulesServiceClient) GetUnit(ctx context.Context, req *connect.Request[v1.GetUnitRequest]) (*connect.Response[v1.GetUnitResponse], error) {
+	return c.getUnit.CallUnary(ctx, req)
+}
+
+// GetCombatTable calls weewar.v1.RulesService.GetCombatTable.
+func (c *rulesServiceClient) GetCombatTable(ctx context.Context, req *connect.Request[v1.GetCombatTableRequest]) (*connect.Response[v1.GetCombatTableResponse], error) {
+	return c.getCombatTable.CallUnary(ctx, req)
+}
+
+// RulesServiceHandler is an implementation of the weewar.v1.RulesService service.
+type RulesServiceHandler interface {
+	// GetRules returns a complete rule set
+	GetRules(context.Context, *connect.Request[v1.GetRulesRequest]) (*connect.Response[v1.GetRulesResponse], error)
+	// GetUnit returns a unit's definition with its movement costs and attacks
+	GetUnit(context.Context, *connect.Request[v1.GetUnitRequest]) (*connect.Response[v1.GetUnitResponse], error)
+	// GetCombatTable returns the attack matrix, optionally for a single attacker or defender
+	GetCombatTable(context.Context, *connect.Request[v1.GetCombatTableRequest]) (*connect.Response[v1.GetCombatTableResponse], error)
+}
+
+// NewRulesServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRulesServiceHandler(svc RulesServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	rulesServiceMethods := v1.File_weewar_v1_rules_proto.Services().ByName("RulesService").Methods()
+	rulesServiceGetRulesHandler := connect.NewUnaryHandler(
+		RulesServiceGetRulesProcedure,
+		svc.GetRules,
+		connect.WithSchema(rulesServiceMethods.ByName("GetRules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	rulesServiceGetUnitHandler := connect.NewUnaryHandler(
+		RulesServiceGetUnitProcedure,
+		svc.GetUnit,
+		connect.WithSchema(rulesServiceMethods.ByName("GetUnit")),
+		connect.WithHandlerOptions(opts...),
+	)
+	rulesServiceGetCombatTableHandler := connect.NewUnaryHandler(
+		RulesServiceGetCombatTableProcedure,
+		svc.GetCombatTable,
+		connect.WithSchema(rulesServiceMethods.ByName("GetCombatTable")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/weewar.v1.RulesService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RulesServiceGetRulesProcedure:
+			rulesServiceGetRulesHandler.ServeHTTP(w, r)
+		case RulesServiceGetUnitProcedure:
+			rulesServiceGetUnitHandler.ServeHTTP(w, r)
+		case RulesServiceGetCombatTableProcedure:
+			rulesServiceGetCombatTableHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRulesServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRulesServiceHandler struct{}
+
+func (UnimplementedRulesServiceHandler) GetRules(context.Context, *connect.Request[v1.GetRulesRequest]) (*connect.Response[v1.GetRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("weewar.v1.RulesService.GetRules is not implemented"))
+}
+
+func (UnimplementedRulesServiceHandler) GetUnit(context.Context, *connect.Request[v1.GetUnitRequest]) (*connect.Response[v1.GetUnitResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("weewar.v1.RulesService.GetUnit is not implemented"))
+}
+
+func (UnimplementedRulesServiceHandler) GetCombatTable(context.Context, *connect.Request[v1.GetCombatTableRequest]) (*connect.Response[v1.GetCombatTableResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("weewar.v1.RulesService.GetCombatTable is not implemented"))
+}
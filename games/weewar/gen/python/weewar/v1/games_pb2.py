@@ -29,7 +29,7 @@ from google.api import annotations_pb2 as google_dot_api_dot_annotations__pb2
 from protoc_gen_openapiv2.options import annotations_pb2 as protoc__gen__openapiv2_dot_options_dot_annotations__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x15weewar/v1/games.proto\x12\tweewar.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x16weewar/v1/models.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xd7\x01\n\x08GameInfo\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12 \n\x0b\x64\x65scription\x18\x03 \x01(\tR\x0b\x64\x65scription\x12\x1a\n\x08\x63\x61tegory\x18\x04 \x01(\tR\x08\x63\x61tegory\x12\x1e\n\ndifficulty\x18\x05 \x01(\tR\ndifficulty\x12\x12\n\x04tags\x18\x06 \x03(\tR\x04tags\x12\x12\n\x04icon\x18\x07 \x01(\tR\x04icon\x12!\n\x0clast_updated\x18\x08 \x01(\tR\x0blastUpdated\"d\n\x10ListGamesRequest\x12\x35\n\npagination\x18\x01 \x01(\x0b\x32\x15.weewar.v1.PaginationR\npagination\x12\x19\n\x08owner_id\x18\x02 \x01(\tR\x07ownerId\"y\n\x11ListGamesResponse\x12%\n\x05items\x18\x01 \x03(\x0b\x32\x0f.weewar.v1.GameR\x05items\x12=\n\npagination\x18\x02 \x01(\x0b\x32\x1d.weewar.v1.PaginationResponseR\npagination\":\n\x0eGetGameRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n\x07version\x18\x02 \x01(\tR\x07version\"\x98\x01\n\x0fGetGameResponse\x12#\n\x04game\x18\x01 \x01(\x0b\x32\x0f.weewar.v1.GameR\x04game\x12*\n\x05state\x18\x02 \x01(\x0b\x32\x14.weewar.v1.GameStateR\x05state\x12\x34\n\x07history\x18\x03 \x01(\x0b\x32\x1a.weewar.v1.GameMoveHistoryR\x07history\"A\n\x15GetGameContentRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n\x07version\x18\x02 \x01(\tR\x07version\"\x8d\x01\n\x16GetGameContentResponse\x12%\n\x0eweewar_content\x18\x01 \x01(\tR\rweewarContent\x12%\n\x0erecipe_content\x18\x02 \x01(\tR\rrecipeContent\x12%\n\x0ereadme_content\x18\x03 \x01(\tR\rreadmeContent\"\x9f\x02\n\x11UpdateGameRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12*\n\x08new_game\x18\x02 \x01(\x0b\x32\x0f.weewar.v1.GameR\x07newGame\x12\x31\n\tnew_state\x18\x03 \x01(\x0b\x32\x14.weewar.v1.GameStateR\x08newState\x12;\n\x0bnew_history\x18\x04 \x01(\x0b\x32\x1a.weewar.v1.GameMoveHistoryR\nnewHistory\x12;\n\x0bupdate_mask\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.FieldMaskR\nupdateMask:\x18\x92\x41\x15\n\x13*\x11UpdateGameRequest\"T\n\x12UpdateGameResponse\x12#\n\x04game\x18\x01 \x01(\x0b\x32\x0f.weewar.v1.GameR\x04game:\x19\x92\x41\x16\n\x14*\x12UpdateGameResponse\"#\n\x11\x44\x65leteGameRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\"\x14\n\x12\x44\x65leteGameResponse\"#\n\x0fGetGamesRequest\x12\x10\n\x03ids\x18\x01 \x03(\tR\x03ids\"\x9b\x01\n\x10GetGamesResponse\x12<\n\x05games\x18\x01 \x03(\x0b\x32&.weewar.v1.GetGamesResponse.GamesEntryR\x05games\x1aI\n\nGamesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12%\n\x05value\x18\x02 \x01(\x0b\x32\x0f.weewar.v1.GameR\x05value:\x02\x38\x01\"8\n\x11\x43reateGameRequest\x12#\n\x04game\x18\x01 \x01(\x0b\x32\x0f.weewar.v1.GameR\x04game\"\x81\x02\n\x12\x43reateGameResponse\x12#\n\x04game\x18\x01 \x01(\x0b\x32\x0f.weewar.v1.GameR\x04game\x12\x33\n\ngame_state\x18\x02 \x01(\x0b\x32\x14.weewar.v1.GameStateR\tgameState\x12Q\n\x0c\x66ield_errors\x18\x03 \x03(\x0b\x32..weewar.v1.CreateGameResponse.FieldErrorsEntryR\x0b\x66ieldErrors\x1a>\n\x10\x46ieldErrorsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\"Y\n\x13ProcessMovesRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12)\n\x05moves\x18\x03 \x03(\x0b\x32\x13.weewar.v1.GameMoveR\x05moves\"\x86\x01\n\x14ProcessMovesResponse\x12<\n\x0cmove_results\x18\x01 \x03(\x0b\x32\x19.weewar.v1.GameMoveResultR\x0bmoveResults\x12\x30\n\x07\x63hanges\x18\x02 \x03(\x0b\x32\x16.weewar.v1.WorldChangeR\x07\x63hanges\".\n\x13GetGameStateRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\"B\n\x14GetGameStateResponse\x12*\n\x05state\x18\x01 \x01(\x0b\x32\x14.weewar.v1.GameStateR\x05state\"Z\n\x10ListMovesRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x16\n\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x15\n\x06last_n\x18\x03 \x01(\x05R\x05lastN\"i\n\x11ListMovesResponse\x12\x19\n\x08has_more\x18\x01 \x01(\x08R\x07hasMore\x12\x39\n\x0bmove_groups\x18\x02 \x03(\x0b\x32\x18.weewar.v1.GameMoveGroupR\nmoveGroups\"J\n\x13GetOptionsAtRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x0c\n\x01q\x18\x02 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x03 \x01(\x05R\x01r\"\x99\x01\n\x14GetOptionsAtResponse\x12/\n\x07options\x18\x01 \x03(\x0b\x32\x15.weewar.v1.GameOptionR\x07options\x12%\n\x0e\x63urrent_player\x18\x02 \x01(\x05R\rcurrentPlayer\x12)\n\x10game_initialized\x18\x03 \x01(\x08R\x0fgameInitialized\"\xa4\x02\n\nGameOption\x12+\n\x04move\x18\x01 \x01(\x0b\x32\x15.weewar.v1.MoveOptionH\x00R\x04move\x12\x31\n\x06\x61ttack\x18\x02 \x01(\x0b\x32\x17.weewar.v1.AttackOptionH\x00R\x06\x61ttack\x12\x35\n\x08\x65nd_turn\x18\x03 \x01(\x0b\x32\x18.weewar.v1.EndTurnOptionH\x00R\x07\x65ndTurn\x12\x32\n\x05\x62uild\x18\x04 \x01(\x0b\x32\x1a.weewar.v1.BuildUnitOptionH\x00R\x05\x62uild\x12<\n\x07\x63\x61pture\x18\x05 \x01(\x0b\x32 .weewar.v1.CaptureBuildingOptionH\x00R\x07\x63\x61ptureB\r\n\x0boption_type\"\x0f\n\rEndTurnOption\"\x80\x01\n\nMoveOption\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12#\n\rmovement_cost\x18\x03 \x01(\x05R\x0cmovementCost\x12\x31\n\x06\x61\x63tion\x18\x04 \x01(\x0b\x32\x19.weewar.v1.MoveUnitActionR\x06\x61\x63tion\"\xff\x01\n\x0c\x41ttackOption\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12(\n\x10target_unit_type\x18\x03 \x01(\x05R\x0etargetUnitType\x12,\n\x12target_unit_health\x18\x04 \x01(\x05R\x10targetUnitHealth\x12\x1d\n\ncan_attack\x18\x05 \x01(\x08R\tcanAttack\x12\'\n\x0f\x64\x61mage_estimate\x18\x06 \x01(\x05R\x0e\x64\x61mageEstimate\x12\x33\n\x06\x61\x63tion\x18\x07 \x01(\x0b\x32\x1b.weewar.v1.AttackUnitActionR\x06\x61\x63tion\"i\n\x0f\x42uildUnitOption\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n\ttile_type\x18\x03 \x01(\x05R\x08tileType\x12\x1d\n\nbuild_cost\x18\x04 \x01(\x05R\tbuildCost\"P\n\x15\x43\x61ptureBuildingOption\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n\ttile_type\x18\x03 \x01(\x05R\x08tileType2\xab\x08\n\x0cGamesService\x12_\n\nCreateGame\x12\x1c.weewar.v1.CreateGameRequest\x1a\x1d.weewar.v1.CreateGameResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\"\t/v1/games:\x01*\x12_\n\x08GetGames\x12\x1a.weewar.v1.GetGamesRequest\x1a\x1b.weewar.v1.GetGamesResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/games:batchGet\x12Y\n\tListGames\x12\x1b.weewar.v1.ListGamesRequest\x1a\x1c.weewar.v1.ListGamesResponse\"\x11\x82\xd3\xe4\x93\x02\x0b\x12\t/v1/games\x12X\n\x07GetGame\x12\x19.weewar.v1.GetGameRequest\x1a\x1a.weewar.v1.GetGameResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/games/{id}\x12\x63\n\nDeleteGame\x12\x1c.weewar.v1.DeleteGameRequest\x1a\x1d.weewar.v1.DeleteGameResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/games/{id=*}\x12k\n\nUpdateGame\x12\x1c.weewar.v1.UpdateGameRequest\x1a\x1d.weewar.v1.UpdateGameResponse\" \x82\xd3\xe4\x93\x02\x1a\x32\x15/v1/games/{game_id=*}:\x01*\x12r\n\x0cGetGameState\x12\x1e.weewar.v1.GetGameStateRequest\x1a\x1f.weewar.v1.GetGameStateResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/games/{game_id}/state\x12i\n\tListMoves\x12\x1b.weewar.v1.ListMovesRequest\x1a\x1c.weewar.v1.ListMovesResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/games/{game_id}/moves\x12u\n\x0cProcessMoves\x12\x1e.weewar.v1.ProcessMovesRequest\x1a\x1f.weewar.v1.ProcessMovesResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x19/v1/games/{game_id}/moves:\x01*\x12|\n\x0cGetOptionsAt\x12\x1e.weewar.v1.GetOptionsAtRequest\x1a\x1f.weewar.v1.GetOptionsAtResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/games/{game_id}/options/{q}/{r}B\x9c\x01\n\rcom.weewar.v1B\nGamesProtoP\x01Z:github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1\xa2\x02\x03WXX\xaa\x02\tWeewar.V1\xca\x02\tWeewar\\V1\xe2\x02\x15Weewar\\V1\\GPBMetadata\xea\x02\nWeewar::V1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x15weewar/v1/games.proto\x12\tweewar.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x16weewar/v1/models.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xd7\x01\n\x08GameInfo\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12 \n\x0b\x64\x65scription\x18\x03 \x01(\tR\x0b\x64\x65scription\x12\x1a\n\x08\x63\x61tegory\x18\x04 \x01(\tR\x08\x63\x61tegory\x12\x1e\n\ndifficulty\x18\x05 \x01(\tR\ndifficulty\x12\x12\n\x04tags\x18\x06 \x03(\tR\x04tags\x12\x12\n\x04icon\x18\x07 \x01(\tR\x04icon\x12!\n\x0clast_updated\x18\x08 \x01(\tR\x0blastUpdated\"d\n\x10ListGamesRequest\x12\x35\n\npagination\x18\x01 \x01(\x0b\x32\x15.weewar.v1.PaginationR\npagination\x12\x19\n\x08owner_id\x18\x02 \x01(\tR\x07ownerId\"y\n\x11ListGamesResponse\x12%\n\x05items\x18\x01 \x03(\x0b\x32\x0f.weewar.v1.GameR\x05items\x12=\n\npagination\x18\x02 \x01(\x0b\x32\x1d.weewar.v1.PaginationResponseR\npagination\"R\n\x0eGetGameRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n\x07version\x18\x02 \x01(\tR\x07version\x12\x16\n\x06player\x18\x03 \x01(\x05R\x06player\"\x98\x01\n\x0fGetGameResponse\x12#\n\x04game\x18\x01 \x01(\x0b\x32\x0f.weewar.v1.GameR\x04game\x12*\n\x05state\x18\x02 \x01(\x0b\x32\x14.weewar.v1.GameStateR\x05state\x12\x34\n\x07history\x18\x03 \x01(\x0b\x32\x1a.weewar.v1.GameMoveHistoryR\x07history\"A\n\x15GetGameContentRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n\x07version\x18\x02 \x01(\tR\x07version\"\x8d\x01\n\x16GetGameContentResponse\x12%\n\x0eweewar_content\x18\x01 \x01(\tR\rweewarContent\x12%\n\x0erecipe_content\x18\x02 \x01(\tR\rrecipeContent\x12%\n\x0ereadme_content\x18\x03 \x01(\tR\rreadmeContent\"\x9f\x02\n\x11UpdateGameRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12*\n\x08new_game\x18\x02 \x01(\x0b\x32\x0f.weewar.v1.GameR\x07newGame\x12\x31\n\tnew_state\x18\x03 \x01(\x0b\x32\x14.weewar.v1.GameStateR\x08newState\x12;\n\x0bnew_history\x18\x04 \x01(\x0b\x32\x1a.weewar.v1.GameMoveHistoryR\nnewHistory\x12;\n\x0bupdate_mask\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.FieldMaskR\nupdateMask:\x18\x92\x41\x15\n\x13*\x11UpdateGameRequest\"T\n\x12UpdateGameResponse\x12#\n\x04game\x18\x01 \x01(\x0b\x32\x0f.weewar.v1.GameR\x04game:\x19\x92\x41\x16\n\x14*\x12UpdateGameResponse\"#\n\x11\x44\x65leteGameRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\"\x14\n\x12\x44\x65leteGameResponse\"#\n\x0fGetGamesRequest\x12\x10\n\x03ids\x18\x01 \x03(\tR\x03ids\"\x9b\x01\n\x10GetGamesResponse\x12<\n\x05games\x18\x01 \x03(\x0b\x32&.weewar.v1.GetGamesResponse.GamesEntryR\x05games\x1aI\n\nGamesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12%\n\x05value\x18\x02 \x01(\x0b\x32\x0f.weewar.v1.GameR\x05value:\x02\x38\x01\"8\n\x11\x43reateGameRequest\x12#\n\x04game\x18\x01 \x01(\x0b\x32\x0f.weewar.v1.GameR\x04game\"\x81\x02\n\x12\x43reateGameResponse\x12#\n\x04game\x18\x01 \x01(\x0b\x32\x0f.weewar.v1.GameR\x04game\x12\x33\n\ngame_state\x18\x02 \x01(\x0b\x32\x14.weewar.v1.GameStateR\tgameState\x12Q\n\x0c\x66ield_errors\x18\x03 \x03(\x0b\x32..weewar.v1.CreateGameResponse.FieldErrorsEntryR\x0b\x66ieldErrors\x1a>\n\x10\x46ieldErrorsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\"\x96\x01\n\x13ProcessMovesRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12)\n\x05moves\x18\x03 \x03(\x0b\x32\x13.weewar.v1.GameMoveR\x05moves\x12;\n\x1a\x65xpected_last_sequence_num\x18\x04 \x01(\x03R\x17\x65xpectedLastSequenceNum\"\x86\x01\n\x14ProcessMovesResponse\x12<\n\x0cmove_results\x18\x01 \x03(\x0b\x32\x19.weewar.v1.GameMoveResultR\x0bmoveResults\x12\x30\n\x07\x63hanges\x18\x02 \x03(\x0b\x32\x16.weewar.v1.WorldChangeR\x07\x63hanges\",\n\x11VerifyGameRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\"\xbb\x01\n\x12VerifyGameResponse\x12\x1a\n\x08verified\x18\x01 \x01(\x08R\x08verified\x12\'\n\x0fgroups_replayed\x18\x02 \x01(\x05R\x0egroupsReplayed\x12%\n\x0emoves_replayed\x18\x03 \x01(\x05R\rmovesReplayed\x12\x39\n\ndivergence\x18\x04 \x01(\x0b\x32\x19.weewar.v1.GameDivergenceR\ndivergence\"\xac\x02\n\x0eGameDivergence\x12\x1f\n\x0bgroup_index\x18\x01 \x01(\x05R\ngroupIndex\x12\x1d\n\nmove_index\x18\x02 \x01(\x05R\tmoveIndex\x12!\n\x0c\x63hange_index\x18\x03 \x01(\x05R\x0b\x63hangeIndex\x12!\n\x0csequence_num\x18\x04 \x01(\x03R\x0bsequenceNum\x12\x16\n\x06reason\x18\x05 \x01(\tR\x06reason\x12?\n\x0f\x65xpected_change\x18\x06 \x01(\x0b\x32\x16.weewar.v1.WorldChangeR\x0e\x65xpectedChange\x12;\n\ractual_change\x18\x07 \x01(\x0b\x32\x16.weewar.v1.WorldChangeR\x0c\x61\x63tualChange\"A\n\x10UndoMovesRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x14\n\x05\x63ount\x18\x02 \x01(\x05R\x05\x63ount\"\x84\x01\n\x11UndoMovesResponse\x12=\n\rundone_groups\x18\x01 \x03(\x0b\x32\x18.weewar.v1.GameMoveGroupR\x0cundoneGroups\x12\x30\n\x07\x63hanges\x18\x02 \x03(\x0b\x32\x16.weewar.v1.WorldChangeR\x07\x63hanges\"s\n\x14SubscribeGameRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12*\n\x11\x66rom_sequence_num\x18\x02 \x01(\x03R\x0f\x66romSequenceNum\x12\x16\n\x06player\x18\x03 \x01(\x05R\x06player\"\x82\x01\n\x15SubscribeGameResponse\x12\x37\n\nmove_group\x18\x01 \x01(\x0b\x32\x18.weewar.v1.GameMoveGroupR\tmoveGroup\x12\x30\n\x04undo\x18\x02 \x01(\x0b\x32\x1c.weewar.v1.UndoMovesResponseR\x04undo\"F\n\x13GetGameStateRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x16\n\x06player\x18\x02 \x01(\x05R\x06player\"\xb9\x01\n\x14GetGameStateResponse\x12*\n\x05state\x18\x01 \x01(\x0b\x32\x14.weewar.v1.GameStateR\x05state\x12.\n\x13turn_time_remaining\x18\x02 \x01(\x05R\x11turnTimeRemaining\x12\x45\n\x10victory_progress\x18\x03 \x03(\x0b\x32\x1a.weewar.v1.VictoryProgressR\x0fvictoryProgress\"r\n\x10ListMovesRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x16\n\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x15\n\x06last_n\x18\x03 \x01(\x05R\x05lastN\x12\x16\n\x06player\x18\x04 \x01(\x05R\x06player\"i\n\x11ListMovesResponse\x12\x19\n\x08has_more\x18\x01 \x01(\x08R\x07hasMore\x12\x39\n\x0bmove_groups\x18\x02 \x03(\x0b\x32\x18.weewar.v1.GameMoveGroupR\nmoveGroups\"J\n\x13GetOptionsAtRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x0c\n\x01q\x18\x02 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x03 \x01(\x05R\x01r\"\x99\x01\n\x14GetOptionsAtResponse\x12/\n\x07options\x18\x01 \x03(\x0b\x32\x15.weewar.v1.GameOptionR\x07options\x12%\n\x0e\x63urrent_player\x18\x02 \x01(\x05R\rcurrentPlayer\x12)\n\x10game_initialized\x18\x03 \x01(\x08R\x0fgameInitialized\"}\n\x0eGetPathRequest\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x15\n\x06\x66rom_q\x18\x02 \x01(\x05R\x05\x66romQ\x12\x15\n\x06\x66rom_r\x18\x03 \x01(\x05R\x05\x66romR\x12\x11\n\x04to_q\x18\x04 \x01(\x05R\x03toQ\x12\x11\n\x04to_r\x18\x05 \x01(\x05R\x03toR\"\xd1\x01\n\x0fGetPathResponse\x12)\n\x05steps\x18\x01 \x03(\x0b\x32\x13.weewar.v1.PathStepR\x05steps\x12\x1d\n\ntotal_cost\x18\x02 \x01(\x01R\ttotalCost\x12#\n\rmovement_cost\x18\x03 \x01(\x05R\x0cmovementCost\x12\x1c\n\treachable\x18\x04 \x01(\x08R\treachable\x12\x31\n\x06\x61\x63tion\x18\x05 \x01(\x0b\x32\x19.weewar.v1.MoveUnitActionR\x06\x61\x63tion\"Y\n\x08PathStep\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12\x12\n\x04\x63ost\x18\x03 \x01(\x01R\x04\x63ost\x12\x1d\n\ntotal_cost\x18\x04 \x01(\x01R\ttotalCost\"\x86\x04\n\nGameOption\x12+\n\x04move\x18\x01 \x01(\x0b\x32\x15.weewar.v1.MoveOptionH\x00R\x04move\x12\x31\n\x06\x61ttack\x18\x02 \x01(\x0b\x32\x17.weewar.v1.AttackOptionH\x00R\x06\x61ttack\x12\x35\n\x08\x65nd_turn\x18\x03 \x01(\x0b\x32\x18.weewar.v1.EndTurnOptionH\x00R\x07\x65ndTurn\x12\x32\n\x05\x62uild\x18\x04 \x01(\x0b\x32\x1a.weewar.v1.BuildUnitOptionH\x00R\x05\x62uild\x12<\n\x07\x63\x61pture\x18\x05 \x01(\x0b\x32 .weewar.v1.CaptureBuildingOptionH\x00R\x07\x63\x61pture\x12/\n\x04load\x18\x06 \x01(\x0b\x32\x19.weewar.v1.LoadUnitOptionH\x00R\x04load\x12\x35\n\x06unload\x18\x07 \x01(\x0b\x32\x1b.weewar.v1.UnloadUnitOptionH\x00R\x06unload\x12/\n\x04heal\x18\x08 \x01(\x0b\x32\x19.weewar.v1.HealUnitOptionH\x00R\x04heal\x12G\n\x0emodify_terrain\x18\t \x01(\x0b\x32\x1e.weewar.v1.ModifyTerrainOptionH\x00R\rmodifyTerrainB\r\n\x0boption_type\"\x0f\n\rEndTurnOption\"\x80\x01\n\nMoveOption\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12#\n\rmovement_cost\x18\x03 \x01(\x05R\x0cmovementCost\x12\x31\n\x06\x61\x63tion\x18\x04 \x01(\x0b\x32\x19.weewar.v1.MoveUnitActionR\x06\x61\x63tion\"\xbd\x03\n\x0c\x41ttackOption\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12(\n\x10target_unit_type\x18\x03 \x01(\x05R\x0etargetUnitType\x12,\n\x12target_unit_health\x18\x04 \x01(\x05R\x10targetUnitHealth\x12\x1d\n\ncan_attack\x18\x05 \x01(\x08R\tcanAttack\x12\'\n\x0f\x64\x61mage_estimate\x18\x06 \x01(\x05R\x0e\x64\x61mageEstimate\x12\x33\n\x06\x61\x63tion\x18\x07 \x01(\x0b\x32\x1b.weewar.v1.AttackUnitActionR\x06\x61\x63tion\x12\x32\n\x15\x65xpected_damage_dealt\x18\x08 \x01(\x01R\x13\x65xpectedDamageDealt\x12\x32\n\x15\x65xpected_damage_taken\x18\t \x01(\x01R\x13\x65xpectedDamageTaken\x12)\n\x10kill_probability\x18\n \x01(\x01R\x0fkillProbability\x12)\n\x10loss_probability\x18\x0b \x01(\x01R\x0flossProbability\"\xba\x01\n\x0f\x42uildUnitOption\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n\ttile_type\x18\x03 \x01(\x05R\x08tileType\x12\x1d\n\nbuild_cost\x18\x04 \x01(\x05R\tbuildCost\x12\x1b\n\tunit_type\x18\x05 \x01(\x05R\x08unitType\x12\x32\n\x06\x61\x63tion\x18\x06 \x01(\x0b\x32\x1a.weewar.v1.BuildUnitActionR\x06\x61\x63tion\"\xda\x01\n\x15\x43\x61ptureBuildingOption\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n\ttile_type\x18\x03 \x01(\x05R\x08tileType\x12)\n\x10\x63\x61pture_progress\x18\x04 \x01(\x05R\x0f\x63\x61ptureProgress\x12#\n\rcapture_turns\x18\x05 \x01(\x05R\x0c\x63\x61ptureTurns\x12\x38\n\x06\x61\x63tion\x18\x06 \x01(\x0b\x32 .weewar.v1.CaptureBuildingActionR\x06\x61\x63tion\"\x90\x02\n\x13ModifyTerrainOption\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12%\n\x0eterrain_action\x18\x03 \x01(\tR\rterrainAction\x12\x12\n\x04name\x18\x04 \x01(\tR\x04name\x12\"\n\rnew_tile_type\x18\x05 \x01(\x05R\x0bnewTileType\x12\x1a\n\x08progress\x18\x06 \x01(\x05R\x08progress\x12\x14\n\x05turns\x18\x07 \x01(\x05R\x05turns\x12\x14\n\x05\x63oins\x18\x08 \x01(\x05R\x05\x63oins\x12\x36\n\x06\x61\x63tion\x18\t \x01(\x0b\x32\x1e.weewar.v1.ModifyTerrainActionR\x06\x61\x63tion\"\x8f\x01\n\x0eLoadUnitOption\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12.\n\x13transport_unit_type\x18\x03 \x01(\x05R\x11transportUnitType\x12\x31\n\x06\x61\x63tion\x18\x04 \x01(\x0b\x32\x19.weewar.v1.LoadUnitActionR\x06\x61\x63tion\"\xa1\x01\n\x10UnloadUnitOption\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12\x1f\n\x0b\x63\x61rgo_index\x18\x03 \x01(\x05R\ncargoIndex\x12\x1b\n\tunit_type\x18\x04 \x01(\x05R\x08unitType\x12\x33\n\x06\x61\x63tion\x18\x05 \x01(\x0b\x32\x1b.weewar.v1.UnloadUnitActionR\x06\x61\x63tion\"\x94\x01\n\x0eHealUnitOption\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n\tunit_type\x18\x03 \x01(\x05R\x08unitType\x12\x16\n\x06\x61mount\x18\x04 \x01(\x05R\x06\x61mount\x12\x31\n\x06\x61\x63tion\x18\x05 \x01(\x0b\x32\x19.weewar.v1.HealUnitActionR\x06\x61\x63tion2\x8f\x0c\n\x0cGamesService\x12_\n\nCreateGame\x12\x1c.weewar.v1.CreateGameRequest\x1a\x1d.weewar.v1.CreateGameResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\"\t/v1/games:\x01*\x12_\n\x08GetGames\x12\x1a.weewar.v1.GetGamesRequest\x1a\x1b.weewar.v1.GetGamesResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/games:batchGet\x12Y\n\tListGames\x12\x1b.weewar.v1.ListGamesRequest\x1a\x1c.weewar.v1.ListGamesResponse\"\x11\x82\xd3\xe4\x93\x02\x0b\x12\t/v1/games\x12X\n\x07GetGame\x12\x19.weewar.v1.GetGameRequest\x1a\x1a.weewar.v1.GetGameResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/games/{id}\x12\x63\n\nDeleteGame\x12\x1c.weewar.v1.DeleteGameRequest\x1a\x1d.weewar.v1.DeleteGameResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/games/{id=*}\x12k\n\nUpdateGame\x12\x1c.weewar.v1.UpdateGameRequest\x1a\x1d.weewar.v1.UpdateGameResponse\" \x82\xd3\xe4\x93\x02\x1a\x32\x15/v1/games/{game_id=*}:\x01*\x12r\n\x0cGetGameState\x12\x1e.weewar.v1.GetGameStateRequest\x1a\x1f.weewar.v1.GetGameStateResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/games/{game_id}/state\x12i\n\tListMoves\x12\x1b.weewar.v1.ListMovesRequest\x1a\x1c.weewar.v1.ListMovesResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/games/{game_id}/moves\x12u\n\x0cProcessMoves\x12\x1e.weewar.v1.ProcessMovesRequest\x1a\x1f.weewar.v1.ProcessMovesResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x19/v1/games/{game_id}/moves:\x01*\x12|\n\x0cGetOptionsAt\x12\x1e.weewar.v1.GetOptionsAtRequest\x1a\x1f.weewar.v1.GetOptionsAtResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/games/{game_id}/options/{q}/{r}\x12\x82\x01\n\x07GetPath\x12\x19.weewar.v1.GetPathRequest\x1a\x1a.weewar.v1.GetPathResponse\"@\x82\xd3\xe4\x93\x02:\x12\x38/v1/games/{game_id}/path/{from_q}/{from_r}/{to_q}/{to_r}\x12m\n\nVerifyGame\x12\x1c.weewar.v1.VerifyGameRequest\x1a\x1d.weewar.v1.VerifyGameResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/games/{game_id}/verify\x12q\n\tUndoMoves\x12\x1b.weewar.v1.UndoMovesRequest\x1a\x1c.weewar.v1.UndoMovesResponse\")\x82\xd3\xe4\x93\x02#\"\x1e/v1/games/{game_id}/moves/undo:\x01*\x12{\n\rSubscribeGame\x12\x1f.weewar.v1.SubscribeGameRequest\x1a .weewar.v1.SubscribeGameResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/games/{game_id}/subscribe0\x01\x42\x9c\x01\n\rcom.weewar.v1B\nGamesProtoP\x01Z:github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1\xa2\x02\x03WXX\xaa\x02\tWeewar.V1\xca\x02\tWeewar\\V1\xe2\x02\x15Weewar\\V1\\GPBMetadata\xea\x02\nWeewar::V1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -65,6 +65,14 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_GAMESSERVICE'].methods_by_name['ProcessMoves']._serialized_options = b'\202\323\344\223\002\036\"\031/v1/games/{game_id}/moves:\001*'
   _globals['_GAMESSERVICE'].methods_by_name['GetOptionsAt']._loaded_options = None
   _globals['_GAMESSERVICE'].methods_by_name['GetOptionsAt']._serialized_options = b'\202\323\344\223\002%\022#/v1/games/{game_id}/options/{q}/{r}'
+  _globals['_GAMESSERVICE'].methods_by_name['GetPath']._loaded_options = None
+  _globals['_GAMESSERVICE'].methods_by_name['GetPath']._serialized_options = b'\202\323\344\223\002:\0228/v1/games/{game_id}/path/{from_q}/{from_r}/{to_q}/{to_r}'
+  _globals['_GAMESSERVICE'].methods_by_name['VerifyGame']._loaded_options = None
+  _globals['_GAMESSERVICE'].methods_by_name['VerifyGame']._serialized_options = b'\202\323\344\223\002\034\022\032/v1/games/{game_id}/verify'
+  _globals['_GAMESSERVICE'].methods_by_name['UndoMoves']._loaded_options = None
+  _globals['_GAMESSERVICE'].methods_by_name['UndoMoves']._serialized_options = b'\202\323\344\223\002#\"\036/v1/games/{game_id}/moves/undo:\001*'
+  _globals['_GAMESSERVICE'].methods_by_name['SubscribeGame']._loaded_options = None
+  _globals['_GAMESSERVICE'].methods_by_name['SubscribeGame']._serialized_options = b'\202\323\344\223\002\037\022\035/v1/games/{game_id}/subscribe'
   _globals['_GAMEINFO']._serialized_start=206
   _globals['_GAMEINFO']._serialized_end=421
   _globals['_LISTGAMESREQUEST']._serialized_start=423
@@ -72,61 +80,89 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_LISTGAMESRESPONSE']._serialized_start=525
   _globals['_LISTGAMESRESPONSE']._serialized_end=646
   _globals['_GETGAMEREQUEST']._serialized_start=648
-  _globals['_GETGAMEREQUEST']._serialized_end=706
-  _globals['_GETGAMERESPONSE']._serialized_start=709
-  _globals['_GETGAMERESPONSE']._serialized_end=861
-  _globals['_GETGAMECONTENTREQUEST']._serialized_start=863
-  _globals['_GETGAMECONTENTREQUEST']._serialized_end=928
-  _globals['_GETGAMECONTENTRESPONSE']._serialized_start=931
-  _globals['_GETGAMECONTENTRESPONSE']._serialized_end=1072
-  _globals['_UPDATEGAMEREQUEST']._serialized_start=1075
-  _globals['_UPDATEGAMEREQUEST']._serialized_end=1362
-  _globals['_UPDATEGAMERESPONSE']._serialized_start=1364
-  _globals['_UPDATEGAMERESPONSE']._serialized_end=1448
-  _globals['_DELETEGAMEREQUEST']._serialized_start=1450
-  _globals['_DELETEGAMEREQUEST']._serialized_end=1485
-  _globals['_DELETEGAMERESPONSE']._serialized_start=1487
-  _globals['_DELETEGAMERESPONSE']._serialized_end=1507
-  _globals['_GETGAMESREQUEST']._serialized_start=1509
-  _globals['_GETGAMESREQUEST']._serialized_end=1544
-  _globals['_GETGAMESRESPONSE']._serialized_start=1547
-  _globals['_GETGAMESRESPONSE']._serialized_end=1702
-  _globals['_GETGAMESRESPONSE_GAMESENTRY']._serialized_start=1629
-  _globals['_GETGAMESRESPONSE_GAMESENTRY']._serialized_end=1702
-  _globals['_CREATEGAMEREQUEST']._serialized_start=1704
-  _globals['_CREATEGAMEREQUEST']._serialized_end=1760
-  _globals['_CREATEGAMERESPONSE']._serialized_start=1763
-  _globals['_CREATEGAMERESPONSE']._serialized_end=2020
-  _globals['_CREATEGAMERESPONSE_FIELDERRORSENTRY']._serialized_start=1958
-  _globals['_CREATEGAMERESPONSE_FIELDERRORSENTRY']._serialized_end=2020
-  _globals['_PROCESSMOVESREQUEST']._serialized_start=2022
-  _globals['_PROCESSMOVESREQUEST']._serialized_end=2111
-  _globals['_PROCESSMOVESRESPONSE']._serialized_start=2114
-  _globals['_PROCESSMOVESRESPONSE']._serialized_end=2248
-  _globals['_GETGAMESTATEREQUEST']._serialized_start=2250
-  _globals['_GETGAMESTATEREQUEST']._serialized_end=2296
-  _globals['_GETGAMESTATERESPONSE']._serialized_start=2298
-  _globals['_GETGAMESTATERESPONSE']._serialized_end=2364
-  _globals['_LISTMOVESREQUEST']._serialized_start=2366
-  _globals['_LISTMOVESREQUEST']._serialized_end=2456
-  _globals['_LISTMOVESRESPONSE']._serialized_start=2458
-  _globals['_LISTMOVESRESPONSE']._serialized_end=2563
-  _globals['_GETOPTIONSATREQUEST']._serialized_start=2565
-  _globals['_GETOPTIONSATREQUEST']._serialized_end=2639
-  _globals['_GETOPTIONSATRESPONSE']._serialized_start=2642
-  _globals['_GETOPTIONSATRESPONSE']._serialized_end=2795
-  _globals['_GAMEOPTION']._serialized_start=2798
-  _globals['_GAMEOPTION']._serialized_end=3090
-  _globals['_ENDTURNOPTION']._serialized_start=3092
-  _globals['_ENDTURNOPTION']._serialized_end=3107
-  _globals['_MOVEOPTION']._serialized_start=3110
-  _globals['_MOVEOPTION']._serialized_end=3238
-  _globals['_ATTACKOPTION']._serialized_start=3241
-  _globals['_ATTACKOPTION']._serialized_end=3496
-  _globals['_BUILDUNITOPTION']._serialized_start=3498
-  _globals['_BUILDUNITOPTION']._serialized_end=3603
-  _globals['_CAPTUREBUILDINGOPTION']._serialized_start=3605
-  _globals['_CAPTUREBUILDINGOPTION']._serialized_end=3685
-  _globals['_GAMESSERVICE']._serialized_start=3688
-  _globals['_GAMESSERVICE']._serialized_end=4755
+  _globals['_GETGAMEREQUEST']._serialized_end=730
+  _globals['_GETGAMERESPONSE']._serialized_start=733
+  _globals['_GETGAMERESPONSE']._serialized_end=885
+  _globals['_GETGAMECONTENTREQUEST']._serialized_start=887
+  _globals['_GETGAMECONTENTREQUEST']._serialized_end=952
+  _globals['_GETGAMECONTENTRESPONSE']._serialized_start=955
+  _globals['_GETGAMECONTENTRESPONSE']._serialized_end=1096
+  _globals['_UPDATEGAMEREQUEST']._serialized_start=1099
+  _globals['_UPDATEGAMEREQUEST']._serialized_end=1386
+  _globals['_UPDATEGAMERESPONSE']._serialized_start=1388
+  _globals['_UPDATEGAMERESPONSE']._serialized_end=1472
+  _globals['_DELETEGAMEREQUEST']._serialized_start=1474
+  _globals['_DELETEGAMEREQUEST']._serialized_end=1509
+  _globals['_DELETEGAMERESPONSE']._serialized_start=1511
+  _globals['_DELETEGAMERESPONSE']._serialized_end=1531
+  _globals['_GETGAMESREQUEST']._serialized_start=1533
+  _globals['_GETGAMESREQUEST']._serialized_end=1568
+  _globals['_GETGAMESRESPONSE']._serialized_start=1571
+  _globals['_GETGAMESRESPONSE']._serialized_end=1726
+  _globals['_GETGAMESRESPONSE_GAMESENTRY']._serialized_start=1653
+  _globals['_GETGAMESRESPONSE_GAMESENTRY']._serialized_end=1726
+  _globals['_CREATEGAMEREQUEST']._serialized_start=1728
+  _globals['_CREATEGAMEREQUEST']._serialized_end=1784
+  _globals['_CREATEGAMERESPONSE']._serialized_start=1787
+  _globals['_CREATEGAMERESPONSE']._serialized_end=2044
+  _globals['_CREATEGAMERESPONSE_FIELDERRORSENTRY']._serialized_start=1982
+  _globals['_CREATEGAMERESPONSE_FIELDERRORSENTRY']._serialized_end=2044
+  _globals['_PROCESSMOVESREQUEST']._serialized_start=2047
+  _globals['_PROCESSMOVESREQUEST']._serialized_end=2197
+  _globals['_PROCESSMOVESRESPONSE']._serialized_start=2200
+  _globals['_PROCESSMOVESRESPONSE']._serialized_end=2334
+  _globals['_VERIFYGAMEREQUEST']._serialized_start=2336
+  _globals['_VERIFYGAMEREQUEST']._serialized_end=2380
+  _globals['_VERIFYGAMERESPONSE']._serialized_start=2383
+  _globals['_VERIFYGAMERESPONSE']._serialized_end=2570
+  _globals['_GAMEDIVERGENCE']._serialized_start=2573
+  _globals['_GAMEDIVERGENCE']._serialized_end=2873
+  _globals['_UNDOMOVESREQUEST']._serialized_start=2875
+  _globals['_UNDOMOVESREQUEST']._serialized_end=2940
+  _globals['_UNDOMOVESRESPONSE']._serialized_start=2943
+  _globals['_UNDOMOVESRESPONSE']._serialized_end=3075
+  _globals['_SUBSCRIBEGAMEREQUEST']._serialized_start=3077
+  _globals['_SUBSCRIBEGAMEREQUEST']._serialized_end=3192
+  _globals['_SUBSCRIBEGAMERESPONSE']._serialized_start=3195
+  _globals['_SUBSCRIBEGAMERESPONSE']._serialized_end=3325
+  _globals['_GETGAMESTATEREQUEST']._serialized_start=3327
+  _globals['_GETGAMESTATEREQUEST']._serialized_end=3397
+  _globals['_GETGAMESTATERESPONSE']._serialized_start=3400
+  _globals['_GETGAMESTATERESPONSE']._serialized_end=3585
+  _globals['_LISTMOVESREQUEST']._serialized_start=3587
+  _globals['_LISTMOVESREQUEST']._serialized_end=3701
+  _globals['_LISTMOVESRESPONSE']._serialized_start=3703
+  _globals['_LISTMOVESRESPONSE']._serialized_end=3808
+  _globals['_GETOPTIONSATREQUEST']._serialized_start=3810
+  _globals['_GETOPTIONSATREQUEST']._serialized_end=3884
+  _globals['_GETOPTIONSATRESPONSE']._serialized_start=3887
+  _globals['_GETOPTIONSATRESPONSE']._serialized_end=4040
+  _globals['_GETPATHREQUEST']._serialized_start=4042
+  _globals['_GETPATHREQUEST']._serialized_end=4167
+  _globals['_GETPATHRESPONSE']._serialized_start=4170
+  _globals['_GETPATHRESPONSE']._serialized_end=4379
+  _globals['_PATHSTEP']._serialized_start=4381
+  _globals['_PATHSTEP']._serialized_end=4470
+  _globals['_GAMEOPTION']._serialized_start=4473
+  _globals['_GAMEOPTION']._serialized_end=4991
+  _globals['_ENDTURNOPTION']._serialized_start=4993
+  _globals['_ENDTURNOPTION']._serialized_end=5008
+  _globals['_MOVEOPTION']._serialized_start=5011
+  _globals['_MOVEOPTION']._serialized_end=5139
+  _globals['_ATTACKOPTION']._serialized_start=5142
+  _globals['_ATTACKOPTION']._serialized_end=5587
+  _globals['_BUILDUNITOPTION']._serialized_start=5590
+  _globals['_BUILDUNITOPTION']._serialized_end=5776
+  _globals['_CAPTUREBUILDINGOPTION']._serialized_start=5779
+  _globals['_CAPTUREBUILDINGOPTION']._serialized_end=5997
+  _globals['_MODIFYTERRAINOPTION']._serialized_start=6000
+  _globals['_MODIFYTERRAINOPTION']._serialized_end=6272
+  _globals['_LOADUNITOPTION']._serialized_start=6275
+  _globals['_LOADUNITOPTION']._serialized_end=6418
+  _globals['_UNLOADUNITOPTION']._serialized_start=6421
+  _globals['_UNLOADUNITOPTION']._serialized_end=6582
+  _globals['_HEALUNITOPTION']._serialized_start=6585
+  _globals['_HEALUNITOPTION']._serialized_end=6733
+  _globals['_GAMESSERVICE']._serialized_start=6736
+  _globals['_GAMESSERVICE']._serialized_end=8287
 # @@protoc_insertion_point(module_scope)
@@ -65,6 +65,26 @@ class GamesServiceStub(object):
                 request_serializer=weewar_dot_v1_dot_games__pb2.GetOptionsAtRequest.SerializeToString,
                 response_deserializer=weewar_dot_v1_dot_games__pb2.GetOptionsAtResponse.FromString,
                 _registered_method=True)
+        self.GetPath = channel.unary_unary(
+                '/weewar.v1.GamesService/GetPath',
+                request_serializer=weewar_dot_v1_dot_games__pb2.GetPathRequest.SerializeToString,
+                response_deserializer=weewar_dot_v1_dot_games__pb2.GetPathResponse.FromString,
+                _registered_method=True)
+        self.VerifyGame = channel.unary_unary(
+                '/weewar.v1.GamesService/VerifyGame',
+                request_serializer=weewar_dot_v1_dot_games__pb2.VerifyGameRequest.SerializeToString,
+                response_deserializer=weewar_dot_v1_dot_games__pb2.VerifyGameResponse.FromString,
+                _registered_method=True)
+        self.UndoMoves = channel.unary_unary(
+                '/weewar.v1.GamesService/UndoMoves',
+                request_serializer=weewar_dot_v1_dot_games__pb2.UndoMovesRequest.SerializeToString,
+                response_deserializer=weewar_dot_v1_dot_games__pb2.UndoMovesResponse.FromString,
+                _registered_method=True)
+        self.SubscribeGame = channel.unary_stream(
+                '/weewar.v1.GamesService/SubscribeGame',
+                request_serializer=weewar_dot_v1_dot_games__pb2.SubscribeGameRequest.SerializeToString,
+                response_deserializer=weewar_dot_v1_dot_games__pb2.SubscribeGameResponse.FromString,
+                _registered_method=True)
 
 
 class GamesServiceServicer(object):
@@ -95,7 +115,7 @@ class GamesServiceServicer(object):
         raise NotImplementedError('Method not implemented!')
 
     def GetGame(self, request, context):
-        """GetGame returns a specific game with metadata
+        """GetGame returns a specific game with metadata, its state and history
         """
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
         context.set_details('Method not implemented!')
@@ -142,6 +162,37 @@ class GamesServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetPath(self, request, context):
+        """Returns the cheapest path for the unit at (from_q, from_r) to reach (to_q, to_r)
+        along with the cost of each step
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def VerifyGame(self, request, context):
+        """Admin: Replays a game's move history from its initial state and reports the
+        first place where recomputed changes differ from the recorded ones
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UndoMoves(self, request, context):
+        """Undo the latest non-permanent move groups in the current turn
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SubscribeGame(self, request, context):
+        """Streams move groups as they are committed to a game.  Groups already in the
+        history after from_sequence_num are sent first so clients can resume.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_GamesServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -195,6 +246,26 @@ def add_GamesServiceServicer_to_server(servicer, server):
                     request_deserializer=weewar_dot_v1_dot_games__pb2.GetOptionsAtRequest.FromString,
                     response_serializer=weewar_dot_v1_dot_games__pb2.GetOptionsAtResponse.SerializeToString,
             ),
+            'GetPath': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetPath,
+                    request_deserializer=weewar_dot_v1_dot_games__pb2.GetPathRequest.FromString,
+                    response_serializer=weewar_dot_v1_dot_games__pb2.GetPathResponse.SerializeToString,
+            ),
+            'VerifyGame': grpc.unary_unary_rpc_method_handler(
+                    servicer.VerifyGame,
+                    request_deserializer=weewar_dot_v1_dot_games__pb2.VerifyGameRequest.FromString,
+                    response_serializer=weewar_dot_v1_dot_games__pb2.VerifyGameResponse.SerializeToString,
+            ),
+            'UndoMoves': grpc.unary_unary_rpc_method_handler(
+                    servicer.UndoMoves,
+                    request_deserializer=weewar_dot_v1_dot_games__pb2.UndoMovesRequest.FromString,
+                    response_serializer=weewar_dot_v1_dot_games__pb2.UndoMovesResponse.SerializeToString,
+            ),
+            'SubscribeGame': grpc.unary_stream_rpc_method_handler(
+                    servicer.SubscribeGame,
+                    request_deserializer=weewar_dot_v1_dot_games__pb2.SubscribeGameRequest.FromString,
+                    response_serializer=weewar_dot_v1_dot_games__pb2.SubscribeGameResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'weewar.v1.GamesService', rpc_method_handlers)
@@ -476,3 +547,111 @@ class GamesService(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def GetPath(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/weewar.v1.GamesService/GetPath',
+            weewar_dot_v1_dot_games__pb2.GetPathRequest.SerializeToString,
+            weewar_dot_v1_dot_games__pb2.GetPathResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def VerifyGame(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/weewar.v1.GamesService/VerifyGame',
+            weewar_dot_v1_dot_games__pb2.VerifyGameRequest.SerializeToString,
+            weewar_dot_v1_dot_games__pb2.VerifyGameResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def UndoMoves(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/weewar.v1.GamesService/UndoMoves',
+            weewar_dot_v1_dot_games__pb2.UndoMovesRequest.SerializeToString,
+            weewar_dot_v1_dot_games__pb2.UndoMovesResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def SubscribeGame(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/weewar.v1.GamesService/SubscribeGame',
+            weewar_dot_v1_dot_games__pb2.SubscribeGameRequest.SerializeToString,
+            weewar_dot_v1_dot_games__pb2.SubscribeGameResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
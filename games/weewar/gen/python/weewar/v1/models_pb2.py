@@ -25,7 +25,7 @@ _sym_db = _symbol_database.Default()
 from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x16weewar/v1/models.proto\x12\tweewar.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x93\x02\n\x04User\x12\x39\n\ncreated_at\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x39\n\nupdated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x0e\n\x02id\x18\x03 \x01(\tR\x02id\x12\x12\n\x04name\x18\x04 \x01(\tR\x04name\x12 \n\x0b\x64\x65scription\x18\x05 \x01(\tR\x0b\x64\x65scription\x12\x12\n\x04tags\x18\x06 \x03(\tR\x04tags\x12\x1b\n\timage_url\x18\x07 \x01(\tR\x08imageUrl\x12\x1e\n\ndifficulty\x18\x08 \x01(\tR\ndifficulty\"e\n\nPagination\x12\x19\n\x08page_key\x18\x01 \x01(\tR\x07pageKey\x12\x1f\n\x0bpage_offset\x18\x02 \x01(\x05R\npageOffset\x12\x1b\n\tpage_size\x18\x03 \x01(\x05R\x08pageSize\"\xa2\x01\n\x12PaginationResponse\x12\"\n\rnext_page_key\x18\x02 \x01(\tR\x0bnextPageKey\x12(\n\x10next_page_offset\x18\x03 \x01(\x05R\x0enextPageOffset\x12\x19\n\x08has_more\x18\x04 \x01(\x08R\x07hasMore\x12#\n\rtotal_results\x18\x05 \x01(\x05R\x0ctotalResults\"\xe8\x02\n\x05World\x12\x39\n\ncreated_at\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x39\n\nupdated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x0e\n\x02id\x18\x03 \x01(\tR\x02id\x12\x1d\n\ncreator_id\x18\x04 \x01(\tR\tcreatorId\x12\x12\n\x04name\x18\x05 \x01(\tR\x04name\x12 \n\x0b\x64\x65scription\x18\x06 \x01(\tR\x0b\x64\x65scription\x12\x12\n\x04tags\x18\x07 \x03(\tR\x04tags\x12\x1b\n\timage_url\x18\x08 \x01(\tR\x08imageUrl\x12\x1e\n\ndifficulty\x18\t \x01(\tR\ndifficulty\x12\x33\n\nworld_data\x18\n \x01(\x0b\x32\x14.weewar.v1.WorldDataR\tworldData\"Y\n\tWorldData\x12%\n\x05tiles\x18\x01 \x03(\x0b\x32\x0f.weewar.v1.TileR\x05tiles\x12%\n\x05units\x18\x02 \x03(\x0b\x32\x0f.weewar.v1.UnitR\x05units\"W\n\x04Tile\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n\ttile_type\x18\x03 \x01(\x05R\x08tileType\x12\x16\n\x06player\x18\x04 \x01(\x05R\x06player\"\xca\x01\n\x04Unit\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12\x16\n\x06player\x18\x03 \x01(\x05R\x06player\x12\x1b\n\tunit_type\x18\x04 \x01(\x05R\x08unitType\x12)\n\x10\x61vailable_health\x18\x05 \x01(\x05R\x0f\x61vailableHealth\x12#\n\rdistance_left\x18\x06 \x01(\x05R\x0c\x64istanceLeft\x12!\n\x0cturn_counter\x18\x07 \x01(\x05R\x0bturnCounter\"\xb8\x01\n\x11TerrainDefinition\x12\x0e\n\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12$\n\x0e\x62\x61se_move_cost\x18\x03 \x01(\x01R\x0c\x62\x61seMoveCost\x12#\n\rdefense_bonus\x18\x04 \x01(\x01R\x0c\x64\x65\x66\x65nseBonus\x12\x12\n\x04type\x18\x05 \x01(\x05R\x04type\x12 \n\x0b\x64\x65scription\x18\x06 \x01(\tR\x0b\x64\x65scription\"\xb8\x01\n\x0eUnitDefinition\x12\x0e\n\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12\'\n\x0fmovement_points\x18\x03 \x01(\x05R\x0emovementPoints\x12!\n\x0c\x61ttack_range\x18\x04 \x01(\x05R\x0b\x61ttackRange\x12\x16\n\x06health\x18\x05 \x01(\x05R\x06health\x12\x1e\n\nproperties\x18\x06 \x03(\tR\nproperties\"\xa1\x01\n\x0eMovementMatrix\x12:\n\x05\x63osts\x18\x01 \x03(\x0b\x32$.weewar.v1.MovementMatrix.CostsEntryR\x05\x63osts\x1aS\n\nCostsEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12/\n\x05value\x18\x02 \x01(\x0b\x32\x19.weewar.v1.TerrainCostMapR\x05value:\x02\x38\x01\"\xa3\x01\n\x0eTerrainCostMap\x12P\n\rterrain_costs\x18\x01 \x03(\x0b\x32+.weewar.v1.TerrainCostMap.TerrainCostsEntryR\x0cterrainCosts\x1a?\n\x11TerrainCostsEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x01R\x05value:\x02\x38\x01\"\x83\x03\n\x04Game\x12\x39\n\ncreated_at\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x39\n\nupdated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x0e\n\x02id\x18\x03 \x01(\tR\x02id\x12\x1d\n\ncreator_id\x18\x04 \x01(\tR\tcreatorId\x12\x19\n\x08world_id\x18\x05 \x01(\tR\x07worldId\x12\x12\n\x04name\x18\x06 \x01(\tR\x04name\x12 \n\x0b\x64\x65scription\x18\x07 \x01(\tR\x0b\x64\x65scription\x12\x12\n\x04tags\x18\x08 \x03(\tR\x04tags\x12\x1b\n\timage_url\x18\t \x01(\tR\x08imageUrl\x12\x1e\n\ndifficulty\x18\n \x01(\tR\ndifficulty\x12\x34\n\x06\x63onfig\x18\x0b \x01(\x0b\x32\x1c.weewar.v1.GameConfigurationR\x06\x63onfig\"y\n\x11GameConfiguration\x12/\n\x07players\x18\x01 \x03(\x0b\x32\x15.weewar.v1.GamePlayerR\x07players\x12\x33\n\x08settings\x18\x02 \x01(\x0b\x32\x17.weewar.v1.GameSettingsR\x08settings\"y\n\nGamePlayer\x12\x1b\n\tplayer_id\x18\x01 \x01(\x05R\x08playerId\x12\x1f\n\x0bplayer_type\x18\x02 \x01(\tR\nplayerType\x12\x14\n\x05\x63olor\x18\x03 \x01(\tR\x05\x63olor\x12\x17\n\x07team_id\x18\x04 \x01(\x05R\x06teamId\"\x95\x01\n\x0cGameSettings\x12#\n\rallowed_units\x18\x01 \x03(\x05R\x0c\x61llowedUnits\x12&\n\x0fturn_time_limit\x18\x02 \x01(\x05R\rturnTimeLimit\x12\x1b\n\tteam_mode\x18\x03 \x01(\tR\x08teamMode\x12\x1b\n\tmax_turns\x18\x04 \x01(\x05R\x08maxTurns\"\xde\x01\n\tGameState\x12\x39\n\nupdated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x17\n\x07game_id\x18\x03 \x01(\tR\x06gameId\x12!\n\x0cturn_counter\x18\x04 \x01(\x05R\x0bturnCounter\x12%\n\x0e\x63urrent_player\x18\x05 \x01(\x05R\rcurrentPlayer\x12\x33\n\nworld_data\x18\x06 \x01(\x0b\x32\x14.weewar.v1.WorldDataR\tworldData\"\\\n\x0fGameMoveHistory\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x30\n\x06groups\x18\x02 \x03(\x0b\x32\x18.weewar.v1.GameMoveGroupR\x06groups\"\xea\x01\n\rGameMoveGroup\x12\x39\n\nstarted_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tstartedAt\x12\x35\n\x08\x65nded_at\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x07\x65ndedAt\x12)\n\x05moves\x18\x04 \x03(\x0b\x32\x13.weewar.v1.GameMoveR\x05moves\x12<\n\x0cmove_results\x18\x05 \x03(\x0b\x32\x19.weewar.v1.GameMoveResultR\x0bmoveResults\"\xbd\x02\n\x08GameMove\x12\x16\n\x06player\x18\x01 \x01(\x05R\x06player\x12\x38\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12!\n\x0csequence_num\x18\x03 \x01(\x03R\x0bsequenceNum\x12\x38\n\tmove_unit\x18\x04 \x01(\x0b\x32\x19.weewar.v1.MoveUnitActionH\x00R\x08moveUnit\x12>\n\x0b\x61ttack_unit\x18\x05 \x01(\x0b\x32\x1b.weewar.v1.AttackUnitActionH\x00R\nattackUnit\x12\x35\n\x08\x65nd_turn\x18\x06 \x01(\x0b\x32\x18.weewar.v1.EndTurnActionH\x00R\x07\x65ndTurnB\x0b\n\tmove_type\"\x88\x01\n\x0eGameMoveResult\x12!\n\x0cis_permanent\x18\x01 \x01(\x08R\x0bisPermanent\x12!\n\x0csequence_num\x18\x02 \x01(\x03R\x0bsequenceNum\x12\x30\n\x07\x63hanges\x18\x03 \x03(\x0b\x32\x16.weewar.v1.WorldChangeR\x07\x63hanges\"d\n\x0eMoveUnitAction\x12\x15\n\x06\x66rom_q\x18\x01 \x01(\x05R\x05\x66romQ\x12\x15\n\x06\x66rom_r\x18\x02 \x01(\x05R\x05\x66romR\x12\x11\n\x04to_q\x18\x03 \x01(\x05R\x03toQ\x12\x11\n\x04to_r\x18\x04 \x01(\x05R\x03toR\"\x8e\x01\n\x10\x41ttackUnitAction\x12\x1d\n\nattacker_q\x18\x01 \x01(\x05R\tattackerQ\x12\x1d\n\nattacker_r\x18\x02 \x01(\x05R\tattackerR\x12\x1d\n\ndefender_q\x18\x03 \x01(\x05R\tdefenderQ\x12\x1d\n\ndefender_r\x18\x04 \x01(\x05R\tdefenderR\"\x0f\n\rEndTurnAction\"\xa5\x02\n\x0bWorldChange\x12;\n\nunit_moved\x18\x01 \x01(\x0b\x32\x1a.weewar.v1.UnitMovedChangeH\x00R\tunitMoved\x12\x41\n\x0cunit_damaged\x18\x02 \x01(\x0b\x32\x1c.weewar.v1.UnitDamagedChangeH\x00R\x0bunitDamaged\x12>\n\x0bunit_killed\x18\x03 \x01(\x0b\x32\x1b.weewar.v1.UnitKilledChangeH\x00R\nunitKilled\x12G\n\x0eplayer_changed\x18\x04 \x01(\x0b\x32\x1e.weewar.v1.PlayerChangedChangeH\x00R\rplayerChangedB\r\n\x0b\x63hange_type\"{\n\x0fUnitMovedChange\x12\x34\n\rprevious_unit\x18\x06 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x0cpreviousUnit\x12\x32\n\x0cupdated_unit\x18\x07 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x0bupdatedUnit\"}\n\x11UnitDamagedChange\x12\x34\n\rprevious_unit\x18\x06 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x0cpreviousUnit\x12\x32\n\x0cupdated_unit\x18\x07 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x0bupdatedUnit\"H\n\x10UnitKilledChange\x12\x34\n\rprevious_unit\x18\x06 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x0cpreviousUnit\"\xcf\x01\n\x13PlayerChangedChange\x12\'\n\x0fprevious_player\x18\x01 \x01(\x05R\x0epreviousPlayer\x12\x1d\n\nnew_player\x18\x02 \x01(\x05R\tnewPlayer\x12#\n\rprevious_turn\x18\x03 \x01(\x05R\x0cpreviousTurn\x12\x19\n\x08new_turn\x18\x04 \x01(\x05R\x07newTurn\x12\x30\n\x0breset_units\x18\x05 \x03(\x0b\x32\x0f.weewar.v1.UnitR\nresetUnitsB\x9d\x01\n\rcom.weewar.v1B\x0bModelsProtoP\x01Z:github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1\xa2\x02\x03WXX\xaa\x02\tWeewar.V1\xca\x02\tWeewar\\V1\xe2\x02\x15Weewar\\V1\\GPBMetadata\xea\x02\nWeewar::V1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x16weewar/v1/models.proto\x12\tweewar.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x93\x02\n\x04User\x12\x39\n\ncreated_at\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x39\n\nupdated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x0e\n\x02id\x18\x03 \x01(\tR\x02id\x12\x12\n\x04name\x18\x04 \x01(\tR\x04name\x12 \n\x0b\x64\x65scription\x18\x05 \x01(\tR\x0b\x64\x65scription\x12\x12\n\x04tags\x18\x06 \x03(\tR\x04tags\x12\x1b\n\timage_url\x18\x07 \x01(\tR\x08imageUrl\x12\x1e\n\ndifficulty\x18\x08 \x01(\tR\ndifficulty\"e\n\nPagination\x12\x19\n\x08page_key\x18\x01 \x01(\tR\x07pageKey\x12\x1f\n\x0bpage_offset\x18\x02 \x01(\x05R\npageOffset\x12\x1b\n\tpage_size\x18\x03 \x01(\x05R\x08pageSize\"\xa2\x01\n\x12PaginationResponse\x12\"\n\rnext_page_key\x18\x02 \x01(\tR\x0bnextPageKey\x12(\n\x10next_page_offset\x18\x03 \x01(\x05R\x0enextPageOffset\x12\x19\n\x08has_more\x18\x04 \x01(\x08R\x07hasMore\x12#\n\rtotal_results\x18\x05 \x01(\x05R\x0ctotalResults\"\xe8\x02\n\x05World\x12\x39\n\ncreated_at\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x39\n\nupdated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x0e\n\x02id\x18\x03 \x01(\tR\x02id\x12\x1d\n\ncreator_id\x18\x04 \x01(\tR\tcreatorId\x12\x12\n\x04name\x18\x05 \x01(\tR\x04name\x12 \n\x0b\x64\x65scription\x18\x06 \x01(\tR\x0b\x64\x65scription\x12\x12\n\x04tags\x18\x07 \x03(\tR\x04tags\x12\x1b\n\timage_url\x18\x08 \x01(\tR\x08imageUrl\x12\x1e\n\ndifficulty\x18\t \x01(\tR\ndifficulty\x12\x33\n\nworld_data\x18\n \x01(\x0b\x32\x14.weewar.v1.WorldDataR\tworldData\"Y\n\tWorldData\x12%\n\x05tiles\x18\x01 \x03(\x0b\x32\x0f.weewar.v1.TileR\x05tiles\x12%\n\x05units\x18\x02 \x03(\x0b\x32\x0f.weewar.v1.UnitR\x05units\"\x88\x02\n\x04Tile\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n\ttile_type\x18\x03 \x01(\x05R\x08tileType\x12\x16\n\x06player\x18\x04 \x01(\x05R\x06player\x12%\n\x0e\x63\x61pture_player\x18\x05 \x01(\x05R\rcapturePlayer\x12)\n\x10\x63\x61pture_progress\x18\x06 \x01(\x05R\x0f\x63\x61ptureProgress\x12%\n\x0eterrain_action\x18\x07 \x01(\tR\rterrainAction\x12\x36\n\x17terrain_action_progress\x18\x08 \x01(\x05R\x15terrainActionProgress\"\xde\x02\n\x04Unit\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12\x16\n\x06player\x18\x03 \x01(\x05R\x06player\x12\x1b\n\tunit_type\x18\x04 \x01(\x05R\x08unitType\x12)\n\x10\x61vailable_health\x18\x05 \x01(\x05R\x0f\x61vailableHealth\x12#\n\rdistance_left\x18\x06 \x01(\x05R\x0c\x64istanceLeft\x12!\n\x0cturn_counter\x18\x07 \x01(\x05R\x0bturnCounter\x12\x1b\n\thas_moved\x18\x08 \x01(\x08R\x08hasMoved\x12!\n\x0chas_attacked\x18\t \x01(\x08R\x0bhasAttacked\x12+\n\x11\x61\x63tions_remaining\x18\n \x01(\x05R\x10\x61\x63tionsRemaining\x12%\n\x05\x63\x61rgo\x18\x0b \x03(\x0b\x32\x0f.weewar.v1.UnitR\x05\x63\x61rgo\"\xf3\x02\n\x11TerrainDefinition\x12\x0e\n\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12$\n\x0e\x62\x61se_move_cost\x18\x03 \x01(\x01R\x0c\x62\x61seMoveCost\x12#\n\rdefense_bonus\x18\x04 \x01(\x01R\x0c\x64\x65\x66\x65nseBonus\x12\x12\n\x04type\x18\x05 \x01(\x05R\x04type\x12 \n\x0b\x64\x65scription\x18\x06 \x01(\tR\x0b\x64\x65scription\x12\'\n\x0f\x62uildable_units\x18\x07 \x03(\x05R\x0e\x62uildableUnits\x12#\n\rcapture_turns\x18\x08 \x01(\x05R\x0c\x63\x61ptureTurns\x12#\n\rrepair_amount\x18\t \x01(\x05R\x0crepairAmount\x12%\n\x0erepair_classes\x18\n \x03(\tR\rrepairClasses\x12\x1f\n\x0brepair_cost\x18\x0b \x01(\x01R\nrepairCost\"\xd0\x04\n\x0eUnitDefinition\x12\x0e\n\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12\'\n\x0fmovement_points\x18\x03 \x01(\x05R\x0emovementPoints\x12!\n\x0c\x61ttack_range\x18\x04 \x01(\x05R\x0b\x61ttackRange\x12\x16\n\x06health\x18\x05 \x01(\x05R\x06health\x12\x1e\n\nproperties\x18\x06 \x03(\tR\nproperties\x12\x14\n\x05\x63oins\x18\x07 \x01(\x05R\x05\x63oins\x12\x1f\n\x0b\x63\x61n_capture\x18\x08 \x01(\x08R\ncanCapture\x12\x1f\n\x0bsight_range\x18\t \x01(\x05R\nsightRange\x12\x1d\n\nunit_class\x18\n \x01(\tR\tunitClass\x12\x31\n\x15\x63\x61n_move_after_attack\x18\x0b \x01(\x08R\x12\x63\x61nMoveAfterAttack\x12(\n\x10\x61\x63tions_per_turn\x18\x0c \x01(\x05R\x0e\x61\x63tionsPerTurn\x12(\n\x10min_attack_range\x18\r \x01(\x05R\x0eminAttackRange\x12#\n\rindirect_fire\x18\x0e \x01(\x08R\x0cindirectFire\x12-\n\x12transport_capacity\x18\x0f \x01(\x05R\x11transportCapacity\x12#\n\rcargo_classes\x18\x10 \x03(\tR\x0c\x63\x61rgoClasses\x12\x1f\n\x0bheal_amount\x18\x11 \x01(\x05R\nhealAmount\"\x97\x01\n\rMovementRules\x12.\n\x13pass_through_allies\x18\x01 \x01(\x08R\x11passThroughAllies\x12(\n\x10zoc_unit_classes\x18\x02 \x03(\tR\x0ezocUnitClasses\x12,\n\x12zoc_immune_classes\x18\x03 \x03(\tR\x10zocImmuneClasses\"\x8d\x02\n\x0b\x43ombatRules\x12S\n\x0f\x63lass_modifiers\x18\x01 \x03(\x0b\x32*.weewar.v1.CombatRules.ClassModifiersEntryR\x0e\x63lassModifiers\x12\x45\n\x1fterrain_defense_ignored_classes\x18\x02 \x03(\tR\x1cterrainDefenseIgnoredClasses\x1a\x62\n\x13\x43lassModifiersEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x35\n\x05value\x18\x02 \x01(\x0b\x32\x1f.weewar.v1.ClassDamageModifiersR\x05value:\x02\x38\x01\"\xb0\x01\n\x12TerrainActionRules\x12\x44\n\x07\x61\x63tions\x18\x01 \x03(\x0b\x32*.weewar.v1.TerrainActionRules.ActionsEntryR\x07\x61\x63tions\x1aT\n\x0c\x41\x63tionsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12.\n\x05value\x18\x02 \x01(\x0b\x32\x18.weewar.v1.TerrainActionR\x05value:\x02\x38\x01\"\x9e\x02\n\rTerrainAction\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12U\n\x0fterrain_changes\x18\x03 \x03(\x0b\x32,.weewar.v1.TerrainAction.TerrainChangesEntryR\x0eterrainChanges\x12\x14\n\x05turns\x18\x04 \x01(\x05R\x05turns\x12\x14\n\x05\x63oins\x18\x05 \x01(\x05R\x05\x63oins\x12#\n\runit_property\x18\x06 \x01(\tR\x0cunitProperty\x1a\x41\n\x13TerrainChangesEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x05R\x05value:\x02\x38\x01\"\xbb\x01\n\x14\x43lassDamageModifiers\x12_\n\x10\x64\x65\x66\x65nder_classes\x18\x01 \x03(\x0b\x32\x34.weewar.v1.ClassDamageModifiers.DefenderClassesEntryR\x0f\x64\x65\x66\x65nderClasses\x1a\x42\n\x14\x44\x65\x66\x65nderClassesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x01R\x05value:\x02\x38\x01\"\xa1\x01\n\x0eMovementMatrix\x12:\n\x05\x63osts\x18\x01 \x03(\x0b\x32$.weewar.v1.MovementMatrix.CostsEntryR\x05\x63osts\x1aS\n\nCostsEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12/\n\x05value\x18\x02 \x01(\x0b\x32\x19.weewar.v1.TerrainCostMapR\x05value:\x02\x38\x01\"\xa3\x01\n\x0eTerrainCostMap\x12P\n\rterrain_costs\x18\x01 \x03(\x0b\x32+.weewar.v1.TerrainCostMap.TerrainCostsEntryR\x0cterrainCosts\x1a?\n\x11TerrainCostsEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x01R\x05value:\x02\x38\x01\"\xa8\x01\n\x0c\x41ttackMatrix\x12>\n\x07\x61ttacks\x18\x01 \x03(\x0b\x32$.weewar.v1.AttackMatrix.AttacksEntryR\x07\x61ttacks\x1aX\n\x0c\x41ttacksEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x32\n\x05value\x18\x02 \x01(\x0b\x32\x1c.weewar.v1.DefenderDamageMapR\x05value:\x02\x38\x01\"\xd4\x01\n\x11\x44\x65\x66\x65nderDamageMap\x12\\\n\x10\x64\x65\x66\x65nder_damages\x18\x01 \x03(\x0b\x32\x31.weewar.v1.DefenderDamageMap.DefenderDamagesEntryR\x0f\x64\x65\x66\x65nderDamages\x1a\x61\n\x14\x44\x65\x66\x65nderDamagesEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x33\n\x05value\x18\x02 \x01(\x0b\x32\x1d.weewar.v1.DamageDistributionR\x05value:\x02\x38\x01\"\xbb\x01\n\x12\x44\x61mageDistribution\x12\x1d\n\nmin_damage\x18\x01 \x01(\x05R\tminDamage\x12\x1d\n\nmax_damage\x18\x02 \x01(\x05R\tmaxDamage\x12>\n\x0e\x64\x61mage_buckets\x18\x03 \x03(\x0b\x32\x17.weewar.v1.DamageBucketR\rdamageBuckets\x12\'\n\x0f\x65xpected_damage\x18\x04 \x01(\x01R\x0e\x65xpectedDamage\">\n\x0c\x44\x61mageBucket\x12\x16\n\x06\x64\x61mage\x18\x01 \x01(\x05R\x06\x64\x61mage\x12\x16\n\x06weight\x18\x02 \x01(\x01R\x06weight\"\xf2\x04\n\x07RuleSet\x12\x33\n\x05units\x18\x01 \x03(\x0b\x32\x1d.weewar.v1.RuleSet.UnitsEntryR\x05units\x12<\n\x08terrains\x18\x02 \x03(\x0b\x32 .weewar.v1.RuleSet.TerrainsEntryR\x08terrains\x12\x42\n\x0fmovement_matrix\x18\x03 \x01(\x0b\x32\x19.weewar.v1.MovementMatrixR\x0emovementMatrix\x12<\n\rattack_matrix\x18\x04 \x01(\x0b\x32\x17.weewar.v1.AttackMatrixR\x0c\x61ttackMatrix\x12?\n\x0emovement_rules\x18\x05 \x01(\x0b\x32\x18.weewar.v1.MovementRulesR\rmovementRules\x12\x39\n\x0c\x63ombat_rules\x18\x06 \x01(\x0b\x32\x16.weewar.v1.CombatRulesR\x0b\x63ombatRules\x12\x46\n\x0fterrain_actions\x18\x07 \x01(\x0b\x32\x1d.weewar.v1.TerrainActionRulesR\x0eterrainActions\x1aS\n\nUnitsEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12/\n\x05value\x18\x02 \x01(\x0b\x32\x19.weewar.v1.UnitDefinitionR\x05value:\x02\x38\x01\x1aY\n\rTerrainsEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x32\n\x05value\x18\x02 \x01(\x0b\x32\x1c.weewar.v1.TerrainDefinitionR\x05value:\x02\x38\x01\"\x9e\x03\n\x04Game\x12\x39\n\ncreated_at\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x39\n\nupdated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x0e\n\x02id\x18\x03 \x01(\tR\x02id\x12\x1d\n\ncreator_id\x18\x04 \x01(\tR\tcreatorId\x12\x19\n\x08world_id\x18\x05 \x01(\tR\x07worldId\x12\x12\n\x04name\x18\x06 \x01(\tR\x04name\x12 \n\x0b\x64\x65scription\x18\x07 \x01(\tR\x0b\x64\x65scription\x12\x12\n\x04tags\x18\x08 \x03(\tR\x04tags\x12\x1b\n\timage_url\x18\t \x01(\tR\x08imageUrl\x12\x1e\n\ndifficulty\x18\n \x01(\tR\ndifficulty\x12\x34\n\x06\x63onfig\x18\x0b \x01(\x0b\x32\x1c.weewar.v1.GameConfigurationR\x06\x63onfig\x12\x19\n\x08rules_id\x18\x0c \x01(\tR\x07rulesId\"y\n\x11GameConfiguration\x12/\n\x07players\x18\x01 \x03(\x0b\x32\x15.weewar.v1.GamePlayerR\x07players\x12\x33\n\x08settings\x18\x02 \x01(\x0b\x32\x17.weewar.v1.GameSettingsR\x08settings\"y\n\nGamePlayer\x12\x1b\n\tplayer_id\x18\x01 \x01(\x05R\x08playerId\x12\x1f\n\x0bplayer_type\x18\x02 \x01(\tR\nplayerType\x12\x14\n\x05\x63olor\x18\x03 \x01(\tR\x05\x63olor\x12\x17\n\x07team_id\x18\x04 \x01(\x05R\x06teamId\"\xd6\x02\n\x0cGameSettings\x12#\n\rallowed_units\x18\x01 \x03(\x05R\x0c\x61llowedUnits\x12&\n\x0fturn_time_limit\x18\x02 \x01(\x05R\rturnTimeLimit\x12\x1b\n\tteam_mode\x18\x03 \x01(\tR\x08teamMode\x12\x1b\n\tmax_turns\x18\x04 \x01(\x05R\x08maxTurns\x12-\n\x05\x63oins\x18\x05 \x01(\x0b\x32\x17.weewar.v1.CoinSettingsR\x05\x63oins\x12\x1c\n\nfog_of_war\x18\x06 \x01(\x08R\x08\x66ogOfWar\x12\x34\n\x07victory\x18\x07 \x01(\x0b\x32\x1a.weewar.v1.VictorySettingsR\x07victory\x12<\n\rrules_overlay\x18\x08 \x01(\x0b\x32\x17.weewar.v1.RulesOverlayR\x0crulesOverlay\"\xdf\x02\n\x0cRulesOverlay\x12%\n\x0e\x64isabled_units\x18\x01 \x03(\x05R\rdisabledUnits\x12\x45\n\nunit_coins\x18\x02 \x03(\x0b\x32&.weewar.v1.RulesOverlay.UnitCoinsEntryR\tunitCoins\x12]\n\x12\x64\x61mage_multipliers\x18\x03 \x03(\x0b\x32..weewar.v1.RulesOverlay.DamageMultipliersEntryR\x11\x64\x61mageMultipliers\x1a<\n\x0eUnitCoinsEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x05R\x05value:\x02\x38\x01\x1a\x44\n\x16\x44\x61mageMultipliersEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x01R\x05value:\x02\x38\x01\"\xbb\x01\n\x0fVictorySettings\x12 \n\x0b\x65limination\x18\x01 \x01(\x08R\x0b\x65limination\x12#\n\rcapture_bases\x18\x02 \x01(\x05R\x0c\x63\x61ptureBases\x12\x34\n\x16headquarters_tile_type\x18\x03 \x01(\x05R\x14headquartersTileType\x12+\n\x12score_at_max_turns\x18\x04 \x01(\x08R\x0fscoreAtMaxTurns\"\xac\x01\n\x0fVictoryProgress\x12\x16\n\x06player\x18\x01 \x01(\x05R\x06player\x12\x44\n\x08progress\x18\x02 \x03(\x0b\x32(.weewar.v1.VictoryProgress.ProgressEntryR\x08progress\x1a;\n\rProgressEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x01R\x05value:\x02\x38\x01\"h\n\x0c\x43oinSettings\x12\"\n\rstart_of_game\x18\x01 \x01(\x05R\x0bstartOfGame\x12\x19\n\x08per_turn\x18\x02 \x01(\x05R\x07perTurn\x12\x19\n\x08per_base\x18\x03 \x01(\x05R\x07perBase\"\xb6\x05\n\tGameState\x12\x39\n\nupdated_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x17\n\x07game_id\x18\x03 \x01(\tR\x06gameId\x12!\n\x0cturn_counter\x18\x04 \x01(\x05R\x0bturnCounter\x12%\n\x0e\x63urrent_player\x18\x05 \x01(\x05R\rcurrentPlayer\x12\x33\n\nworld_data\x18\x06 \x01(\x0b\x32\x14.weewar.v1.WorldDataR\tworldData\x12H\n\x0cplayer_coins\x18\x07 \x03(\x0b\x32%.weewar.v1.GameState.PlayerCoinsEntryR\x0bplayerCoins\x12*\n\x11last_sequence_num\x18\x08 \x01(\x03R\x0flastSequenceNum\x12\x19\n\x08rng_seed\x18\t \x01(\x03R\x07rngSeed\x12!\n\x0crng_position\x18\n \x01(\x03R\x0brngPosition\x12\x42\n\x0fturn_started_at\x18\x0b \x01(\x0b\x32\x1a.google.protobuf.TimestampR\rturnStartedAt\x12?\n\rturn_deadline\x18\x0c \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x0cturnDeadline\x12\x16\n\x06winner\x18\r \x01(\x05R\x06winner\x12+\n\x11victory_condition\x18\x0e \x01(\tR\x10victoryCondition\x12\x18\n\x07winners\x18\x0f \x03(\x05R\x07winners\x1a>\n\x10PlayerCoinsEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x05R\x05value:\x02\x38\x01\"\x97\x01\n\x0fGameMoveHistory\x12\x17\n\x07game_id\x18\x01 \x01(\tR\x06gameId\x12\x30\n\x06groups\x18\x02 \x03(\x0b\x32\x18.weewar.v1.GameMoveGroupR\x06groups\x12\x39\n\rinitial_state\x18\x03 \x01(\x0b\x32\x14.weewar.v1.GameStateR\x0cinitialState\"\xea\x01\n\rGameMoveGroup\x12\x39\n\nstarted_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tstartedAt\x12\x35\n\x08\x65nded_at\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x07\x65ndedAt\x12)\n\x05moves\x18\x04 \x03(\x0b\x32\x13.weewar.v1.GameMoveR\x05moves\x12<\n\x0cmove_results\x18\x05 \x03(\x0b\x32\x19.weewar.v1.GameMoveResultR\x0bmoveResults\"\xc6\x05\n\x08GameMove\x12\x16\n\x06player\x18\x01 \x01(\x05R\x06player\x12\x38\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12!\n\x0csequence_num\x18\x03 \x01(\x03R\x0bsequenceNum\x12\x38\n\tmove_unit\x18\x04 \x01(\x0b\x32\x19.weewar.v1.MoveUnitActionH\x00R\x08moveUnit\x12>\n\x0b\x61ttack_unit\x18\x05 \x01(\x0b\x32\x1b.weewar.v1.AttackUnitActionH\x00R\nattackUnit\x12\x35\n\x08\x65nd_turn\x18\x06 \x01(\x0b\x32\x18.weewar.v1.EndTurnActionH\x00R\x07\x65ndTurn\x12;\n\nbuild_unit\x18\x07 \x01(\x0b\x32\x1a.weewar.v1.BuildUnitActionH\x00R\tbuildUnit\x12M\n\x10\x63\x61pture_building\x18\x08 \x01(\x0b\x32 .weewar.v1.CaptureBuildingActionH\x00R\x0f\x63\x61ptureBuilding\x12\x38\n\tload_unit\x18\t \x01(\x0b\x32\x19.weewar.v1.LoadUnitActionH\x00R\x08loadUnit\x12>\n\x0bunload_unit\x18\n \x01(\x0b\x32\x1b.weewar.v1.UnloadUnitActionH\x00R\nunloadUnit\x12\x38\n\theal_unit\x18\x0b \x01(\x0b\x32\x19.weewar.v1.HealUnitActionH\x00R\x08healUnit\x12G\n\x0emodify_terrain\x18\x0c \x01(\x0b\x32\x1e.weewar.v1.ModifyTerrainActionH\x00R\rmodifyTerrainB\x0b\n\tmove_type\"\x88\x01\n\x0eGameMoveResult\x12!\n\x0cis_permanent\x18\x01 \x01(\x08R\x0bisPermanent\x12!\n\x0csequence_num\x18\x02 \x01(\x03R\x0bsequenceNum\x12\x30\n\x07\x63hanges\x18\x03 \x03(\x0b\x32\x16.weewar.v1.WorldChangeR\x07\x63hanges\"&\n\x08HexCoord\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\"\x8d\x01\n\x0eMoveUnitAction\x12\x15\n\x06\x66rom_q\x18\x01 \x01(\x05R\x05\x66romQ\x12\x15\n\x06\x66rom_r\x18\x02 \x01(\x05R\x05\x66romR\x12\x11\n\x04to_q\x18\x03 \x01(\x05R\x03toQ\x12\x11\n\x04to_r\x18\x04 \x01(\x05R\x03toR\x12\'\n\x04path\x18\x05 \x03(\x0b\x32\x13.weewar.v1.HexCoordR\x04path\"\x8e\x01\n\x10\x41ttackUnitAction\x12\x1d\n\nattacker_q\x18\x01 \x01(\x05R\tattackerQ\x12\x1d\n\nattacker_r\x18\x02 \x01(\x05R\tattackerR\x12\x1d\n\ndefender_q\x18\x03 \x01(\x05R\tdefenderQ\x12\x1d\n\ndefender_r\x18\x04 \x01(\x05R\tdefenderR\",\n\rEndTurnAction\x12\x1b\n\ttimed_out\x18\x01 \x01(\x08R\x08timedOut\"J\n\x0f\x42uildUnitAction\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12\x1b\n\tunit_type\x18\x03 \x01(\x05R\x08unitType\"3\n\x15\x43\x61ptureBuildingAction\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\"\x8e\x01\n\x13ModifyTerrainAction\x12\x0c\n\x01q\x18\x01 \x01(\x05R\x01q\x12\x0c\n\x01r\x18\x02 \x01(\x05R\x01r\x12\x19\n\x08target_q\x18\x03 \x01(\x05R\x07targetQ\x12\x19\n\x08target_r\x18\x04 \x01(\x05R\x07targetR\x12%\n\x0eterrain_action\x18\x05 \x01(\tR\rterrainAction\"\x80\x01\n\x0eLoadUnitAction\x12\x15\n\x06unit_q\x18\x01 \x01(\x05R\x05unitQ\x12\x15\n\x06unit_r\x18\x02 \x01(\x05R\x05unitR\x12\x1f\n\x0btransport_q\x18\x03 \x01(\x05R\ntransportQ\x12\x1f\n\x0btransport_r\x18\x04 \x01(\x05R\ntransportR\"\x9b\x01\n\x10UnloadUnitAction\x12\x1f\n\x0btransport_q\x18\x01 \x01(\x05R\ntransportQ\x12\x1f\n\x0btransport_r\x18\x02 \x01(\x05R\ntransportR\x12\x1f\n\x0b\x63\x61rgo_index\x18\x03 \x01(\x05R\ncargoIndex\x12\x11\n\x04to_q\x18\x04 \x01(\x05R\x03toQ\x12\x11\n\x04to_r\x18\x05 \x01(\x05R\x03toR\"|\n\x0eHealUnitAction\x12\x19\n\x08healer_q\x18\x01 \x01(\x05R\x07healerQ\x12\x19\n\x08healer_r\x18\x02 \x01(\x05R\x07healerR\x12\x19\n\x08target_q\x18\x03 \x01(\x05R\x07targetQ\x12\x19\n\x08target_r\x18\x04 \x01(\x05R\x07targetR\"\xfd\x05\n\x0bWorldChange\x12;\n\nunit_moved\x18\x01 \x01(\x0b\x32\x1a.weewar.v1.UnitMovedChangeH\x00R\tunitMoved\x12\x41\n\x0cunit_damaged\x18\x02 \x01(\x0b\x32\x1c.weewar.v1.UnitDamagedChangeH\x00R\x0bunitDamaged\x12>\n\x0bunit_killed\x18\x03 \x01(\x0b\x32\x1b.weewar.v1.UnitKilledChangeH\x00R\nunitKilled\x12G\n\x0eplayer_changed\x18\x04 \x01(\x0b\x32\x1e.weewar.v1.PlayerChangedChangeH\x00R\rplayerChanged\x12\x44\n\rcoins_changed\x18\x05 \x01(\x0b\x32\x1d.weewar.v1.CoinsChangedChangeH\x00R\x0c\x63oinsChanged\x12\x41\n\x0cunit_created\x18\x06 \x01(\x0b\x32\x1c.weewar.v1.UnitCreatedChangeH\x00R\x0bunitCreated\x12\x44\n\rtile_captured\x18\x07 \x01(\x0b\x32\x1d.weewar.v1.TileCapturedChangeH\x00R\x0ctileCaptured\x12>\n\x0bunit_loaded\x18\x08 \x01(\x0b\x32\x1b.weewar.v1.UnitLoadedChangeH\x00R\nunitLoaded\x12\x44\n\runit_unloaded\x18\t \x01(\x0b\x32\x1d.weewar.v1.UnitUnloadedChangeH\x00R\x0cunitUnloaded\x12>\n\x0bunit_healed\x18\n \x01(\x0b\x32\x1b.weewar.v1.UnitHealedChangeH\x00R\nunitHealed\x12\x41\n\x0ctile_changed\x18\x0b \x01(\x0b\x32\x1c.weewar.v1.TileChangedChangeH\x00R\x0btileChangedB\r\n\x0b\x63hange_type\"{\n\x0fUnitMovedChange\x12\x34\n\rprevious_unit\x18\x06 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x0cpreviousUnit\x12\x32\n\x0cupdated_unit\x18\x07 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x0bupdatedUnit\"\xf7\x01\n\x11UnitDamagedChange\x12\x34\n\rprevious_unit\x18\x06 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x0cpreviousUnit\x12\x32\n\x0cupdated_unit\x18\x07 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x0bupdatedUnit\x12<\n\x11previous_attacker\x18\x08 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x10previousAttacker\x12:\n\x10updated_attacker\x18\t \x01(\x0b\x32\x0f.weewar.v1.UnitR\x0fupdatedAttacker\"\xee\x01\n\x10UnitHealedChange\x12\x34\n\rprevious_unit\x18\x01 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x0cpreviousUnit\x12\x32\n\x0cupdated_unit\x18\x02 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x0bupdatedUnit\x12\x38\n\x0fprevious_healer\x18\x03 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x0epreviousHealer\x12\x36\n\x0eupdated_healer\x18\x04 \x01(\x0b\x32\x0f.weewar.v1.UnitR\rupdatedHealer\"H\n\x10UnitKilledChange\x12\x34\n\rprevious_unit\x18\x06 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x0cpreviousUnit\"\xcf\x01\n\x13PlayerChangedChange\x12\'\n\x0fprevious_player\x18\x01 \x01(\x05R\x0epreviousPlayer\x12\x1d\n\nnew_player\x18\x02 \x01(\x05R\tnewPlayer\x12#\n\rprevious_turn\x18\x03 \x01(\x05R\x0cpreviousTurn\x12\x19\n\x08new_turn\x18\x04 \x01(\x05R\x07newTurn\x12\x30\n\x0breset_units\x18\x05 \x03(\x0b\x32\x0f.weewar.v1.UnitR\nresetUnits\"p\n\x12\x43oinsChangedChange\x12\x16\n\x06player\x18\x01 \x01(\x05R\x06player\x12%\n\x0eprevious_coins\x18\x02 \x01(\x05R\rpreviousCoins\x12\x1b\n\tnew_coins\x18\x03 \x01(\x05R\x08newCoins\"8\n\x11UnitCreatedChange\x12#\n\x04unit\x18\x01 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x04unit\"\xe8\x01\n\x12TileCapturedChange\x12\x34\n\rprevious_tile\x18\x01 \x01(\x0b\x32\x0f.weewar.v1.TileR\x0cpreviousTile\x12\x32\n\x0cupdated_tile\x18\x02 \x01(\x0b\x32\x0f.weewar.v1.TileR\x0bupdatedTile\x12\x34\n\rprevious_unit\x18\x03 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x0cpreviousUnit\x12\x32\n\x0cupdated_unit\x18\x04 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x0bupdatedUnit\"\xe7\x01\n\x11TileChangedChange\x12\x34\n\rprevious_tile\x18\x01 \x01(\x0b\x32\x0f.weewar.v1.TileR\x0cpreviousTile\x12\x32\n\x0cupdated_tile\x18\x02 \x01(\x0b\x32\x0f.weewar.v1.TileR\x0bupdatedTile\x12\x34\n\rprevious_unit\x18\x03 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x0cpreviousUnit\x12\x32\n\x0cupdated_unit\x18\x04 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x0bupdatedUnit\"\xb5\x01\n\x10UnitLoadedChange\x12#\n\x04unit\x18\x01 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x04unit\x12>\n\x12previous_transport\x18\x02 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x11previousTransport\x12<\n\x11updated_transport\x18\x03 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x10updatedTransport\"\xb7\x01\n\x12UnitUnloadedChange\x12#\n\x04unit\x18\x01 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x04unit\x12>\n\x12previous_transport\x18\x02 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x11previousTransport\x12<\n\x11updated_transport\x18\x03 \x01(\x0b\x32\x0f.weewar.v1.UnitR\x10updatedTransportB\x9d\x01\n\rcom.weewar.v1B\x0bModelsProtoP\x01Z:github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1\xa2\x02\x03WXX\xaa\x02\tWeewar.V1\xca\x02\tWeewar\\V1\xe2\x02\x15Weewar\\V1\\GPBMetadata\xea\x02\nWeewar::V1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -33,10 +33,34 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'weewar.v1.models_pb2', _glo
 if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'\n\rcom.weewar.v1B\013ModelsProtoP\001Z:github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1\242\002\003WXX\252\002\tWeewar.V1\312\002\tWeewar\\V1\342\002\025Weewar\\V1\\GPBMetadata\352\002\nWeewar::V1'
+  _globals['_COMBATRULES_CLASSMODIFIERSENTRY']._loaded_options = None
+  _globals['_COMBATRULES_CLASSMODIFIERSENTRY']._serialized_options = b'8\001'
+  _globals['_TERRAINACTIONRULES_ACTIONSENTRY']._loaded_options = None
+  _globals['_TERRAINACTIONRULES_ACTIONSENTRY']._serialized_options = b'8\001'
+  _globals['_TERRAINACTION_TERRAINCHANGESENTRY']._loaded_options = None
+  _globals['_TERRAINACTION_TERRAINCHANGESENTRY']._serialized_options = b'8\001'
+  _globals['_CLASSDAMAGEMODIFIERS_DEFENDERCLASSESENTRY']._loaded_options = None
+  _globals['_CLASSDAMAGEMODIFIERS_DEFENDERCLASSESENTRY']._serialized_options = b'8\001'
   _globals['_MOVEMENTMATRIX_COSTSENTRY']._loaded_options = None
   _globals['_MOVEMENTMATRIX_COSTSENTRY']._serialized_options = b'8\001'
   _globals['_TERRAINCOSTMAP_TERRAINCOSTSENTRY']._loaded_options = None
   _globals['_TERRAINCOSTMAP_TERRAINCOSTSENTRY']._serialized_options = b'8\001'
+  _globals['_ATTACKMATRIX_ATTACKSENTRY']._loaded_options = None
+  _globals['_ATTACKMATRIX_ATTACKSENTRY']._serialized_options = b'8\001'
+  _globals['_DEFENDERDAMAGEMAP_DEFENDERDAMAGESENTRY']._loaded_options = None
+  _globals['_DEFENDERDAMAGEMAP_DEFENDERDAMAGESENTRY']._serialized_options = b'8\001'
+  _globals['_RULESET_UNITSENTRY']._loaded_options = None
+  _globals['_RULESET_UNITSENTRY']._serialized_options = b'8\001'
+  _globals['_RULESET_TERRAINSENTRY']._loaded_options = None
+  _globals['_RULESET_TERRAINSENTRY']._serialized_options = b'8\001'
+  _globals['_RULESOVERLAY_UNITCOINSENTRY']._loaded_options = None
+  _globals['_RULESOVERLAY_UNITCOINSENTRY']._serialized_options = b'8\001'
+  _globals['_RULESOVERLAY_DAMAGEMULTIPLIERSENTRY']._loaded_options = None
+  _globals['_RULESOVERLAY_DAMAGEMULTIPLIERSENTRY']._serialized_options = b'8\001'
+  _globals['_VICTORYPROGRESS_PROGRESSENTRY']._loaded_options = None
+  _globals['_VICTORYPROGRESS_PROGRESSENTRY']._serialized_options = b'8\001'
+  _globals['_GAMESTATE_PLAYERCOINSENTRY']._loaded_options = None
+  _globals['_GAMESTATE_PLAYERCOINSENTRY']._serialized_options = b'8\001'
   _globals['_USER']._serialized_start=71
   _globals['_USER']._serialized_end=346
   _globals['_PAGINATION']._serialized_start=348
@@ -47,54 +71,134 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_WORLD']._serialized_end=977
   _globals['_WORLDDATA']._serialized_start=979
   _globals['_WORLDDATA']._serialized_end=1068
-  _globals['_TILE']._serialized_start=1070
-  _globals['_TILE']._serialized_end=1157
-  _globals['_UNIT']._serialized_start=1160
-  _globals['_UNIT']._serialized_end=1362
-  _globals['_TERRAINDEFINITION']._serialized_start=1365
-  _globals['_TERRAINDEFINITION']._serialized_end=1549
-  _globals['_UNITDEFINITION']._serialized_start=1552
-  _globals['_UNITDEFINITION']._serialized_end=1736
-  _globals['_MOVEMENTMATRIX']._serialized_start=1739
-  _globals['_MOVEMENTMATRIX']._serialized_end=1900
-  _globals['_MOVEMENTMATRIX_COSTSENTRY']._serialized_start=1817
-  _globals['_MOVEMENTMATRIX_COSTSENTRY']._serialized_end=1900
-  _globals['_TERRAINCOSTMAP']._serialized_start=1903
-  _globals['_TERRAINCOSTMAP']._serialized_end=2066
-  _globals['_TERRAINCOSTMAP_TERRAINCOSTSENTRY']._serialized_start=2003
-  _globals['_TERRAINCOSTMAP_TERRAINCOSTSENTRY']._serialized_end=2066
-  _globals['_GAME']._serialized_start=2069
-  _globals['_GAME']._serialized_end=2456
-  _globals['_GAMECONFIGURATION']._serialized_start=2458
-  _globals['_GAMECONFIGURATION']._serialized_end=2579
-  _globals['_GAMEPLAYER']._serialized_start=2581
-  _globals['_GAMEPLAYER']._serialized_end=2702
-  _globals['_GAMESETTINGS']._serialized_start=2705
-  _globals['_GAMESETTINGS']._serialized_end=2854
-  _globals['_GAMESTATE']._serialized_start=2857
-  _globals['_GAMESTATE']._serialized_end=3079
-  _globals['_GAMEMOVEHISTORY']._serialized_start=3081
-  _globals['_GAMEMOVEHISTORY']._serialized_end=3173
-  _globals['_GAMEMOVEGROUP']._serialized_start=3176
-  _globals['_GAMEMOVEGROUP']._serialized_end=3410
-  _globals['_GAMEMOVE']._serialized_start=3413
-  _globals['_GAMEMOVE']._serialized_end=3730
-  _globals['_GAMEMOVERESULT']._serialized_start=3733
-  _globals['_GAMEMOVERESULT']._serialized_end=3869
-  _globals['_MOVEUNITACTION']._serialized_start=3871
-  _globals['_MOVEUNITACTION']._serialized_end=3971
-  _globals['_ATTACKUNITACTION']._serialized_start=3974
-  _globals['_ATTACKUNITACTION']._serialized_end=4116
-  _globals['_ENDTURNACTION']._serialized_start=4118
-  _globals['_ENDTURNACTION']._serialized_end=4133
-  _globals['_WORLDCHANGE']._serialized_start=4136
-  _globals['_WORLDCHANGE']._serialized_end=4429
-  _globals['_UNITMOVEDCHANGE']._serialized_start=4431
-  _globals['_UNITMOVEDCHANGE']._serialized_end=4554
-  _globals['_UNITDAMAGEDCHANGE']._serialized_start=4556
-  _globals['_UNITDAMAGEDCHANGE']._serialized_end=4681
-  _globals['_UNITKILLEDCHANGE']._serialized_start=4683
-  _globals['_UNITKILLEDCHANGE']._serialized_end=4755
-  _globals['_PLAYERCHANGEDCHANGE']._serialized_start=4758
-  _globals['_PLAYERCHANGEDCHANGE']._serialized_end=4965
+  _globals['_TILE']._serialized_start=1071
+  _globals['_TILE']._serialized_end=1335
+  _globals['_UNIT']._serialized_start=1338
+  _globals['_UNIT']._serialized_end=1688
+  _globals['_TERRAINDEFINITION']._serialized_start=1691
+  _globals['_TERRAINDEFINITION']._serialized_end=2062
+  _globals['_UNITDEFINITION']._serialized_start=2065
+  _globals['_UNITDEFINITION']._serialized_end=2657
+  _globals['_MOVEMENTRULES']._serialized_start=2660
+  _globals['_MOVEMENTRULES']._serialized_end=2811
+  _globals['_COMBATRULES']._serialized_start=2814
+  _globals['_COMBATRULES']._serialized_end=3083
+  _globals['_COMBATRULES_CLASSMODIFIERSENTRY']._serialized_start=2985
+  _globals['_COMBATRULES_CLASSMODIFIERSENTRY']._serialized_end=3083
+  _globals['_TERRAINACTIONRULES']._serialized_start=3086
+  _globals['_TERRAINACTIONRULES']._serialized_end=3262
+  _globals['_TERRAINACTIONRULES_ACTIONSENTRY']._serialized_start=3178
+  _globals['_TERRAINACTIONRULES_ACTIONSENTRY']._serialized_end=3262
+  _globals['_TERRAINACTION']._serialized_start=3265
+  _globals['_TERRAINACTION']._serialized_end=3551
+  _globals['_TERRAINACTION_TERRAINCHANGESENTRY']._serialized_start=3486
+  _globals['_TERRAINACTION_TERRAINCHANGESENTRY']._serialized_end=3551
+  _globals['_CLASSDAMAGEMODIFIERS']._serialized_start=3554
+  _globals['_CLASSDAMAGEMODIFIERS']._serialized_end=3741
+  _globals['_CLASSDAMAGEMODIFIERS_DEFENDERCLASSESENTRY']._serialized_start=3675
+  _globals['_CLASSDAMAGEMODIFIERS_DEFENDERCLASSESENTRY']._serialized_end=3741
+  _globals['_MOVEMENTMATRIX']._serialized_start=3744
+  _globals['_MOVEMENTMATRIX']._serialized_end=3905
+  _globals['_MOVEMENTMATRIX_COSTSENTRY']._serialized_start=3822
+  _globals['_MOVEMENTMATRIX_COSTSENTRY']._serialized_end=3905
+  _globals['_TERRAINCOSTMAP']._serialized_start=3908
+  _globals['_TERRAINCOSTMAP']._serialized_end=4071
+  _globals['_TERRAINCOSTMAP_TERRAINCOSTSENTRY']._serialized_start=4008
+  _globals['_TERRAINCOSTMAP_TERRAINCOSTSENTRY']._serialized_end=4071
+  _globals['_ATTACKMATRIX']._serialized_start=4074
+  _globals['_ATTACKMATRIX']._serialized_end=4242
+  _globals['_ATTACKMATRIX_ATTACKSENTRY']._serialized_start=4154
+  _globals['_ATTACKMATRIX_ATTACKSENTRY']._serialized_end=4242
+  _globals['_DEFENDERDAMAGEMAP']._serialized_start=4245
+  _globals['_DEFENDERDAMAGEMAP']._serialized_end=4457
+  _globals['_DEFENDERDAMAGEMAP_DEFENDERDAMAGESENTRY']._serialized_start=4360
+  _globals['_DEFENDERDAMAGEMAP_DEFENDERDAMAGESENTRY']._serialized_end=4457
+  _globals['_DAMAGEDISTRIBUTION']._serialized_start=4460
+  _globals['_DAMAGEDISTRIBUTION']._serialized_end=4647
+  _globals['_DAMAGEBUCKET']._serialized_start=4649
+  _globals['_DAMAGEBUCKET']._serialized_end=4711
+  _globals['_RULESET']._serialized_start=4714
+  _globals['_RULESET']._serialized_end=5340
+  _globals['_RULESET_UNITSENTRY']._serialized_start=5166
+  _globals['_RULESET_UNITSENTRY']._serialized_end=5249
+  _globals['_RULESET_TERRAINSENTRY']._serialized_start=5251
+  _globals['_RULESET_TERRAINSENTRY']._serialized_end=5340
+  _globals['_GAME']._serialized_start=5343
+  _globals['_GAME']._serialized_end=5757
+  _globals['_GAMECONFIGURATION']._serialized_start=5759
+  _globals['_GAMECONFIGURATION']._serialized_end=5880
+  _globals['_GAMEPLAYER']._serialized_start=5882
+  _globals['_GAMEPLAYER']._serialized_end=6003
+  _globals['_GAMESETTINGS']._serialized_start=6006
+  _globals['_GAMESETTINGS']._serialized_end=6348
+  _globals['_RULESOVERLAY']._serialized_start=6351
+  _globals['_RULESOVERLAY']._serialized_end=6702
+  _globals['_RULESOVERLAY_UNITCOINSENTRY']._serialized_start=6572
+  _globals['_RULESOVERLAY_UNITCOINSENTRY']._serialized_end=6632
+  _globals['_RULESOVERLAY_DAMAGEMULTIPLIERSENTRY']._serialized_start=6634
+  _globals['_RULESOVERLAY_DAMAGEMULTIPLIERSENTRY']._serialized_end=6702
+  _globals['_VICTORYSETTINGS']._serialized_start=6705
+  _globals['_VICTORYSETTINGS']._serialized_end=6892
+  _globals['_VICTORYPROGRESS']._serialized_start=6895
+  _globals['_VICTORYPROGRESS']._serialized_end=7067
+  _globals['_VICTORYPROGRESS_PROGRESSENTRY']._serialized_start=7008
+  _globals['_VICTORYPROGRESS_PROGRESSENTRY']._serialized_end=7067
+  _globals['_COINSETTINGS']._serialized_start=7069
+  _globals['_COINSETTINGS']._serialized_end=7173
+  _globals['_GAMESTATE']._serialized_start=7176
+  _globals['_GAMESTATE']._serialized_end=7870
+  _globals['_GAMESTATE_PLAYERCOINSENTRY']._serialized_start=7808
+  _globals['_GAMESTATE_PLAYERCOINSENTRY']._serialized_end=7870
+  _globals['_GAMEMOVEHISTORY']._serialized_start=7873
+  _globals['_GAMEMOVEHISTORY']._serialized_end=8024
+  _globals['_GAMEMOVEGROUP']._serialized_start=8027
+  _globals['_GAMEMOVEGROUP']._serialized_end=8261
+  _globals['_GAMEMOVE']._serialized_start=8264
+  _globals['_GAMEMOVE']._serialized_end=8974
+  _globals['_GAMEMOVERESULT']._serialized_start=8977
+  _globals['_GAMEMOVERESULT']._serialized_end=9113
+  _globals['_HEXCOORD']._serialized_start=9115
+  _globals['_HEXCOORD']._serialized_end=9153
+  _globals['_MOVEUNITACTION']._serialized_start=9156
+  _globals['_MOVEUNITACTION']._serialized_end=9297
+  _globals['_ATTACKUNITACTION']._serialized_start=9300
+  _globals['_ATTACKUNITACTION']._serialized_end=9442
+  _globals['_ENDTURNACTION']._serialized_start=9444
+  _globals['_ENDTURNACTION']._serialized_end=9488
+  _globals['_BUILDUNITACTION']._serialized_start=9490
+  _globals['_BUILDUNITACTION']._serialized_end=9564
+  _globals['_CAPTUREBUILDINGACTION']._serialized_start=9566
+  _globals['_CAPTUREBUILDINGACTION']._serialized_end=9617
+  _globals['_MODIFYTERRAINACTION']._serialized_start=9620
+  _globals['_MODIFYTERRAINACTION']._serialized_end=9762
+  _globals['_LOADUNITACTION']._serialized_start=9765
+  _globals['_LOADUNITACTION']._serialized_end=9893
+  _globals['_UNLOADUNITACTION']._serialized_start=9896
+  _globals['_UNLOADUNITACTION']._serialized_end=10051
+  _globals['_HEALUNITACTION']._serialized_start=10053
+  _globals['_HEALUNITACTION']._serialized_end=10177
+  _globals['_WORLDCHANGE']._serialized_start=10180
+  _globals['_WORLDCHANGE']._serialized_end=10945
+  _globals['_UNITMOVEDCHANGE']._serialized_start=10947
+  _globals['_UNITMOVEDCHANGE']._serialized_end=11070
+  _globals['_UNITDAMAGEDCHANGE']._serialized_start=11073
+  _globals['_UNITDAMAGEDCHANGE']._serialized_end=11320
+  _globals['_UNITHEALEDCHANGE']._serialized_start=11323
+  _globals['_UNITHEALEDCHANGE']._serialized_end=11561
+  _globals['_UNITKILLEDCHANGE']._serialized_start=11563
+  _globals['_UNITKILLEDCHANGE']._serialized_end=11635
+  _globals['_PLAYERCHANGEDCHANGE']._serialized_start=11638
+  _globals['_PLAYERCHANGEDCHANGE']._serialized_end=11845
+  _globals['_COINSCHANGEDCHANGE']._serialized_start=11847
+  _globals['_COINSCHANGEDCHANGE']._serialized_end=11959
+  _globals['_UNITCREATEDCHANGE']._serialized_start=11961
+  _globals['_UNITCREATEDCHANGE']._serialized_end=12017
+  _globals['_TILECAPTUREDCHANGE']._serialized_start=12020
+  _globals['_TILECAPTUREDCHANGE']._serialized_end=12252
+  _globals['_TILECHANGEDCHANGE']._serialized_start=12255
+  _globals['_TILECHANGEDCHANGE']._serialized_end=12486
+  _globals['_UNITLOADEDCHANGE']._serialized_start=12489
+  _globals['_UNITLOADEDCHANGE']._serialized_end=12670
+  _globals['_UNITUNLOADEDCHANGE']._serialized_start=12673
+  _globals['_UNITUNLOADEDCHANGE']._serialized_end=12856
 # @@protoc_insertion_point(module_scope)
@@ -0,0 +1,57 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# NO CHECKED-IN PROTOBUF GENCODE
+# source: weewar/v1/rules.proto
+# Protobuf Python Version: 6.31.1
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import descriptor_pool as _descriptor_pool
+from google.protobuf import runtime_version as _runtime_version
+from google.protobuf import symbol_database as _symbol_database
+from google.protobuf.internal import builder as _builder
+_runtime_version.ValidateProtobufRuntimeVersion(
+    _runtime_version.Domain.PUBLIC,
+    6,
+    31,
+    1,
+    '',
+    'weewar/v1/rules.proto'
+)
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+from weewar.v1 import models_pb2 as weewar_dot_v1_dot_models__pb2
+from google.api import annotations_pb2 as google_dot_api_dot_annotations__pb2
+
+
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x15weewar/v1/rules.proto\x12\tweewar.v1\x1a\x16weewar/v1/models.proto\x1a\x1cgoogle/api/annotations.proto\",\n\x0fGetRulesRequest\x12\x19\n\x08rules_id\x18\x01 \x01(\tR\x07rulesId\"W\n\x10GetRulesResponse\x12\x19\n\x08rules_id\x18\x01 \x01(\tR\x07rulesId\x12(\n\x05rules\x18\x02 \x01(\x0b\x32\x12.weewar.v1.RuleSetR\x05rules\"D\n\x0eGetUnitRequest\x12\x19\n\x08rules_id\x18\x01 \x01(\tR\x07rulesId\x12\x17\n\x07unit_id\x18\x02 \x01(\x05R\x06unitId\"\xd5\x01\n\x0fGetUnitResponse\x12\x19\n\x08rules_id\x18\x01 \x01(\tR\x07rulesId\x12-\n\x04unit\x18\x02 \x01(\x0b\x32\x19.weewar.v1.UnitDefinitionR\x04unit\x12@\n\x0emovement_costs\x18\x03 \x01(\x0b\x32\x19.weewar.v1.TerrainCostMapR\rmovementCosts\x12\x36\n\x07\x61ttacks\x18\x04 \x01(\x0b\x32\x1c.weewar.v1.DefenderDamageMapR\x07\x61ttacks\"t\n\x15GetCombatTableRequest\x12\x19\n\x08rules_id\x18\x01 \x01(\tR\x07rulesId\x12\x1f\n\x0b\x61ttacker_id\x18\x02 \x01(\x05R\nattackerId\x12\x1f\n\x0b\x64\x65\x66\x65nder_id\x18\x03 \x01(\x05R\ndefenderId\"q\n\x16GetCombatTableResponse\x12\x19\n\x08rules_id\x18\x01 \x01(\tR\x07rulesId\x12<\n\rattack_matrix\x18\x02 \x01(\x0b\x32\x17.weewar.v1.AttackMatrixR\x0c\x61ttackMatrix2\xbc\x02\n\x0cRulesService\x12V\n\x08GetRules\x12\x1a.weewar.v1.GetRulesRequest\x1a\x1b.weewar.v1.GetRulesResponse\"\x11\x82\xd3\xe4\x93\x02\x0b\x12\t/v1/rules\x12\x63\n\x07GetUnit\x12\x19.weewar.v1.GetUnitRequest\x1a\x1a.weewar.v1.GetUnitResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/rules/units/{unit_id}\x12o\n\x0eGetCombatTable\x12 .weewar.v1.GetCombatTableRequest\x1a!.weewar.v1.GetCombatTableResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/rules/combatB\x9c\x01\n\rcom.weewar.v1B\nRulesProtoP\x01Z:github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1\xa2\x02\x03WXX\xaa\x02\tWeewar.V1\xca\x02\tWeewar\\V1\xe2\x02\x15Weewar\\V1\\GPBMetadata\xea\x02\nWeewar::V1b\x06proto3')
+
+_globals = globals()
+_builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
+_builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'weewar.v1.rules_pb2', _globals)
+if not _descriptor._USE_C_DESCRIPTORS:
+  _globals['DESCRIPTOR']._loaded_options = None
+  _globals['DESCRIPTOR']._serialized_options = b'\n\rcom.weewar.v1B\nRulesProtoP\001Z:github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1\242\002\003WXX\252\002\tWeewar.V1\312\002\tWeewar\\V1\342\002\025Weewar\\V1\\GPBMetadata\352\002\nWeewar::V1'
+  _globals['_RULESSERVICE'].methods_by_name['GetRules']._loaded_options = None
+  _globals['_RULESSERVICE'].methods_by_name['GetRules']._serialized_options = b'\202\323\344\223\002\013\022\t/v1/rules'
+  _globals['_RULESSERVICE'].methods_by_name['GetUnit']._loaded_options = None
+  _globals['_RULESSERVICE'].methods_by_name['GetUnit']._serialized_options = b'\202\323\344\223\002\033\022\031/v1/rules/units/{unit_id}'
+  _globals['_RULESSERVICE'].methods_by_name['GetCombatTable']._loaded_options = None
+  _globals['_RULESSERVICE'].methods_by_name['GetCombatTable']._serialized_options = b'\202\323\344\223\002\022\022\020/v1/rules/combat'
+  _globals['_GETRULESREQUEST']._serialized_start=90
+  _globals['_GETRULESREQUEST']._serialized_end=134
+  _globals['_GETRULESRESPONSE']._serialized_start=136
+  _globals['_GETRULESRESPONSE']._serialized_end=223
+  _globals['_GETUNITREQUEST']._serialized_start=225
+  _globals['_GETUNITREQUEST']._serialized_end=293
+  _globals['_GETUNITRESPONSE']._serialized_start=296
+  _globals['_GETUNITRESPONSE']._serialized_end=509
+  _globals['_GETCOMBATTABLEREQUEST']._serialized_start=511
+  _globals['_GETCOMBATTABLEREQUEST']._serialized_end=627
+  _globals['_GETCOMBATTABLERESPONSE']._serialized_start=629
+  _globals['_GETCOMBATTABLERESPONSE']._serialized_end=742
+  _globals['_RULESSERVICE']._serialized_start=745
+  _globals['_RULESSERVICE']._serialized_end=1061
+# @@protoc_insertion_point(module_scope)
@@ -20,6 +20,7 @@ import (
 // Weewar_v1_servicesServicesExports provides WASM exports for dependency injection
 type Weewar_v1_servicesServicesExports struct {
 	GamesService  weewarv1.GamesServiceServer
+	RulesService  weewarv1.RulesServiceServer
 	UsersService  weewarv1.UsersServiceServer
 	WorldsService weewarv1.WorldsServiceServer
 }
@@ -60,6 +61,26 @@ func (exports *Weewar_v1_servicesServicesExports) RegisterAPI() {
 			"getOptionsAt": js.FuncOf(func(this js.Value, args []js.Value) any {
 				return exports.gamesServiceGetOptionsAt(this, args)
 			}),
+			"getPath": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gamesServiceGetPath(this, args)
+			}),
+			"verifyGame": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gamesServiceVerifyGame(this, args)
+			}),
+			"undoMoves": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.gamesServiceUndoMoves(this, args)
+			}),
+		},
+		"rulesService": map[string]interface{}{
+			"getRules": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.rulesServiceGetRules(this, args)
+			}),
+			"getUnit": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.rulesServiceGetUnit(this, args)
+			}),
+			"getCombatTable": js.FuncOf(func(this js.Value, args []js.Value) any {
+				return exports.rulesServiceGetCombatTable(this, args)
+			}),
 		},
 		"usersService": map[string]interface{}{
 			"createUser": js.FuncOf(func(this js.Value, args []js.Value) any {
@@ -601,6 +622,300 @@ func (exports *Weewar_v1_servicesServicesExports) gamesServiceGetOptionsAt(this
 	return createJSResponse(true, "Success", json.RawMessage(responseJSON))
 }
 
+// gamesServiceGetPath handles the GetPath method for GamesService
+func (exports *Weewar_v1_servicesServicesExports) gamesServiceGetPath(this js.Value, args []js.Value) any {
+	if exports.GamesService == nil {
+		return createJSResponse(false, "GamesService not initialized", nil)
+	}
+
+	if len(args) < 1 {
+		return createJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return createJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &weewarv1.GetPathRequest{}
+	opts := protojson.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}
+	if err := opts.Unmarshal([]byte(requestJSON), req); err != nil {
+		return createJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.GamesService.GetPath(ctx, req)
+	if err != nil {
+		return createJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	marshalOpts := protojson.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: false, // Don't emit zero values
+		UseEnumNumbers:  false, // Use enum string values
+	}
+	responseJSON, err := marshalOpts.Marshal(resp)
+	if err != nil {
+		return createJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return createJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// gamesServiceVerifyGame handles the VerifyGame method for GamesService
+func (exports *Weewar_v1_servicesServicesExports) gamesServiceVerifyGame(this js.Value, args []js.Value) any {
+	if exports.GamesService == nil {
+		return createJSResponse(false, "GamesService not initialized", nil)
+	}
+
+	if len(args) < 1 {
+		return createJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return createJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &weewarv1.VerifyGameRequest{}
+	opts := protojson.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}
+	if err := opts.Unmarshal([]byte(requestJSON), req); err != nil {
+		return createJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.GamesService.VerifyGame(ctx, req)
+	if err != nil {
+		return createJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	marshalOpts := protojson.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: false, // Don't emit zero values
+		UseEnumNumbers:  false, // Use enum string values
+	}
+	responseJSON, err := marshalOpts.Marshal(resp)
+	if err != nil {
+		return createJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return createJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// gamesServiceUndoMoves handles the UndoMoves method for GamesService
+func (exports *Weewar_v1_servicesServicesExports) gamesServiceUndoMoves(this js.Value, args []js.Value) any {
+	if exports.GamesService == nil {
+		return createJSResponse(false, "GamesService not initialized", nil)
+	}
+
+	if len(args) < 1 {
+		return createJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return createJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &weewarv1.UndoMovesRequest{}
+	opts := protojson.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}
+	if err := opts.Unmarshal([]byte(requestJSON), req); err != nil {
+		return createJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.GamesService.UndoMoves(ctx, req)
+	if err != nil {
+		return createJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	marshalOpts := protojson.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: false, // Don't emit zero values
+		UseEnumNumbers:  false, // Use enum string values
+	}
+	responseJSON, err := marshalOpts.Marshal(resp)
+	if err != nil {
+		return createJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return createJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// rulesServiceGetRules handles the GetRules method for RulesService
+func (exports *Weewar_v1_servicesServicesExports) rulesServiceGetRules(this js.Value, args []js.Value) any {
+	if exports.RulesService == nil {
+		return createJSResponse(false, "RulesService not initialized", nil)
+	}
+
+	if len(args) < 1 {
+		return createJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return createJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &weewarv1.GetRulesRequest{}
+	opts := protojson.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}
+	if err := opts.Unmarshal([]byte(requestJSON), req); err != nil {
+		return createJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.RulesService.GetRules(ctx, req)
+	if err != nil {
+		return createJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	marshalOpts := protojson.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: false, // Don't emit zero values
+		UseEnumNumbers:  false, // Use enum string values
+	}
+	responseJSON, err := marshalOpts.Marshal(resp)
+	if err != nil {
+		return createJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return createJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// rulesServiceGetUnit handles the GetUnit method for RulesService
+func (exports *Weewar_v1_servicesServicesExports) rulesServiceGetUnit(this js.Value, args []js.Value) any {
+	if exports.RulesService == nil {
+		return createJSResponse(false, "RulesService not initialized", nil)
+	}
+
+	if len(args) < 1 {
+		return createJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return createJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &weewarv1.GetUnitRequest{}
+	opts := protojson.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}
+	if err := opts.Unmarshal([]byte(requestJSON), req); err != nil {
+		return createJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.RulesService.GetUnit(ctx, req)
+	if err != nil {
+		return createJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	marshalOpts := protojson.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: false, // Don't emit zero values
+		UseEnumNumbers:  false, // Use enum string values
+	}
+	responseJSON, err := marshalOpts.Marshal(resp)
+	if err != nil {
+		return createJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return createJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
+// rulesServiceGetCombatTable handles the GetCombatTable method for RulesService
+func (exports *Weewar_v1_servicesServicesExports) rulesServiceGetCombatTable(this js.Value, args []js.Value) any {
+	if exports.RulesService == nil {
+		return createJSResponse(false, "RulesService not initialized", nil)
+	}
+
+	if len(args) < 1 {
+		return createJSResponse(false, "Request JSON required", nil)
+	}
+
+	requestJSON := args[0].String()
+	if requestJSON == "" {
+		return createJSResponse(false, "Request JSON is empty", nil)
+	}
+
+	// Parse request
+	req := &weewarv1.GetCombatTableRequest{}
+	opts := protojson.UnmarshalOptions{
+		DiscardUnknown: true,
+		AllowPartial:   true, // Allow partial messages for better compatibility
+	}
+	if err := opts.Unmarshal([]byte(requestJSON), req); err != nil {
+		return createJSResponse(false, fmt.Sprintf("Failed to parse request: %v", err), nil)
+	}
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Call service method
+	resp, err := exports.RulesService.GetCombatTable(ctx, req)
+	if err != nil {
+		return createJSResponse(false, fmt.Sprintf("Service call failed: %v", err), nil)
+	}
+
+	// Marshal response with options for better TypeScript compatibility
+	marshalOpts := protojson.MarshalOptions{
+		UseProtoNames:   false, // Use JSON names (camelCase) instead of proto names
+		EmitUnpopulated: false, // Don't emit zero values
+		UseEnumNumbers:  false, // Use enum string values
+	}
+	responseJSON, err := marshalOpts.Marshal(resp)
+	if err != nil {
+		return createJSResponse(false, fmt.Sprintf("Failed to marshal response: %v", err), nil)
+	}
+
+	return createJSResponse(true, "Success", json.RawMessage(responseJSON))
+}
+
 // usersServiceCreateUser handles the CreateUser method for UsersService
 func (exports *Weewar_v1_servicesServicesExports) usersServiceCreateUser(this js.Value, args []js.Value) any {
 	if exports.UsersService == nil {
@@ -1,6 +1,7 @@
 package ai
 
 import (
+	"fmt"
 	"time"
 
 	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
@@ -102,10 +103,11 @@ type MoveSuggestions struct {
 // MoveProposal represents a specific move recommendation
 type MoveProposal struct {
 	// Move identification
-	Action ActionType      `json:"action"` // Type of action
-	UnitID int             `json:"unitID"` // Unit to act with
-	From   weewar.Position `json:"from"`   // Current position
-	To     weewar.Position `json:"to"`     // Target position
+	Action   ActionType      `json:"action"`   // Type of action
+	UnitID   int             `json:"unitID"`   // Unit to act with
+	UnitType int32           `json:"unitType"` // Unit type to build for ActionCreateUnit
+	From     weewar.Position `json:"from"`     // Current position
+	To       weewar.Position `json:"to"`       // Target position
 
 	// Move evaluation
 	Priority float64 `json:"priority"` // Move quality score (0-1)
@@ -117,6 +119,27 @@ type MoveProposal struct {
 	Category MoveCategory `json:"category"` // Strategic category
 }
 
+// ToGameMove converts the proposal into a move the given player can submit to the game
+func (p *MoveProposal) ToGameMove(playerID int32) (*v1.GameMove, error) {
+	move := &v1.GameMove{Player: playerID}
+	fromQ, fromR, toQ, toR := int32(p.From.Q), int32(p.From.R), int32(p.To.Q), int32(p.To.R)
+	switch p.Action {
+	case ActionMove:
+		move.MoveType = &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{FromQ: fromQ, FromR: fromR, ToQ: toQ, ToR: toR}}
+	case ActionAttack:
+		move.MoveType = &v1.GameMove_AttackUnit{AttackUnit: &v1.AttackUnitAction{AttackerQ: fromQ, AttackerR: fromR, DefenderQ: toQ, DefenderR: toR}}
+	case ActionCreateUnit:
+		move.MoveType = &v1.GameMove_BuildUnit{BuildUnit: &v1.BuildUnitAction{Q: toQ, R: toR, UnitType: p.UnitType}}
+	case ActionCapture:
+		move.MoveType = &v1.GameMove_CaptureBuilding{CaptureBuilding: &v1.CaptureBuildingAction{Q: toQ, R: toR}}
+	case ActionEndTurn:
+		move.MoveType = &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}
+	default:
+		return nil, fmt.Errorf("cannot convert %s proposal to a move", p.Action)
+	}
+	return move, nil
+}
+
 // ActionType represents the type of action being proposed
 type ActionType int
 
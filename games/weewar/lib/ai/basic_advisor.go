@@ -172,7 +172,17 @@ func (ba *BasicAIAdvisor) generateAllValidMoves(game *weewar.Game, playerID int3
 			moves = append(moves, attackMoves...)
 		}
 
-		// TODO: Generate other move types (create unit, capture, repair)
+		// Generate capture moves
+		if captureMoves, err := ba.generateCaptureMoves(game, unit); err == nil {
+			moves = append(moves, captureMoves...)
+		}
+
+		// TODO: Generate other move types (repair)
+	}
+
+	// Generate unit production on the player's bases
+	if buildMoves, err := ba.generateBuildMoves(game, playerID); err == nil {
+		moves = append(moves, buildMoves...)
 	}
 
 	// Always include end turn as an option
@@ -209,11 +219,101 @@ func (ba *BasicAIAdvisor) generateMovementMoves(game *weewar.Game, unit *v1.Unit
 // generateAttackMoves creates attack proposals for a specific unit
 func (ba *BasicAIAdvisor) generateAttackMoves(game *weewar.Game, unit *v1.Unit) ([]*MoveProposal, error) {
 	moves := make([]*MoveProposal, 0)
+	if unit.ActionsRemaining <= 0 {
+		return moves, nil
+	}
+
+	targets, err := game.GetUnitAttackOptions(unit)
+	if err != nil {
+		return moves, err
+	}
 
-	// Get valid attack targets (this would use the existing game methods)
-	// For now, we'll generate some basic attacks
-	// TODO: Implement proper integration with game.GetUnitAttackOptions(unit)
+	from := weewar.UnitGetCoord(unit)
+	for _, coord := range targets {
+		target := game.World.UnitAt(coord)
+		if target == nil {
+			continue
+		}
+		outcome, err := game.SimulateCombat(unit, target)
+		if err != nil {
+			continue
+		}
+
+		value := ba.attackValue(game, unit, target, outcome)
+		moves = append(moves, &MoveProposal{
+			Action:   ActionAttack,
+			UnitID:   -1,
+			From:     from,
+			To:       coord,
+			Priority: math.Max(0, math.Min(1, 0.5+value)),
+			Risk:     outcome.LossProbability,
+			Value:    value,
+			Reason: fmt.Sprintf("Attack %s with %s (%.0f%% kill, %.0f%% loss)", ba.getUnitName(target), ba.getUnitName(unit),
+				outcome.KillProbability*100, outcome.LossProbability*100),
+			Category: CategoryOffensive,
+		})
+	}
+	return moves, nil
+}
 
+// generateCaptureMoves creates a capture proposal if the unit is on a capturable building
+func (ba *BasicAIAdvisor) generateCaptureMoves(game *weewar.Game, unit *v1.Unit) ([]*MoveProposal, error) {
+	moves := make([]*MoveProposal, 0)
+
+	var dmp weewar.DefaultMoveProcessor
+	tile, err := dmp.GetCaptureOption(game, unit.Q, unit.R)
+	if err != nil {
+		return moves, nil
+	}
+
+	coord := weewar.UnitGetCoord(unit)
+	moves = append(moves, &MoveProposal{
+		Action:   ActionCapture,
+		UnitID:   -1,
+		From:     coord,
+		To:       coord,
+		Priority: 0.7, // Buildings provide income and production
+		Risk:     0.2,
+		Value:    ba.GetStrategicValue(game, coord),
+		Reason:   fmt.Sprintf("Capture %s with %s", ba.getTerrainName(game, tile.TileType), ba.getUnitName(unit)),
+		Category: CategoryEconomic,
+	})
+	return moves, nil
+}
+
+// generateBuildMoves creates proposals to build units on the player's empty bases.  Build options
+// come from the game's rules so units the game does not allow or the player cannot afford are
+// never proposed.
+func (ba *BasicAIAdvisor) generateBuildMoves(game *weewar.Game, playerID int32) ([]*MoveProposal, error) {
+	moves := make([]*MoveProposal, 0)
+	if game.CurrentPlayer != playerID {
+		return moves, nil
+	}
+
+	var dmp weewar.DefaultMoveProcessor
+	for coord, tile := range game.World.TilesByCoord() {
+		if tile.Player != playerID {
+			continue
+		}
+		buildOptions, err := dmp.GetBuildOptions(game, int32(coord.Q), int32(coord.R))
+		if err != nil {
+			continue
+		}
+		for _, unitData := range buildOptions {
+			moves = append(moves, &MoveProposal{
+				Action:   ActionCreateUnit,
+				UnitID:   -1,
+				UnitType: unitData.Id,
+				From:     coord,
+				To:       coord,
+				Priority: 0.5,
+				Risk:     0.0,
+				Value:    ba.GetStrategicValue(game, coord),
+				Reason:   fmt.Sprintf("Build %s for %d coins", unitData.Name, unitData.Coins),
+				Category: CategoryEconomic,
+			})
+		}
+	}
 	return moves, nil
 }
 
@@ -243,8 +343,8 @@ func (ba *BasicAIAdvisor) findEnemyThreats(game *weewar.Game, targetUnit *v1.Uni
 
 	// Check all enemy players
 	for pid := int32(1); pid <= game.World.PlayerCount(); pid++ {
-		if pid == playerID {
-			continue // Skip own units
+		if game.PlayerTeams.AreAllies(pid, playerID) {
+			continue // Skip own and allied units
 		}
 
 		enemyUnits := game.GetUnitsForPlayer(int(pid))
@@ -254,7 +354,7 @@ func (ba *BasicAIAdvisor) findEnemyThreats(game *weewar.Game, targetUnit *v1.Uni
 			if ba.canUnitAttackTarget(game, enemyUnit, targetUnit) {
 				threat := Threat{
 					Position:    weewar.UnitGetCoord(enemyUnit),
-					ThreatLevel: ba.calculateThreatLevel(enemyUnit, targetUnit),
+					ThreatLevel: ba.calculateThreatLevel(game, enemyUnit, targetUnit),
 					ThreatType:  ThreatDirectAttack,
 					TargetUnit:  targetUnit,
 					ThreatUnit:  enemyUnit,
@@ -296,7 +396,7 @@ func (ba *BasicAIAdvisor) findAttackOpportunities(game *weewar.Game, attackerUni
 
 	// Check all enemy players
 	for pid := int32(1); pid <= game.World.PlayerCount(); pid++ {
-		if pid == playerID {
+		if game.PlayerTeams.AreAllies(pid, playerID) {
 			continue
 		}
 
@@ -304,7 +404,7 @@ func (ba *BasicAIAdvisor) findAttackOpportunities(game *weewar.Game, attackerUni
 		for _, enemyUnit := range enemyUnits {
 			// Check if we can attack this enemy unit
 			if ba.canUnitAttackTarget(game, attackerUnit, enemyUnit) {
-				opportunityValue := ba.calculateAttackOpportunityValue(attackerUnit, enemyUnit)
+				opportunityValue := ba.calculateAttackOpportunityValue(game, attackerUnit, enemyUnit)
 
 				if opportunityValue > 0.3 { // Only consider good opportunities
 					opportunity := Opportunity{
@@ -331,8 +431,45 @@ func (ba *BasicAIAdvisor) findAttackOpportunities(game *weewar.Game, attackerUni
 func (ba *BasicAIAdvisor) findCaptureOpportunities(game *weewar.Game, unit *v1.Unit, playerID int32) []Opportunity {
 	opportunities := make([]Opportunity, 0)
 
-	// TODO: Implement base/city capture opportunity detection
-	// This would check for undefended bases/cities within movement range
+	rulesEngine := game.GetRulesEngine()
+	if rulesEngine == nil || !rulesEngine.CanUnitCapture(unit.UnitType) {
+		return opportunities
+	}
+
+	// Buildings the unit is on or can reach this turn
+	reachable := []weewar.AxialCoord{weewar.UnitGetCoord(unit)}
+	if moveOptions, err := game.GetUnitMovementOptions(unit); err == nil {
+		for _, option := range moveOptions {
+			reachable = append(reachable, option.Coord)
+		}
+	}
+
+	for _, coord := range reachable {
+		tile := game.World.TileAt(coord)
+		if tile == nil || (tile.Player != 0 && game.PlayerTeams.AreAllies(tile.Player, playerID)) || rulesEngine.GetCaptureTurns(tile.TileType) <= 0 {
+			continue
+		}
+
+		// Only undefended buildings are opportunities
+		if occupant := game.World.UnitAt(coord); occupant != nil && occupant != unit {
+			continue
+		}
+
+		description := fmt.Sprintf("Capture %s with %s", ba.getTerrainName(game, tile.TileType), ba.getUnitName(unit))
+		if tile.Player != 0 {
+			description = fmt.Sprintf("Capture enemy %s with %s", ba.getTerrainName(game, tile.TileType), ba.getUnitName(unit))
+		}
+		opportunities = append(opportunities, Opportunity{
+			Position:        coord,
+			OpportunityType: OpportunityUndefendedBase,
+			Value:           0.6,
+			RequiredUnit:    unit,
+			Description:     description,
+			Difficulty:      0.3,
+			TimeWindow:      int(rulesEngine.GetCaptureTurns(tile.TileType)),
+			Requirements:    []string{"Unit that can capture", "Building stays undefended"},
+		})
+	}
 
 	return opportunities
 }
@@ -341,12 +478,10 @@ func (ba *BasicAIAdvisor) findCaptureOpportunities(game *weewar.Game, unit *v1.U
 // Helper Methods
 // =============================================================================
 
-// canUnitAttackTarget checks if one unit can attack another
+// canUnitAttackTarget checks if one unit can attack another from where it stands
 func (ba *BasicAIAdvisor) canUnitAttackTarget(game *weewar.Game, attacker, target *v1.Unit) bool {
-	// TODO: Implement proper attack range checking using game methods
-	// For now, use simple distance check
-	distance := ba.calculateDistance(weewar.CoordFromInt32(attacker.Q, attacker.R), weewar.CoordFromInt32(target.Q, target.R))
-	return distance <= 1.0 // Assume range of 1 for most units
+	canAttack, err := game.GetRulesEngine().CanUnitAttackTarget(attacker, target, game.PlayerTeams)
+	return err == nil && canAttack
 }
 
 // calculateDistance returns the distance between two positions
@@ -358,23 +493,49 @@ func (ba *BasicAIAdvisor) calculateDistance(pos1, pos2 weewar.AxialCoord) float6
 	return (math.Abs(dx) + math.Abs(dy) + math.Abs(dz)) / 2.0
 }
 
-// calculateThreatLevel assesses how dangerous a threat is
-func (ba *BasicAIAdvisor) calculateThreatLevel(threatUnit, targetUnit *v1.Unit) float64 {
-	// Simple threat calculation based on unit health
-	threatHealth := float64(threatUnit.AvailableHealth) / 100.0
-	targetHealth := float64(targetUnit.AvailableHealth) / 100.0
-
-	// Higher threat level if attacker is healthy and target is weak
-	return threatHealth * (1.0 - targetHealth*0.5)
+// calculateThreatLevel assesses how dangerous a threat is - the share of the target's health the
+// threat is expected to take or the chance it destroys the target, whichever is higher
+func (ba *BasicAIAdvisor) calculateThreatLevel(game *weewar.Game, threatUnit, targetUnit *v1.Unit) float64 {
+	outcome, err := game.SimulateCombat(threatUnit, targetUnit)
+	if err != nil || targetUnit.AvailableHealth <= 0 {
+		return 0
+	}
+	damageShare := outcome.ExpectedDamageDealt / float64(targetUnit.AvailableHealth)
+	return math.Min(math.Max(damageShare, outcome.KillProbability), 1.0)
 }
 
 // calculateAttackOpportunityValue assesses how good an attack opportunity is
-func (ba *BasicAIAdvisor) calculateAttackOpportunityValue(attacker, target *v1.Unit) float64 {
-	attackerHealth := float64(attacker.AvailableHealth) / 100.0
-	targetHealth := float64(target.AvailableHealth) / 100.0
+func (ba *BasicAIAdvisor) calculateAttackOpportunityValue(game *weewar.Game, attacker, target *v1.Unit) float64 {
+	outcome, err := game.SimulateCombat(attacker, target)
+	if err != nil {
+		return 0
+	}
+	return math.Max(0, math.Min(1, ba.attackValue(game, attacker, target, outcome)))
+}
 
-	// Good opportunity if attacker is healthy and target is weak
-	return attackerHealth * (1.0 - targetHealth)
+// attackValue scores an attack from its simulated outcome - the share of the target's health
+// expected to be taken and the chance of destroying it, less the same for the attacker, with
+// each side weighted by what the unit cost to build
+func (ba *BasicAIAdvisor) attackValue(game *weewar.Game, attacker, target *v1.Unit, outcome *weewar.CombatOutcome) float64 {
+	attackerCost, targetCost := 1.0, 1.0
+	if rulesEngine := game.GetRulesEngine(); rulesEngine != nil {
+		if unitData, err := rulesEngine.GetUnitData(attacker.UnitType); err == nil && unitData.Coins > 0 {
+			attackerCost = float64(unitData.Coins)
+		}
+		if unitData, err := rulesEngine.GetUnitData(target.UnitType); err == nil && unitData.Coins > 0 {
+			targetCost = float64(unitData.Coins)
+		}
+	}
+
+	gain, loss := outcome.KillProbability, outcome.LossProbability
+	if target.AvailableHealth > 0 {
+		gain += outcome.ExpectedDamageDealt / float64(target.AvailableHealth)
+	}
+	if attacker.AvailableHealth > 0 {
+		loss += outcome.ExpectedDamageTaken / float64(attacker.AvailableHealth)
+	}
+	weight := targetCost / (targetCost + attackerCost)
+	return (weight*gain - (1-weight)*loss) / 2
 }
 
 // getUnitName returns a human-readable unit name
@@ -383,6 +544,16 @@ func (ba *BasicAIAdvisor) getUnitName(unit *v1.Unit) string {
 	return fmt.Sprintf("Unit%d", unit.UnitType)
 }
 
+// getTerrainName returns a display name for a terrain type
+func (ba *BasicAIAdvisor) getTerrainName(game *weewar.Game, terrainType int32) string {
+	if rulesEngine := game.GetRulesEngine(); rulesEngine != nil {
+		if terrain, err := rulesEngine.GetTerrainData(terrainType); err == nil {
+			return terrain.Name
+		}
+	}
+	return fmt.Sprintf("Terrain%d", terrainType)
+}
+
 // generateThreatSolutions suggests ways to deal with a threat
 func (ba *BasicAIAdvisor) generateThreatSolutions(game *weewar.Game, target, threat *v1.Unit) []string {
 	solutions := make([]string, 0)
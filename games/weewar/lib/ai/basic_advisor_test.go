@@ -0,0 +1,53 @@
+package ai
+
+import (
+	"testing"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+	weewar "github.com/panyam/turnengine/games/weewar/lib"
+)
+
+func TestGenerateBuildMovesSkipsDisabledUnits(t *testing.T) {
+	world := weewar.NewWorld("test")
+	for q := -2; q <= 2; q++ {
+		world.AddTile(weewar.NewTile(weewar.AxialCoord{Q: q, R: 0}, 5))
+	}
+	base := world.TileAt(weewar.AxialCoord{Q: 0, R: 0})
+	base.TileType = 1
+	base.Player = 1
+	world.AddUnit(weewar.NewUnit(1, 2, weewar.AxialCoord{Q: 2, R: 0}))
+
+	rulesEngine := weewar.DefaultRulesEngine().WithOverlay([]int32{1, 2, 3}, &v1.RulesOverlay{DisabledUnits: []int32{3}})
+	game, err := weewar.NewGame(world, rulesEngine, 42)
+	if err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+	game.PlayerCoins[1] = 1000
+
+	proposals, err := NewBasicAIAdvisor(rulesEngine).generateBuildMoves(game, 1)
+	if err != nil {
+		t.Fatalf("Failed to generate build moves: %v", err)
+	}
+	if len(proposals) == 0 {
+		t.Fatal("Expected the allowed units to be proposed")
+	}
+	for _, proposal := range proposals {
+		if proposal.UnitType == 3 {
+			t.Errorf("Expected the disabled unit to never be proposed, got %v", proposal)
+		}
+		move, err := proposal.ToGameMove(1)
+		if err != nil {
+			t.Fatalf("Failed to convert proposal: %v", err)
+		}
+		if build := move.GetBuildUnit(); build == nil || build.UnitType != proposal.UnitType || build.Q != 0 || build.R != 0 {
+			t.Errorf("Expected a build of unit type %d on the base, got %v", proposal.UnitType, move)
+		}
+	}
+
+	// The proposed build is one the game accepts
+	move, _ := proposals[0].ToGameMove(1)
+	var dmp weewar.DefaultMoveProcessor
+	if _, err := dmp.ProcessMove(game, move); err != nil {
+		t.Errorf("Failed to process the proposed build: %v", err)
+	}
+}
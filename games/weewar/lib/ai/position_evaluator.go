@@ -187,7 +187,7 @@ func (pe *PositionEvaluator) evaluateUnitValue(game *weewar.Game, playerID int32
 		units := game.GetUnitsForPlayer(int(pid))
 		for _, unit := range units {
 			unitCost := pe.getUnitCost(unit.UnitType)
-			if pid == playerID {
+			if game.PlayerTeams.AreAllies(pid, playerID) {
 				playerValue += unitCost
 			}
 			totalValue += unitCost
@@ -356,7 +356,7 @@ func (pe *PositionEvaluator) evaluateAttackOptions(game *weewar.Game, playerID i
 	// Normalize by enemy total unit value
 	enemyValue := 0.0
 	for pid := int32(1); pid <= game.World.PlayerCount(); pid++ {
-		if pid != playerID {
+		if !game.PlayerTeams.AreAllies(pid, playerID) {
 			enemyValue += pe.getTotalUnitValue(game, pid)
 		}
 	}
@@ -521,7 +521,7 @@ func (pe *PositionEvaluator) isControlledByPlayer(pos weewar.AxialCoord, playerI
 	// Check if there's a friendly unit on this position
 	if game.World != nil {
 		if unit := game.World.UnitAt(pos); unit != nil {
-			return unit.Player == playerID
+			return game.PlayerTeams.AreAllies(unit.Player, playerID)
 		}
 	}
 
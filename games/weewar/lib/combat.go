@@ -0,0 +1,91 @@
+package weewar
+
+import (
+	"fmt"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+// =============================================================================
+// Combat Simulation
+// =============================================================================
+
+// CombatOutcome is the exact expected result of an attack including the defender's counter attack
+type CombatOutcome struct {
+	ExpectedDamageDealt float64 `json:"expectedDamageDealt"` // Expected damage to the defender
+	ExpectedDamageTaken float64 `json:"expectedDamageTaken"` // Expected counter attack damage to the attacker
+	KillProbability     float64 `json:"killProbability"`     // Chance the defender is destroyed
+	LossProbability     float64 `json:"lossProbability"`     // Chance the attacker is destroyed by the counter attack
+}
+
+// SimulateCombat computes the outcome of an attack the same way ProcessAttackUnit resolves it.  For
+// each damage the attack can deal the defender either dies or counter attacks (if it can) with the
+// health it has left, so the counter attack distribution is conditioned on the defender surviving.
+func (re *RulesEngine) SimulateCombat(world *World, attacker, defender *v1.Unit, teams Teams) (*CombatOutcome, error) {
+	if attacker == nil || defender == nil {
+		return nil, fmt.Errorf("attacker or defender is nil")
+	}
+
+	attack, err := re.GetCombatPrediction(attacker, defender, world.TileAt(UnitGetCoord(defender)))
+	if err != nil {
+		return nil, err
+	}
+	totalWeight := 0.0
+	for _, bucket := range attack.DamageBuckets {
+		totalWeight += bucket.Weight
+	}
+	if totalWeight <= 0 {
+		return &CombatOutcome{}, nil
+	}
+
+	canCounter := re.CanCounterAttack(attacker, defender, teams)
+	attackerTile := world.TileAt(UnitGetCoord(attacker))
+	outcome := &CombatOutcome{ExpectedDamageDealt: attack.ExpectedDamage}
+	for _, bucket := range attack.DamageBuckets {
+		probability := bucket.Weight / totalWeight
+		remaining := defender.AvailableHealth - bucket.Damage
+		if remaining <= 0 {
+			outcome.KillProbability += probability
+			continue
+		}
+		if !canCounter {
+			continue
+		}
+
+		// The defender hits back with the health it has left
+		survivor := &v1.Unit{
+			Q:               defender.Q,
+			R:               defender.R,
+			Player:          defender.Player,
+			UnitType:        defender.UnitType,
+			AvailableHealth: remaining,
+		}
+		counter, err := re.GetCombatPrediction(survivor, attacker, attackerTile)
+		if err != nil {
+			continue
+		}
+		outcome.ExpectedDamageTaken += probability * counter.ExpectedDamage
+		outcome.LossProbability += probability * damageProbability(counter, attacker.AvailableHealth)
+	}
+	return outcome, nil
+}
+
+// damageProbability returns the chance a damage distribution deals at least the given damage
+func damageProbability(dist *v1.DamageDistribution, damage int32) float64 {
+	total, matching := 0.0, 0.0
+	for _, bucket := range dist.DamageBuckets {
+		total += bucket.Weight
+		if bucket.Damage >= damage {
+			matching += bucket.Weight
+		}
+	}
+	if total <= 0 {
+		return 0
+	}
+	return matching / total
+}
+
+// SimulateCombat computes the outcome of an attack between two units where they stand now
+func (g *Game) SimulateCombat(attacker, defender *v1.Unit) (*CombatOutcome, error) {
+	return g.rulesEngine.SimulateCombat(g.World, attacker, defender, g.PlayerTeams)
+}
@@ -0,0 +1,210 @@
+package weewar
+
+import (
+	"maps"
+	"math"
+	"slices"
+	"testing"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCombatPredictionScalesWithAttackerHealth(t *testing.T) {
+	rulesEngine := DefaultRulesEngine()
+	attacker := NewUnit(1, 1, AxialCoord{Q: 0, R: 0})
+	defender := NewUnit(1, 2, AxialCoord{Q: 1, R: 0})
+	grass := NewTile(AxialCoord{Q: 1, R: 0}, 5)
+
+	full, err := rulesEngine.GetCombatPrediction(attacker, defender, grass)
+	if err != nil {
+		t.Fatalf("Failed to predict combat: %v", err)
+	}
+	base, _ := rulesEngine.getBaseDamageDistribution(1, 1)
+	if !slices.EqualFunc(full.DamageBuckets, base.DamageBuckets, func(a, b *v1.DamageBucket) bool { return proto.Equal(a, b) }) {
+		t.Errorf("Expected a healthy attacker on open ground to deal the base damage %v, got %v", base.DamageBuckets, full.DamageBuckets)
+	}
+
+	attacker.AvailableHealth = 10
+	wounded, err := rulesEngine.GetCombatPrediction(attacker, defender, grass)
+	if err != nil {
+		t.Fatalf("Failed to predict combat: %v", err)
+	}
+	if wounded.MaxDamage > 1 || wounded.ExpectedDamage >= full.ExpectedDamage {
+		t.Errorf("Expected a 10 HP attacker to hit for at most a tenth of the damage, got %+v", wounded)
+	}
+}
+
+func TestCombatPredictionTerrainAndClassModifiers(t *testing.T) {
+	rulesEngine := *DefaultRulesEngine()
+	rulesEngine.Terrains = maps.Clone(rulesEngine.Terrains)
+	rulesEngine.Terrains[7] = &v1.TerrainDefinition{Id: 7, Name: "Mountains", BaseMoveCost: 2, DefenseBonus: 0.5}
+
+	attacker := NewUnit(3, 1, AxialCoord{Q: 0, R: 0})
+	defender := NewUnit(1, 2, AxialCoord{Q: 1, R: 0})
+	open, err := rulesEngine.GetCombatPrediction(attacker, defender, NewTile(AxialCoord{Q: 1, R: 0}, 5))
+	if err != nil {
+		t.Fatalf("Failed to predict combat: %v", err)
+	}
+	covered, err := rulesEngine.GetCombatPrediction(attacker, defender, NewTile(AxialCoord{Q: 1, R: 0}, 7))
+	if err != nil {
+		t.Fatalf("Failed to predict combat: %v", err)
+	}
+	if math.Abs(covered.ExpectedDamage-open.ExpectedDamage/2) > 0.5 {
+		t.Errorf("Expected mountains to halve the damage taken, got %v vs %v in the open", covered.ExpectedDamage, open.ExpectedDamage)
+	}
+
+	rulesEngine.CombatRules = &v1.CombatRules{ClassModifiers: map[string]*v1.ClassDamageModifiers{
+		"land": {DefenderClasses: map[string]float64{"land": 2}},
+	}}
+	boosted, err := rulesEngine.GetCombatPrediction(attacker, defender, NewTile(AxialCoord{Q: 1, R: 0}, 5))
+	if err != nil {
+		t.Fatalf("Failed to predict combat: %v", err)
+	}
+	if math.Abs(boosted.ExpectedDamage-open.ExpectedDamage*2) > 0.5 {
+		t.Errorf("Expected the class modifier to double the damage, got %v vs %v", boosted.ExpectedDamage, open.ExpectedDamage)
+	}
+}
+
+func TestCombatDamageMatchesPrediction(t *testing.T) {
+	game := newTestGame(t)
+	attacker := game.World.UnitAt(AxialCoord{Q: 2, R: 0})
+	defender := NewUnit(1, 2, AxialCoord{Q: 3, R: 0})
+	defender.AvailableHealth = 3
+	game.World.AddUnit(defender)
+
+	prediction, err := game.GetCombatPrediction(attacker, defender)
+	if err != nil {
+		t.Fatalf("Failed to predict combat: %v", err)
+	}
+	if prediction.MaxDamage > 3 {
+		t.Errorf("Expected damage to be capped at the defender's health, got %+v", prediction)
+	}
+
+	var dmp DefaultMoveProcessor
+	attack := &v1.GameMove{
+		Player: 1,
+		MoveType: &v1.GameMove_AttackUnit{AttackUnit: &v1.AttackUnitAction{
+			AttackerQ: 2, AttackerR: 0, DefenderQ: 3, DefenderR: 0,
+		}},
+	}
+	if _, err := dmp.ProcessMove(game, attack); err != nil {
+		t.Fatalf("Failed to attack: %v", err)
+	}
+
+	damage := 3 - defender.AvailableHealth
+	predicted := false
+	for _, bucket := range prediction.DamageBuckets {
+		predicted = predicted || bucket.Damage == damage
+	}
+	if !predicted {
+		t.Errorf("Expected the damage dealt (%d) to be one of the predicted outcomes %+v", damage, prediction.DamageBuckets)
+	}
+}
+
+func TestSimulateCombat(t *testing.T) {
+	game := newTestGame(t)
+	attacker := game.World.UnitAt(AxialCoord{Q: 2, R: 0})
+	defender := NewUnit(1, 2, AxialCoord{Q: 3, R: 0})
+	game.World.AddUnit(defender)
+
+	// A soldier deals 3 to 7 damage to another soldier, so one with 4 health left only survives a 3
+	defender.AvailableHealth = 4
+	outcome, err := game.SimulateCombat(attacker, defender)
+	if err != nil {
+		t.Fatalf("Failed to simulate combat: %v", err)
+	}
+	if math.Abs(outcome.KillProbability-0.952/0.997) > 1e-9 {
+		t.Errorf("Expected the kill probability to be the chance of dealing 4 or more, got %v", outcome.KillProbability)
+	}
+	if outcome.ExpectedDamageTaken != 0 || outcome.LossProbability != 0 {
+		t.Errorf("Expected a 1 health survivor's counter attack to do nothing, got %+v", outcome)
+	}
+
+	// A full health defender always survives and its counter attack finishes a 1 health attacker
+	defender.AvailableHealth = 100
+	attacker.AvailableHealth = 1
+	outcome, err = game.SimulateCombat(attacker, defender)
+	if err != nil {
+		t.Fatalf("Failed to simulate combat: %v", err)
+	}
+	if outcome.KillProbability != 0 || math.Abs(outcome.LossProbability-1) > 1e-9 {
+		t.Errorf("Expected the attacker to be lost without killing the defender, got %+v", outcome)
+	}
+}
+
+func TestAttackOptionsUseHexRange(t *testing.T) {
+	game := newTestGame(t)
+	attacker := game.World.UnitAt(AxialCoord{Q: 2, R: 0})
+
+	// (3,1) is inside the Q/R square around (2,0) but two hexes away
+	game.World.AddUnit(NewUnit(1, 2, AxialCoord{Q: 3, R: 1}))
+	game.World.AddUnit(NewUnit(1, 2, AxialCoord{Q: 3, R: -1}))
+	options, err := game.GetUnitAttackOptions(attacker)
+	if err != nil {
+		t.Fatalf("Failed to get attack options: %v", err)
+	}
+	if !slices.Equal(options, []AxialCoord{{Q: 3, R: -1}}) {
+		t.Errorf("Expected only the adjacent enemy to be attackable, got %v", options)
+	}
+}
+
+func TestArtilleryMinimumRangeAndIndirectFire(t *testing.T) {
+	game := newTestGame(t)
+	artillery := NewUnit(8, 1, AxialCoord{Q: 0, R: 2})
+	artillery.ActionsRemaining = 1
+	game.World.AddUnit(artillery)
+	adjacent := NewUnit(1, 2, AxialCoord{Q: 1, R: 2})
+	distant := NewUnit(1, 2, AxialCoord{Q: 2, R: 2})
+	game.World.AddUnit(adjacent)
+	game.World.AddUnit(distant)
+
+	options, err := game.GetUnitAttackOptions(artillery)
+	if err != nil {
+		t.Fatalf("Failed to get attack options: %v", err)
+	}
+	if !slices.Equal(options, []AxialCoord{{Q: 2, R: 2}}) {
+		t.Errorf("Expected artillery to only reach the enemy outside its minimum range, got %v", options)
+	}
+	if game.CanAttackUnit(artillery, adjacent) {
+		t.Error("Expected artillery not to be able to attack an adjacent unit")
+	}
+
+	// Adjacent units can still attack the artillery but it cannot fire back
+	rulesEngine := game.GetRulesEngine()
+	if rulesEngine.CanCounterAttack(adjacent, artillery, nil) {
+		t.Error("Expected artillery not to counter attack adjacent attackers")
+	}
+	if rulesEngine.CanCounterAttack(artillery, distant, nil) {
+		t.Error("Expected indirect fire not to be countered")
+	}
+
+	var dmp DefaultMoveProcessor
+	if _, err := dmp.ProcessAttackUnit(game, nil, &v1.AttackUnitAction{AttackerQ: 0, AttackerR: 2, DefenderQ: 2, DefenderR: 2}); err != nil {
+		t.Fatalf("Failed to attack: %v", err)
+	}
+	if artillery.AvailableHealth != 100 {
+		t.Errorf("Expected artillery to take no counter fire, got %d health", artillery.AvailableHealth)
+	}
+}
+
+func TestRuleSetCarriesAttackMatrix(t *testing.T) {
+	rulesEngine := DefaultRulesEngine()
+	data, err := protojson.Marshal(rulesEngine.RuleSet())
+	if err != nil {
+		t.Fatalf("Failed to marshal rule set: %v", err)
+	}
+	ruleSet := &v1.RuleSet{}
+	if err := protojson.Unmarshal(data, ruleSet); err != nil {
+		t.Fatalf("Failed to unmarshal rule set: %v", err)
+	}
+
+	base, _ := rulesEngine.getBaseDamageDistribution(1, 1)
+	if dist := ruleSet.AttackMatrix.GetAttacks()[1].GetDefenderDamages()[1]; !proto.Equal(dist, base) {
+		t.Errorf("Expected the rule set to carry the soldier's damage against soldiers %v, got %v", base, dist)
+	}
+	if len(ruleSet.Units) != len(rulesEngine.Units) || len(ruleSet.AttackMatrix.GetAttacks()) != len(rulesEngine.AttackMatrix.GetAttacks()) {
+		t.Errorf("Expected the rule set to carry every unit and attacker")
+	}
+}
@@ -46,10 +46,21 @@ type Game struct {
 	Teams   []TeamInfo   `json:"teams"`   // Information about each team
 
 	// Game systems and configuration
-	Seed int64 `json:"seed"` // Random seed for deterministic gameplay
+	Seed     int64 `json:"seed"`     // Random seed for deterministic gameplay
+	FogOfWar bool  `json:"fogOfWar"` // Players only see around their own units and buildings
+
+	// Economy
+	CoinSettings *v1.CoinSettings `json:"coinSettings"` // How players earn coins (nil = no income)
+	PlayerCoins  map[int32]int32  `json:"playerCoins"`  // Coins currently held by each player
+
+	// Teams and victory
+	PlayerTeams     Teams               `json:"playerTeams"`     // Team of each player (nil = free for all)
+	VictorySettings *v1.VictorySettings `json:"victorySettings"` // How the game is won (nil = last player standing)
+	MaxTurns        int32               `json:"maxTurns"`        // Turn limit (0 = unlimited)
 
 	// Random number generator
-	rng *rand.Rand `json:"-"` // RNG for deterministic gameplay
+	rng       *rand.Rand      `json:"-"` // RNG for deterministic gameplay
+	rngSource *countingSource `json:"-"` // Source of rng tracking the position in the random stream
 
 	// Asset management
 	assetProvider AssetProvider `json:"-"` // Asset provider for tiles and units (interface for platform flexibility)
@@ -64,6 +75,9 @@ type Game struct {
 	// Internal state
 	winner    int32 `json:"winner"`    // Winner player ID (-1 if no winner)
 	hasWinner bool  `json:"hasWinner"` // Whether game has ended with winner
+
+	winners          []int32 // Winner and their teammates
+	victoryCondition string  // Victory condition that decided the game
 }
 
 // =============================================================================
@@ -99,11 +113,105 @@ func (g *Game) GetPlayersOnTeam(teamID int) []*PlayerInfo {
 
 // ArePlayersOnSameTeam checks if two players are on the same team
 func (g *Game) ArePlayersOnSameTeam(playerID1, playerID2 int) bool {
-	if playerID1 < 0 || playerID1 >= len(g.Players) ||
-		playerID2 < 0 || playerID2 >= len(g.Players) {
-		return false
+	return g.PlayerTeams.AreAllies(int32(playerID1), int32(playerID2))
+}
+
+// =============================================================================
+// Economy
+// =============================================================================
+
+// GetPlayerCoins returns the coins currently held by a player
+func (g *Game) GetPlayerCoins(playerID int32) int32 {
+	return g.PlayerCoins[playerID]
+}
+
+// CountPlayerBases returns the number of income generating structures owned by a player
+func (g *Game) CountPlayerBases(playerID int32) int {
+	if g.rulesEngine == nil {
+		return 0
+	}
+	count := 0
+	for _, tile := range g.World.TilesByCoord() {
+		if tile.Player == playerID && g.rulesEngine.IsPlayerTerrain(tile.TileType) {
+			count++
+		}
+	}
+	return count
+}
+
+// CalculateTurnIncome returns the coins a player earns at the start of their turn
+func (g *Game) CalculateTurnIncome(playerID int32) int32 {
+	if g.CoinSettings == nil {
+		return 0
+	}
+	return g.CoinSettings.PerTurn + g.CoinSettings.PerBase*int32(g.CountPlayerBases(playerID))
+}
+
+// adjustPlayerCoins adds delta coins to a player's balance and returns the change describing it
+func (g *Game) adjustPlayerCoins(playerID int32, delta int32) *v1.WorldChange {
+	if g.PlayerCoins == nil {
+		g.PlayerCoins = map[int32]int32{}
+	}
+	previousCoins := g.PlayerCoins[playerID]
+	g.PlayerCoins[playerID] = previousCoins + delta
+	return &v1.WorldChange{
+		ChangeType: &v1.WorldChange_CoinsChanged{
+			CoinsChanged: &v1.CoinsChangedChange{
+				Player:        playerID,
+				PreviousCoins: previousCoins,
+				NewCoins:      g.PlayerCoins[playerID],
+			},
+		},
+	}
+}
+
+// =============================================================================
+// Checkpoints
+// =============================================================================
+
+// gameCheckpoint holds the game state outside the World that moves can change
+type gameCheckpoint struct {
+	currentPlayer int32
+	turnCounter   int32
+	playerCoins   map[int32]int32
+	rngPosition   int64
+	winner        int32
+	winners       []int32
+	hasWinner     bool
+	condition     string
+	status        GameStatus
+}
+
+// checkpoint saves the non World game state so it can be restored if a batch of moves fails
+func (g *Game) checkpoint() *gameCheckpoint {
+	playerCoins := map[int32]int32{}
+	for player, coins := range g.PlayerCoins {
+		playerCoins[player] = coins
+	}
+	return &gameCheckpoint{
+		currentPlayer: g.CurrentPlayer,
+		turnCounter:   g.TurnCounter,
+		playerCoins:   playerCoins,
+		rngPosition:   g.RNGPosition(),
+		winner:        g.winner,
+		winners:       g.winners,
+		hasWinner:     g.hasWinner,
+		condition:     g.victoryCondition,
+		status:        g.Status,
 	}
-	return g.Players[playerID1].TeamID == g.Players[playerID2].TeamID
+}
+
+// restore resets the non World game state to a checkpoint
+func (g *Game) restore(c *gameCheckpoint) {
+	g.CurrentPlayer = c.currentPlayer
+	g.TurnCounter = c.turnCounter
+	g.PlayerCoins = c.playerCoins
+	g.SeekRNG(c.rngPosition)
+	g.winner = c.winner
+	g.winners = c.winners
+	g.hasWinner = c.hasWinner
+	g.victoryCondition = c.condition
+	g.Status = c.status
 }
 
 // =============================================================================
@@ -133,6 +241,9 @@ func (g *Game) initializeStartingUnits() error {
 			unit.AvailableHealth = unitData.Health
 			unit.DistanceLeft = unitData.MovementPoints
 			unit.TurnCounter = g.TurnCounter
+			unit.HasMoved = false
+			unit.HasAttacked = false
+			unit.ActionsRemaining = g.rulesEngine.GetActionsPerTurn(unit.UnitType)
 			fmt.Printf("initializeStartingUnits: Set unit DistanceLeft to %d (from rules engine MovementPoints %d)\n", unit.DistanceLeft, unitData.MovementPoints)
 		}
 	}
@@ -151,7 +262,7 @@ func (g *Game) resetPlayerUnits(playerID int32) error {
 	}
 
 	fmt.Printf("resetPlayerUnits: Resetting player %d units, PlayerCount=%d, TurnCounter=%d\n", playerID, g.World.PlayerCount(), g.TurnCounter)
-	for _, unit := range g.World.unitsByPlayer[playerID] {
+	for _, unit := range g.World.GetPlayerUnits(int(playerID)) {
 		// Get unit data from rules engine
 		unitData, err := g.rulesEngine.GetUnitData(unit.UnitType)
 		if err != nil {
@@ -163,30 +274,23 @@ func (g *Game) resetPlayerUnits(playerID int32) error {
 			unit.UnitType, unit.DistanceLeft, unitData.MovementPoints)
 		unit.DistanceLeft = int32(unitData.MovementPoints)
 		unit.TurnCounter = int32(g.TurnCounter)
-		fmt.Printf("resetPlayerUnits: Set unit DistanceLeft to %d\n", unit.DistanceLeft)
-	}
-
-	return nil
-}
-
-// checkVictoryConditions checks if any player has won
-func (g *Game) checkVictoryConditions() (winner int32, hasWinner bool) {
-	// Simple victory condition: last player with units wins
-	playersWithUnits := 0
-	lastPlayerWithUnits := int32(-1)
 
-	for playerID := int32(1); playerID <= g.World.PlayerCount(); playerID++ {
-		if len(g.World.unitsByPlayer[playerID]) > 0 {
-			playersWithUnits++
-			lastPlayerWithUnits = playerID
+		// Reset the actions taken last turn
+		unit.HasMoved = false
+		unit.HasAttacked = false
+		unit.ActionsRemaining = g.rulesEngine.GetActionsPerTurn(unit.UnitType)
+
+		// Carried units can be unloaded again
+		for _, cargo := range unit.Cargo {
+			cargo.TurnCounter = g.TurnCounter
+			cargo.HasMoved = false
+			cargo.HasAttacked = false
+			cargo.ActionsRemaining = g.rulesEngine.GetActionsPerTurn(cargo.UnitType)
 		}
+		fmt.Printf("resetPlayerUnits: Set unit DistanceLeft to %d\n", unit.DistanceLeft)
 	}
 
-	if playersWithUnits == 1 {
-		return lastPlayerWithUnits, true
-	}
-
-	return -1, false
+	return nil
 }
 
 // validateGameState validates the current game state
@@ -272,12 +376,13 @@ func NewGame(world *World, rulesEngine *RulesEngine, seed int64) (*Game, error)
 		Status:        GameStatusPlaying,
 		winner:        -1,
 		hasWinner:     false,
+		PlayerCoins:   map[int32]int32{},
 		CreatedAt:     time.Now(),
 		LastActionAt:  time.Now(),
-		rng:           rand.New(rand.NewSource(seed)),
 		assetProvider: NewAssetManager("data"),
 		rulesEngine:   rulesEngine,
 	}
+	game.SeekRNG(0)
 
 	// Initialize units storage for compatibility (will be migrated)
 
@@ -300,7 +405,7 @@ func LoadGame(saveData []byte) (*Game, error) {
 	}
 
 	// Restore transient state
-	game.rng = rand.New(rand.NewSource(game.Seed))
+	game.SeekRNG(0)
 	game.assetProvider = NewAssetManager("data")
 	game.rulesEngine = nil // Will be set by caller
 
@@ -363,13 +468,14 @@ func (g *Game) GetWinner() (int32, bool) {
 
 // GetUnitsForPlayer returns all units owned by player
 func (g *Game) GetUnitsForPlayer(playerID int) []*v1.Unit {
-	if playerID < 0 || playerID >= len(g.World.unitsByPlayer) {
+	if playerID < 0 || int32(playerID) > g.World.PlayerCount() {
 		return nil
 	}
 
 	// Return a copy to prevent external modification
-	units := make([]*v1.Unit, len(g.World.unitsByPlayer[playerID]))
-	copy(units, g.World.unitsByPlayer[playerID])
+	playerUnits := g.World.GetPlayerUnits(playerID)
+	units := make([]*v1.Unit, len(playerUnits))
+	copy(units, playerUnits)
 	return units
 }
 
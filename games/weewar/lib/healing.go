@@ -0,0 +1,156 @@
+package weewar
+
+import (
+	"fmt"
+	"time"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+// =============================================================================
+// Healing - Repairs on owned bases and healing by units like Medics
+// =============================================================================
+
+// repairPlayerUnits repairs a player's damaged units standing on tiles they own at the start of
+// their turn.  Repairs are paid for from the player's coins (if the terrain charges for them) and
+// units are only repaired as far as the player can afford.
+func (g *Game) repairPlayerUnits(playerID int32) (changes []*v1.WorldChange) {
+	for _, unit := range g.World.GetPlayerUnits(int(playerID)) {
+		tile := g.World.TileAt(UnitGetCoord(unit))
+		if tile == nil || tile.Player != playerID {
+			continue
+		}
+		unitData, err := g.rulesEngine.GetUnitData(unit.UnitType)
+		if err != nil {
+			continue
+		}
+
+		amount := min(g.rulesEngine.GetRepairAmount(tile.TileType, unit.UnitType), unitData.Health-unit.AvailableHealth)
+		for amount > 0 && g.rulesEngine.GetRepairCost(tile.TileType, unit.UnitType, amount) > g.PlayerCoins[playerID] {
+			amount--
+		}
+		if amount <= 0 {
+			continue
+		}
+
+		if cost := g.rulesEngine.GetRepairCost(tile.TileType, unit.UnitType, amount); cost > 0 {
+			changes = append(changes, g.adjustPlayerCoins(playerID, -cost))
+		}
+		changes = append(changes, g.healUnit(unit, amount))
+	}
+	return
+}
+
+// healUnit restores health to a unit and returns the change describing it
+func (g *Game) healUnit(unit *v1.Unit, amount int32) *v1.WorldChange {
+	previousUnit := CopyUnit(unit)
+	unit.AvailableHealth += amount
+	return &v1.WorldChange{
+		ChangeType: &v1.WorldChange_UnitHealed{
+			UnitHealed: &v1.UnitHealedChange{
+				PreviousUnit: previousUnit,
+				UpdatedUnit:  CopyUnit(unit),
+			},
+		},
+	}
+}
+
+// HealUnit restores health to a friendly unit next to the healer.  Healing uses up one of the
+// healer's actions for the turn.
+func (m *DefaultMoveProcessor) ProcessHealUnit(g *Game, move *v1.GameMove, action *v1.HealUnitAction) (result *v1.GameMoveResult, err error) {
+	// Initialize the result object
+	result = &v1.GameMoveResult{
+		IsPermanent: false,
+		Changes:     []*v1.WorldChange{},
+	}
+
+	healer := g.World.UnitAt(CoordFromInt32(action.HealerQ, action.HealerR))
+	target := g.World.UnitAt(CoordFromInt32(action.TargetQ, action.TargetR))
+	if healer == nil || target == nil {
+		return nil, fmt.Errorf("healer or target is nil")
+	}
+	amount, err := g.validateHeal(healer, target)
+	if err != nil {
+		return nil, err
+	}
+
+	// The heal records the healer using up its action alongside the healed unit
+	previousHealer := CopyUnit(healer)
+	healer.ActionsRemaining--
+	if !g.rulesEngine.CanMoveAfterAttack(healer.UnitType) {
+		healer.DistanceLeft = 0
+	}
+	change := g.healUnit(target, amount)
+	change.GetUnitHealed().PreviousHealer = previousHealer
+	change.GetUnitHealed().UpdatedHealer = CopyUnit(healer)
+	result.Changes = append(result.Changes, change)
+
+	// Update timestamp
+	g.LastActionAt = time.Now()
+
+	return result, nil
+}
+
+// validateHeal checks if a unit can heal another and returns the health it would restore
+func (g *Game) validateHeal(healer, target *v1.Unit) (int32, error) {
+	if healer.Player != g.CurrentPlayer {
+		return 0, fmt.Errorf("not player %d's turn", healer.Player)
+	}
+	healAmount := g.rulesEngine.GetHealAmount(healer.UnitType)
+	if healAmount <= 0 {
+		return 0, fmt.Errorf("unit type %d cannot heal", healer.UnitType)
+	}
+	if err := g.validateUnitCanAttack(healer); err != nil {
+		return 0, err
+	}
+	if !g.PlayerTeams.AreAllies(healer.Player, target.Player) {
+		return 0, fmt.Errorf("units can only heal friendly units")
+	}
+
+	healerCoord, targetCoord := UnitGetCoord(healer), UnitGetCoord(target)
+	if healerCoord.Distance(targetCoord) != 1 {
+		return 0, fmt.Errorf("unit at %v is not next to healer at %v", targetCoord, healerCoord)
+	}
+
+	targetData, err := g.rulesEngine.GetUnitData(target.UnitType)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get unit data for type %d: %w", target.UnitType, err)
+	}
+	if target.AvailableHealth >= targetData.Health {
+		return 0, fmt.Errorf("unit at %v is not damaged", targetCoord)
+	}
+	return min(healAmount, targetData.Health-target.AvailableHealth), nil
+}
+
+// GetHealOptions returns the units the healer at given coordinates can heal with the health each would regain
+func (m *DefaultMoveProcessor) GetHealOptions(game *Game, q, r int32) ([]*v1.HealUnitOption, error) {
+	healer := game.World.UnitAt(AxialCoord{Q: int(q), R: int(r)})
+	if healer == nil {
+		return nil, fmt.Errorf("no unit found at position (%d, %d)", q, r)
+	}
+
+	var options []*v1.HealUnitOption
+	var neighbors [6]AxialCoord
+	UnitGetCoord(healer).Neighbors(&neighbors)
+	for _, coord := range neighbors {
+		target := game.World.UnitAt(coord)
+		if target == nil {
+			continue
+		}
+		if amount, err := game.validateHeal(healer, target); err == nil {
+			options = append(options, &v1.HealUnitOption{
+				Q:        int32(coord.Q),
+				R:        int32(coord.R),
+				UnitType: target.UnitType,
+				Amount:   amount,
+				Action: &v1.HealUnitAction{
+					HealerQ: q,
+					HealerR: r,
+					TargetQ: int32(coord.Q),
+					TargetR: int32(coord.R),
+				},
+			})
+		}
+	}
+	return options, nil
+}
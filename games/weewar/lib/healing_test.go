@@ -0,0 +1,124 @@
+package weewar
+
+import (
+	"maps"
+	"testing"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// endTurnChanges ends the current player's turn and returns the changes it made
+func endTurnChanges(t *testing.T, game *Game) []*v1.WorldChange {
+	var dmp DefaultMoveProcessor
+	move := &v1.GameMove{Player: game.CurrentPlayer, MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}
+	results, err := dmp.ProcessMoves(game, []*v1.GameMove{move})
+	if err != nil {
+		t.Fatalf("Failed to end turn: %v", err)
+	}
+	return results[0].Changes
+}
+
+func TestRepairOnOwnedBase(t *testing.T) {
+	game := newTestGame(t)
+	onBase := NewUnit(1, 1, AxialCoord{Q: 0, R: 0})
+	onBase.AvailableHealth = 50
+	game.World.AddUnit(onBase)
+	game.World.UnitAt(AxialCoord{Q: 2, R: 0}).AvailableHealth = 50
+	endTurn(t, game)
+
+	var healed []*v1.UnitHealedChange
+	for _, change := range endTurnChanges(t, game) {
+		if change.GetUnitHealed() != nil {
+			healed = append(healed, change.GetUnitHealed())
+		}
+	}
+	if len(healed) != 1 || healed[0].PreviousUnit.AvailableHealth != 50 || healed[0].UpdatedUnit.AvailableHealth != 70 {
+		t.Fatalf("Expected only the soldier on the base to be repaired by 20, got %v", healed)
+	}
+	if unit := game.World.UnitAt(AxialCoord{Q: 2, R: 0}); unit.AvailableHealth != 50 {
+		t.Errorf("Expected the soldier off the base not to be repaired, got %d health", unit.AvailableHealth)
+	}
+
+	// Repairs never go past full health
+	game.World.UnitAt(AxialCoord{Q: 0, R: 0}).AvailableHealth = 95
+	endTurn(t, game)
+	endTurn(t, game)
+	if unit := game.World.UnitAt(AxialCoord{Q: 0, R: 0}); unit.AvailableHealth != 100 {
+		t.Errorf("Expected the soldier to be repaired to full health, got %d", unit.AvailableHealth)
+	}
+}
+
+func TestRepairCostsCoins(t *testing.T) {
+	game := newTestGame(t)
+	game.rulesEngine.Terrains = maps.Clone(game.rulesEngine.Terrains)
+	landBase := proto.Clone(game.rulesEngine.Terrains[1]).(*v1.TerrainDefinition)
+	landBase.RepairCost = 0.3
+	game.rulesEngine.Terrains[1] = landBase
+
+	// A full repair of 20 health costs 30% of a 75 coin soldier's price for a fifth of its health
+	onBase := NewUnit(1, 1, AxialCoord{Q: 0, R: 0})
+	onBase.AvailableHealth = 50
+	game.World.AddUnit(onBase)
+	game.PlayerCoins[1] = 10
+	endTurn(t, game)
+	endTurn(t, game)
+	if unit := game.World.UnitAt(AxialCoord{Q: 0, R: 0}); unit.AvailableHealth != 70 || game.PlayerCoins[1] != 5 {
+		t.Fatalf("Expected a 20 health repair for 5 coins, got %d health and %d coins left", unit.AvailableHealth, game.PlayerCoins[1])
+	}
+
+	// Units are only repaired as far as the player can afford
+	game.PlayerCoins[1] = 3
+	endTurn(t, game)
+	endTurn(t, game)
+	if unit := game.World.UnitAt(AxialCoord{Q: 0, R: 0}); unit.AvailableHealth != 83 || game.PlayerCoins[1] != 0 {
+		t.Errorf("Expected a 13 health repair for the last 3 coins, got %d health and %d coins left", unit.AvailableHealth, game.PlayerCoins[1])
+	}
+}
+
+func TestMedicHealsAdjacentUnit(t *testing.T) {
+	game := newTestGame(t)
+	medic := NewUnit(27, 1, AxialCoord{Q: 1, R: 0})
+	medic.ActionsRemaining = 1
+	game.World.AddUnit(medic)
+	game.World.UnitAt(AxialCoord{Q: 2, R: 0}).AvailableHealth = 90
+
+	var dmp DefaultMoveProcessor
+	options, err := dmp.GetHealOptions(game, 1, 0)
+	if err != nil || len(options) != 1 || options[0].Amount != 10 {
+		t.Fatalf("Expected to be able to heal the damaged soldier by 10, got %v (%v)", options, err)
+	}
+
+	results, err := dmp.ProcessMoves(game, []*v1.GameMove{{Player: 1, MoveType: &v1.GameMove_HealUnit{HealUnit: options[0].Action}}})
+	if err != nil {
+		t.Fatalf("Failed to heal unit: %v", err)
+	}
+	if len(results[0].Changes) != 1 {
+		t.Fatalf("Expected a single heal change, got %v", results[0].Changes)
+	}
+	healed := results[0].Changes[0].GetUnitHealed()
+	if healed == nil || healed.UpdatedUnit.AvailableHealth != 100 {
+		t.Errorf("Expected the soldier to be healed to full health, got %v", results[0].Changes)
+	}
+	if healed.GetPreviousHealer().GetActionsRemaining() != 1 || healed.GetUpdatedHealer().GetActionsRemaining() != 0 {
+		t.Errorf("Expected the heal to record the medic using up its action, got %v", healed)
+	}
+	if inverse, err := InvertWorldChange(results[0].Changes[0]); err != nil || inverse.GetUnitHealed().GetUpdatedHealer().GetActionsRemaining() != 1 {
+		t.Errorf("Expected undoing the heal to give the medic its action back, got %v (%v)", inverse, err)
+	}
+
+	// Healing uses up the medic's action for the turn
+	game.World.UnitAt(AxialCoord{Q: 2, R: 0}).AvailableHealth = 50
+	if _, err := dmp.ProcessHealUnit(game, nil, options[0].Action); err == nil {
+		t.Error("Expected a medic not to heal twice in a turn")
+	}
+
+	// Enemy units cannot be healed
+	game.World.UnitAt(AxialCoord{Q: 1, R: 0}).ActionsRemaining = 1
+	enemy := NewUnit(1, 2, AxialCoord{Q: 1, R: -1})
+	enemy.AvailableHealth = 50
+	game.World.AddUnit(enemy)
+	if _, err := dmp.ProcessHealUnit(game, nil, &v1.HealUnitAction{HealerQ: 1, HealerR: 0, TargetQ: 1, TargetR: -1}); err == nil {
+		t.Error("Expected a medic not to heal an enemy unit")
+	}
+}
@@ -0,0 +1,106 @@
+package weewar
+
+import (
+	"testing"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+// newCorridorWorld creates a one tile wide row of grass from (0,0) to (length-1,0) with a
+// unit of the given type for player 1 at the start
+func newCorridorWorld(length int, unitType int) (*World, *v1.Unit) {
+	world := NewWorld("corridor")
+	for q := 0; q < length; q++ {
+		world.AddTile(NewTile(AxialCoord{Q: q, R: 0}, 5))
+	}
+	mover := NewUnit(unitType, 1, AxialCoord{Q: 0, R: 0})
+	mover.DistanceLeft = 3
+	world.AddUnit(mover)
+	return world, mover
+}
+
+func hasOption(options []TileOption, coord AxialCoord) bool {
+	for _, option := range options {
+		if option.Coord == coord {
+			return true
+		}
+	}
+	return false
+}
+
+func TestZoneOfControlStopsMovement(t *testing.T) {
+	world, mover := newCorridorWorld(4, 1)
+	rulesEngine := DefaultRulesEngine()
+
+	options, err := rulesEngine.GetMovementOptions(world, mover, 3, nil)
+	if err != nil {
+		t.Fatalf("Failed to get movement options: %v", err)
+	}
+	if !hasOption(options, AxialCoord{Q: 3, R: 0}) {
+		t.Fatalf("Expected the end of the corridor to be reachable, got %v", options)
+	}
+
+	// An enemy soldier next to (2,0) and (3,0) makes the mover stop at (2,0)
+	world.AddUnit(NewUnit(1, 2, AxialCoord{Q: 2, R: 1}))
+	options, err = rulesEngine.GetMovementOptions(world, mover, 3, nil)
+	if err != nil {
+		t.Fatalf("Failed to get movement options: %v", err)
+	}
+	if !hasOption(options, AxialCoord{Q: 2, R: 0}) || hasOption(options, AxialCoord{Q: 3, R: 0}) {
+		t.Errorf("Expected movement to stop on entering the zone of control, got %v", options)
+	}
+
+	path := []AxialCoord{{Q: 0, R: 0}, {Q: 1, R: 0}, {Q: 2, R: 0}, {Q: 3, R: 0}}
+	if valid, _ := rulesEngine.IsValidPath(mover, path, world, nil); valid {
+		t.Error("Expected a path through the zone of control to be invalid")
+	}
+	if valid, err := rulesEngine.IsValidPath(mover, path[:3], world, nil); !valid {
+		t.Errorf("Expected a path ending in the zone of control to be valid: %v", err)
+	}
+
+	// Allies do not exert a zone of control
+	options, err = rulesEngine.GetMovementOptions(world, mover, 3, Teams{1: 1, 2: 1})
+	if err != nil {
+		t.Fatalf("Failed to get movement options: %v", err)
+	}
+	if !hasOption(options, AxialCoord{Q: 3, R: 0}) {
+		t.Errorf("Expected allies not to stop movement, got %v", options)
+	}
+}
+
+func TestAirUnitsIgnoreZoneOfControl(t *testing.T) {
+	world, helicopter := newCorridorWorld(4, 17)
+	world.AddUnit(NewUnit(1, 2, AxialCoord{Q: 2, R: 1}))
+	rulesEngine := DefaultRulesEngine()
+
+	options, err := rulesEngine.GetMovementOptions(world, helicopter, 3, nil)
+	if err != nil {
+		t.Fatalf("Failed to get movement options: %v", err)
+	}
+	if !hasOption(options, AxialCoord{Q: 3, R: 0}) {
+		t.Errorf("Expected air units to fly past the zone of control, got %v", options)
+	}
+}
+
+func TestPassThroughAlliesRule(t *testing.T) {
+	world, mover := newCorridorWorld(3, 1)
+	world.AddUnit(NewUnit(1, 1, AxialCoord{Q: 1, R: 0}))
+
+	rulesEngine := *DefaultRulesEngine()
+	options, err := rulesEngine.GetMovementOptions(world, mover, 3, nil)
+	if err != nil {
+		t.Fatalf("Failed to get movement options: %v", err)
+	}
+	if !hasOption(options, AxialCoord{Q: 2, R: 0}) {
+		t.Errorf("Expected to move through a friendly unit, got %v", options)
+	}
+
+	rulesEngine.MovementRules = &v1.MovementRules{PassThroughAllies: false}
+	options, err = rulesEngine.GetMovementOptions(world, mover, 3, nil)
+	if err != nil {
+		t.Fatalf("Failed to get movement options: %v", err)
+	}
+	if len(options) != 0 {
+		t.Errorf("Expected friendly units to block movement when the rules forbid passing through, got %v", options)
+	}
+}
@@ -14,15 +14,64 @@ type MoveProcessor interface {
 type DefaultMoveProcessor struct {
 }
 
-// Process a set of moves in a transaction and returns a "log entry" of the changes as a result
+// MoveError describes which move in a batch failed and why
+type MoveError struct {
+	MoveIndex int          // Index of the failed move in the batch
+	Move      *v1.GameMove // The move that failed
+	Err       error        // Why the move failed
+}
+
+func (e *MoveError) Error() string {
+	return fmt.Sprintf("move %d failed: %v", e.MoveIndex, e.Err)
+}
+
+func (e *MoveError) Unwrap() error {
+	return e.Err
+}
+
+// Process a set of moves in a transaction and returns a "log entry" of the changes as a result.
+// All moves are processed on a pushed world and only committed if every move succeeds.  If
+// any move fails the game is left unchanged and a *MoveError is returned.
 func (m *DefaultMoveProcessor) ProcessMoves(game *Game, moves []*v1.GameMove) (results []*v1.GameMoveResult, err error) {
+	world := game.World
+	checkpoint := game.checkpoint()
+	game.World = world.Push()
+
 	results = []*v1.GameMoveResult{}
-	for _, move := range moves {
-		result, err := m.ProcessMove(game, move)
+	for i, move := range moves {
+		var result *v1.GameMoveResult
+		var visibleBefore *Visibility
+		if game.FogOfWar {
+			visibleBefore = game.ComputeVisibility(move.Player)
+		}
+		if game.hasWinner {
+			err = fmt.Errorf("game is over")
+		} else {
+			result, err = m.ProcessMove(game, move)
+		}
 		if err != nil {
-			return results, err
+			// Discard the overlay and everything the batch changed
+			game.World = world
+			game.restore(checkpoint)
+			return nil, &MoveError{MoveIndex: i, Move: move, Err: err}
+		}
+		// Sequence numbers are assigned to moves by the caller (eg the games service)
+		result.SequenceNum = move.SequenceNum
+
+		// With fog of war a move that uncovers hidden enemies cannot be undone to scout for free
+		if visibleBefore != nil && game.revealsEnemies(visibleBefore, game.ComputeVisibility(move.Player)) {
+			result.IsPermanent = true
 		}
 		results = append(results, result)
+
+		// The game ends as soon as a victory condition is met, eg when the last enemy unit is killed
+		game.updateVictory()
+	}
+
+	overlay := game.World
+	game.World = world
+	if err := overlay.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit moves: %w", err)
 	}
 	return
 }
@@ -53,6 +102,24 @@ func (m *DefaultMoveProcessor) ProcessMove(game *Game, move *v1.GameMove) (resul
 	case *v1.GameMove_EndTurn:
 		fmt.Printf("Processing EndTurn: %+v\n", a.EndTurn)
 		return m.ProcessEndTurn(game, move, a.EndTurn)
+	case *v1.GameMove_BuildUnit:
+		fmt.Printf("Processing BuildUnit: %+v\n", a.BuildUnit)
+		return m.ProcessBuildUnit(game, move, a.BuildUnit)
+	case *v1.GameMove_CaptureBuilding:
+		fmt.Printf("Processing CaptureBuilding: %+v\n", a.CaptureBuilding)
+		return m.ProcessCaptureBuilding(game, move, a.CaptureBuilding)
+	case *v1.GameMove_LoadUnit:
+		fmt.Printf("Processing LoadUnit: %+v\n", a.LoadUnit)
+		return m.ProcessLoadUnit(game, move, a.LoadUnit)
+	case *v1.GameMove_UnloadUnit:
+		fmt.Printf("Processing UnloadUnit: %+v\n", a.UnloadUnit)
+		return m.ProcessUnloadUnit(game, move, a.UnloadUnit)
+	case *v1.GameMove_HealUnit:
+		fmt.Printf("Processing HealUnit: %+v\n", a.HealUnit)
+		return m.ProcessHealUnit(game, move, a.HealUnit)
+	case *v1.GameMove_ModifyTerrain:
+		fmt.Printf("Processing ModifyTerrain: %+v\n", a.ModifyTerrain)
+		return m.ProcessModifyTerrain(game, move, a.ModifyTerrain)
 	default:
 		return nil, fmt.Errorf("unknown move type: %T", move.MoveType)
 	}
@@ -65,12 +132,15 @@ func (m *DefaultMoveProcessor) ProcessEndTurn(g *Game, move *v1.GameMove, action
 	// Initialize the result object
 	results = &v1.GameMoveResult{
 		IsPermanent: false,
-		SequenceNum: 0, // TODO: Set proper sequence number
 		Changes:     []*v1.WorldChange{},
 	}
 
+	// Only the current player can end their turn
+	if err := checkMovePlayer(g, move); err != nil {
+		return nil, err
+	}
+
 	// Store previous state for GameLog
-	// TODO - use a pushed world at ProcessMoves level instead of g.World each time
 	previousPlayer := g.CurrentPlayer
 	previousTurn := g.TurnCounter
 
@@ -89,8 +159,7 @@ func (m *DefaultMoveProcessor) ProcessEndTurn(g *Game, move *v1.GameMove, action
 
 	// Debug: Print all unit positions in the world before capturing resetUnits
 	fmt.Printf("ProcessEndTurn: DEBUG - All units in world before capturing resetUnits:\n")
-	allUnits := g.World.unitsByCoord
-	for _, unit := range allUnits {
+	for _, unit := range g.World.UnitsByCoord() {
 		fmt.Printf("  Unit at (%d, %d) player=%d, distanceLeft=%d\n", unit.Q, unit.R, unit.Player, unit.DistanceLeft)
 	}
 
@@ -98,13 +167,16 @@ func (m *DefaultMoveProcessor) ProcessEndTurn(g *Game, move *v1.GameMove, action
 		fmt.Printf("ProcessEndTurn: Adding resetUnit at (%d, %d) player=%d, distanceLeft=%d\n",
 			unit.Q, unit.R, unit.Player, unit.DistanceLeft)
 		resetUnit := &v1.Unit{
-			Q:               unit.Q,
-			R:               unit.R,
-			Player:          unit.Player,
-			UnitType:        unit.UnitType,
-			AvailableHealth: unit.AvailableHealth,
-			DistanceLeft:    unit.DistanceLeft,
-			TurnCounter:     unit.TurnCounter,
+			Q:                unit.Q,
+			R:                unit.R,
+			Player:           unit.Player,
+			UnitType:         unit.UnitType,
+			AvailableHealth:  unit.AvailableHealth,
+			DistanceLeft:     unit.DistanceLeft,
+			TurnCounter:      unit.TurnCounter,
+			HasMoved:         unit.HasMoved,
+			HasAttacked:      unit.HasAttacked,
+			ActionsRemaining: unit.ActionsRemaining,
 		}
 		resetUnits = append(resetUnits, resetUnit)
 	}
@@ -125,16 +197,6 @@ func (m *DefaultMoveProcessor) ProcessEndTurn(g *Game, move *v1.GameMove, action
 
 	fmt.Printf("ProcessEndTurn: AFTER turn advance - newCurrentPlayer=%d, turnCounter=%d\n", g.CurrentPlayer, g.TurnCounter)
 
-	// Check for victory conditions
-	if winner, hasWinner := g.checkVictoryConditions(); hasWinner {
-		g.winner = winner
-		g.hasWinner = true
-		g.Status = GameStatusEnded
-
-		// Update GameLog status when game ends
-		// TODO - g.SetGameLogStatus("completed")
-	}
-
 	// Update timestamp
 	g.LastActionAt = time.Now()
 	change := &v1.WorldChange{
@@ -151,9 +213,105 @@ func (m *DefaultMoveProcessor) ProcessEndTurn(g *Game, move *v1.GameMove, action
 
 	results.Changes = append(results.Changes, change)
 
+	// Credit income to the player whose turn is starting
+	if income := g.CalculateTurnIncome(g.CurrentPlayer); income > 0 {
+		results.Changes = append(results.Changes, g.adjustPlayerCoins(g.CurrentPlayer, income))
+	}
+
+	// Repair the starting player's units on their own bases (after income so repairs can be paid for)
+	results.Changes = append(results.Changes, g.repairPlayerUnits(g.CurrentPlayer)...)
+
 	return
 }
 
+// checkMovePlayer checks a move is made by the player whose turn it is
+func checkMovePlayer(g *Game, move *v1.GameMove) error {
+	if move.GetPlayer() != g.CurrentPlayer {
+		return fmt.Errorf("move is by player %d, but it's player %d's turn", move.GetPlayer(), g.CurrentPlayer)
+	}
+	return nil
+}
+
+// BuildUnit creates a new unit on a base owned by the current player
+func (m *DefaultMoveProcessor) ProcessBuildUnit(g *Game, move *v1.GameMove, action *v1.BuildUnitAction) (result *v1.GameMoveResult, err error) {
+	// Initialize the result object
+	result = &v1.GameMoveResult{
+		IsPermanent: false,
+		Changes:     []*v1.WorldChange{},
+	}
+
+	if err := checkMovePlayer(g, move); err != nil {
+		return nil, err
+	}
+
+	coord := CoordFromInt32(action.Q, action.R)
+	tile := g.World.TileAt(coord)
+	if tile == nil {
+		return nil, fmt.Errorf("no tile at %v", coord)
+	}
+
+	// Check the base belongs to the current player
+	if tile.Player != g.CurrentPlayer {
+		return nil, fmt.Errorf("tile at %v is not owned by player %d", coord, g.CurrentPlayer)
+	}
+
+	if g.World.UnitAt(coord) != nil {
+		return nil, fmt.Errorf("tile at %v is already occupied", coord)
+	}
+
+	if !g.rulesEngine.CanBuildUnit(tile.TileType, action.UnitType) {
+		return nil, fmt.Errorf("unit type %d cannot be built on terrain %d", action.UnitType, tile.TileType)
+	}
+
+	unitData, err := g.rulesEngine.GetUnitData(action.UnitType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unit data: %w", err)
+	}
+
+	coins := g.GetPlayerCoins(g.CurrentPlayer)
+	if coins < unitData.Coins {
+		return nil, fmt.Errorf("insufficient coins: need %d, have %d", unitData.Coins, coins)
+	}
+
+	// Newly built units cannot move or attack until the next turn
+	unit := NewUnit(int(action.UnitType), int(g.CurrentPlayer), coord)
+	unit.AvailableHealth = unitData.Health
+	unit.DistanceLeft = 0
+	unit.ActionsRemaining = 0
+	unit.TurnCounter = g.TurnCounter
+	if _, err := g.World.AddUnit(unit); err != nil {
+		return nil, fmt.Errorf("failed to add unit: %w", err)
+	}
+
+	result.Changes = append(result.Changes, g.adjustPlayerCoins(g.CurrentPlayer, -unitData.Coins))
+
+	createdUnit := &v1.Unit{
+		Q:                unit.Q,
+		R:                unit.R,
+		Player:           unit.Player,
+		UnitType:         unit.UnitType,
+		AvailableHealth:  unit.AvailableHealth,
+		DistanceLeft:     unit.DistanceLeft,
+		TurnCounter:      unit.TurnCounter,
+		HasMoved:         unit.HasMoved,
+		HasAttacked:      unit.HasAttacked,
+		ActionsRemaining: unit.ActionsRemaining,
+	}
+	change := &v1.WorldChange{
+		ChangeType: &v1.WorldChange_UnitCreated{
+			UnitCreated: &v1.UnitCreatedChange{
+				Unit: createdUnit,
+			},
+		},
+	}
+	result.Changes = append(result.Changes, change)
+
+	// Update timestamp
+	g.LastActionAt = time.Now()
+
+	return result, nil
+}
+
 // CanEndTurn checks if current player can end their turn
 /*
 func (g *Game) CanEndTurn() bool {
@@ -183,9 +341,12 @@ func (g *Game) IsValidMove(from, to AxialCoord) bool {
 		return false
 	}
 
-	// Create simple two-tile path and validate using RulesEngine
-	path := []AxialCoord{from, to}
-	valid, err := g.rulesEngine.IsValidPath(unit, path, g.World)
+	// Validate the cheapest path using RulesEngine
+	path, err := g.rulesEngine.FindPath(g.World, unit, to, g.PlayerTeams)
+	if err != nil {
+		return false
+	}
+	valid, err := g.rulesEngine.IsValidPath(unit, path, g.World, g.PlayerTeams)
 	if err != nil {
 		return false
 	}
@@ -193,16 +354,41 @@ func (g *Game) IsValidMove(from, to AxialCoord) bool {
 	return valid
 }
 
+// FindPath returns the cheapest path for the unit at from to reach to
+func (g *Game) FindPath(from, to AxialCoord) ([]AxialCoord, error) {
+	unit := g.World.UnitAt(from)
+	if unit == nil {
+		return nil, fmt.Errorf("no unit at %v", from)
+	}
+	return g.rulesEngine.FindPath(g.World, unit, to, g.PlayerTeams)
+}
+
+// movePath returns the path a move action takes - the one given in the action or the cheapest one
+func (g *Game) movePath(unit *v1.Unit, action *v1.MoveUnitAction) ([]AxialCoord, error) {
+	from := CoordFromInt32(action.FromQ, action.FromR)
+	to := CoordFromInt32(action.ToQ, action.ToR)
+	if len(action.Path) == 0 {
+		return g.rulesEngine.FindPath(g.World, unit, to, g.PlayerTeams)
+	}
+
+	path := make([]AxialCoord, len(action.Path))
+	for i, coord := range action.Path {
+		path[i] = CoordFromInt32(coord.Q, coord.R)
+	}
+	if path[0] != from || path[len(path)-1] != to {
+		return nil, fmt.Errorf("path must run from %v to %v", from, to)
+	}
+	return path, nil
+}
+
 // MoveUnit executes unit movement using cube coordinates
 func (m *DefaultMoveProcessor) ProcessMoveUnit(g *Game, move *v1.GameMove, action *v1.MoveUnitAction) (result *v1.GameMoveResult, err error) {
 	// Initialize the result object
 	result = &v1.GameMoveResult{
 		IsPermanent: false,
-		SequenceNum: 0, // TODO: Set proper sequence number
 		Changes:     []*v1.WorldChange{},
 	}
 
-	// TODO - use a pushed world at ProcessMoves level instead of g.World each time
 	from := CoordFromInt32(action.FromQ, action.FromR)
 	to := CoordFromInt32(action.ToQ, action.ToR)
 	unit := g.World.UnitAt(from)
@@ -215,31 +401,47 @@ func (m *DefaultMoveProcessor) ProcessMoveUnit(g *Game, move *v1.GameMove, actio
 		return nil, fmt.Errorf("not player %d's turn", unit.Player)
 	}
 
-	// Check if move is valid
+	// Check the unit's actions this turn still allow it to move
+	if err := g.validateUnitCanMove(unit); err != nil {
+		return nil, err
+	}
+
+	// Check the path is valid step by step
 	unitCoord := UnitGetCoord(unit)
-	if !g.IsValidMove(unitCoord, to) {
+	path, err := g.movePath(unit, action)
+	if err != nil {
+		return nil, fmt.Errorf("invalid move from %v to %v: %w", unitCoord, to, err)
+	}
+	if len(path) < 2 {
 		return nil, fmt.Errorf("invalid move from %v to %v", unitCoord, to)
 	}
+	if _, err := g.rulesEngine.IsValidPath(unit, path, g.World, g.PlayerTeams); err != nil {
+		return nil, fmt.Errorf("invalid move from %v to %v: %w", unitCoord, to, err)
+	}
 
-	// Get movement cost using RulesEngine
-	costFloat, err := g.rulesEngine.GetMovementCost(g.World, unit, to)
+	// Charge the terrain cost of each step taken
+	stepCosts, err := g.rulesEngine.PathStepCosts(g.World, unit.UnitType, path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate movement cost: %w", err)
 	}
-	cost := int(costFloat + 0.5) // Round to nearest integer
-	if cost > int(unit.DistanceLeft) {
-		return nil, fmt.Errorf("insufficient movement points: need %d, have %d", cost, unit.DistanceLeft)
+	costFloat := 0.0
+	for _, stepCost := range stepCosts {
+		costFloat += stepCost
 	}
+	cost := MovementPoints(costFloat)
 
 	// Capture unit state before move
 	previousUnit := &v1.Unit{
-		Q:               unit.Q,
-		R:               unit.R,
-		Player:          unit.Player,
-		UnitType:        unit.UnitType,
-		AvailableHealth: unit.AvailableHealth,
-		DistanceLeft:    unit.DistanceLeft,
-		TurnCounter:     unit.TurnCounter,
+		Q:                unit.Q,
+		R:                unit.R,
+		Player:           unit.Player,
+		UnitType:         unit.UnitType,
+		AvailableHealth:  unit.AvailableHealth,
+		DistanceLeft:     unit.DistanceLeft,
+		TurnCounter:      unit.TurnCounter,
+		HasMoved:         unit.HasMoved,
+		HasAttacked:      unit.HasAttacked,
+		ActionsRemaining: unit.ActionsRemaining,
 	}
 
 	// Move unit using World unit management
@@ -249,17 +451,21 @@ func (m *DefaultMoveProcessor) ProcessMoveUnit(g *Game, move *v1.GameMove, actio
 	}
 
 	// Update unit stats
-	unit.DistanceLeft -= int32(cost)
+	unit.DistanceLeft -= cost
+	unit.HasMoved = true
 
 	// Capture unit state after move
 	updatedUnit := &v1.Unit{
-		Q:               unit.Q,
-		R:               unit.R,
-		Player:          unit.Player,
-		UnitType:        unit.UnitType,
-		AvailableHealth: unit.AvailableHealth,
-		DistanceLeft:    unit.DistanceLeft,
-		TurnCounter:     unit.TurnCounter,
+		Q:                unit.Q,
+		R:                unit.R,
+		Player:           unit.Player,
+		UnitType:         unit.UnitType,
+		AvailableHealth:  unit.AvailableHealth,
+		DistanceLeft:     unit.DistanceLeft,
+		TurnCounter:      unit.TurnCounter,
+		HasMoved:         unit.HasMoved,
+		HasAttacked:      unit.HasAttacked,
+		ActionsRemaining: unit.ActionsRemaining,
 	}
 
 	// Update timestamp
@@ -276,6 +482,11 @@ func (m *DefaultMoveProcessor) ProcessMoveUnit(g *Game, move *v1.GameMove, actio
 	}
 
 	result.Changes = append(result.Changes, change)
+
+	// Leaving a tile abandons any capture in progress there
+	if abandoned := g.abandonCapture(from); abandoned != nil {
+		result.Changes = append(result.Changes, abandoned)
+	}
 	return result, nil
 }
 
@@ -284,11 +495,9 @@ func (m *DefaultMoveProcessor) ProcessAttackUnit(g *Game, move *v1.GameMove, act
 	// Initialize the result object
 	result = &v1.GameMoveResult{
 		IsPermanent: true, // Attacks are permanent (cannot be undone)
-		SequenceNum: 0,    // TODO: Set proper sequence number
 		Changes:     []*v1.WorldChange{},
 	}
 
-	// TODO - use a pushed world at ProcessMoves level instead of g.World each time
 	attacker := g.World.UnitAt(CoordFromInt32(action.AttackerQ, action.AttackerR))
 	defender := g.World.UnitAt(CoordFromInt32(action.DefenderQ, action.DefenderR))
 	if attacker == nil || defender == nil {
@@ -300,39 +509,57 @@ func (m *DefaultMoveProcessor) ProcessAttackUnit(g *Game, move *v1.GameMove, act
 		return nil, fmt.Errorf("not player %d's turn", attacker.Player)
 	}
 
+	// Check the attacker has an action left this turn
+	if err := g.validateUnitCanAttack(attacker); err != nil {
+		return nil, err
+	}
+
 	// Check if units can attack each other
 	if !g.CanAttackUnit(attacker, defender) {
 		return nil, fmt.Errorf("attacker cannot attack defender")
 	}
 
-	// Store original health for world changes
-	attackerOriginalHealth := attacker.AvailableHealth
+	// Store original state for world changes
+	attackerOriginal := CopyUnit(attacker)
 	defenderOriginalHealth := defender.AvailableHealth
 
-	// Calculate damage using rules engine
+	// Attacking uses up one of the attacker's actions and, unless its rules allow moving
+	// after attacking, the rest of its movement
+	attacker.HasAttacked = true
+	attacker.ActionsRemaining--
+	if !g.rulesEngine.CanMoveAfterAttack(attacker.UnitType) {
+		attacker.DistanceLeft = 0
+	}
+	attackerSpent := CopyUnit(attacker)
+
+	// Calculate damage using rules engine - the defender's terrain and both units' health count
 	attackerDamage := 0
 	defenderDamage := 0
 
-	defenderDamage, err = g.rulesEngine.CalculateCombatDamage(attacker.UnitType, defender.UnitType, g.rng)
+	defenderTile := g.World.TileAt(UnitGetCoord(defender))
+	defenderDamage, err = g.rulesEngine.CalculateCombatDamage(attacker, defender, defenderTile, g.rng)
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate combat damage: %w", err)
 	}
 
-	// Check if defender can counter-attack
-	if canCounter, err := g.rulesEngine.CanUnitAttackTarget(defender, attacker); err == nil && canCounter {
-		attackerDamage, err = g.rulesEngine.CalculateCombatDamage(defender.UnitType, attacker.UnitType, g.rng)
-		if err != nil {
-			// If counter-attack calculation fails, no counter damage
-			attackerDamage = 0
-		}
-	}
-
 	// Apply damage
 	defender.AvailableHealth -= int32(defenderDamage)
 	if defender.AvailableHealth < 0 {
 		defender.AvailableHealth = 0
 	}
 
+	// Check if a surviving defender can counter-attack with the health it has left
+	if defender.AvailableHealth > 0 {
+		if g.rulesEngine.CanCounterAttack(attacker, defender, g.PlayerTeams) {
+			attackerTile := g.World.TileAt(UnitGetCoord(attacker))
+			attackerDamage, err = g.rulesEngine.CalculateCombatDamage(defender, attacker, attackerTile, g.rng)
+			if err != nil {
+				// If counter-attack calculation fails, no counter damage
+				attackerDamage = 0
+			}
+		}
+	}
+
 	attacker.AvailableHealth -= int32(attackerDamage)
 	if attacker.AvailableHealth < 0 {
 		attacker.AvailableHealth = 0
@@ -342,62 +569,65 @@ func (m *DefaultMoveProcessor) ProcessAttackUnit(g *Game, move *v1.GameMove, act
 	defenderKilled := defender.AvailableHealth <= 0
 	attackerKilled := attacker.AvailableHealth <= 0
 
-	// Add damage changes to world changes
-	if defenderDamage > 0 {
-		// Capture defender state before damage
-		defenderPreviousUnit := &v1.Unit{
-			Q:               defender.Q,
-			R:               defender.R,
-			Player:          defender.Player,
-			UnitType:        defender.UnitType,
-			AvailableHealth: defenderOriginalHealth,
-			DistanceLeft:    defender.DistanceLeft,
-			TurnCounter:     defender.TurnCounter,
-		}
+	// Add damage changes to world changes.  The defender's change is recorded even if it took no
+	// damage as it also records the attacker using up its action.
+
+	// Capture defender state before damage
+	defenderPreviousUnit := &v1.Unit{
+		Q:                defender.Q,
+		R:                defender.R,
+		Player:           defender.Player,
+		UnitType:         defender.UnitType,
+		AvailableHealth:  defenderOriginalHealth,
+		DistanceLeft:     defender.DistanceLeft,
+		TurnCounter:      defender.TurnCounter,
+		HasMoved:         defender.HasMoved,
+		HasAttacked:      defender.HasAttacked,
+		ActionsRemaining: defender.ActionsRemaining,
+	}
 
-		// Capture defender state after damage
-		defenderUpdatedUnit := &v1.Unit{
-			Q:               defender.Q,
-			R:               defender.R,
-			Player:          defender.Player,
-			UnitType:        defender.UnitType,
-			AvailableHealth: defender.AvailableHealth,
-			DistanceLeft:    defender.DistanceLeft,
-			TurnCounter:     defender.TurnCounter,
-		}
+	// Capture defender state after damage
+	defenderUpdatedUnit := &v1.Unit{
+		Q:                defender.Q,
+		R:                defender.R,
+		Player:           defender.Player,
+		UnitType:         defender.UnitType,
+		AvailableHealth:  defender.AvailableHealth,
+		DistanceLeft:     defender.DistanceLeft,
+		TurnCounter:      defender.TurnCounter,
+		HasMoved:         defender.HasMoved,
+		HasAttacked:      defender.HasAttacked,
+		ActionsRemaining: defender.ActionsRemaining,
+	}
 
-		change := &v1.WorldChange{
-			ChangeType: &v1.WorldChange_UnitDamaged{
-				UnitDamaged: &v1.UnitDamagedChange{
-					PreviousUnit: defenderPreviousUnit,
-					UpdatedUnit:  defenderUpdatedUnit,
-				},
+	change := &v1.WorldChange{
+		ChangeType: &v1.WorldChange_UnitDamaged{
+			UnitDamaged: &v1.UnitDamagedChange{
+				PreviousUnit:     defenderPreviousUnit,
+				UpdatedUnit:      defenderUpdatedUnit,
+				PreviousAttacker: CopyUnit(attackerOriginal),
+				UpdatedAttacker:  attackerSpent,
 			},
-		}
-		result.Changes = append(result.Changes, change)
+		},
 	}
+	result.Changes = append(result.Changes, change)
 
 	if attackerDamage > 0 {
-		// Capture attacker state before damage
-		attackerPreviousUnit := &v1.Unit{
-			Q:               attacker.Q,
-			R:               attacker.R,
-			Player:          attacker.Player,
-			UnitType:        attacker.UnitType,
-			AvailableHealth: attackerOriginalHealth,
-			DistanceLeft:    attacker.DistanceLeft,
-			TurnCounter:     attacker.TurnCounter,
-		}
+		// Capture attacker state before damage, after it used up its action
+		attackerPreviousUnit := CopyUnit(attackerSpent)
 
 		// Capture attacker state after damage
 		attackerUpdatedUnit := &v1.Unit{
-			Q:               attacker.Q,
-			R:               attacker.R,
-			Player:          attacker.Player,
-			UnitType:        attacker.UnitType,
-			AvailableHealth: attacker.AvailableHealth,
-			DistanceLeft:    attacker.DistanceLeft,
-			TurnCounter:     attacker.TurnCounter,
+			Q:                attacker.Q,
+			R:                attacker.R,
+			Player:           attacker.Player,
+			UnitType:         attacker.UnitType,
+			AvailableHealth:  attacker.AvailableHealth,
+			DistanceLeft:     attacker.DistanceLeft,
+			TurnCounter:      attacker.TurnCounter,
+			HasMoved:         attacker.HasMoved,
+			HasAttacked:      attacker.HasAttacked,
+			ActionsRemaining: attacker.ActionsRemaining,
 		}
 
 		change := &v1.WorldChange{
@@ -413,15 +643,20 @@ func (m *DefaultMoveProcessor) ProcessAttackUnit(g *Game, move *v1.GameMove, act
 
 	// Add kill changes if units were killed
 	if defenderKilled {
-		// Capture defender state before being killed (use original health before damage)
+		// Capture defender state before being killed (use original health before damage).
+		// Any units it was carrying are destroyed with it.
 		defenderPreviousUnit := &v1.Unit{
-			Q:               defender.Q,
-			R:               defender.R,
-			Player:          defender.Player,
-			UnitType:        defender.UnitType,
-			AvailableHealth: defenderOriginalHealth,
-			DistanceLeft:    defender.DistanceLeft,
-			TurnCounter:     defender.TurnCounter,
+			Q:                defender.Q,
+			R:                defender.R,
+			Player:           defender.Player,
+			UnitType:         defender.UnitType,
+			AvailableHealth:  defenderOriginalHealth,
+			DistanceLeft:     defender.DistanceLeft,
+			TurnCounter:      defender.TurnCounter,
+			HasMoved:         defender.HasMoved,
+			HasAttacked:      defender.HasAttacked,
+			ActionsRemaining: defender.ActionsRemaining,
+			Cargo:            defender.Cargo,
 		}
 
 		change := &v1.WorldChange{
@@ -432,20 +667,15 @@ func (m *DefaultMoveProcessor) ProcessAttackUnit(g *Game, move *v1.GameMove, act
 			},
 		}
 		result.Changes = append(result.Changes, change)
+		if abandoned := g.abandonCapture(UnitGetCoord(defender)); abandoned != nil {
+			result.Changes = append(result.Changes, abandoned)
+		}
 		g.World.RemoveUnit(defender)
 	}
 
 	if attackerKilled {
 		// Capture attacker state before being killed (use original health before damage)
-		attackerPreviousUnit := &v1.Unit{
-			Q:               attacker.Q,
-			R:               attacker.R,
-			Player:          attacker.Player,
-			UnitType:        attacker.UnitType,
-			AvailableHealth: attackerOriginalHealth,
-			DistanceLeft:    attacker.DistanceLeft,
-			TurnCounter:     attacker.TurnCounter,
-		}
+		attackerPreviousUnit := CopyUnit(attackerOriginal)
 
 		change := &v1.WorldChange{
 			ChangeType: &v1.WorldChange_UnitKilled{
@@ -455,6 +685,9 @@ func (m *DefaultMoveProcessor) ProcessAttackUnit(g *Game, move *v1.GameMove, act
 			},
 		}
 		result.Changes = append(result.Changes, change)
+		if abandoned := g.abandonCapture(UnitGetCoord(attacker)); abandoned != nil {
+			result.Changes = append(result.Changes, abandoned)
+		}
 		g.World.RemoveUnit(attacker)
 	}
 
@@ -464,6 +697,132 @@ func (m *DefaultMoveProcessor) ProcessAttackUnit(g *Game, move *v1.GameMove, act
 	return result, nil
 }
 
+// CaptureBuilding captures (or continues capturing) the building under a unit.
+// Each capture action adds a turn of progress and uses up the unit's turn.  Once
+// the progress reaches the terrain's capture turns the tile changes owner.
+func (m *DefaultMoveProcessor) ProcessCaptureBuilding(g *Game, move *v1.GameMove, action *v1.CaptureBuildingAction) (result *v1.GameMoveResult, err error) {
+	// Initialize the result object
+	result = &v1.GameMoveResult{
+		IsPermanent: false,
+		Changes:     []*v1.WorldChange{},
+	}
+
+	unit := g.World.UnitAt(CoordFromInt32(action.Q, action.R))
+	if unit == nil {
+		return nil, fmt.Errorf("unit is nil")
+	}
+
+	tile, err := g.validateCapture(unit)
+	if err != nil {
+		return nil, err
+	}
+
+	previousTile := CopyTile(tile)
+	previousUnit := CopyUnit(unit)
+
+	// A different player taking over the capture starts from scratch
+	if tile.CapturePlayer != unit.Player {
+		tile.CapturePlayer = unit.Player
+		tile.CaptureProgress = 0
+	}
+	tile.CaptureProgress++
+
+	// Capture complete - the tile changes owner
+	if tile.CaptureProgress >= g.rulesEngine.GetCaptureTurns(tile.TileType) {
+		tile.Player = unit.Player
+		tile.CapturePlayer = 0
+		tile.CaptureProgress = 0
+	}
+
+	// Capturing uses up the unit's turn so it can neither move nor attack afterwards
+	unit.DistanceLeft = 0
+	unit.HasMoved = true
+	unit.ActionsRemaining = 0
+
+	change := &v1.WorldChange{
+		ChangeType: &v1.WorldChange_TileCaptured{
+			TileCaptured: &v1.TileCapturedChange{
+				PreviousTile: previousTile,
+				UpdatedTile:  CopyTile(tile),
+				PreviousUnit: previousUnit,
+				UpdatedUnit:  CopyUnit(unit),
+			},
+		},
+	}
+	result.Changes = append(result.Changes, change)
+
+	// Update timestamp
+	g.LastActionAt = time.Now()
+
+	return result, nil
+}
+
+// validateCapture checks if a unit can capture the tile it is standing on and returns the tile
+func (g *Game) validateCapture(unit *v1.Unit) (*v1.Tile, error) {
+	if unit.Player != g.CurrentPlayer {
+		return nil, fmt.Errorf("not player %d's turn", unit.Player)
+	}
+	if !g.rulesEngine.CanUnitCapture(unit.UnitType) {
+		return nil, fmt.Errorf("unit type %d cannot capture buildings", unit.UnitType)
+	}
+
+	coord := UnitGetCoord(unit)
+	tile := g.World.TileAt(coord)
+	if tile == nil {
+		return nil, fmt.Errorf("no tile at %v", coord)
+	}
+	if g.rulesEngine.GetCaptureTurns(tile.TileType) <= 0 {
+		return nil, fmt.Errorf("terrain %d cannot be captured", tile.TileType)
+	}
+	if tile.Player == unit.Player {
+		return nil, fmt.Errorf("tile at %v is already owned by player %d", coord, unit.Player)
+	}
+	if tile.Player != 0 && g.PlayerTeams.AreAllies(tile.Player, unit.Player) {
+		return nil, fmt.Errorf("tile at %v is owned by teammate %d", coord, tile.Player)
+	}
+	if unit.DistanceLeft <= 0 {
+		return nil, fmt.Errorf("unit has no movement points remaining")
+	}
+	return tile, nil
+}
+
+// validateUnitCanMove checks if a unit's actions this turn still allow it to move
+func (g *Game) validateUnitCanMove(unit *v1.Unit) error {
+	if unit.HasAttacked && !g.rulesEngine.CanMoveAfterAttack(unit.UnitType) {
+		return fmt.Errorf("unit type %d cannot move after attacking", unit.UnitType)
+	}
+	return nil
+}
+
+// validateUnitCanAttack checks if a unit has an action left to attack with this turn
+func (g *Game) validateUnitCanAttack(unit *v1.Unit) error {
+	if unit.ActionsRemaining <= 0 {
+		return fmt.Errorf("unit has no actions remaining this turn")
+	}
+	return nil
+}
+
+// abandonCapture resets any capture in progress on a tile (eg when the capturing unit leaves or dies)
+func (g *Game) abandonCapture(coord AxialCoord) *v1.WorldChange {
+	tile := g.World.TileAt(coord)
+	if tile == nil || tile.CapturePlayer == 0 {
+		return nil
+	}
+
+	previousTile := CopyTile(tile)
+	tile.CapturePlayer = 0
+	tile.CaptureProgress = 0
+
+	return &v1.WorldChange{
+		ChangeType: &v1.WorldChange_TileCaptured{
+			TileCaptured: &v1.TileCapturedChange{
+				PreviousTile: previousTile,
+				UpdatedTile:  CopyTile(tile),
+			},
+		},
+	}
+}
+
 // CanMoveUnit validates potential movement using cube coordinates
 func (g *Game) CanMoveUnit(unit *v1.Unit, to AxialCoord) bool {
 	if unit == nil {
@@ -492,18 +851,26 @@ func (g *Game) CanAttackUnit(attacker, defender *v1.Unit) bool {
 	}
 
 	// Check if units are enemies
-	if attacker.Player == defender.Player {
+	if g.PlayerTeams.AreAllies(attacker.Player, defender.Player) {
 		return false
 	}
 
 	// Use rules engine for attack validation
-	canAttack, err := g.rulesEngine.CanUnitAttackTarget(attacker, defender)
+	canAttack, err := g.rulesEngine.CanUnitAttackTarget(attacker, defender, g.PlayerTeams)
 	if err != nil {
 		return false
 	}
 	return canAttack
 }
 
+// GetCombatPrediction returns the damage an attacker would deal to a defender where they stand now
+func (g *Game) GetCombatPrediction(attacker, defender *v1.Unit) (*v1.DamageDistribution, error) {
+	if attacker == nil || defender == nil {
+		return nil, fmt.Errorf("attacker or defender is nil")
+	}
+	return g.rulesEngine.GetCombatPrediction(attacker, defender, g.World.TileAt(UnitGetCoord(defender)))
+}
+
 // AttackUnitAt executes combat between units at the given coordinates
 func (g *Game) AttackUnitAt(attackerPos, targetPos AxialCoord) (*CombatResult, error) {
 	// Find attacker unit using World
@@ -552,7 +919,10 @@ func (m *DefaultMoveProcessor) GetMovementOptions(game *Game, q, r int32) ([]Til
 	if unit.DistanceLeft <= 0 {
 		return nil, fmt.Errorf("unit has no movement points remaining")
 	}
-	return game.rulesEngine.GetMovementOptions(game.World, unit, int(unit.DistanceLeft))
+	if err := game.validateUnitCanMove(unit); err != nil {
+		return nil, err
+	}
+	return game.rulesEngine.GetMovementOptions(game.World, unit, int(unit.DistanceLeft), game.PlayerTeams)
 }
 
 // GetAttackOptions returns attack options for unit at given coordinates with full validation
@@ -567,7 +937,48 @@ func (m *DefaultMoveProcessor) GetAttackOptions(game *Game, q, r int32) ([]Axial
 	if unit.AvailableHealth <= 0 {
 		return nil, fmt.Errorf("unit has no health remaining")
 	}
-	return game.rulesEngine.GetAttackOptions(game.World, unit)
+	if err := game.validateUnitCanAttack(unit); err != nil {
+		return nil, err
+	}
+	return game.rulesEngine.GetAttackOptions(game.World, unit, game.PlayerTeams)
+}
+
+// GetBuildOptions returns the unit types the current player can afford to build at given coordinates
+func (m *DefaultMoveProcessor) GetBuildOptions(game *Game, q, r int32) ([]*v1.UnitDefinition, error) {
+	coord := AxialCoord{Q: int(q), R: int(r)}
+	tile := game.World.TileAt(coord)
+	if tile == nil {
+		return nil, fmt.Errorf("no tile found at position (%d, %d)", q, r)
+	}
+	if tile.Player != game.CurrentPlayer {
+		return nil, fmt.Errorf("tile belongs to player %d, but it's player %d's turn", tile.Player, game.CurrentPlayer)
+	}
+	if game.World.UnitAt(coord) != nil {
+		return nil, fmt.Errorf("tile at (%d, %d) is occupied", q, r)
+	}
+
+	coins := game.GetPlayerCoins(game.CurrentPlayer)
+	var options []*v1.UnitDefinition
+	for _, unitType := range game.rulesEngine.GetBuildableUnits(tile.TileType) {
+		unitData, err := game.rulesEngine.GetUnitData(unitType)
+		if err != nil || unitData.Coins > coins {
+			continue
+		}
+		options = append(options, unitData)
+	}
+	return options, nil
+}
+
+// GetCaptureOption returns the tile the unit at given coordinates can capture with full validation
+func (m *DefaultMoveProcessor) GetCaptureOption(game *Game, q, r int32) (*v1.Tile, error) {
+	unit := game.World.UnitAt(AxialCoord{Q: int(q), R: int(r)})
+	if unit == nil {
+		return nil, fmt.Errorf("no unit found at position (%d, %d)", q, r)
+	}
+	if unit.AvailableHealth <= 0 {
+		return nil, fmt.Errorf("unit has no health remaining")
+	}
+	return game.validateCapture(unit)
 }
 
 // CanSelectUnit validates if unit at given coordinates can be selected by current player
@@ -609,7 +1020,7 @@ func (g *Game) GetUnitMovementOptions(unit *v1.Unit) ([]TileOption, error) {
 	if unit != nil {
 		dl = int(unit.DistanceLeft)
 	}
-	return g.rulesEngine.GetMovementOptions(g.World, unit, dl)
+	return g.rulesEngine.GetMovementOptions(g.World, unit, dl, g.PlayerTeams)
 }
 
 // GetUnitAttackOptions returns all positions a unit can attack using rules engine
@@ -617,7 +1028,7 @@ func (g *Game) GetUnitAttackOptionsFrom(q, r int) ([]AxialCoord, error) {
 	return g.GetUnitAttackOptions(g.World.UnitAt(AxialCoord{q, r}))
 }
 func (g *Game) GetUnitAttackOptions(unit *v1.Unit) ([]AxialCoord, error) {
-	return g.rulesEngine.GetAttackOptions(g.World, unit)
+	return g.rulesEngine.GetAttackOptions(g.World, unit, g.PlayerTeams)
 }
 
 /*
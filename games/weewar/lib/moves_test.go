@@ -0,0 +1,403 @@
+package weewar
+
+import (
+	"slices"
+	"testing"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+// newTestGame creates a small two player game on a grass field with a land base
+// owned by player 1 at the origin
+func newTestGame(t *testing.T) *Game {
+	world := NewWorld("test")
+	for q := -3; q <= 3; q++ {
+		for r := -3; r <= 3; r++ {
+			world.AddTile(NewTile(AxialCoord{Q: q, R: r}, 5))
+		}
+	}
+	base := world.TileAt(AxialCoord{Q: 0, R: 0})
+	base.TileType = 1
+	base.Player = 1
+
+	world.AddUnit(NewUnit(1, 1, AxialCoord{Q: 2, R: 0}))
+	world.AddUnit(NewUnit(1, 2, AxialCoord{Q: -2, R: 0}))
+
+	game, err := NewGame(world, DefaultRulesEngine(), 42)
+	if err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+	return game
+}
+
+func TestProcessBuildUnit(t *testing.T) {
+	game := newTestGame(t)
+	game.PlayerCoins[1] = 100
+
+	var dmp DefaultMoveProcessor
+	build := &v1.GameMove{
+		Player:   1,
+		MoveType: &v1.GameMove_BuildUnit{BuildUnit: &v1.BuildUnitAction{Q: 0, R: 0, UnitType: 1}},
+	}
+	result, err := dmp.ProcessMove(game, build)
+	if err != nil {
+		t.Fatalf("Failed to build unit: %v", err)
+	}
+
+	if len(result.Changes) != 2 {
+		t.Fatalf("Expected 2 changes, got %d", len(result.Changes))
+	}
+	if coins := result.Changes[0].GetCoinsChanged(); coins == nil || coins.PreviousCoins != 100 || coins.NewCoins != 25 {
+		t.Errorf("Unexpected coins change: %v", result.Changes[0])
+	}
+	if created := result.Changes[1].GetUnitCreated(); created == nil || created.Unit.UnitType != 1 {
+		t.Errorf("Unexpected unit created change: %v", result.Changes[1])
+	}
+
+	unit := game.World.UnitAt(AxialCoord{Q: 0, R: 0})
+	if unit == nil || unit.Player != 1 {
+		t.Fatalf("Built unit not found on base")
+	}
+	if unit.DistanceLeft != 0 {
+		t.Errorf("Built unit should not be able to move this turn, has %d", unit.DistanceLeft)
+	}
+
+	// Base is now occupied
+	if _, err := dmp.ProcessMove(game, build); err == nil {
+		t.Error("Expected building on an occupied base to fail")
+	}
+}
+
+func TestProcessBuildUnitValidation(t *testing.T) {
+	game := newTestGame(t)
+	var dmp DefaultMoveProcessor
+
+	// Not enough coins
+	game.PlayerCoins[1] = 10
+	if _, err := dmp.ProcessBuildUnit(game, &v1.GameMove{Player: 1}, &v1.BuildUnitAction{Q: 0, R: 0, UnitType: 1}); err == nil {
+		t.Error("Expected build with insufficient coins to fail")
+	}
+
+	// Naval units cannot be built on a land base
+	game.PlayerCoins[1] = 10000
+	if _, err := dmp.ProcessBuildUnit(game, &v1.GameMove{Player: 1}, &v1.BuildUnitAction{Q: 0, R: 0, UnitType: 12}); err == nil {
+		t.Error("Expected building a battleship on a land base to fail")
+	}
+
+	// Grass is not a base
+	if _, err := dmp.ProcessBuildUnit(game, &v1.GameMove{Player: 1}, &v1.BuildUnitAction{Q: 1, R: 0, UnitType: 1}); err == nil {
+		t.Error("Expected building on grass to fail")
+	}
+
+	// Only the current player can build
+	if _, err := dmp.ProcessBuildUnit(game, &v1.GameMove{Player: 2}, &v1.BuildUnitAction{Q: 0, R: 0, UnitType: 1}); err == nil {
+		t.Error("Expected building out of turn to fail")
+	}
+
+	options, err := dmp.GetBuildOptions(game, 0, 0)
+	if err != nil {
+		t.Fatalf("Failed to get build options: %v", err)
+	}
+	if len(options) == 0 {
+		t.Error("Expected build options on land base")
+	}
+}
+
+func TestEndTurnCreditsIncome(t *testing.T) {
+	game := newTestGame(t)
+	game.CoinSettings = &v1.CoinSettings{StartOfGame: 300, PerTurn: 50, PerBase: 100}
+
+	// Give player 2 a base so it earns base income when its turn starts
+	base2 := game.World.TileAt(AxialCoord{Q: -1, R: 0})
+	base2.TileType = 1
+	base2.Player = 2
+
+	var dmp DefaultMoveProcessor
+	result, err := dmp.ProcessMove(game, &v1.GameMove{Player: 1, MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}})
+	if err != nil {
+		t.Fatalf("Failed to end turn: %v", err)
+	}
+
+	if got := game.GetPlayerCoins(2); got != 150 {
+		t.Errorf("Expected player 2 to have 150 coins, got %d", got)
+	}
+	if got := game.GetPlayerCoins(1); got != 0 {
+		t.Errorf("Expected player 1 coins to be unchanged, got %d", got)
+	}
+
+	last := result.Changes[len(result.Changes)-1].GetCoinsChanged()
+	if last == nil || last.Player != 2 || last.NewCoins != 150 {
+		t.Errorf("Expected coins changed for player 2, got %v", result.Changes[len(result.Changes)-1])
+	}
+}
+
+func TestEndTurnOutOfTurn(t *testing.T) {
+	game := newTestGame(t)
+
+	var dmp DefaultMoveProcessor
+	if _, err := dmp.ProcessMove(game, &v1.GameMove{Player: 2, MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}); err == nil {
+		t.Error("Expected ending another player's turn to fail")
+	}
+	if game.CurrentPlayer != 1 || game.TurnCounter != 1 {
+		t.Errorf("Expected player 1's turn to continue, got player %d on turn %d", game.CurrentPlayer, game.TurnCounter)
+	}
+}
+
+func TestProcessCaptureBuilding(t *testing.T) {
+	game := newTestGame(t)
+	city := game.World.TileAt(AxialCoord{Q: 2, R: 0})
+	city.TileType = 21
+
+	var dmp DefaultMoveProcessor
+	capture := &v1.GameMove{
+		Player:   1,
+		MoveType: &v1.GameMove_CaptureBuilding{CaptureBuilding: &v1.CaptureBuildingAction{Q: 2, R: 0}},
+	}
+
+	// First turn of capture only makes progress
+	result, err := dmp.ProcessMove(game, capture)
+	if err != nil {
+		t.Fatalf("Failed to capture: %v", err)
+	}
+	captured := result.Changes[0].GetTileCaptured()
+	if captured == nil || captured.UpdatedTile.CaptureProgress != 1 || captured.UpdatedTile.Player != 0 {
+		t.Fatalf("Unexpected capture change: %v", result.Changes[0])
+	}
+	if city.CapturePlayer != 1 || city.Player != 0 {
+		t.Errorf("Expected capture in progress by player 1, got capturePlayer=%d, player=%d", city.CapturePlayer, city.Player)
+	}
+
+	// Capturing uses up the unit's turn
+	if _, err := dmp.ProcessMove(game, capture); err == nil {
+		t.Error("Expected second capture in the same turn to fail")
+	}
+	if unit := captured.UpdatedUnit; !unit.HasMoved || unit.ActionsRemaining != 0 {
+		t.Errorf("Expected the capture to record the unit's spent turn, got %v", unit)
+	}
+	game.World.AddUnit(NewUnit(1, 2, AxialCoord{Q: 3, R: 0}))
+	if _, err := dmp.ProcessAttackUnit(game, nil, &v1.AttackUnitAction{AttackerQ: 2, AttackerR: 0, DefenderQ: 3, DefenderR: 0}); err == nil {
+		t.Error("Expected attacking after capturing in the same turn to fail")
+	}
+
+	// Next turn completes the capture
+	game.World.UnitAt(AxialCoord{Q: 2, R: 0}).DistanceLeft = 3
+	if _, err := dmp.ProcessMove(game, capture); err != nil {
+		t.Fatalf("Failed to complete capture: %v", err)
+	}
+	if city.Player != 1 || city.CaptureProgress != 0 || city.CapturePlayer != 0 {
+		t.Errorf("Expected city to be owned by player 1, got player=%d progress=%d", city.Player, city.CaptureProgress)
+	}
+
+	// Can't capture what we already own
+	game.World.UnitAt(AxialCoord{Q: 2, R: 0}).DistanceLeft = 3
+	if _, err := dmp.ProcessMove(game, capture); err == nil {
+		t.Error("Expected capturing an owned building to fail")
+	}
+}
+
+func TestMovingOffAbandonsCapture(t *testing.T) {
+	game := newTestGame(t)
+	city := game.World.TileAt(AxialCoord{Q: 2, R: 0})
+	city.TileType = 21
+
+	var dmp DefaultMoveProcessor
+	if _, err := dmp.ProcessCaptureBuilding(game, nil, &v1.CaptureBuildingAction{Q: 2, R: 0}); err != nil {
+		t.Fatalf("Failed to capture: %v", err)
+	}
+
+	game.World.UnitAt(AxialCoord{Q: 2, R: 0}).DistanceLeft = 3
+	result, err := dmp.ProcessMoveUnit(game, nil, &v1.MoveUnitAction{FromQ: 2, FromR: 0, ToQ: 3, ToR: 0})
+	if err != nil {
+		t.Fatalf("Failed to move: %v", err)
+	}
+	if city.CapturePlayer != 0 || city.CaptureProgress != 0 {
+		t.Errorf("Expected capture to be abandoned, got capturePlayer=%d progress=%d", city.CapturePlayer, city.CaptureProgress)
+	}
+	if result.Changes[len(result.Changes)-1].GetTileCaptured() == nil {
+		t.Error("Expected a tile captured change for the abandoned capture")
+	}
+}
+
+func TestProcessMovesIsAtomic(t *testing.T) {
+	game := newTestGame(t)
+	game.PlayerCoins[1] = 100
+
+	var dmp DefaultMoveProcessor
+	moves := []*v1.GameMove{
+		{Player: 1, MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{FromQ: 2, FromR: 0, ToQ: 3, ToR: 0}}},
+		{Player: 1, MoveType: &v1.GameMove_BuildUnit{BuildUnit: &v1.BuildUnitAction{Q: 0, R: 0, UnitType: 1}}},
+		// Grass is not a base so this fails
+		{Player: 1, MoveType: &v1.GameMove_BuildUnit{BuildUnit: &v1.BuildUnitAction{Q: 1, R: 0, UnitType: 1}}},
+	}
+	_, err := dmp.ProcessMoves(game, moves)
+	moveErr, ok := err.(*MoveError)
+	if !ok {
+		t.Fatalf("Expected a MoveError, got %v", err)
+	}
+	if moveErr.MoveIndex != 2 {
+		t.Errorf("Expected move 2 to fail, got %d", moveErr.MoveIndex)
+	}
+
+	// Nothing from the failed batch was applied
+	if game.World.UnitAt(AxialCoord{Q: 2, R: 0}) == nil || game.World.UnitAt(AxialCoord{Q: 3, R: 0}) != nil {
+		t.Error("Expected unit move to be rolled back")
+	}
+	if game.World.UnitAt(AxialCoord{Q: 0, R: 0}) != nil {
+		t.Error("Expected built unit to be rolled back")
+	}
+	if game.GetPlayerCoins(1) != 100 {
+		t.Errorf("Expected coins to be rolled back, got %d", game.GetPlayerCoins(1))
+	}
+
+	// The valid part of the batch commits
+	if _, err := dmp.ProcessMoves(game, moves[:2]); err != nil {
+		t.Fatalf("Failed to process moves: %v", err)
+	}
+	if game.World.UnitAt(AxialCoord{Q: 3, R: 0}) == nil || game.World.UnitAt(AxialCoord{Q: 0, R: 0}) == nil {
+		t.Error("Expected moves to be committed")
+	}
+	if game.GetPlayerCoins(1) != 25 {
+		t.Errorf("Expected coins to be spent, got %d", game.GetPlayerCoins(1))
+	}
+}
+
+func TestProcessMovesKeepsSequenceNumbers(t *testing.T) {
+	game := newTestGame(t)
+
+	var dmp DefaultMoveProcessor
+	moves := []*v1.GameMove{
+		{Player: 1, SequenceNum: 7, MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{FromQ: 2, FromR: 0, ToQ: 3, ToR: 0}}},
+		{Player: 1, SequenceNum: 8, MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}},
+	}
+	results, err := dmp.ProcessMoves(game, moves)
+	if err != nil {
+		t.Fatalf("Failed to process moves: %v", err)
+	}
+	for i, result := range results {
+		if result.SequenceNum != moves[i].SequenceNum {
+			t.Errorf("Expected result %d to have sequence number %d, got %d", i, moves[i].SequenceNum, result.SequenceNum)
+		}
+	}
+}
+
+func TestProcessMoveUnitAlongPath(t *testing.T) {
+	game := newTestGame(t)
+	game.World.TileAt(AxialCoord{Q: 1, R: 0}).TileType = 7 // Mountains cost a soldier 2
+
+	path, err := game.FindPath(AxialCoord{Q: 2, R: 0}, AxialCoord{Q: 0, R: 1})
+	if err != nil {
+		t.Fatalf("Failed to find path: %v", err)
+	}
+	if !slices.Equal(path, []AxialCoord{{Q: 2, R: 0}, {Q: 1, R: 1}, {Q: 0, R: 1}}) {
+		t.Errorf("Expected the cheapest path to go around the mountains, got %v", path)
+	}
+
+	var dmp DefaultMoveProcessor
+	invalid := []*v1.HexCoord{{Q: 2, R: 0}, {Q: 0, R: 1}}
+	if _, err := dmp.ProcessMoveUnit(game, nil, &v1.MoveUnitAction{FromQ: 2, FromR: 0, ToQ: 0, ToR: 1, Path: invalid}); err == nil {
+		t.Error("Expected a path that jumps hexes to be rejected")
+	}
+
+	// The player's chosen path is charged its real cost even if it is not the cheapest
+	overMountains := []*v1.HexCoord{{Q: 2, R: 0}, {Q: 1, R: 0}, {Q: 0, R: 1}}
+	if _, err := dmp.ProcessMoveUnit(game, nil, &v1.MoveUnitAction{FromQ: 2, FromR: 0, ToQ: 0, ToR: 1, Path: overMountains}); err != nil {
+		t.Fatalf("Failed to move along path: %v", err)
+	}
+	if unit := game.World.UnitAt(AxialCoord{Q: 0, R: 1}); unit == nil || unit.DistanceLeft != 0 {
+		t.Errorf("Expected the move over the mountains to use all 3 movement points, got %v", unit)
+	}
+}
+
+func TestProcessMoveUnitChargesCheapestPath(t *testing.T) {
+	game := newTestGame(t)
+	game.World.TileAt(AxialCoord{Q: 1, R: 0}).TileType = 7
+
+	var dmp DefaultMoveProcessor
+	if _, err := dmp.ProcessMoveUnit(game, nil, &v1.MoveUnitAction{FromQ: 2, FromR: 0, ToQ: 0, ToR: 1}); err != nil {
+		t.Fatalf("Failed to move: %v", err)
+	}
+	if unit := game.World.UnitAt(AxialCoord{Q: 0, R: 1}); unit == nil || unit.DistanceLeft != 1 {
+		t.Errorf("Expected the move around the mountains to cost 2 movement points, got %v", unit)
+	}
+}
+
+func TestAttackUsesUpAction(t *testing.T) {
+	game := newTestGame(t)
+	game.World.AddUnit(NewUnit(1, 2, AxialCoord{Q: 3, R: 0}))
+
+	var dmp DefaultMoveProcessor
+	attack := &v1.AttackUnitAction{AttackerQ: 2, AttackerR: 0, DefenderQ: 3, DefenderR: 0}
+	if _, err := dmp.ProcessAttackUnit(game, nil, attack); err != nil {
+		t.Fatalf("Failed to attack: %v", err)
+	}
+	attacker := game.World.UnitAt(AxialCoord{Q: 2, R: 0})
+	if !attacker.HasAttacked || attacker.ActionsRemaining != 0 {
+		t.Errorf("Expected the attack to use up the attacker's action, got %v", attacker)
+	}
+	if _, err := dmp.ProcessAttackUnit(game, nil, attack); err == nil {
+		t.Error("Expected a second attack in the same turn to be rejected")
+	}
+	if options, err := dmp.GetAttackOptions(game, 2, 0); err == nil {
+		t.Errorf("Expected no attack options after attacking, got %v", options)
+	}
+
+	// Soldiers can still move after attacking
+	if _, err := dmp.ProcessMoveUnit(game, nil, &v1.MoveUnitAction{FromQ: 2, FromR: 0, ToQ: 1, ToR: 0}); err != nil {
+		t.Fatalf("Failed to move after attacking: %v", err)
+	}
+	if !attacker.HasMoved {
+		t.Errorf("Expected the unit to be marked as moved, got %v", attacker)
+	}
+
+	// Ending the turn gives the unit its actions back
+	endTurn(t, game)
+	endTurn(t, game)
+	if attacker.HasMoved || attacker.HasAttacked || attacker.ActionsRemaining != 1 {
+		t.Errorf("Expected the unit's actions to be reset for the new turn, got %v", attacker)
+	}
+}
+
+func TestOneShotKillRecordsAttackerAction(t *testing.T) {
+	game := newTestGame(t)
+	defender := NewUnit(1, 2, AxialCoord{Q: 3, R: 0})
+	defender.AvailableHealth = 1
+	game.World.AddUnit(defender)
+
+	var dmp DefaultMoveProcessor
+	result, err := dmp.ProcessAttackUnit(game, nil, &v1.AttackUnitAction{AttackerQ: 2, AttackerR: 0, DefenderQ: 3, DefenderR: 0})
+	if err != nil {
+		t.Fatalf("Failed to attack: %v", err)
+	}
+	if len(result.Changes) != 2 || result.Changes[1].GetUnitKilled() == nil {
+		t.Fatalf("Expected the defender to be damaged and killed, got %v", result.Changes)
+	}
+
+	// The killed defender cannot counter attack but the attacker's used up action is still recorded
+	damaged := result.Changes[0].GetUnitDamaged()
+	if damaged.GetPreviousAttacker().GetActionsRemaining() != 1 || damaged.GetUpdatedAttacker().GetActionsRemaining() != 0 || !damaged.GetUpdatedAttacker().GetHasAttacked() {
+		t.Errorf("Expected the attack to record the attacker using up its action, got %v", damaged)
+	}
+	if inverse, err := InvertWorldChange(result.Changes[0]); err != nil || inverse.GetUnitDamaged().GetUpdatedAttacker().GetHasAttacked() {
+		t.Errorf("Expected undoing the attack to give the attacker its action back, got %v (%v)", inverse, err)
+	}
+}
+
+func TestArtilleryCannotMoveAfterAttack(t *testing.T) {
+	game := newTestGame(t)
+	artillery := NewUnit(8, 1, AxialCoord{Q: 0, R: 2})
+	artillery.DistanceLeft = 3
+	artillery.ActionsRemaining = 1
+	game.World.AddUnit(artillery)
+	game.World.AddUnit(NewUnit(1, 2, AxialCoord{Q: 2, R: 2}))
+
+	var dmp DefaultMoveProcessor
+	if _, err := dmp.ProcessAttackUnit(game, nil, &v1.AttackUnitAction{AttackerQ: 0, AttackerR: 2, DefenderQ: 2, DefenderR: 2}); err != nil {
+		t.Fatalf("Failed to attack: %v", err)
+	}
+	if artillery.DistanceLeft != 0 {
+		t.Errorf("Expected artillery to lose its movement after attacking, got %v", artillery)
+	}
+	if _, err := dmp.ProcessMoveUnit(game, nil, &v1.MoveUnitAction{FromQ: 0, FromR: 2, ToQ: 0, ToR: 3}); err == nil {
+		t.Error("Expected artillery to be unable to move after attacking")
+	}
+}
@@ -55,3 +55,46 @@ func ProtoInt32(val int) int32 {
 func ProtoInt(val int32) int {
 	return int(val)
 }
+
+// CopyUnit returns a snapshot of a unit's complete state
+func CopyUnit(u *v1.Unit) *v1.Unit {
+	return &v1.Unit{
+		Q:                u.Q,
+		R:                u.R,
+		Player:           u.Player,
+		UnitType:         u.UnitType,
+		AvailableHealth:  u.AvailableHealth,
+		DistanceLeft:     u.DistanceLeft,
+		TurnCounter:      u.TurnCounter,
+		HasMoved:         u.HasMoved,
+		HasAttacked:      u.HasAttacked,
+		ActionsRemaining: u.ActionsRemaining,
+		Cargo:            CopyUnits(u.Cargo),
+	}
+}
+
+// CopyUnits returns snapshots of a list of units (eg a transport's cargo)
+func CopyUnits(units []*v1.Unit) []*v1.Unit {
+	if units == nil {
+		return nil
+	}
+	out := make([]*v1.Unit, len(units))
+	for i, u := range units {
+		out[i] = CopyUnit(u)
+	}
+	return out
+}
+
+// CopyTile returns a snapshot of a tile's complete state
+func CopyTile(t *v1.Tile) *v1.Tile {
+	return &v1.Tile{
+		Q:                     t.Q,
+		R:                     t.R,
+		TileType:              t.TileType,
+		Player:                t.Player,
+		CapturePlayer:         t.CapturePlayer,
+		CaptureProgress:       t.CaptureProgress,
+		TerrainAction:         t.TerrainAction,
+		TerrainActionProgress: t.TerrainActionProgress,
+	}
+}
@@ -0,0 +1,146 @@
+package weewar
+
+import (
+	"fmt"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Replay - Recomputing a game from its move history
+// =============================================================================
+
+// ReplayDivergence describes the first point where a replayed game differs from its recorded history
+type ReplayDivergence struct {
+	GroupIndex  int             // Index of the move group in the history
+	MoveIndex   int             // Index of the move in the group (-1 if not specific to a move)
+	ChangeIndex int             // Index of the change in the move's result (-1 if not specific to a change)
+	SequenceNum int64           // Sequence number of the move
+	Reason      string          // What was different
+	Expected    *v1.WorldChange // The recorded change (if any)
+	Actual      *v1.WorldChange // The recomputed change (if any)
+}
+
+// ReplayResult summarizes a replay of a move history
+type ReplayResult struct {
+	GroupsReplayed int
+	MovesReplayed  int
+	Divergence     *ReplayDivergence // nil if the replay matched the history
+}
+
+// ReplayHistory re-executes the moves in a history on a game that is in the history's
+// initial state (including its random seed) and compares the results with the recorded
+// ones.  The replay stops at the first divergence and the game is left in the replayed state.
+func ReplayHistory(game *Game, history *v1.GameMoveHistory) *ReplayResult {
+	var dmp DefaultMoveProcessor
+	out := &ReplayResult{}
+	for groupIndex, group := range history.Groups {
+		results, err := dmp.ProcessMoves(game, group.Moves)
+		if err != nil {
+			divergence := &ReplayDivergence{GroupIndex: groupIndex, MoveIndex: -1, ChangeIndex: -1, Reason: err.Error()}
+			if moveErr, ok := err.(*MoveError); ok {
+				divergence.MoveIndex = moveErr.MoveIndex
+				divergence.SequenceNum = moveErr.Move.SequenceNum
+			}
+			out.Divergence = divergence
+			return out
+		}
+
+		if len(results) != len(group.MoveResults) {
+			out.Divergence = &ReplayDivergence{
+				GroupIndex:  groupIndex,
+				MoveIndex:   -1,
+				ChangeIndex: -1,
+				Reason:      fmt.Sprintf("recorded %d move results, replay produced %d", len(group.MoveResults), len(results)),
+			}
+			return out
+		}
+
+		for moveIndex, result := range results {
+			if divergence := compareMoveResults(group.MoveResults[moveIndex], result); divergence != nil {
+				divergence.GroupIndex = groupIndex
+				divergence.MoveIndex = moveIndex
+				divergence.SequenceNum = group.Moves[moveIndex].SequenceNum
+				out.Divergence = divergence
+				return out
+			}
+			out.MovesReplayed++
+		}
+		out.GroupsReplayed++
+	}
+	return out
+}
+
+// compareMoveResults returns where a recomputed move result differs from the recorded one or nil if they match
+func compareMoveResults(expected, actual *v1.GameMoveResult) *ReplayDivergence {
+	if expected.IsPermanent != actual.IsPermanent {
+		return &ReplayDivergence{
+			ChangeIndex: -1,
+			Reason:      fmt.Sprintf("recorded is_permanent=%t, replay produced %t", expected.IsPermanent, actual.IsPermanent),
+		}
+	}
+
+	for i := 0; i < max(len(expected.Changes), len(actual.Changes)); i++ {
+		var expectedChange, actualChange *v1.WorldChange
+		if i < len(expected.Changes) {
+			expectedChange = expected.Changes[i]
+		}
+		if i < len(actual.Changes) {
+			actualChange = actual.Changes[i]
+		}
+
+		switch {
+		case actualChange == nil:
+			return &ReplayDivergence{ChangeIndex: i, Reason: "recorded change is missing from replay", Expected: expectedChange}
+		case expectedChange == nil:
+			return &ReplayDivergence{ChangeIndex: i, Reason: "replay produced an extra change", Actual: actualChange}
+		case !proto.Equal(expectedChange, actualChange):
+			return &ReplayDivergence{ChangeIndex: i, Reason: "change differs", Expected: expectedChange, Actual: actualChange}
+		}
+	}
+	return nil
+}
+
+// DiffWorldData returns a description of the first difference between two world snapshots or
+// an empty string if they have the same tiles and units
+func DiffWorldData(expected, actual *v1.WorldData) string {
+	expectedTiles := map[AxialCoord]*v1.Tile{}
+	for _, tile := range expected.GetTiles() {
+		expectedTiles[TileGetCoord(tile)] = tile
+	}
+	actualTiles := map[AxialCoord]*v1.Tile{}
+	for _, tile := range actual.GetTiles() {
+		actualTiles[TileGetCoord(tile)] = tile
+	}
+	for coord, tile := range expectedTiles {
+		if other := actualTiles[coord]; !proto.Equal(tile, other) {
+			return fmt.Sprintf("tile at %v: expected %v, got %v", coord, tile, other)
+		}
+	}
+	for coord, tile := range actualTiles {
+		if expectedTiles[coord] == nil {
+			return fmt.Sprintf("unexpected tile at %v: %v", coord, tile)
+		}
+	}
+
+	expectedUnits := map[AxialCoord]*v1.Unit{}
+	for _, unit := range expected.GetUnits() {
+		expectedUnits[UnitGetCoord(unit)] = unit
+	}
+	actualUnits := map[AxialCoord]*v1.Unit{}
+	for _, unit := range actual.GetUnits() {
+		actualUnits[UnitGetCoord(unit)] = unit
+	}
+	for coord, unit := range expectedUnits {
+		if other := actualUnits[coord]; !proto.Equal(unit, other) {
+			return fmt.Sprintf("unit at %v: expected %v, got %v", coord, unit, other)
+		}
+	}
+	for coord, unit := range actualUnits {
+		if expectedUnits[coord] == nil {
+			return fmt.Sprintf("unexpected unit at %v: %v", coord, unit)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,71 @@
+package weewar
+
+import (
+	"testing"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+// recordTestHistory plays a few move groups on a test game and records them as a history
+func recordTestHistory(t *testing.T) *v1.GameMoveHistory {
+	game := newTestGame(t)
+	game.World.MoveUnit(game.World.UnitAt(AxialCoord{Q: -2, R: 0}), AxialCoord{Q: 1, R: 1})
+
+	groups := [][]*v1.GameMove{
+		{{Player: 1, SequenceNum: 1, MoveType: &v1.GameMove_AttackUnit{AttackUnit: &v1.AttackUnitAction{AttackerQ: 2, AttackerR: 0, DefenderQ: 1, DefenderR: 1}}}},
+		{{Player: 1, SequenceNum: 2, MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}},
+	}
+
+	var dmp DefaultMoveProcessor
+	history := &v1.GameMoveHistory{}
+	for _, moves := range groups {
+		results, err := dmp.ProcessMoves(game, moves)
+		if err != nil {
+			t.Fatalf("Failed to process moves: %v", err)
+		}
+		history.Groups = append(history.Groups, &v1.GameMoveGroup{Moves: moves, MoveResults: results})
+	}
+	return history
+}
+
+func TestReplayHistoryMatches(t *testing.T) {
+	history := recordTestHistory(t)
+
+	game := newTestGame(t)
+	game.World.MoveUnit(game.World.UnitAt(AxialCoord{Q: -2, R: 0}), AxialCoord{Q: 1, R: 1})
+	result := ReplayHistory(game, history)
+	if result.Divergence != nil {
+		t.Fatalf("Expected replay to match, diverged: %+v", result.Divergence)
+	}
+	if result.GroupsReplayed != 2 || result.MovesReplayed != 2 {
+		t.Errorf("Expected 2 groups and moves replayed, got %d and %d", result.GroupsReplayed, result.MovesReplayed)
+	}
+	if game.CurrentPlayer != 2 {
+		t.Errorf("Expected replayed game to be on player 2, got %d", game.CurrentPlayer)
+	}
+}
+
+func TestReplayHistoryReportsDivergence(t *testing.T) {
+	history := recordTestHistory(t)
+
+	// Corrupt the recorded damage of the attack
+	damaged := history.Groups[0].MoveResults[0].Changes[0].GetUnitDamaged()
+	if damaged == nil {
+		t.Fatalf("Expected the attack to record damage, got %v", history.Groups[0].MoveResults[0].Changes[0])
+	}
+	damaged.UpdatedUnit.AvailableHealth += 1
+
+	game := newTestGame(t)
+	game.World.MoveUnit(game.World.UnitAt(AxialCoord{Q: -2, R: 0}), AxialCoord{Q: 1, R: 1})
+	result := ReplayHistory(game, history)
+	d := result.Divergence
+	if d == nil {
+		t.Fatal("Expected replay to diverge")
+	}
+	if d.GroupIndex != 0 || d.MoveIndex != 0 || d.ChangeIndex != 0 || d.SequenceNum != 1 {
+		t.Errorf("Unexpected divergence location: %+v", d)
+	}
+	if d.Expected == nil || d.Actual == nil {
+		t.Error("Expected both the recorded and replayed change")
+	}
+}
@@ -0,0 +1,50 @@
+package weewar
+
+import (
+	"math/rand"
+)
+
+// =============================================================================
+// Deterministic Random Numbers
+// =============================================================================
+
+// countingSource wraps a seeded random source and counts how many values have been
+// drawn from it.  A game's random stream can then be saved as (seed, position) and
+// restored exactly by reseeding and skipping ahead.
+type countingSource struct {
+	src      rand.Source64
+	position int64
+}
+
+func newCountingSource(seed int64) *countingSource {
+	return &countingSource{src: rand.NewSource(seed).(rand.Source64)}
+}
+
+func (s *countingSource) Int63() int64 {
+	s.position++
+	return s.src.Int63()
+}
+
+func (s *countingSource) Uint64() uint64 {
+	s.position++
+	return s.src.Uint64()
+}
+
+func (s *countingSource) Seed(seed int64) {
+	s.src.Seed(seed)
+	s.position = 0
+}
+
+// RNGPosition returns how many values have been drawn from the game's random stream
+func (g *Game) RNGPosition() int64 {
+	return g.rngSource.position
+}
+
+// SeekRNG resets the game's random stream to the given position from its seed
+func (g *Game) SeekRNG(position int64) {
+	g.rngSource = newCountingSource(g.Seed)
+	g.rng = rand.New(g.rngSource)
+	for g.rngSource.position < position {
+		g.rngSource.Int63()
+	}
+}
@@ -0,0 +1,45 @@
+package weewar
+
+import (
+	"testing"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+func TestSeekRNGRestoresStream(t *testing.T) {
+	game := newTestGame(t)
+	first := []float64{game.rng.Float64(), game.rng.Float64(), game.rng.Float64()}
+	if game.RNGPosition() != 3 {
+		t.Fatalf("Expected position 3, got %d", game.RNGPosition())
+	}
+
+	// A game rebuilt at position 1 continues the same stream
+	game.SeekRNG(1)
+	if next := game.rng.Float64(); next != first[1] {
+		t.Errorf("Expected %f after seeking, got %f", first[1], next)
+	}
+}
+
+func TestFailedMovesDoNotConsumeRandomness(t *testing.T) {
+	game := newTestGame(t)
+	game.World.MoveUnit(game.World.UnitAt(AxialCoord{Q: -2, R: 0}), AxialCoord{Q: 1, R: 0})
+
+	var dmp DefaultMoveProcessor
+	moves := []*v1.GameMove{
+		{Player: 1, MoveType: &v1.GameMove_AttackUnit{AttackUnit: &v1.AttackUnitAction{AttackerQ: 2, AttackerR: 0, DefenderQ: 1, DefenderR: 0}}},
+		{Player: 1, MoveType: &v1.GameMove_BuildUnit{BuildUnit: &v1.BuildUnitAction{Q: 1, R: 0, UnitType: 1}}},
+	}
+	if _, err := dmp.ProcessMoves(game, moves); err == nil {
+		t.Fatal("Expected the batch to fail")
+	}
+	if game.RNGPosition() != 0 {
+		t.Errorf("Expected random stream to be rolled back, at position %d", game.RNGPosition())
+	}
+
+	if _, err := dmp.ProcessMoves(game, moves[:1]); err != nil {
+		t.Fatalf("Failed to attack: %v", err)
+	}
+	if game.RNGPosition() == 0 {
+		t.Error("Expected the attack to draw from the random stream")
+	}
+}
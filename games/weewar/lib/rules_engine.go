@@ -2,7 +2,9 @@ package weewar
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
+	"slices"
 
 	"github.com/panyam/turnengine/games/weewar/assets"
 	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
@@ -20,17 +22,26 @@ type RulesEngine struct {
 
 	// Canonical rule matrices using proto types
 	MovementMatrix *v1.MovementMatrix `json:"movementMatrix"`
-	AttackMatrix   *AttackMatrix      `json:"attackMatrix"` // TODO: Convert to proto type
-}
+	AttackMatrix   *v1.AttackMatrix   `json:"attackMatrix"`
+
+	// How units move around other units (nil = units block all movement and there are no zones of control)
+	MovementRules *v1.MovementRules `json:"movementRules"`
 
-// MovementMatrix is now defined in protos/weewar/v1/models.proto
+	// Adjustments to combat damage beyond the attack matrix (nil = no class modifiers)
+	CombatRules *v1.CombatRules `json:"combatRules"`
 
-// AttackMatrix defines combat outcomes between unit types using IDs
-type AttackMatrix struct {
-	// attacks[attackerID][defenderID] = damage distribution
-	Attacks map[int32]map[int32]*DamageDistribution `json:"attacks"`
+	// Changes units can make to terrain during play (nil = terrain never changes)
+	TerrainActions *v1.TerrainActionRules `json:"terrainActions"`
+
+	// Per game damage multipliers keyed by attacker unit type (nil = none, see WithOverlay)
+	DamageMultipliers map[int32]float64 `json:"damageMultipliers,omitempty"`
 }
 
+// TransportProperty marks unit types that can carry other units
+const TransportProperty = "transport"
+
+// MovementMatrix and AttackMatrix are now defined in protos/weewar/v1/models.proto
+
 // =============================================================================
 // Constructor and Initialization
 // =============================================================================
@@ -41,7 +52,7 @@ func NewRulesEngine() *RulesEngine {
 		Units:          make(map[int32]*v1.UnitDefinition),
 		Terrains:       make(map[int32]*v1.TerrainDefinition),
 		MovementMatrix: &v1.MovementMatrix{Costs: make(map[int32]*v1.TerrainCostMap)},
-		AttackMatrix:   &AttackMatrix{Attacks: make(map[int32]map[int32]*DamageDistribution)},
+		AttackMatrix:   &v1.AttackMatrix{Attacks: make(map[int32]*v1.DefenderDamageMap)},
 	}
 }
 
@@ -69,6 +80,200 @@ func (re *RulesEngine) GetTerrainData(terrainID int32) (*v1.TerrainDefinition, e
 	return terrain, nil
 }
 
+// IsPlayerTerrain checks if a terrain is a player-controllable structure (bases, cities etc)
+func (re *RulesEngine) IsPlayerTerrain(terrainID int32) bool {
+	terrain, err := re.GetTerrainData(terrainID)
+	if err != nil {
+		return false
+	}
+	return TerrainType(terrain.Type) == TerrainPlayer
+}
+
+// GetBuildableUnits returns the unit types that can be built on a terrain type
+func (re *RulesEngine) GetBuildableUnits(terrainID int32) []int32 {
+	terrain, err := re.GetTerrainData(terrainID)
+	if err != nil {
+		return nil
+	}
+	return terrain.BuildableUnits
+}
+
+// CanBuildUnit checks if a unit type can be built on a terrain type
+func (re *RulesEngine) CanBuildUnit(terrainID, unitID int32) bool {
+	for _, buildable := range re.GetBuildableUnits(terrainID) {
+		if buildable == unitID {
+			return true
+		}
+	}
+	return false
+}
+
+// GetCaptureTurns returns the number of turns needed to capture a terrain type (0 = cannot be captured)
+func (re *RulesEngine) GetCaptureTurns(terrainID int32) int32 {
+	terrain, err := re.GetTerrainData(terrainID)
+	if err != nil || TerrainType(terrain.Type) != TerrainPlayer {
+		return 0
+	}
+	return terrain.CaptureTurns
+}
+
+// CanUnitCapture checks if a unit type is able to capture buildings
+func (re *RulesEngine) CanUnitCapture(unitID int32) bool {
+	unit, err := re.GetUnitData(unitID)
+	if err != nil {
+		return false
+	}
+	return unit.CanCapture
+}
+
+// GetUnitClass returns the movement class of a unit type (eg "land" or "air")
+func (re *RulesEngine) GetUnitClass(unitID int32) string {
+	unit, err := re.GetUnitData(unitID)
+	if err != nil {
+		return ""
+	}
+	return unit.UnitClass
+}
+
+// GetActionsPerTurn returns the number of attacks/actions a unit type can take each turn
+func (re *RulesEngine) GetActionsPerTurn(unitID int32) int32 {
+	unit, err := re.GetUnitData(unitID)
+	if err != nil || unit.ActionsPerTurn <= 0 {
+		return 1
+	}
+	return unit.ActionsPerTurn
+}
+
+// GetAttackRange returns the closest and furthest distances a unit type can attack at
+func (re *RulesEngine) GetAttackRange(unitID int32) (minRange, maxRange int, err error) {
+	unit, err := re.GetUnitData(unitID)
+	if err != nil {
+		return 0, 0, err
+	}
+	return max(1, int(unit.MinAttackRange)), int(unit.AttackRange), nil
+}
+
+// HasIndirectFire checks if a unit type's attacks are ranged fire that cannot be countered
+func (re *RulesEngine) HasIndirectFire(unitID int32) bool {
+	unit, err := re.GetUnitData(unitID)
+	if err != nil {
+		return false
+	}
+	return unit.IndirectFire
+}
+
+// CanMoveAfterAttack checks if a unit type can use its remaining movement after attacking
+func (re *RulesEngine) CanMoveAfterAttack(unitID int32) bool {
+	unit, err := re.GetUnitData(unitID)
+	if err != nil {
+		return false
+	}
+	return unit.CanMoveAfterAttack
+}
+
+// GetTransportCapacity returns how many units a unit type can carry (0 = not a transport)
+func (re *RulesEngine) GetTransportCapacity(unitID int32) int {
+	unit, err := re.GetUnitData(unitID)
+	if err != nil || !slices.Contains(unit.Properties, TransportProperty) {
+		return 0
+	}
+	return max(1, int(unit.TransportCapacity))
+}
+
+// CanCarry checks if a transport unit type can carry a unit type
+func (re *RulesEngine) CanCarry(transportID, cargoID int32) bool {
+	if re.GetTransportCapacity(transportID) == 0 {
+		return false
+	}
+	transport, _ := re.GetUnitData(transportID)
+	return len(transport.CargoClasses) == 0 || slices.Contains(transport.CargoClasses, re.GetUnitClass(cargoID))
+}
+
+// GetRepairAmount returns the health a unit type regains each turn on its owner's tile of a terrain type
+func (re *RulesEngine) GetRepairAmount(terrainID, unitID int32) int32 {
+	terrain, err := re.GetTerrainData(terrainID)
+	if err != nil || terrain.RepairAmount <= 0 {
+		return 0
+	}
+	if len(terrain.RepairClasses) > 0 && !slices.Contains(terrain.RepairClasses, re.GetUnitClass(unitID)) {
+		return 0
+	}
+	return terrain.RepairAmount
+}
+
+// GetRepairCost returns the coins charged to repair the given health of a unit type on a terrain type.
+// Repairs cost the terrain's fraction of the unit's price for its full health, rounded up.
+func (re *RulesEngine) GetRepairCost(terrainID, unitID, health int32) int32 {
+	terrain, err := re.GetTerrainData(terrainID)
+	if err != nil || terrain.RepairCost <= 0 {
+		return 0
+	}
+	unit, err := re.GetUnitData(unitID)
+	if err != nil || unit.Health <= 0 {
+		return 0
+	}
+	return int32(math.Ceil(terrain.RepairCost * float64(unit.Coins) * float64(health) / float64(unit.Health)))
+}
+
+// GetTerrainAction returns a terrain action by ID
+func (re *RulesEngine) GetTerrainAction(actionID string) (*v1.TerrainAction, error) {
+	action, exists := re.TerrainActions.GetActions()[actionID]
+	if !exists {
+		return nil, fmt.Errorf("terrain action %q not found", actionID)
+	}
+	return action, nil
+}
+
+// GetTerrainActionTurns returns the turns of work a terrain action needs
+func (re *RulesEngine) GetTerrainActionTurns(action *v1.TerrainAction) int32 {
+	return max(1, action.Turns)
+}
+
+// CanPerformTerrainAction checks if a unit type has the property a terrain action needs
+func (re *RulesEngine) CanPerformTerrainAction(unitID int32, action *v1.TerrainAction) bool {
+	unit, err := re.GetUnitData(unitID)
+	if err != nil {
+		return false
+	}
+	return action.UnitProperty != "" && slices.Contains(unit.Properties, action.UnitProperty)
+}
+
+// GetHealAmount returns the health a unit type restores to an adjacent friendly unit (0 if it cannot heal)
+func (re *RulesEngine) GetHealAmount(unitID int32) int32 {
+	unit, err := re.GetUnitData(unitID)
+	if err != nil {
+		return 0
+	}
+	return unit.HealAmount
+}
+
+// CanPassThrough checks if a unit may move through a hex held by another unit
+func (re *RulesEngine) CanPassThrough(unit *v1.Unit, occupant *v1.Unit, teams Teams) bool {
+	if occupant == unit {
+		return true
+	}
+	return re.MovementRules.GetPassThroughAllies() && teams.AreAllies(unit.Player, occupant.Player)
+}
+
+// InEnemyZOC checks if a unit entering a hex must stop there because it is next to an enemy
+// unit that exerts a zone of control
+func (re *RulesEngine) InEnemyZOC(world *World, unit *v1.Unit, coord AxialCoord, teams Teams) bool {
+	zocClasses := re.MovementRules.GetZocUnitClasses()
+	if len(zocClasses) == 0 || slices.Contains(re.MovementRules.GetZocImmuneClasses(), re.GetUnitClass(unit.UnitType)) {
+		return false
+	}
+
+	var neighbors [6]AxialCoord
+	coord.Neighbors(&neighbors)
+	for _, neighbor := range neighbors {
+		enemy := world.UnitAt(neighbor)
+		if enemy != nil && !teams.AreAllies(unit.Player, enemy.Player) && slices.Contains(zocClasses, re.GetUnitClass(enemy.UnitType)) {
+			return true
+		}
+	}
+	return false
+}
+
 // getUnitTerrainCost returns movement cost for unit type on terrain type (internal helper)
 // First checks unit-specific matrix, then falls back to terrain's base cost
 func (re *RulesEngine) getUnitTerrainCost(unitID, terrainID int32) (float64, error) {
@@ -93,44 +298,87 @@ func (re *RulesEngine) getUnitTerrainCost(unitID, terrainID int32) (float64, err
 }
 
 // GetMovementCost calculates movement cost for a unit to move to a specific destination
-// Uses the unit's current position as starting point and recalculates based on current world state
-func (re *RulesEngine) GetMovementCost(world *World, unit *v1.Unit, to AxialCoord) (float64, error) {
+// Uses the unit's current position as starting point and the cheapest path from there
+func (re *RulesEngine) GetMovementCost(world *World, unit *v1.Unit, to AxialCoord, teams Teams) (float64, error) {
+	path, err := re.FindPath(world, unit, to, teams)
+	if err != nil {
+		return 0, err
+	}
+	costs, err := re.PathStepCosts(world, unit.UnitType, path)
+	if err != nil {
+		return 0, err
+	}
+	total := 0.0
+	for _, cost := range costs {
+		total += cost
+	}
+	return total, nil
+}
+
+// MovementPoints converts a path cost to the whole movement points charged for it
+func MovementPoints(cost float64) int32 {
+	return int32(cost + 0.5) // Round to nearest integer
+}
+
+// FindPath returns the cheapest path (including both ends) for a unit from its current position
+// to a destination.  The path may pass through allied units but not end on any unit.  It is not
+// limited by the unit's remaining movement so callers can preview moves for later turns.
+func (re *RulesEngine) FindPath(world *World, unit *v1.Unit, to AxialCoord, teams Teams) ([]AxialCoord, error) {
 	if unit == nil {
-		return 0, fmt.Errorf("unit is nil")
+		return nil, fmt.Errorf("unit is nil")
 	}
 
 	from := UnitGetCoord(unit)
 	if from == to {
-		return 0, nil
+		return []AxialCoord{from}, nil
 	}
-
-	// For single adjacent moves, just return terrain cost
-	distance := CubeDistance(from, to)
-	if distance == 1 {
-		toTile := world.TileAt(to)
-		if toTile == nil {
-			return 0, fmt.Errorf("invalid destination tile")
-		}
-		return re.getUnitTerrainCost(unit.UnitType, toTile.TileType)
+	if world.TileAt(to) == nil {
+		return nil, fmt.Errorf("invalid destination tile %v", to)
+	}
+	if occupant := world.UnitAt(to); occupant != nil {
+		return nil, fmt.Errorf("destination %v is occupied", to)
 	}
 
-	// For multi-tile moves, use Dijkstra pathfinding
-	return re.calculatePathCost(world, unit.UnitType, from, to)
+	_, previous := re.shortestPaths(world, unit, teams, math.Inf(1))
+	path := pathTo(previous, from, to)
+	if path == nil {
+		return nil, fmt.Errorf("no path from %v to %v", from, to)
+	}
+	return path, nil
 }
 
-// calculatePathCost uses Dijkstra's algorithm to find minimum cost path
-func (re *RulesEngine) calculatePathCost(world *World, unitType int32, from, to AxialCoord) (float64, error) {
-	// Simple implementation - for now return distance * average terrain cost
-	// TODO: Implement full Dijkstra's algorithm
-	distance := float64(CubeDistance(from, to))
-
-	// Get average terrain cost as approximation
-	averageCost := 1.5                                    // Default average
-	if terrain, err := re.GetTerrainData(1); err == nil { // Use grass as reference
-		averageCost = terrain.BaseMoveCost
+// PathStepCosts returns the cost of entering each tile of a path after the first
+func (re *RulesEngine) PathStepCosts(world *World, unitType int32, path []AxialCoord) ([]float64, error) {
+	var costs []float64
+	for i := 1; i < len(path); i++ {
+		tile := world.TileAt(path[i])
+		if tile == nil {
+			return nil, fmt.Errorf("path step %d: destination tile %v does not exist", i, path[i])
+		}
+		cost, err := re.getUnitTerrainCost(unitType, tile.TileType)
+		if err != nil {
+			return nil, fmt.Errorf("path step %d: unit type %d cannot traverse terrain %d: %w", i, unitType, tile.TileType, err)
+		}
+		costs = append(costs, cost)
 	}
+	return costs, nil
+}
 
-	return distance * averageCost, nil
+// pathTo walks back through the predecessors found by shortestPaths to build the path from start
+// to a coordinate.  Returns nil if the coordinate was not reached.
+func pathTo(previous map[AxialCoord]AxialCoord, start, to AxialCoord) []AxialCoord {
+	if to != start {
+		if _, reached := previous[to]; !reached {
+			return nil
+		}
+	}
+	path := []AxialCoord{to}
+	for coord := to; coord != start; {
+		coord = previous[coord]
+		path = append(path, coord)
+	}
+	slices.Reverse(path)
+	return path
 }
 
 // =============================================================================
@@ -143,7 +391,8 @@ func (re *RulesEngine) calculatePathCost(world *World, unitType int32, from, to
 // - Terrain traversability (unit type vs terrain rules)
 // - Movement cost feasibility (enough movement points)
 // - Game state validity (no units blocking, correct start position)
-func (re *RulesEngine) IsValidPath(unit *v1.Unit, path []AxialCoord, world *World) (bool, error) {
+// - Zones of control (the path must stop on entering an enemy zone of control)
+func (re *RulesEngine) IsValidPath(unit *v1.Unit, path []AxialCoord, world *World, teams Teams) (bool, error) {
 	if unit == nil {
 		return false, fmt.Errorf("unit is nil")
 	}
@@ -176,31 +425,32 @@ func (re *RulesEngine) IsValidPath(unit *v1.Unit, path []AxialCoord, world *Worl
 			return false, fmt.Errorf("path step %d->%d: tiles are not adjacent (distance=%d)", i-1, i, distance)
 		}
 
-		// 2. Check destination tile exists
-		toTile := world.TileAt(toCoord)
-		if toTile == nil {
-			return false, fmt.Errorf("path step %d: destination tile %v does not exist", i, toCoord)
-		}
-
-		// 3. Check terrain traversability
-		stepCost, err := re.getUnitTerrainCost(unit.UnitType, toTile.TileType)
+		// 2-3. Check destination tile exists and terrain traversability
+		stepCosts, err := re.PathStepCosts(world, unit.UnitType, path[i-1:i+1])
 		if err != nil {
-			return false, fmt.Errorf("path step %d: unit type %d cannot traverse terrain %d: %w",
-				i, unit.UnitType, toTile.TileType, err)
+			return false, err
 		}
+		stepCost := stepCosts[0]
 
-		// 4. Check for blocking units
+		// 4. Check for blocking units - units may pass through allies but cannot stop on them
 		blockingUnit := world.UnitAt(toCoord)
 		if blockingUnit != nil && blockingUnit != unit {
-			return false, fmt.Errorf("path step %d: tile %v is blocked by unit", i, toCoord)
+			if i == len(path)-1 || !re.CanPassThrough(unit, blockingUnit, teams) {
+				return false, fmt.Errorf("path step %d: tile %v is blocked by unit", i, toCoord)
+			}
 		}
 
-		// 5. Accumulate movement cost
+		// 5. Check zones of control - entering an enemy's zone of control ends the move
+		if i < len(path)-1 && re.InEnemyZOC(world, unit, toCoord, teams) {
+			return false, fmt.Errorf("path step %d: unit must stop in enemy zone of control at %v", i, toCoord)
+		}
+
+		// 6. Accumulate movement cost
 		totalCost += stepCost
 	}
 
-	// 6. Check total movement cost against unit's remaining movement
-	if totalCost > float64(unit.DistanceLeft) {
+	// 7. Check the movement points charged for the path against unit's remaining movement
+	if MovementPoints(totalCost) > unit.DistanceLeft {
 		return false, fmt.Errorf("path requires %.2f movement points, unit has %d remaining",
 			totalCost, unit.DistanceLeft)
 	}
@@ -208,29 +458,103 @@ func (re *RulesEngine) IsValidPath(unit *v1.Unit, path []AxialCoord, world *Worl
 	return true, nil
 }
 
-// CalculateCombatDamage calculates damage using canonical DamageDistribution
-func (re *RulesEngine) CalculateCombatDamage(attackerID, defenderID int32, rng *rand.Rand) (int, error) {
-	attackerAttacks, exists := re.AttackMatrix.Attacks[attackerID]
-	if !exists {
-		return 0, fmt.Errorf("unit ID %d cannot attack", attackerID)
+// CalculateCombatDamage rolls the damage an attacker deals to a defender standing on defenderTile
+// from the adjusted damage distribution returned by GetCombatPrediction
+func (re *RulesEngine) CalculateCombatDamage(attacker, defender *v1.Unit, defenderTile *v1.Tile, rng *rand.Rand) (int, error) {
+	damageDist, err := re.GetCombatPrediction(attacker, defender, defenderTile)
+	if err != nil {
+		return 0, err
 	}
 
-	damageDist, exists := attackerAttacks[defenderID]
-	if !exists {
-		return 0, fmt.Errorf("unit ID %d cannot attack unit ID %d", attackerID, defenderID)
+	return int(re.rollDamageFromBuckets(damageDist.DamageBuckets, rng)), nil
+}
+
+// GetCombatPrediction returns the distribution of damage an attacker deals to a defender standing on
+// defenderTile.  The attack matrix's base damage is scaled by the attacker's remaining health, the
+// defense bonus of the defender's terrain and any class modifiers in the combat rules, and is capped
+// at the defender's remaining health.
+func (re *RulesEngine) GetCombatPrediction(attacker, defender *v1.Unit, defenderTile *v1.Tile) (*v1.DamageDistribution, error) {
+	baseDist, err := re.getBaseDamageDistribution(attacker.UnitType, defender.UnitType)
+	if err != nil {
+		return nil, err
+	}
+
+	modifier := re.CombatModifier(attacker, defender, defenderTile)
+	adjusted := &v1.DamageDistribution{}
+	totalWeight := 0.0
+	for _, bucket := range baseDist.DamageBuckets {
+		damage := int32(math.Round(float64(bucket.Damage) * modifier))
+		if damage > defender.AvailableHealth {
+			damage = defender.AvailableHealth
+		}
+
+		// Buckets that now deal the same damage are merged keeping the original order so the
+		// same random roll picks the same bucket
+		merged := false
+		for i := range adjusted.DamageBuckets {
+			if adjusted.DamageBuckets[i].Damage == damage {
+				adjusted.DamageBuckets[i].Weight += bucket.Weight
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			adjusted.DamageBuckets = append(adjusted.DamageBuckets, &v1.DamageBucket{Damage: damage, Weight: bucket.Weight})
+		}
+
+		adjusted.ExpectedDamage += float64(damage) * bucket.Weight
+		totalWeight += bucket.Weight
+	}
+	if totalWeight > 0 {
+		adjusted.ExpectedDamage /= totalWeight
+	}
+	for i, bucket := range adjusted.DamageBuckets {
+		if i == 0 || bucket.Damage < adjusted.MinDamage {
+			adjusted.MinDamage = bucket.Damage
+		}
+		adjusted.MaxDamage = max(adjusted.MaxDamage, bucket.Damage)
 	}
 
-	return re.rollDamageFromBuckets(damageDist.DamageBuckets, rng), nil
+	return adjusted, nil
 }
 
-// GetCombatPrediction provides combat prediction using existing types
-func (re *RulesEngine) GetCombatPrediction(attackerID, defenderID int32) (*DamageDistribution, error) {
-	attackerAttacks, exists := re.AttackMatrix.Attacks[attackerID]
+// CombatModifier returns the factor an attacker's base damage against a defender is scaled by
+func (re *RulesEngine) CombatModifier(attacker, defender *v1.Unit, defenderTile *v1.Tile) float64 {
+	modifier := 1.0
+
+	// Wounded units hit with a fraction of their full strength
+	if attackerData, err := re.GetUnitData(attacker.UnitType); err == nil && attackerData.Health > 0 {
+		modifier *= math.Min(float64(attacker.AvailableHealth)/float64(attackerData.Health), 1)
+	}
+
+	// Terrain protects the units on it unless their class does not benefit from cover
+	defenderClass := re.GetUnitClass(defender.UnitType)
+	if defenderTile != nil && !slices.Contains(re.CombatRules.GetTerrainDefenseIgnoredClasses(), defenderClass) {
+		if terrain, err := re.GetTerrainData(defenderTile.TileType); err == nil {
+			modifier *= 1 - math.Max(0, math.Min(terrain.DefenseBonus, 1))
+		}
+	}
+
+	// Classes can be stronger or weaker against other classes
+	if classModifier, ok := re.CombatRules.GetClassModifiers()[re.GetUnitClass(attacker.UnitType)].GetDefenderClasses()[defenderClass]; ok {
+		modifier *= classModifier
+	}
+
+	// Games can make individual unit types stronger or weaker
+	if unitModifier, ok := re.DamageMultipliers[attacker.UnitType]; ok {
+		modifier *= unitModifier
+	}
+	return modifier
+}
+
+// getBaseDamageDistribution returns the attack matrix entry for an attacker type against a defender type
+func (re *RulesEngine) getBaseDamageDistribution(attackerID, defenderID int32) (*v1.DamageDistribution, error) {
+	attackerAttacks, exists := re.AttackMatrix.GetAttacks()[attackerID]
 	if !exists {
 		return nil, fmt.Errorf("unit ID %d cannot attack", attackerID)
 	}
 
-	damageDist, exists := attackerAttacks[defenderID]
+	damageDist, exists := attackerAttacks.GetDefenderDamages()[defenderID]
 	if !exists {
 		return nil, fmt.Errorf("unit ID %d cannot attack unit ID %d", attackerID, defenderID)
 	}
@@ -239,7 +563,7 @@ func (re *RulesEngine) GetCombatPrediction(attackerID, defenderID int32) (*Damag
 }
 
 // rollDamageFromBuckets uses weighted random selection
-func (re *RulesEngine) rollDamageFromBuckets(buckets []DamageBucket, rng *rand.Rand) int {
+func (re *RulesEngine) rollDamageFromBuckets(buckets []*v1.DamageBucket, rng *rand.Rand) int32 {
 	if len(buckets) == 0 {
 		return 0
 	}
@@ -281,6 +605,19 @@ func (re *RulesEngine) GetLoadedTerrainsCount() int {
 	return len(re.Terrains)
 }
 
+// RuleSet returns the loaded rules as a single proto message so clients can be sent the same data
+func (re *RulesEngine) RuleSet() *v1.RuleSet {
+	return &v1.RuleSet{
+		Units:          re.Units,
+		Terrains:       re.Terrains,
+		MovementMatrix: re.MovementMatrix,
+		AttackMatrix:   re.AttackMatrix,
+		MovementRules:  re.MovementRules,
+		CombatRules:    re.CombatRules,
+		TerrainActions: re.TerrainActions,
+	}
+}
+
 // ValidateRules performs basic validation
 func (re *RulesEngine) ValidateRules() error {
 	if len(re.Units) == 0 {
@@ -291,6 +628,26 @@ func (re *RulesEngine) ValidateRules() error {
 		return fmt.Errorf("no terrains loaded")
 	}
 
+	for id, unit := range re.Units {
+		if unit.MinAttackRange > unit.AttackRange {
+			return fmt.Errorf("unit %d has minimum attack range %d beyond its attack range %d", id, unit.MinAttackRange, unit.AttackRange)
+		}
+	}
+
+	for id, terrain := range re.Terrains {
+		if terrain.RepairCost < 0 {
+			return fmt.Errorf("terrain %d has negative repair cost %v", id, terrain.RepairCost)
+		}
+	}
+
+	for id, action := range re.TerrainActions.GetActions() {
+		for from, to := range action.TerrainChanges {
+			if _, exists := re.Terrains[to]; !exists {
+				return fmt.Errorf("terrain action %q turns terrain %d into unknown terrain %d", id, from, to)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -298,15 +655,16 @@ func (re *RulesEngine) ValidateRules() error {
 // Spatial Query Methods for UI/Gameplay
 // =============================================================================
 
-// TileOption represents a tile that a unit can move to with its cost
+// TileOption represents a tile that a unit can move to with its cost and the cheapest path there
 type TileOption struct {
-	Coord AxialCoord `json:"coord"`
-	Cost  float64    `json:"cost"`
+	Coord AxialCoord   `json:"coord"`
+	Cost  float64      `json:"cost"`
+	Path  []AxialCoord `json:"path"`
 }
 
 // GetMovementOptions returns all EMPTY tiles a unit can move to using Dijkstra's algorithm
-// Only returns tiles without units (movement destinations)
-func (re *RulesEngine) GetMovementOptions(world *World, unit *v1.Unit, remainingMovement int) ([]TileOption, error) {
+// Only returns tiles without units (movement destinations).  Paths may pass through allied units.
+func (re *RulesEngine) GetMovementOptions(world *World, unit *v1.Unit, remainingMovement int, teams Teams) ([]TileOption, error) {
 	if unit == nil {
 		return nil, fmt.Errorf("unit is nil")
 	}
@@ -316,14 +674,42 @@ func (re *RulesEngine) GetMovementOptions(world *World, unit *v1.Unit, remaining
 		return nil, fmt.Errorf("failed to get unit data: %w", err)
 	}
 
-	unitCoord := UnitGetCoord(unit)
-	return re.dijkstraMovement(world, unit.UnitType, unitCoord, float64(remainingMovement))
+	return re.dijkstraMovement(world, unit, teams, float64(remainingMovement))
 }
 
 // dijkstraMovement implements Dijkstra's algorithm to find all reachable EMPTY tiles with minimum cost
-func (re *RulesEngine) dijkstraMovement(world *World, unitType int32, startCoord AxialCoord, maxMovement float64) ([]TileOption, error) {
+func (re *RulesEngine) dijkstraMovement(world *World, unit *v1.Unit, teams Teams, maxMovement float64) ([]TileOption, error) {
+	startCoord := UnitGetCoord(unit)
+	distances, previous := re.shortestPaths(world, unit, teams, maxMovement)
+
+	// Convert distances map to TileOption slice (excluding start position)
+	var options []TileOption
+	for coord, cost := range distances {
+		// Exclude the starting position and allied units that were only passed through
+		if coord != startCoord && world.UnitAt(coord) == nil {
+			options = append(options, TileOption{
+				Coord: coord,
+				Cost:  cost,
+				Path:  pathTo(previous, startCoord, coord),
+			})
+		}
+	}
+
+	return options, nil
+}
+
+// shortestPaths runs Dijkstra's algorithm from a unit's position and returns the minimum cost to
+// reach each tile within maxMovement along with the tile each one is reached from.  Enemy units
+// block movement, allied units can be passed through if the movement rules allow it and paths
+// end on entering an enemy zone of control.  Ties are broken by coordinate so the same path is
+// always chosen.
+func (re *RulesEngine) shortestPaths(world *World, unit *v1.Unit, teams Teams, maxMovement float64) (map[AxialCoord]float64, map[AxialCoord]AxialCoord) {
+	startCoord := UnitGetCoord(unit)
+
 	// Distance map: coord -> minimum cost to reach
 	distances := make(map[AxialCoord]float64)
+	previous := make(map[AxialCoord]AxialCoord)
+	visited := make(map[AxialCoord]bool)
 
 	// Priority queue for Dijkstra (simple implementation)
 	type queueItem struct {
@@ -348,8 +734,14 @@ func (re *RulesEngine) dijkstraMovement(world *World, unitType int32, startCoord
 		// Remove from queue
 		queue = append(queue[:minIdx], queue[minIdx+1:]...)
 
-		// Skip if we've already processed this with lower cost
-		if cost, exists := distances[current.coord]; exists && current.cost > cost {
+		// Skip if we've already processed this tile
+		if visited[current.coord] {
+			continue
+		}
+		visited[current.coord] = true
+
+		// Units must stop on entering an enemy zone of control
+		if current.coord != startCoord && re.InEnemyZOC(world, unit, current.coord, teams) {
 			continue
 		}
 
@@ -365,87 +757,78 @@ func (re *RulesEngine) dijkstraMovement(world *World, unitType int32, startCoord
 				continue // Invalid tile
 			}
 
-			// Skip if occupied by another unit (movement rule: only empty tiles)
-			if world.UnitAt(neighborCoord) != nil {
+			// Enemy units block movement, allied units may be passed through
+			if occupant := world.UnitAt(neighborCoord); occupant != nil && !re.CanPassThrough(unit, occupant, teams) {
 				continue // Occupied tile
 			}
 
 			// Get movement cost to this terrain
-			moveCost, err := re.getUnitTerrainCost(unitType, tile.TileType)
+			moveCost, err := re.getUnitTerrainCost(unit.UnitType, tile.TileType)
 			if err != nil {
 				continue // Cannot move on this terrain
 			}
 
 			newCost := current.cost + moveCost
 
-			// Skip if exceeds movement budget
-			if newCost > maxMovement {
+			// Skip if exceeds movement budget (allowing for the rounding of the charged points)
+			if newCost > maxMovement && MovementPoints(newCost) > MovementPoints(maxMovement) {
 				continue
 			}
 
 			// Check if this is a better path to the neighbor
-			if existingCost, exists := distances[neighborCoord]; !exists || newCost < existingCost {
+			existingCost, exists := distances[neighborCoord]
+			if !exists || newCost < existingCost || (newCost == existingCost && coordLess(current.coord, previous[neighborCoord])) {
 				distances[neighborCoord] = newCost
+				previous[neighborCoord] = current.coord
 				queue = append(queue, queueItem{coord: neighborCoord, cost: newCost})
 			}
 		}
 	}
 
-	// Convert distances map to TileOption slice (excluding start position)
-	var options []TileOption
-	for coord, cost := range distances {
-		if coord != startCoord { // Exclude starting position
-			options = append(options, TileOption{
-				Coord: coord,
-				Cost:  cost,
-			})
-		}
-	}
+	return distances, previous
+}
 
-	return options, nil
+// coordLess orders coordinates by Q and then R
+func coordLess(a, b AxialCoord) bool {
+	return a.Q < b.Q || (a.Q == b.Q && a.R < b.R)
 }
 
 // GetAttackOptions returns all positions a unit can attack from its current position
 // Only returns tiles with ENEMY units that are within attack range
-func (re *RulesEngine) GetAttackOptions(world *World, unit *v1.Unit) ([]AxialCoord, error) {
+func (re *RulesEngine) GetAttackOptions(world *World, unit *v1.Unit, teams Teams) ([]AxialCoord, error) {
 	if unit == nil {
 		return nil, fmt.Errorf("unit is nil")
 	}
 
-	unitData, err := re.GetUnitData(unit.UnitType)
+	minRange, maxRange, err := re.GetAttackRange(unit.UnitType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get unit data: %w", err)
 	}
 
 	var attackPositions []AxialCoord
-	attackRange := unitData.AttackRange
 
-	// Check all positions within attack range
+	// Check all hexes between the minimum and maximum attack range
 	unitCoord := UnitGetCoord(unit)
-	for dQ := -attackRange; dQ <= attackRange; dQ++ {
-		for dR := -attackRange; dR <= attackRange; dR++ {
-			if dQ == 0 && dR == 0 {
-				continue // Skip self
-			}
-
-			targetCoord := AxialCoord{Q: unitCoord.Q + int(dQ), R: unitCoord.R + int(dR)}
+	for _, targetCoord := range unitCoord.Range(maxRange) {
+		if unitCoord.Distance(targetCoord) < minRange {
+			continue // Too close (or self)
+		}
 
-			// Check if there's an enemy unit at this position (attack rule: only enemy units)
-			tile := world.TileAt(targetCoord)
-			targetUnit := world.UnitAt(targetCoord)
-			if tile == nil || targetUnit == nil {
-				continue // No unit to attack
-			}
+		// Check if there's an enemy unit at this position (attack rule: only enemy units)
+		tile := world.TileAt(targetCoord)
+		targetUnit := world.UnitAt(targetCoord)
+		if tile == nil || targetUnit == nil {
+			continue // No unit to attack
+		}
 
-			// Check if it's an enemy unit (different player)
-			if targetUnit.Player == unit.Player {
-				continue // Same player, can't attack
-			}
+		// Check if it's an enemy unit (not the same player or a teammate)
+		if teams.AreAllies(unit.Player, targetUnit.Player) {
+			continue // Allied unit, can't attack
+		}
 
-			// Check if this unit can attack the target unit type
-			if _, err := re.GetCombatPrediction(unit.UnitType, targetUnit.UnitType); err == nil {
-				attackPositions = append(attackPositions, targetCoord)
-			}
+		// Check if this unit can attack the target unit type
+		if _, err := re.getBaseDamageDistribution(unit.UnitType, targetUnit.UnitType); err == nil {
+			attackPositions = append(attackPositions, targetCoord)
 		}
 	}
 
@@ -453,47 +836,64 @@ func (re *RulesEngine) GetAttackOptions(world *World, unit *v1.Unit) ([]AxialCoo
 }
 
 // CanUnitAttackTarget checks if a unit can attack a specific target
-func (re *RulesEngine) CanUnitAttackTarget(attacker *v1.Unit, target *v1.Unit) (bool, error) {
+func (re *RulesEngine) CanUnitAttackTarget(attacker *v1.Unit, target *v1.Unit, teams Teams) (bool, error) {
 	if attacker == nil || target == nil {
 		return false, fmt.Errorf("attacker or target is nil")
 	}
 
 	// Check if units are enemies
-	if attacker.Player == target.Player {
-		return false, nil // Same team
+	if teams.AreAllies(attacker.Player, target.Player) {
+		return false, nil // Same player or team
 	}
 
 	// Check if attacker can attack this unit type
-	_, err := re.GetCombatPrediction(attacker.UnitType, target.UnitType)
+	_, err := re.getBaseDamageDistribution(attacker.UnitType, target.UnitType)
 	if err != nil {
 		return false, nil // Cannot attack this unit type
 	}
 
-	// Check range (using simple distance for now)
+	// Check the target is within the attacker's minimum and maximum range
 	attackerCoord := UnitGetCoord(attacker)
 	targetCoord := UnitGetCoord(target)
 	distance := CubeDistance(attackerCoord, targetCoord)
-	unitData, err := re.GetUnitData(attacker.UnitType)
+	minRange, maxRange, err := re.GetAttackRange(attacker.UnitType)
 	if err != nil {
 		return false, err
 	}
 
-	return distance <= int(unitData.AttackRange), nil
+	return distance >= minRange && distance <= maxRange, nil
+}
+
+// CanCounterAttack checks if a defender that survives an attack can hit back at the attacker.
+// Indirect fire cannot be countered and defenders can only hit back within their own range.
+func (re *RulesEngine) CanCounterAttack(attacker, defender *v1.Unit, teams Teams) bool {
+	if re.HasIndirectFire(attacker.UnitType) {
+		return false
+	}
+	canCounter, err := re.CanUnitAttackTarget(defender, attacker, teams)
+	return err == nil && canCounter
 }
 
 // Note: Default terrain data has been migrated to proto definitions.
 // Use LoadRulesEngineFromJSON to load terrain definitions from proto-based data.
 
 var (
-	defaultRulesEngine *RulesEngine
+	defaultRulesEngine   *RulesEngine
+	defaultRulesRegistry *RulesRegistry
 )
 
 func init() {
-	var err error
-	defaultRulesEngine, err = LoadRulesEngineFromJSON(assets.RulesDataJSON)
+	defaultRulesRegistry = NewRulesRegistry()
+	rulesID, err := defaultRulesRegistry.Register(DefaultRulesName, assets.RulesDataJSON)
 	if err != nil {
 		panic(err)
 	}
+	defaultRulesEngine, _ = defaultRulesRegistry.Get(rulesID)
+}
+
+// DefaultRulesRegistry returns the registry holding the shipped rules and any other registered rule sets
+func DefaultRulesRegistry() *RulesRegistry {
+	return defaultRulesRegistry
 }
 
 // GetDefaultRulesEngine returns a font family that works in WASM environments
@@ -116,8 +116,8 @@ func TestRulesEngineMovementCosts(t *testing.T) {
 
 	// Test some other combinations
 	testCases := []struct {
-		unitID    int
-		terrainID int
+		unitID    int32
+		terrainID int32
 		desc      string
 	}{
 		{1, 2, "Soldier on terrain 2"},
@@ -141,7 +141,10 @@ func TestRulesEngineCombatDamage(t *testing.T) {
 	}
 
 	// Test combat prediction between unit 1 and unit 1 (if they can attack each other)
-	damageDistribution, err := rulesEngine.GetCombatPrediction(1, 1)
+	attacker := NewUnit(1, 1, AxialCoord{Q: 0, R: 0})
+	defender := NewUnit(1, 2, AxialCoord{Q: 1, R: 0})
+	defenderTile := NewTile(AxialCoord{Q: 1, R: 0}, 5)
+	damageDistribution, err := rulesEngine.GetCombatPrediction(attacker, defender, defenderTile)
 	if err != nil {
 		t.Logf("Units 1 vs 1 cannot attack each other: %v", err)
 		return // This is fine, not all units can attack all others
@@ -166,14 +169,14 @@ func TestRulesEngineCombatDamage(t *testing.T) {
 
 	// Test actual damage calculation with RNG
 	rng := rand.New(rand.NewSource(42)) // Fixed seed for reproducible tests
-	damage, err := rulesEngine.CalculateCombatDamage(1, 1, rng)
+	damage, err := rulesEngine.CalculateCombatDamage(attacker, defender, defenderTile, rng)
 	if err != nil {
 		t.Fatalf("Failed to calculate combat damage: %v", err)
 	}
 
 	t.Logf("Calculated damage: %d", damage)
 
-	if damage < damageDistribution.MinDamage || damage > damageDistribution.MaxDamage {
+	if damage < int(damageDistribution.MinDamage) || damage > int(damageDistribution.MaxDamage) {
 		t.Errorf("Calculated damage %d outside expected range %d-%d",
 			damage, damageDistribution.MinDamage, damageDistribution.MaxDamage)
 	}
@@ -187,15 +190,17 @@ func TestRulesEngineAttackMatrix(t *testing.T) {
 
 	// Count how many attack combinations we have
 	totalAttacks := 0
-	for attackerID, attacks := range rulesEngine.AttackMatrix.Attacks {
-		for targetID := range attacks {
+	for attackerID, attacks := range rulesEngine.AttackMatrix.GetAttacks() {
+		for targetID := range attacks.GetDefenderDamages() {
 			totalAttacks++
 
 			// Test one example in detail
 			if totalAttacks == 1 {
 				t.Logf("Example attack: Unit %d can attack Unit %d", attackerID, targetID)
 
-				dist, err := rulesEngine.GetCombatPrediction(attackerID, targetID)
+				attacker := NewUnit(int(attackerID), 1, AxialCoord{Q: 0, R: 0})
+				defender := NewUnit(int(targetID), 2, AxialCoord{Q: 1, R: 0})
+				dist, err := rulesEngine.GetCombatPrediction(attacker, defender, NewTile(AxialCoord{Q: 1, R: 0}, 5))
 				if err != nil {
 					t.Errorf("Failed to get prediction for valid attack: %v", err)
 				} else {
@@ -221,8 +226,8 @@ func TestRulesEngineMovementMatrix(t *testing.T) {
 
 	// Count how many movement cost entries we have
 	totalCosts := 0
-	for unitID, costs := range rulesEngine.MovementMatrix.Costs {
-		for terrainID, cost := range costs {
+	for unitID, costs := range rulesEngine.MovementMatrix.GetCosts() {
+		for terrainID, cost := range costs.GetTerrainCosts() {
 			totalCosts++
 
 			// Test one example in detail
@@ -249,28 +254,21 @@ func TestRulesEngineDijkstraMovement(t *testing.T) {
 		t.Fatalf("Failed to load rules engine: %v", err)
 	}
 
-	// Create a simple test map
-	gameMap := NewWorld("test")
+	// Create a simple test world
+	world := NewWorld("test")
 
 	// Fill with grass terrain (terrain ID 1 - should have reasonable movement cost)
 	for q := range 5 {
 		for r := range 5 {
 			coord := AxialCoord{Q: q, R: r}
 			tile := NewTile(coord, 1) // Grass terrain
-			gameMap.AddTile(tile)
+			world.AddTile(tile)
 		}
 	}
 
-	// Create a world for the test
-	world := NewWorld("test")
-
 	// Create a test unit (Soldier - unit type 1)
 	startCoord := AxialCoord{Q: 2, R: 2} // Center of map
-	unit := &Unit{
-		UnitType: 1,
-		Coord:    startCoord,
-		Player:   0,
-	}
+	unit := NewUnit(1, 0, startCoord)
 
 	// Test movement options with different movement budgets
 	testCases := []struct {
@@ -284,7 +282,7 @@ func TestRulesEngineDijkstraMovement(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		options, err := rulesEngine.GetMovementOptions(world, unit, tc.movement)
+		options, err := rulesEngine.GetMovementOptions(world, unit, tc.movement, nil)
 		if err != nil {
 			t.Fatalf("Failed to get movement options for %s: %v", tc.desc, err)
 		}
@@ -322,14 +320,14 @@ func TestRulesEngineDijkstraTerrainCosts(t *testing.T) {
 		t.Fatalf("Failed to load rules engine: %v", err)
 	}
 
-	// Create a map with different terrain costs
-	gameMap := NewWorld("test")
+	// Create a world with different terrain costs
+	world := NewWorld("test")
 
 	// Set up terrain: expensive terrain in middle, cheap around edges
 	for q := range 3 {
 		for r := range 3 {
 			coord := AxialCoord{Q: q, R: r}
-			terrainID := 1 // Default grass
+			terrainID := int32(1) // Default grass
 
 			// Make center tile more expensive if we have different terrain types
 			if q == 1 && r == 1 {
@@ -344,22 +342,15 @@ func TestRulesEngineDijkstraTerrainCosts(t *testing.T) {
 				}
 			}
 
-			tile := NewTile(coord, terrainID)
-			gameMap.AddTile(tile)
+			tile := NewTile(coord, int(terrainID))
+			world.AddTile(tile)
 		}
 	}
 
-	// Create a world for the test
-	world := NewWorld("test")
-
 	// Test unit at corner
-	unit := &Unit{
-		UnitType: 1, // Soldier
-		Coord:    AxialCoord{Q: 0, R: 0},
-		Player:   0,
-	}
+	unit := NewUnit(1, 0, AxialCoord{Q: 0, R: 0}) // Soldier
 
-	options, err := rulesEngine.GetMovementOptions(world, unit, 3)
+	options, err := rulesEngine.GetMovementOptions(world, unit, 3, nil)
 	if err != nil {
 		t.Fatalf("Failed to get movement options: %v", err)
 	}
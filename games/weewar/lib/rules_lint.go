@@ -0,0 +1,228 @@
+package weewar
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+// =============================================================================
+// Rules Lint - Consistency checks across the rule matrices
+// =============================================================================
+
+// Severities of rules lint issues.  Errors are contradictions or references to rules that do not
+// exist, warnings are data the engine tolerates but is probably unintended and infos are worth a
+// look but often deliberate.
+const (
+	LintError   = "error"
+	LintWarning = "warning"
+	LintInfo    = "info"
+)
+
+// lintTolerance is how far computed sums may drift from the values recorded in the rules
+const lintTolerance = 0.01
+
+// RulesLintIssue is a single problem found in a rule set
+type RulesLintIssue struct {
+	Severity string `json:"severity"`
+	Check    string `json:"check"`   // Stable name of the check that failed (eg "attack_unknown_unit")
+	Subject  string `json:"subject"` // What the issue is about (eg "attack 1->12", "unit 21")
+	Message  string `json:"message"`
+}
+
+// RulesLintReport is the machine readable result of linting a rule set
+type RulesLintReport struct {
+	RulesID  string           `json:"rulesId,omitempty"`
+	Errors   int              `json:"errors"`
+	Warnings int              `json:"warnings"`
+	Infos    int              `json:"infos"`
+	Issues   []RulesLintIssue `json:"issues"`
+}
+
+// Failed returns whether the report should fail a build.  Warnings only fail strict builds.
+func (r *RulesLintReport) Failed(strict bool) bool {
+	return r.Errors > 0 || (strict && r.Warnings > 0)
+}
+
+func (r *RulesLintReport) add(severity, check, subject, format string, args ...any) {
+	r.Issues = append(r.Issues, RulesLintIssue{
+		Severity: severity,
+		Check:    check,
+		Subject:  subject,
+		Message:  fmt.Sprintf(format, args...),
+	})
+	switch severity {
+	case LintError:
+		r.Errors++
+	case LintWarning:
+		r.Warnings++
+	default:
+		r.Infos++
+	}
+}
+
+// Lint checks the rules for consistency beyond what ValidateRules requires to load them.  Issues
+// are reported in a stable order so reports can be diffed between rule versions.
+func (re *RulesEngine) Lint() *RulesLintReport {
+	report := &RulesLintReport{Issues: []RulesLintIssue{}}
+	re.lintTerrains(report)
+	re.lintMovementMatrix(report)
+	re.lintAttackMatrix(report)
+	return report
+}
+
+// lintTerrains checks that terrains only build units that exist
+func (re *RulesEngine) lintTerrains(report *RulesLintReport) {
+	for _, terrainID := range slices.Sorted(maps.Keys(re.Terrains)) {
+		for _, unitID := range re.Terrains[terrainID].BuildableUnits {
+			if _, exists := re.Units[unitID]; !exists {
+				report.add(LintError, "terrain_unknown_unit", fmt.Sprintf("terrain %d", terrainID),
+					"terrain %d builds unknown unit %d", terrainID, unitID)
+			}
+		}
+	}
+}
+
+// lintMovementMatrix checks movement costs refer to known units and terrains and that every unit
+// can move somewhere
+func (re *RulesEngine) lintMovementMatrix(report *RulesLintReport) {
+	costs := re.MovementMatrix.GetCosts()
+	if len(costs) == 0 {
+		report.add(LintWarning, "movement_matrix_missing", "movement matrix",
+			"no movement costs are loaded so every unit moves at each terrain's base cost")
+	}
+	for _, unitID := range slices.Sorted(maps.Keys(costs)) {
+		if _, exists := re.Units[unitID]; !exists {
+			report.add(LintError, "movement_unknown_unit", fmt.Sprintf("unit %d", unitID),
+				"movement matrix has costs for unknown unit %d", unitID)
+		}
+		terrainCosts := costs[unitID].GetTerrainCosts()
+		for _, terrainID := range slices.Sorted(maps.Keys(terrainCosts)) {
+			subject := fmt.Sprintf("movement %d/%d", unitID, terrainID)
+			if _, exists := re.Terrains[terrainID]; !exists {
+				report.add(LintError, "movement_unknown_terrain", subject,
+					"movement matrix has costs for unit %d on unknown terrain %d", unitID, terrainID)
+			}
+			if cost := terrainCosts[terrainID]; cost <= 0 {
+				report.add(LintError, "movement_invalid_cost", subject,
+					"unit %d has non-positive cost %v on terrain %d", unitID, cost, terrainID)
+			}
+		}
+	}
+
+	for _, unitID := range slices.Sorted(maps.Keys(re.Units)) {
+		unit := re.Units[unitID]
+		subject := fmt.Sprintf("unit %d", unitID)
+		if _, exists := costs[unitID]; !exists && len(costs) > 0 {
+			report.add(LintWarning, "movement_missing_unit", subject,
+				"unit %d (%s) has no movement costs and moves at every terrain's base cost", unitID, unit.Name)
+		}
+		if unit.MovementPoints <= 0 {
+			continue
+		}
+		movable := false
+		for terrainID := range re.Terrains {
+			if cost, err := re.getUnitTerrainCost(unitID, terrainID); err == nil && cost > 0 && cost <= float64(unit.MovementPoints) {
+				movable = true
+				break
+			}
+		}
+		if !movable {
+			report.add(LintError, "unit_cannot_move", subject,
+				"unit %d (%s) has %d movement points but cannot enter any terrain", unitID, unit.Name, unit.MovementPoints)
+		}
+	}
+}
+
+// lintAttackMatrix checks attack entries refer to known units and that each damage distribution
+// is consistent with its summary values
+func (re *RulesEngine) lintAttackMatrix(report *RulesLintReport) {
+	attacks := re.AttackMatrix.GetAttacks()
+	for _, attackerID := range slices.Sorted(maps.Keys(attacks)) {
+		defenders := attacks[attackerID].GetDefenderDamages()
+		attacker, attackerExists := re.Units[attackerID]
+		if !attackerExists {
+			report.add(LintError, "attack_unknown_unit", fmt.Sprintf("unit %d", attackerID),
+				"attack matrix has attacks by unknown unit %d", attackerID)
+		} else if attacker.AttackRange <= 0 && len(defenders) > 0 {
+			report.add(LintWarning, "attack_without_range", fmt.Sprintf("unit %d", attackerID),
+				"unit %d (%s) has attack matrix entries but no attack range", attackerID, attacker.Name)
+		}
+
+		for _, defenderID := range slices.Sorted(maps.Keys(defenders)) {
+			subject := fmt.Sprintf("attack %d->%d", attackerID, defenderID)
+			defender, defenderExists := re.Units[defenderID]
+			if !defenderExists {
+				report.add(LintError, "attack_unknown_unit", subject,
+					"attack matrix has attacks on unknown unit %d", defenderID)
+			}
+			dist := defenders[defenderID]
+			if dist == nil || len(dist.DamageBuckets) == 0 {
+				report.add(LintWarning, "attack_no_damage", subject,
+					"unit %d can attack unit %d but has no damage buckets", attackerID, defenderID)
+				continue
+			}
+			lintDamageDistribution(report, subject, dist, defender)
+
+			if _, exists := attacks[defenderID].GetDefenderDamages()[attackerID]; defenderExists && !exists {
+				report.add(LintInfo, "attack_asymmetric", subject,
+					"unit %d can attack unit %d but cannot be attacked back", attackerID, defenderID)
+			}
+		}
+	}
+
+	for _, unitID := range slices.Sorted(maps.Keys(re.Units)) {
+		if unit := re.Units[unitID]; unit.AttackRange > 0 && len(attacks[unitID].GetDefenderDamages()) == 0 {
+			report.add(LintWarning, "attack_missing_unit", fmt.Sprintf("unit %d", unitID),
+				"unit %d (%s) has attack range %d but nothing it can attack", unitID, unit.Name, unit.AttackRange)
+		}
+	}
+}
+
+// lintDamageDistribution checks a distribution's buckets against its weights, expected damage and
+// damage range.  defender is nil when the defending unit is unknown.
+func lintDamageDistribution(report *RulesLintReport, subject string, dist *v1.DamageDistribution, defender *v1.UnitDefinition) {
+	totalWeight, expected := 0.0, 0.0
+	seen := map[int32]bool{}
+	for _, bucket := range dist.DamageBuckets {
+		if bucket.Damage < 0 || bucket.Weight < 0 {
+			report.add(LintError, "attack_negative_bucket", subject,
+				"bucket with damage %d has negative damage or weight %v", bucket.Damage, bucket.Weight)
+		}
+		if bucket.Damage < dist.MinDamage || bucket.Damage > dist.MaxDamage {
+			report.add(LintError, "attack_damage_range", subject,
+				"bucket damage %d is outside the damage range %d-%d", bucket.Damage, dist.MinDamage, dist.MaxDamage)
+		}
+		if seen[bucket.Damage] {
+			report.add(LintWarning, "attack_duplicate_bucket", subject,
+				"damage %d appears in more than one bucket", bucket.Damage)
+		}
+		if bucket.Weight == 0 {
+			report.add(LintWarning, "attack_zero_weight", subject,
+				"bucket with damage %d can never be rolled", bucket.Damage)
+		}
+		if health := defender.GetHealth(); health > 0 && bucket.Damage > health {
+			report.add(LintWarning, "attack_overkill", subject,
+				"bucket damage %d exceeds the defender's health %d", bucket.Damage, health)
+		}
+		seen[bucket.Damage] = true
+		totalWeight += bucket.Weight
+		expected += float64(bucket.Damage) * bucket.Weight
+	}
+
+	if math.Abs(totalWeight-1) > lintTolerance {
+		report.add(LintWarning, "attack_weights", subject,
+			"bucket weights sum to %.3f instead of 1", totalWeight)
+	}
+	if math.Abs(expected-dist.ExpectedDamage) > lintTolerance {
+		report.add(LintError, "attack_expected_damage", subject,
+			"expected damage is %.3f but the buckets give %.3f", dist.ExpectedDamage, expected)
+	}
+	if dist.MinDamage > dist.MaxDamage {
+		report.add(LintError, "attack_damage_range", subject,
+			"minimum damage %d is above maximum damage %d", dist.MinDamage, dist.MaxDamage)
+	}
+}
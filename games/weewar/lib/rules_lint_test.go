@@ -0,0 +1,67 @@
+package weewar
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/panyam/turnengine/games/weewar/assets"
+)
+
+func TestLintShippedRules(t *testing.T) {
+	report := DefaultRulesEngine().Lint()
+	for _, issue := range report.Issues {
+		if issue.Severity == LintError {
+			t.Errorf("Unexpected lint error in the shipped rules: %s: %s", issue.Subject, issue.Message)
+		}
+	}
+	if report.Failed(false) || report.Warnings == 0 {
+		t.Errorf("Expected the shipped rules to pass with warnings, got %d errors and %d warnings", report.Errors, report.Warnings)
+	}
+}
+
+func TestLintBrokenRules(t *testing.T) {
+	var rules map[string]any
+	if err := json.Unmarshal(assets.RulesDataJSON, &rules); err != nil {
+		t.Fatalf("Failed to parse rules: %v", err)
+	}
+	attacks := rules["attackMatrix"].(map[string]any)["attacks"].(map[string]any)
+	soldierAttacks := attacks["1"].(map[string]any)
+	soldierAttacks["99"] = soldierAttacks["1"]
+	soldierAttacks["1"].(map[string]any)["expectedDamage"] = 9
+	rules["movementMatrix"] = map[string]any{"costs": map[string]any{
+		"1": map[string]any{"terrainCosts": map[string]any{"5": 1}},
+		"2": map[string]any{"terrainCosts": map[string]any{"5": 99, "77": 1}},
+	}}
+	for _, terrain := range rules["terrains"].(map[string]any) {
+		terrain.(map[string]any)["baseMoveCost"] = 99
+	}
+	data, err := json.Marshal(rules)
+	if err != nil {
+		t.Fatalf("Failed to marshal rules: %v", err)
+	}
+	rulesEngine, err := LoadRulesEngineFromJSON(data)
+	if err != nil {
+		t.Fatalf("Failed to load rules: %v", err)
+	}
+
+	report := rulesEngine.Lint()
+	checks := map[string]bool{}
+	for _, issue := range report.Issues {
+		if issue.Severity == LintError {
+			checks[issue.Subject+" "+issue.Check] = true
+		}
+	}
+	for _, expected := range []string{
+		"attack 1->99 attack_unknown_unit",
+		"attack 1->1 attack_expected_damage",
+		"movement 2/77 movement_unknown_terrain",
+		"unit 2 unit_cannot_move",
+	} {
+		if !checks[expected] {
+			t.Errorf("Expected lint error %q, got %v", expected, checks)
+		}
+	}
+	if !report.Failed(false) {
+		t.Error("Expected the broken rules to fail the lint")
+	}
+}
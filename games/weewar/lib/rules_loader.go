@@ -88,12 +88,63 @@ func LoadRulesEngineFromJSON(jsonData []byte) (*RulesEngine, error) {
 		}
 	}
 
-	if attackMatrixData, ok := rawData["attackMatrix"]; ok {
-		attackBytes, _ := json.Marshal(attackMatrixData)
-		attackMatrix := &AttackMatrix{}
-		if err := json.Unmarshal(attackBytes, attackMatrix); err == nil {
-			rulesEngine.AttackMatrix = attackMatrix
+	if movementRulesData, ok := rawData["movementRules"]; ok {
+		movementRulesBytes, _ := json.Marshal(movementRulesData)
+		movementRules := &v1.MovementRules{}
+		if err := protojson.Unmarshal(movementRulesBytes, movementRules); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal movement rules: %w", err)
 		}
+		rulesEngine.MovementRules = movementRules
+	}
+
+	if combatRulesData, ok := rawData["combatRules"]; ok {
+		combatRulesBytes, _ := json.Marshal(combatRulesData)
+		combatRules := &v1.CombatRules{}
+		if err := protojson.Unmarshal(combatRulesBytes, combatRules); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal combat rules: %w", err)
+		}
+		rulesEngine.CombatRules = combatRules
+	}
+
+	if terrainActionsData, ok := rawData["terrainActions"]; ok {
+		terrainActionsBytes, _ := json.Marshal(terrainActionsData)
+		terrainActions := &v1.TerrainActionRules{}
+		if err := protojson.Unmarshal(terrainActionsBytes, terrainActions); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal terrain actions: %w", err)
+		}
+		rulesEngine.TerrainActions = terrainActions
+	}
+
+	// The attack matrix is stored as attacks[attackerID][defenderID] without the proto's wrapper
+	// messages so each damage distribution is loaded on its own
+	if attackMatrixData, ok := rawData["attackMatrix"].(map[string]interface{}); ok {
+		attackMatrix := &v1.AttackMatrix{Attacks: make(map[int32]*v1.DefenderDamageMap)}
+		attacks, _ := attackMatrixData["attacks"].(map[string]interface{})
+		for attackerStr, defendersData := range attacks {
+			attackerID, err := strconv.ParseInt(attackerStr, 10, 32)
+			if err != nil {
+				continue // Skip invalid IDs
+			}
+			defenders, _ := defendersData.(map[string]interface{})
+			damages := &v1.DefenderDamageMap{DefenderDamages: make(map[int32]*v1.DamageDistribution)}
+			for defenderStr, distJson := range defenders {
+				defenderID, err := strconv.ParseInt(defenderStr, 10, 32)
+				if err != nil {
+					continue
+				}
+				distBytes, err := json.Marshal(distJson)
+				if err != nil {
+					continue
+				}
+				dist := &v1.DamageDistribution{}
+				if err := protojson.Unmarshal(distBytes, dist); err != nil {
+					return nil, fmt.Errorf("failed to unmarshal attack %d->%d: %w", attackerID, defenderID, err)
+				}
+				damages.DefenderDamages[int32(defenderID)] = dist
+			}
+			attackMatrix.Attacks[int32(attackerID)] = damages
+		}
+		rulesEngine.AttackMatrix = attackMatrix
 	}
 
 	// Validate the loaded data
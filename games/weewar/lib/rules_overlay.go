@@ -0,0 +1,65 @@
+package weewar
+
+import (
+	"maps"
+	"slices"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// =============================================================================
+// Rules Overlays - Per game changes to the default rules
+// =============================================================================
+
+// WithOverlay returns a copy of the rules with a game's restrictions and tweaks applied.  Units
+// not in allowedUnits (when any are listed) or disabled by the overlay are removed from every
+// terrain's build list, and the overlay's costs and damage multipliers replace the defaults.
+// The receiver is never modified so the shared default rules can be overlaid safely.
+func (re *RulesEngine) WithOverlay(allowedUnits []int32, overlay *v1.RulesOverlay) *RulesEngine {
+	if len(allowedUnits) == 0 && overlay == nil {
+		return re
+	}
+	out := *re
+
+	// Disabled units can no longer be built anywhere
+	disabled := map[int32]bool{}
+	for _, unitID := range overlay.GetDisabledUnits() {
+		disabled[unitID] = true
+	}
+	if len(allowedUnits) > 0 {
+		for unitID := range re.Units {
+			if !slices.Contains(allowedUnits, unitID) {
+				disabled[unitID] = true
+			}
+		}
+	}
+	if len(disabled) > 0 {
+		isDisabled := func(unitID int32) bool { return disabled[unitID] }
+		out.Terrains = make(map[int32]*v1.TerrainDefinition, len(re.Terrains))
+		for terrainID, terrain := range re.Terrains {
+			if slices.ContainsFunc(terrain.BuildableUnits, isDisabled) {
+				terrain = proto.Clone(terrain).(*v1.TerrainDefinition)
+				terrain.BuildableUnits = slices.DeleteFunc(terrain.BuildableUnits, isDisabled)
+			}
+			out.Terrains[terrainID] = terrain
+		}
+	}
+
+	// Cost changes apply to copies of the unit definitions
+	if len(overlay.GetUnitCoins()) > 0 {
+		out.Units = maps.Clone(re.Units)
+		for unitID, coins := range overlay.GetUnitCoins() {
+			if unit, exists := out.Units[unitID]; exists {
+				unit = proto.Clone(unit).(*v1.UnitDefinition)
+				unit.Coins = coins
+				out.Units[unitID] = unit
+			}
+		}
+	}
+
+	if len(overlay.GetDamageMultipliers()) > 0 {
+		out.DamageMultipliers = maps.Clone(overlay.GetDamageMultipliers())
+	}
+	return &out
+}
@@ -0,0 +1,65 @@
+package weewar
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+func TestRulesOverlayRestrictsBuilds(t *testing.T) {
+	game := newTestGame(t)
+	game.PlayerCoins[1] = 1000
+	game.rulesEngine = DefaultRulesEngine().WithOverlay([]int32{1, 2, 3}, &v1.RulesOverlay{
+		DisabledUnits: []int32{3},
+		UnitCoins:     map[int32]int32{1: 10},
+	})
+
+	var dmp DefaultMoveProcessor
+	options, err := dmp.GetBuildOptions(game, 0, 0)
+	if err != nil {
+		t.Fatalf("Failed to get build options: %v", err)
+	}
+	var unitTypes []int32
+	for _, unitData := range options {
+		unitTypes = append(unitTypes, unitData.Id)
+	}
+	if !slices.Equal(unitTypes, []int32{1, 2}) {
+		t.Errorf("Expected only the allowed units that are not disabled to be buildable, got %v", unitTypes)
+	}
+	if _, err := dmp.ProcessBuildUnit(game, &v1.GameMove{Player: 1}, &v1.BuildUnitAction{Q: 0, R: 0, UnitType: 3}); err == nil {
+		t.Error("Expected building a disabled unit to fail")
+	}
+
+	// Overlaid costs are charged and the shared default rules are left alone
+	if _, err := dmp.ProcessBuildUnit(game, &v1.GameMove{Player: 1}, &v1.BuildUnitAction{Q: 0, R: 0, UnitType: 1}); err != nil {
+		t.Fatalf("Failed to build unit: %v", err)
+	}
+	if game.PlayerCoins[1] != 990 {
+		t.Errorf("Expected the soldier to cost 10 coins, got %d coins left", game.PlayerCoins[1])
+	}
+	defaults := DefaultRulesEngine()
+	if unitData, _ := defaults.GetUnitData(1); unitData.Coins != 75 || !defaults.CanBuildUnit(1, 3) || !defaults.CanBuildUnit(1, 4) {
+		t.Errorf("Expected the default rules to be unchanged by the overlay")
+	}
+}
+
+func TestRulesOverlayDamageMultipliers(t *testing.T) {
+	attacker := NewUnit(1, 1, AxialCoord{Q: 0, R: 0})
+	defender := NewUnit(1, 2, AxialCoord{Q: 1, R: 0})
+	grass := NewTile(AxialCoord{Q: 1, R: 0}, 5)
+
+	normal, err := DefaultRulesEngine().GetCombatPrediction(attacker, defender, grass)
+	if err != nil {
+		t.Fatalf("Failed to predict combat: %v", err)
+	}
+	rulesEngine := DefaultRulesEngine().WithOverlay(nil, &v1.RulesOverlay{DamageMultipliers: map[int32]float64{1: 2}})
+	boosted, err := rulesEngine.GetCombatPrediction(attacker, defender, grass)
+	if err != nil {
+		t.Fatalf("Failed to predict combat: %v", err)
+	}
+	if math.Abs(boosted.ExpectedDamage-normal.ExpectedDamage*2) > 0.5 {
+		t.Errorf("Expected the overlay to double soldier damage, got %v vs %v", boosted.ExpectedDamage, normal.ExpectedDamage)
+	}
+}
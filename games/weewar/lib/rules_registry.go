@@ -0,0 +1,162 @@
+package weewar
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// =============================================================================
+// Rules Registry - Named, versioned rule sets
+// =============================================================================
+
+// DefaultRulesName is the name the rules shipped in assets are registered under
+const DefaultRulesName = "default"
+
+// RulesRegistry holds rule sets by their rules ID.  A rules ID combines the rule set's name with
+// a hash of its data (eg "default@1a2b3c4d5e6f") so a game that records the ID it was created with
+// is always played and replayed under exactly those rules, even after the shipped rules change.
+type RulesRegistry struct {
+	mu        sync.RWMutex
+	rules     map[string]*RulesEngine
+	data      map[string][]byte
+	defaultID string
+}
+
+// NewRulesRegistry creates an empty rules registry
+func NewRulesRegistry() *RulesRegistry {
+	return &RulesRegistry{
+		rules: make(map[string]*RulesEngine),
+		data:  make(map[string][]byte),
+	}
+}
+
+// RulesID returns the ID a rule set with given name and JSON data is registered under
+func RulesID(name string, jsonData []byte) string {
+	hash := sha256.Sum256(jsonData)
+	return name + "@" + hex.EncodeToString(hash[:])[:12]
+}
+
+// Register loads a rule set from JSON and returns its rules ID.  Registering the same data
+// again is a no-op.  The first rule set registered becomes the default.
+func (r *RulesRegistry) Register(name string, jsonData []byte) (string, error) {
+	if name == "" || strings.Contains(name, "@") {
+		return "", fmt.Errorf("invalid rules name %q", name)
+	}
+	rulesID := RulesID(name, jsonData)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.rules[rulesID]; exists {
+		return rulesID, nil
+	}
+
+	rulesEngine, err := LoadRulesEngineFromJSON(jsonData)
+	if err != nil {
+		return "", fmt.Errorf("failed to load rules %s: %w", rulesID, err)
+	}
+	r.rules[rulesID] = rulesEngine
+	r.data[rulesID] = slices.Clone(jsonData)
+	if r.defaultID == "" {
+		r.defaultID = rulesID
+	}
+	return rulesID, nil
+}
+
+// SetDefault makes a registered rule set the one new games are created with
+func (r *RulesRegistry) SetDefault(rulesID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.rules[rulesID]; !exists {
+		return fmt.Errorf("rules %q not found", rulesID)
+	}
+	r.defaultID = rulesID
+	return nil
+}
+
+// DefaultID returns the ID of the rules new games are created with
+func (r *RulesRegistry) DefaultID() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.defaultID
+}
+
+// Get returns the rules engine for a rules ID.  Games created before rules were versioned have
+// no rules ID and are played under the default rules.
+func (r *RulesRegistry) Get(rulesID string) (*RulesEngine, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if rulesID == "" {
+		rulesID = r.defaultID
+	}
+	rulesEngine, exists := r.rules[rulesID]
+	if !exists {
+		return nil, fmt.Errorf("rules %q not found", rulesID)
+	}
+	return rulesEngine, nil
+}
+
+// Data returns the JSON a rule set was registered from
+func (r *RulesRegistry) Data(rulesID string) ([]byte, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	data, exists := r.data[rulesID]
+	if !exists {
+		return nil, fmt.Errorf("rules %q not found", rulesID)
+	}
+	return data, nil
+}
+
+// IDs returns the IDs of all registered rule sets in sorted order
+func (r *RulesRegistry) IDs() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return slices.Sorted(maps.Keys(r.rules))
+}
+
+// Save writes a registered rule set to dir as <rulesID>.json so it can be loaded again with LoadDir
+// after the shipped rules have changed
+func (r *RulesRegistry) Save(dir string, rulesID string) error {
+	data, err := r.Data(rulesID)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, rulesID+".json")
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// LoadDir registers every rule set saved in dir.  Files named <rulesID>.json must still hash to
+// their rules ID, other JSON files are registered under their file name.
+func (r *RulesRegistry) LoadDir(dir string) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		savedID := strings.TrimSuffix(filepath.Base(path), ".json")
+		name, _, versioned := strings.Cut(savedID, "@")
+		if versioned && RulesID(name, data) != savedID {
+			return fmt.Errorf("rules file %s does not match its rules ID (got %s)", path, RulesID(name, data))
+		}
+		if _, err := r.Register(name, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package weewar
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/panyam/turnengine/games/weewar/assets"
+)
+
+// cheapSoldierRules returns the shipped rules with the basic soldier's cost changed
+func cheapSoldierRules(t *testing.T) []byte {
+	var rules map[string]any
+	if err := json.Unmarshal(assets.RulesDataJSON, &rules); err != nil {
+		t.Fatalf("Failed to parse rules: %v", err)
+	}
+	rules["units"].(map[string]any)["1"].(map[string]any)["coins"] = 10
+	data, err := json.Marshal(rules)
+	if err != nil {
+		t.Fatalf("Failed to marshal rules: %v", err)
+	}
+	return data
+}
+
+func TestDefaultRulesRegistry(t *testing.T) {
+	registry := DefaultRulesRegistry()
+	if registry.DefaultID() != RulesID(DefaultRulesName, assets.RulesDataJSON) {
+		t.Errorf("Expected the shipped rules to be the default, got %s", registry.DefaultID())
+	}
+	if rulesEngine, err := registry.Get(""); err != nil || rulesEngine != DefaultRulesEngine() {
+		t.Errorf("Expected games without a rules ID to use the default rules, got %v", err)
+	}
+}
+
+func TestRulesRegistryVersions(t *testing.T) {
+	registry := NewRulesRegistry()
+	defaultID, err := registry.Register(DefaultRulesName, assets.RulesDataJSON)
+	if err != nil {
+		t.Fatalf("Failed to register rules: %v", err)
+	}
+	cheapID, err := registry.Register(DefaultRulesName, cheapSoldierRules(t))
+	if err != nil {
+		t.Fatalf("Failed to register rules: %v", err)
+	}
+	if cheapID == defaultID || registry.DefaultID() != defaultID {
+		t.Fatalf("Expected changed rules to get their own ID without changing the default, got %s and %s", cheapID, defaultID)
+	}
+	if againID, _ := registry.Register(DefaultRulesName, cheapSoldierRules(t)); againID != cheapID || len(registry.IDs()) != 2 {
+		t.Errorf("Expected registering the same rules again to reuse %s, got %s", cheapID, againID)
+	}
+
+	cheapRules, err := registry.Get(cheapID)
+	if err != nil {
+		t.Fatalf("Failed to get rules: %v", err)
+	}
+	if unitData, _ := cheapRules.GetUnitData(1); unitData.Coins != 10 {
+		t.Errorf("Expected the soldier to cost 10 coins under %s, got %d", cheapID, unitData.Coins)
+	}
+	if _, err := registry.Get("default@000000000000"); err == nil {
+		t.Error("Expected unknown rules to not be found")
+	}
+}
+
+func TestRulesRegistrySaveAndLoad(t *testing.T) {
+	dir := t.TempDir()
+	registry := NewRulesRegistry()
+	cheapID, err := registry.Register("cheap", cheapSoldierRules(t))
+	if err != nil {
+		t.Fatalf("Failed to register rules: %v", err)
+	}
+	if err := registry.Save(dir, cheapID); err != nil {
+		t.Fatalf("Failed to save rules: %v", err)
+	}
+
+	loaded := NewRulesRegistry()
+	if err := loaded.LoadDir(dir); err != nil {
+		t.Fatalf("Failed to load rules: %v", err)
+	}
+	if _, err := loaded.Get(cheapID); err != nil {
+		t.Errorf("Expected saved rules to be loaded as %s: %v", cheapID, err)
+	}
+
+	// Archived rules that no longer match their ID are rejected
+	if err := os.WriteFile(filepath.Join(dir, cheapID+".json"), assets.RulesDataJSON, 0644); err != nil {
+		t.Fatalf("Failed to write rules: %v", err)
+	}
+	if err := NewRulesRegistry().LoadDir(dir); err == nil {
+		t.Error("Expected rules that do not match their ID to fail to load")
+	}
+}
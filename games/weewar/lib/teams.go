@@ -0,0 +1,23 @@
+package weewar
+
+// =============================================================================
+// Teams
+// =============================================================================
+
+// Teams maps player IDs to the team they play on.  Players without a team (or on team 0)
+// play alone.  A nil Teams is a free for all game.
+type Teams map[int32]int32
+
+// AreAllies returns true if two players are the same player or play on the same team
+func (t Teams) AreAllies(playerID1, playerID2 int32) bool {
+	if playerID1 == playerID2 {
+		return true
+	}
+	team := t[playerID1]
+	return team != 0 && team == t[playerID2]
+}
+
+// TeamOf returns the team a player is on (0 if the player plays alone)
+func (t Teams) TeamOf(playerID int32) int32 {
+	return t[playerID]
+}
@@ -0,0 +1,105 @@
+package weewar
+
+import (
+	"slices"
+	"testing"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+func TestTeammatesCannotAttack(t *testing.T) {
+	game := newTestGame(t)
+	attacker := game.World.UnitAt(AxialCoord{Q: 2, R: 0})
+	defender := NewUnit(1, 2, AxialCoord{Q: 3, R: 0})
+	game.World.AddUnit(defender)
+
+	if !game.CanAttackUnit(attacker, defender) {
+		t.Fatal("Expected enemies to be able to attack each other")
+	}
+
+	game.PlayerTeams = Teams{1: 1, 2: 1}
+	if game.CanAttackUnit(attacker, defender) {
+		t.Error("Expected teammates not to be able to attack each other")
+	}
+	if options, err := game.GetUnitAttackOptions(attacker); err != nil || len(options) != 0 {
+		t.Errorf("Expected no attack options against teammates, got %v (%v)", options, err)
+	}
+
+	var dmp DefaultMoveProcessor
+	attack := &v1.GameMove{
+		Player: 1,
+		MoveType: &v1.GameMove_AttackUnit{AttackUnit: &v1.AttackUnitAction{
+			AttackerQ: 2, AttackerR: 0, DefenderQ: 3, DefenderR: 0,
+		}},
+	}
+	if _, err := dmp.ProcessMove(game, attack); err == nil {
+		t.Error("Expected an attack on a teammate to be rejected")
+	}
+}
+
+func TestMoveThroughAllies(t *testing.T) {
+	// A one tile wide corridor so the only way forward is through the unit in the way
+	world := NewWorld("corridor")
+	for q := 0; q <= 2; q++ {
+		world.AddTile(NewTile(AxialCoord{Q: q, R: 0}, 5))
+	}
+	mover := NewUnit(1, 1, AxialCoord{Q: 0, R: 0})
+	world.AddUnit(mover)
+	world.AddUnit(NewUnit(1, 2, AxialCoord{Q: 1, R: 0}))
+	rulesEngine := DefaultRulesEngine()
+
+	options, err := rulesEngine.GetMovementOptions(world, mover, 2, nil)
+	if err != nil {
+		t.Fatalf("Failed to get movement options: %v", err)
+	}
+	if len(options) != 0 {
+		t.Errorf("Expected enemies to block the corridor, got %v", options)
+	}
+
+	teams := Teams{1: 1, 2: 1}
+	options, err = rulesEngine.GetMovementOptions(world, mover, 2, teams)
+	if err != nil {
+		t.Fatalf("Failed to get movement options: %v", err)
+	}
+	if len(options) != 1 || options[0].Coord != (AxialCoord{Q: 2, R: 0}) {
+		t.Errorf("Expected to move through the ally to the end of the corridor, got %v", options)
+	}
+	if valid, _ := rulesEngine.IsValidPath(mover, []AxialCoord{{Q: 0, R: 0}, {Q: 1, R: 0}}, world, teams); valid {
+		t.Error("Expected moves ending on an ally to be invalid")
+	}
+}
+
+func TestTeamVictory(t *testing.T) {
+	game := newTestGame(t)
+	game.World.AddUnit(NewUnit(1, 3, AxialCoord{Q: 3, R: 0}))
+	game.PlayerTeams = Teams{1: 1, 3: 1}
+	game.World.RemoveUnit(game.World.UnitAt(AxialCoord{Q: -2, R: 0}))
+	endTurn(t, game)
+
+	winner, hasWinner := game.GetWinner()
+	if !hasWinner || winner != 1 {
+		t.Fatalf("Expected player 1's team to win, got %d (%t)", winner, hasWinner)
+	}
+	if winners := game.GetWinners(); !slices.Equal(winners, []int32{1, 3}) {
+		t.Errorf("Expected players 1 and 3 to share the victory, got %v", winners)
+	}
+}
+
+func TestTeamCaptureBasesVictory(t *testing.T) {
+	game := newTestGame(t)
+	game.World.AddUnit(NewUnit(1, 3, AxialCoord{Q: 3, R: 0}))
+	game.PlayerTeams = Teams{1: 1, 3: 1}
+	game.VictorySettings = &v1.VictorySettings{CaptureBases: 2}
+	second := game.World.TileAt(AxialCoord{Q: 0, R: 2})
+	second.TileType = 1
+
+	if progress := game.VictoryProgress(3); progress["capture_base"] != 0.5 {
+		t.Errorf("Expected player 3 to share their teammate's base, got %v", progress)
+	}
+
+	second.Player = 3
+	endTurn(t, game)
+	if winners := game.GetWinners(); !slices.Equal(winners, []int32{1, 3}) || game.VictoryCondition() != "capture_base" {
+		t.Errorf("Expected players 1 and 3 to win by capturing bases together, got %v %q", winners, game.VictoryCondition())
+	}
+}
@@ -0,0 +1,161 @@
+package weewar
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+// =============================================================================
+// Terrain Actions - Units changing terrain during play
+// =============================================================================
+
+// ModifyTerrain works one turn on a terrain action (eg building a bridge) on the unit's own or
+// an adjacent hex.  Starting work on a tile pays the action's coins and once enough turns of work
+// are done the tile turns into the new terrain.  Like capturing, working uses up the unit's turn.
+func (m *DefaultMoveProcessor) ProcessModifyTerrain(g *Game, move *v1.GameMove, action *v1.ModifyTerrainAction) (result *v1.GameMoveResult, err error) {
+	// Initialize the result object
+	result = &v1.GameMoveResult{
+		IsPermanent: false,
+		Changes:     []*v1.WorldChange{},
+	}
+
+	unit := g.World.UnitAt(CoordFromInt32(action.Q, action.R))
+	if unit == nil {
+		return nil, fmt.Errorf("unit is nil")
+	}
+	tile, terrainAction, err := g.validateTerrainAction(unit, CoordFromInt32(action.TargetQ, action.TargetR), action.TerrainAction)
+	if err != nil {
+		return nil, err
+	}
+
+	previousTile := CopyTile(tile)
+	previousUnit := CopyUnit(unit)
+
+	// Work on a different action (or a fresh tile) starts from scratch and is paid for up front
+	if tile.TerrainAction != terrainAction.Id {
+		if terrainAction.Coins > 0 {
+			result.Changes = append(result.Changes, g.adjustPlayerCoins(unit.Player, -terrainAction.Coins))
+		}
+		tile.TerrainAction = terrainAction.Id
+		tile.TerrainActionProgress = 0
+	}
+	tile.TerrainActionProgress++
+
+	// Work complete - the tile turns into the new terrain
+	if tile.TerrainActionProgress >= g.rulesEngine.GetTerrainActionTurns(terrainAction) {
+		tile.TileType = terrainAction.TerrainChanges[tile.TileType]
+		tile.TerrainAction = ""
+		tile.TerrainActionProgress = 0
+	}
+
+	// Working uses up the unit's turn so it can neither move nor attack afterwards
+	unit.DistanceLeft = 0
+	unit.HasMoved = true
+	unit.ActionsRemaining = 0
+
+	change := &v1.WorldChange{
+		ChangeType: &v1.WorldChange_TileChanged{
+			TileChanged: &v1.TileChangedChange{
+				PreviousTile: previousTile,
+				UpdatedTile:  CopyTile(tile),
+				PreviousUnit: previousUnit,
+				UpdatedUnit:  CopyUnit(unit),
+			},
+		},
+	}
+	result.Changes = append(result.Changes, change)
+
+	// Update timestamp
+	g.LastActionAt = time.Now()
+
+	return result, nil
+}
+
+// validateTerrainAction checks if a unit can work on a terrain action at a hex and returns the tile and action
+func (g *Game) validateTerrainAction(unit *v1.Unit, target AxialCoord, actionID string) (*v1.Tile, *v1.TerrainAction, error) {
+	if unit.Player != g.CurrentPlayer {
+		return nil, nil, fmt.Errorf("not player %d's turn", unit.Player)
+	}
+	terrainAction, err := g.rulesEngine.GetTerrainAction(actionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !g.rulesEngine.CanPerformTerrainAction(unit.UnitType, terrainAction) {
+		return nil, nil, fmt.Errorf("unit type %d cannot %s", unit.UnitType, terrainAction.Name)
+	}
+
+	coord := UnitGetCoord(unit)
+	if coord.Distance(target) > 1 {
+		return nil, nil, fmt.Errorf("hex %v is not next to unit at %v", target, coord)
+	}
+	tile := g.World.TileAt(target)
+	if tile == nil {
+		return nil, nil, fmt.Errorf("no tile at %v", target)
+	}
+	if _, ok := terrainAction.TerrainChanges[tile.TileType]; !ok {
+		return nil, nil, fmt.Errorf("cannot %s on terrain %d", terrainAction.Name, tile.TileType)
+	}
+	if target != coord && g.World.UnitAt(target) != nil {
+		return nil, nil, fmt.Errorf("tile at %v is occupied", target)
+	}
+	if tile.TerrainAction != terrainAction.Id && terrainAction.Coins > g.PlayerCoins[unit.Player] {
+		return nil, nil, fmt.Errorf("player %d cannot afford %s (%d coins)", unit.Player, terrainAction.Name, terrainAction.Coins)
+	}
+	if unit.DistanceLeft <= 0 {
+		return nil, nil, fmt.Errorf("unit has no movement points remaining")
+	}
+	if unit.HasAttacked {
+		return nil, nil, fmt.Errorf("unit cannot work after attacking this turn")
+	}
+	return tile, terrainAction, nil
+}
+
+// GetTerrainActionOptions returns the terrain actions the unit at given coordinates can work on
+func (m *DefaultMoveProcessor) GetTerrainActionOptions(game *Game, q, r int32) ([]*v1.ModifyTerrainOption, error) {
+	unit := game.World.UnitAt(AxialCoord{Q: int(q), R: int(r)})
+	if unit == nil {
+		return nil, fmt.Errorf("no unit found at position (%d, %d)", q, r)
+	}
+
+	// Actions are listed in ID order so options are stable
+	actionIDs := slices.Sorted(maps.Keys(game.rulesEngine.TerrainActions.GetActions()))
+
+	var options []*v1.ModifyTerrainOption
+	coord := UnitGetCoord(unit)
+	var neighbors [6]AxialCoord
+	coord.Neighbors(&neighbors)
+	for _, target := range append([]AxialCoord{coord}, neighbors[:]...) {
+		for _, actionID := range actionIDs {
+			tile, terrainAction, err := game.validateTerrainAction(unit, target, actionID)
+			if err != nil {
+				continue
+			}
+			option := &v1.ModifyTerrainOption{
+				Q:             int32(target.Q),
+				R:             int32(target.R),
+				TerrainAction: actionID,
+				Name:          terrainAction.Name,
+				NewTileType:   terrainAction.TerrainChanges[tile.TileType],
+				Turns:         game.rulesEngine.GetTerrainActionTurns(terrainAction),
+				Action: &v1.ModifyTerrainAction{
+					Q:             q,
+					R:             r,
+					TargetQ:       int32(target.Q),
+					TargetR:       int32(target.R),
+					TerrainAction: actionID,
+				},
+			}
+			if tile.TerrainAction == actionID {
+				option.Progress = tile.TerrainActionProgress
+			} else {
+				option.Coins = terrainAction.Coins
+			}
+			options = append(options, option)
+		}
+	}
+	return options, nil
+}
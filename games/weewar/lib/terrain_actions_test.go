@@ -0,0 +1,122 @@
+package weewar
+
+import (
+	"testing"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+// addEngineer adds a player's engineer ready to act this turn
+func addEngineer(game *Game, player int, coord AxialCoord) *v1.Unit {
+	engineer := NewUnit(29, player, coord)
+	engineer.DistanceLeft = 3
+	engineer.ActionsRemaining = 1
+	game.World.AddUnit(engineer)
+	return engineer
+}
+
+func TestEngineerBuildsBridge(t *testing.T) {
+	game := newTestGame(t)
+	game.World.TileAt(AxialCoord{Q: 2, R: 1}).TileType = 10
+	addEngineer(game, 1, AxialCoord{Q: 1, R: 1})
+	game.PlayerCoins[1] = 150
+
+	var dmp DefaultMoveProcessor
+	options, err := dmp.GetTerrainActionOptions(game, 1, 1)
+	if err != nil {
+		t.Fatalf("Failed to get terrain action options: %v", err)
+	}
+	var bridge *v1.ModifyTerrainOption
+	for _, option := range options {
+		if option.TerrainAction == "build_bridge" {
+			bridge = option
+		}
+	}
+	if bridge == nil || bridge.Q != 2 || bridge.R != 1 || bridge.NewTileType != 17 || bridge.Turns != 2 || bridge.Coins != 100 {
+		t.Fatalf("Expected to be able to bridge the water at (2,1), got %v", options)
+	}
+
+	// The first turn of work pays for the bridge
+	results, err := dmp.ProcessMoves(game, []*v1.GameMove{{Player: 1, MoveType: &v1.GameMove_ModifyTerrain{ModifyTerrain: bridge.Action}}})
+	if err != nil {
+		t.Fatalf("Failed to start the bridge: %v", err)
+	}
+	tile := game.World.TileAt(AxialCoord{Q: 2, R: 1})
+	if tile.TileType != 10 || tile.TerrainAction != "build_bridge" || tile.TerrainActionProgress != 1 || game.PlayerCoins[1] != 50 {
+		t.Fatalf("Expected the bridge to be half built and paid for, got %v with %d coins left", tile, game.PlayerCoins[1])
+	}
+	inverse, err := InvertMoveGroup(&v1.GameMoveGroup{MoveResults: results})
+	if err != nil {
+		t.Fatalf("Failed to invert move group: %v", err)
+	}
+	if undone := inverse[0].GetTileChanged(); undone == nil || undone.UpdatedTile.TerrainAction != "" {
+		t.Errorf("Expected undoing the work to clear the progress, got %v", inverse)
+	}
+
+	// Working uses up the engineer's turn
+	if _, err := dmp.ProcessModifyTerrain(game, nil, bridge.Action); err == nil {
+		t.Error("Expected the engineer not to work twice in a turn")
+	}
+	if worker := results[0].Changes[1].GetTileChanged().GetUpdatedUnit(); !worker.GetHasMoved() || worker.GetActionsRemaining() != 0 {
+		t.Errorf("Expected the work to record the engineer's spent turn, got %v", worker)
+	}
+	game.World.AddUnit(NewUnit(1, 2, AxialCoord{Q: 1, R: 2}))
+	if _, err := dmp.ProcessAttackUnit(game, nil, &v1.AttackUnitAction{AttackerQ: 1, AttackerR: 1, DefenderQ: 1, DefenderR: 2}); err == nil {
+		t.Error("Expected the engineer not to attack after working")
+	}
+
+	endTurn(t, game)
+	endTurn(t, game)
+	result, err := dmp.ProcessModifyTerrain(game, nil, bridge.Action)
+	if err != nil {
+		t.Fatalf("Failed to finish the bridge: %v", err)
+	}
+	if tile.TileType != 17 || tile.TerrainAction != "" || game.PlayerCoins[1] != 50 {
+		t.Errorf("Expected the bridge to be finished at no extra cost, got %v with %d coins left", tile, game.PlayerCoins[1])
+	}
+	if len(result.Changes) != 1 || result.Changes[0].GetTileChanged().GetUpdatedTile().GetTileType() != 17 {
+		t.Errorf("Expected a single tile change to the bridge, got %v", result.Changes)
+	}
+}
+
+func TestTerrainActionRules(t *testing.T) {
+	game := newTestGame(t)
+	game.World.TileAt(AxialCoord{Q: 1, R: 1}).TileType = 9
+	game.PlayerCoins[1] = 10
+	clearForest := &v1.ModifyTerrainAction{Q: 1, R: 1, TargetQ: 1, TargetR: 1, TerrainAction: "clear_forest"}
+
+	// Only units with the right property can change terrain
+	var dmp DefaultMoveProcessor
+	soldier := NewUnit(1, 1, AxialCoord{Q: 1, R: 1})
+	soldier.DistanceLeft = 3
+	game.World.AddUnit(soldier)
+	if _, err := dmp.ProcessModifyTerrain(game, nil, clearForest); err == nil {
+		t.Error("Expected a soldier not to be able to clear a forest")
+	}
+	game.World.RemoveUnit(soldier)
+
+	engineer := addEngineer(game, 1, AxialCoord{Q: 1, R: 1})
+	if _, err := dmp.ProcessModifyTerrain(game, nil, &v1.ModifyTerrainAction{Q: 1, R: 1, TargetQ: 2, TargetR: 0, TerrainAction: "lay_road"}); err == nil {
+		t.Error("Expected work on an occupied hex to fail")
+	}
+	if _, err := dmp.ProcessModifyTerrain(game, nil, &v1.ModifyTerrainAction{Q: 1, R: 1, TargetQ: 1, TargetR: 2, TerrainAction: "lay_road"}); err == nil {
+		t.Error("Expected work the player cannot afford to fail")
+	}
+	if _, err := dmp.ProcessModifyTerrain(game, nil, &v1.ModifyTerrainAction{Q: 1, R: 1, TargetQ: 1, TargetR: 3, TerrainAction: "clear_forest"}); err == nil {
+		t.Error("Expected work on a hex out of reach to fail")
+	}
+
+	engineer.HasAttacked = true
+	if _, err := dmp.ProcessModifyTerrain(game, nil, clearForest); err == nil {
+		t.Error("Expected work after attacking to fail")
+	}
+	engineer.HasAttacked = false
+
+	// Clearing a forest is free and takes a single turn
+	if _, err := dmp.ProcessModifyTerrain(game, nil, clearForest); err != nil {
+		t.Fatalf("Failed to clear forest: %v", err)
+	}
+	if tile := game.World.TileAt(AxialCoord{Q: 1, R: 1}); tile.TileType != 5 || engineer.DistanceLeft != 0 {
+		t.Errorf("Expected the forest to be cleared to grass, got %v", tile)
+	}
+}
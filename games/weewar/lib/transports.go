@@ -0,0 +1,214 @@
+package weewar
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+// =============================================================================
+// Transports - Loading and unloading carried units
+// =============================================================================
+
+// LoadUnit boards a unit onto an adjacent transport.  The unit leaves the map and travels
+// with the transport until it is unloaded.  Boarding uses up the unit's movement.
+func (m *DefaultMoveProcessor) ProcessLoadUnit(g *Game, move *v1.GameMove, action *v1.LoadUnitAction) (result *v1.GameMoveResult, err error) {
+	// Initialize the result object
+	result = &v1.GameMoveResult{
+		IsPermanent: false,
+		Changes:     []*v1.WorldChange{},
+	}
+
+	from := CoordFromInt32(action.UnitQ, action.UnitR)
+	unit := g.World.UnitAt(from)
+	transport := g.World.UnitAt(CoordFromInt32(action.TransportQ, action.TransportR))
+	if unit == nil || transport == nil {
+		return nil, fmt.Errorf("unit or transport is nil")
+	}
+	if err := g.validateLoad(unit, transport); err != nil {
+		return nil, err
+	}
+
+	previousUnit := CopyUnit(unit)
+	previousTransport := CopyUnit(transport)
+
+	if err := g.World.RemoveUnit(unit); err != nil {
+		return nil, fmt.Errorf("failed to remove unit: %w", err)
+	}
+	unit.DistanceLeft = 0
+	unit.HasMoved = true
+	transport.Cargo = append(transport.Cargo, unit)
+
+	change := &v1.WorldChange{
+		ChangeType: &v1.WorldChange_UnitLoaded{
+			UnitLoaded: &v1.UnitLoadedChange{
+				Unit:              previousUnit,
+				PreviousTransport: previousTransport,
+				UpdatedTransport:  CopyUnit(transport),
+			},
+		},
+	}
+	result.Changes = append(result.Changes, change)
+
+	// Leaving a tile abandons any capture in progress there
+	if abandoned := g.abandonCapture(from); abandoned != nil {
+		result.Changes = append(result.Changes, abandoned)
+	}
+
+	// Update timestamp
+	g.LastActionAt = time.Now()
+
+	return result, nil
+}
+
+// UnloadUnit puts a carried unit down on an empty hex next to its transport.  Unloading
+// uses up the unit's movement but it can still attack.
+func (m *DefaultMoveProcessor) ProcessUnloadUnit(g *Game, move *v1.GameMove, action *v1.UnloadUnitAction) (result *v1.GameMoveResult, err error) {
+	// Initialize the result object
+	result = &v1.GameMoveResult{
+		IsPermanent: false,
+		Changes:     []*v1.WorldChange{},
+	}
+
+	transport := g.World.UnitAt(CoordFromInt32(action.TransportQ, action.TransportR))
+	if transport == nil {
+		return nil, fmt.Errorf("transport is nil")
+	}
+	to := CoordFromInt32(action.ToQ, action.ToR)
+	if err := g.validateUnload(transport, int(action.CargoIndex), to); err != nil {
+		return nil, err
+	}
+
+	previousTransport := CopyUnit(transport)
+
+	unit := transport.Cargo[action.CargoIndex]
+	transport.Cargo = slices.Delete(transport.Cargo, int(action.CargoIndex), int(action.CargoIndex)+1)
+	UnitSetCoord(unit, to)
+	unit.DistanceLeft = 0
+	unit.HasMoved = true
+	if _, err := g.World.AddUnit(unit); err != nil {
+		return nil, fmt.Errorf("failed to add unit: %w", err)
+	}
+
+	change := &v1.WorldChange{
+		ChangeType: &v1.WorldChange_UnitUnloaded{
+			UnitUnloaded: &v1.UnitUnloadedChange{
+				Unit:              CopyUnit(unit),
+				PreviousTransport: previousTransport,
+				UpdatedTransport:  CopyUnit(transport),
+			},
+		},
+	}
+	result.Changes = append(result.Changes, change)
+
+	// Update timestamp
+	g.LastActionAt = time.Now()
+
+	return result, nil
+}
+
+// validateLoad checks if a unit can board a transport
+func (g *Game) validateLoad(unit, transport *v1.Unit) error {
+	if unit.Player != g.CurrentPlayer {
+		return fmt.Errorf("not player %d's turn", unit.Player)
+	}
+	if transport.Player != unit.Player {
+		return fmt.Errorf("units can only board their own player's transports")
+	}
+
+	unitCoord, transportCoord := UnitGetCoord(unit), UnitGetCoord(transport)
+	if unitCoord.Distance(transportCoord) != 1 {
+		return fmt.Errorf("transport at %v is not next to unit at %v", transportCoord, unitCoord)
+	}
+	if !g.rulesEngine.CanCarry(transport.UnitType, unit.UnitType) {
+		return fmt.Errorf("unit type %d cannot carry unit type %d", transport.UnitType, unit.UnitType)
+	}
+	if len(transport.Cargo) >= g.rulesEngine.GetTransportCapacity(transport.UnitType) {
+		return fmt.Errorf("transport at %v is full", transportCoord)
+	}
+	if len(unit.Cargo) > 0 {
+		return fmt.Errorf("transports carrying units cannot be loaded")
+	}
+	if unit.DistanceLeft <= 0 {
+		return fmt.Errorf("unit has no movement points remaining")
+	}
+	return g.validateUnitCanMove(unit)
+}
+
+// validateUnload checks if a transport can unload one of its carried units to a hex
+func (g *Game) validateUnload(transport *v1.Unit, cargoIndex int, to AxialCoord) error {
+	if transport.Player != g.CurrentPlayer {
+		return fmt.Errorf("not player %d's turn", transport.Player)
+	}
+	if cargoIndex < 0 || cargoIndex >= len(transport.Cargo) {
+		return fmt.Errorf("transport has no unit at cargo index %d", cargoIndex)
+	}
+
+	// A unit cannot be loaded and unloaded again in the same turn
+	unit := transport.Cargo[cargoIndex]
+	if unit.HasMoved {
+		return fmt.Errorf("carried unit has already moved this turn")
+	}
+
+	transportCoord := UnitGetCoord(transport)
+	if transportCoord.Distance(to) != 1 {
+		return fmt.Errorf("hex %v is not next to transport at %v", to, transportCoord)
+	}
+	tile := g.World.TileAt(to)
+	if tile == nil {
+		return fmt.Errorf("no tile at %v", to)
+	}
+	if g.World.UnitAt(to) != nil {
+		return fmt.Errorf("tile at %v is already occupied", to)
+	}
+	if _, err := g.rulesEngine.getUnitTerrainCost(unit.UnitType, tile.TileType); err != nil {
+		return fmt.Errorf("unit type %d cannot be unloaded onto terrain %d", unit.UnitType, tile.TileType)
+	}
+	return nil
+}
+
+// GetLoadOptions returns the adjacent transports the unit at given coordinates can board
+func (m *DefaultMoveProcessor) GetLoadOptions(game *Game, q, r int32) ([]AxialCoord, error) {
+	unit := game.World.UnitAt(AxialCoord{Q: int(q), R: int(r)})
+	if unit == nil {
+		return nil, fmt.Errorf("no unit found at position (%d, %d)", q, r)
+	}
+
+	var options []AxialCoord
+	var neighbors [6]AxialCoord
+	UnitGetCoord(unit).Neighbors(&neighbors)
+	for _, coord := range neighbors {
+		if transport := game.World.UnitAt(coord); transport != nil && game.validateLoad(unit, transport) == nil {
+			options = append(options, coord)
+		}
+	}
+	return options, nil
+}
+
+// GetUnloadOptions returns every way the transport at given coordinates can unload its carried units
+func (m *DefaultMoveProcessor) GetUnloadOptions(game *Game, q, r int32) ([]*v1.UnloadUnitAction, error) {
+	transport := game.World.UnitAt(AxialCoord{Q: int(q), R: int(r)})
+	if transport == nil {
+		return nil, fmt.Errorf("no unit found at position (%d, %d)", q, r)
+	}
+
+	var options []*v1.UnloadUnitAction
+	var neighbors [6]AxialCoord
+	UnitGetCoord(transport).Neighbors(&neighbors)
+	for i := range transport.Cargo {
+		for _, coord := range neighbors {
+			if game.validateUnload(transport, i, coord) == nil {
+				options = append(options, &v1.UnloadUnitAction{
+					TransportQ: q,
+					TransportR: r,
+					CargoIndex: int32(i),
+					ToQ:        int32(coord.Q),
+					ToR:        int32(coord.R),
+				})
+			}
+		}
+	}
+	return options, nil
+}
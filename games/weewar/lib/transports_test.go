@@ -0,0 +1,157 @@
+package weewar
+
+import (
+	"testing"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+// addHovercraft adds a player's hovercraft (a transport for one land unit) ready to act this turn
+func addHovercraft(game *Game, player int, coord AxialCoord) *v1.Unit {
+	hovercraft := NewUnit(7, player, coord)
+	hovercraft.DistanceLeft = 3
+	hovercraft.ActionsRemaining = 1
+	game.World.AddUnit(hovercraft)
+	return hovercraft
+}
+
+func TestLoadAndUnloadUnit(t *testing.T) {
+	game := newTestGame(t)
+	hovercraft := addHovercraft(game, 1, AxialCoord{Q: 3, R: 0})
+
+	var dmp DefaultMoveProcessor
+	load := &v1.GameMove{Player: 1, MoveType: &v1.GameMove_LoadUnit{LoadUnit: &v1.LoadUnitAction{UnitQ: 2, UnitR: 0, TransportQ: 3, TransportR: 0}}}
+	results, err := dmp.ProcessMoves(game, []*v1.GameMove{load})
+	if err != nil {
+		t.Fatalf("Failed to load unit: %v", err)
+	}
+	hovercraft = game.World.UnitAt(AxialCoord{Q: 3, R: 0})
+	if game.World.UnitAt(AxialCoord{Q: 2, R: 0}) != nil || len(hovercraft.Cargo) != 1 {
+		t.Fatalf("Expected the soldier to be carried by the hovercraft, got %v", hovercraft)
+	}
+	if loaded := results[0].Changes[0].GetUnitLoaded(); loaded == nil || loaded.Unit.Q != 2 || len(loaded.UpdatedTransport.Cargo) != 1 {
+		t.Errorf("Unexpected load change: %v", results[0].Changes[0])
+	}
+
+	// A unit cannot board and get off again in the same turn
+	unload := &v1.UnloadUnitAction{TransportQ: 3, TransportR: 0, CargoIndex: 0, ToQ: 4, ToR: 0}
+	if _, err := dmp.ProcessUnloadUnit(game, nil, unload); err == nil {
+		t.Error("Expected unloading in the same turn to fail")
+	}
+
+	// The soldier travels with the hovercraft and gets off next turn
+	if _, err := dmp.ProcessMoveUnit(game, nil, &v1.MoveUnitAction{FromQ: 3, FromR: 0, ToQ: 3, ToR: -1}); err != nil {
+		t.Fatalf("Failed to move transport: %v", err)
+	}
+	endTurn(t, game)
+	endTurn(t, game)
+
+	// Two of the hovercraft's neighbors are off the map
+	options, err := dmp.GetUnloadOptions(game, 3, -1)
+	if err != nil || len(options) != 4 {
+		t.Errorf("Expected the soldier to be unloadable on the 4 neighbors in the map, got %v (%v)", options, err)
+	}
+	unload = &v1.UnloadUnitAction{TransportQ: 3, TransportR: -1, CargoIndex: 0, ToQ: 2, ToR: -1}
+	if _, err := dmp.ProcessUnloadUnit(game, nil, unload); err != nil {
+		t.Fatalf("Failed to unload unit: %v", err)
+	}
+	soldier := game.World.UnitAt(AxialCoord{Q: 2, R: -1})
+	if soldier == nil || soldier.UnitType != 1 || len(hovercraft.Cargo) != 0 {
+		t.Fatalf("Expected the soldier to be back on the map at (2,-1), got %v", hovercraft)
+	}
+	if soldier.DistanceLeft != 0 || soldier.ActionsRemaining != 1 {
+		t.Errorf("Expected an unloaded unit to be unable to move but still able to attack, got %v", soldier)
+	}
+}
+
+func TestTransportRules(t *testing.T) {
+	game := newTestGame(t)
+	var dmp DefaultMoveProcessor
+	loadOnto := func(unitCoord, transportCoord AxialCoord) error {
+		_, err := dmp.ProcessLoadUnit(game, nil, &v1.LoadUnitAction{
+			UnitQ: int32(unitCoord.Q), UnitR: int32(unitCoord.R),
+			TransportQ: int32(transportCoord.Q), TransportR: int32(transportCoord.R),
+		})
+		return err
+	}
+
+	// Aircraft carriers only carry air units
+	carrier := NewUnit(39, 1, AxialCoord{Q: 3, R: 0})
+	game.World.AddUnit(carrier)
+	if err := loadOnto(AxialCoord{Q: 2, R: 0}, AxialCoord{Q: 3, R: 0}); err == nil {
+		t.Error("Expected a soldier not to be able to board an aircraft carrier")
+	}
+
+	// Units that are not transports cannot carry anything
+	game.World.AddUnit(NewUnit(1, 1, AxialCoord{Q: 2, R: 1}))
+	if err := loadOnto(AxialCoord{Q: 2, R: 0}, AxialCoord{Q: 2, R: 1}); err == nil {
+		t.Error("Expected a soldier not to be able to board another soldier")
+	}
+
+	// Transports only take units up to their capacity and only from their own player
+	addHovercraft(game, 1, AxialCoord{Q: 1, R: 0})
+	addHovercraft(game, 2, AxialCoord{Q: 1, R: 1})
+	if options, _ := dmp.GetLoadOptions(game, 2, 0); len(options) != 1 || options[0] != (AxialCoord{Q: 1, R: 0}) {
+		t.Errorf("Expected the soldier to only be able to board its own hovercraft, got %v", options)
+	}
+	if err := loadOnto(AxialCoord{Q: 2, R: 0}, AxialCoord{Q: 1, R: 0}); err != nil {
+		t.Fatalf("Failed to load unit: %v", err)
+	}
+	game.World.UnitAt(AxialCoord{Q: 2, R: 1}).DistanceLeft = 3
+	if err := loadOnto(AxialCoord{Q: 2, R: 1}, AxialCoord{Q: 1, R: 0}); err == nil {
+		t.Error("Expected a full hovercraft to not take another unit")
+	}
+}
+
+func TestCargoDestroyedWithTransport(t *testing.T) {
+	game := newTestGame(t)
+	hovercraft := addHovercraft(game, 1, AxialCoord{Q: 3, R: 0})
+	hovercraft.AvailableHealth = 1
+	attacker := NewUnit(1, 2, AxialCoord{Q: 3, R: -1})
+	attacker.ActionsRemaining = 1
+	game.World.AddUnit(attacker)
+
+	var dmp DefaultMoveProcessor
+	if _, err := dmp.ProcessLoadUnit(game, nil, &v1.LoadUnitAction{UnitQ: 2, UnitR: 0, TransportQ: 3, TransportR: 0}); err != nil {
+		t.Fatalf("Failed to load unit: %v", err)
+	}
+	endTurn(t, game)
+
+	result, err := dmp.ProcessAttackUnit(game, nil, &v1.AttackUnitAction{AttackerQ: 3, AttackerR: -1, DefenderQ: 3, DefenderR: 0})
+	if err != nil {
+		t.Fatalf("Failed to attack: %v", err)
+	}
+	var killed *v1.UnitKilledChange
+	for _, change := range result.Changes {
+		if change.GetUnitKilled() != nil {
+			killed = change.GetUnitKilled()
+		}
+	}
+	if killed == nil || len(killed.PreviousUnit.Cargo) != 1 {
+		t.Fatalf("Expected the hovercraft to be killed with its cargo, got %v", result.Changes)
+	}
+	if units := game.World.GetPlayerUnits(1); len(units) != 0 {
+		t.Errorf("Expected player 1 to have no units left, got %v", units)
+	}
+}
+
+func TestUndoLoadUnit(t *testing.T) {
+	game := newTestGame(t)
+	addHovercraft(game, 1, AxialCoord{Q: 3, R: 0})
+
+	var dmp DefaultMoveProcessor
+	moves := []*v1.GameMove{{Player: 1, MoveType: &v1.GameMove_LoadUnit{LoadUnit: &v1.LoadUnitAction{UnitQ: 2, UnitR: 0, TransportQ: 3, TransportR: 0}}}}
+	results, err := dmp.ProcessMoves(game, moves)
+	if err != nil {
+		t.Fatalf("Failed to load unit: %v", err)
+	}
+
+	inverse, err := InvertMoveGroup(&v1.GameMoveGroup{Moves: moves, MoveResults: results})
+	if err != nil {
+		t.Fatalf("Failed to invert move group: %v", err)
+	}
+	unloaded := inverse[0].GetUnitUnloaded()
+	if unloaded == nil || unloaded.Unit.Q != 2 || unloaded.Unit.DistanceLeft != 3 || len(unloaded.UpdatedTransport.Cargo) != 0 {
+		t.Errorf("Expected the soldier to be put back where it was, got %v", inverse[0])
+	}
+}
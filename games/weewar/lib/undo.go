@@ -0,0 +1,167 @@
+package weewar
+
+import (
+	"fmt"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+// =============================================================================
+// Undo - Inverting recorded world changes
+// =============================================================================
+
+// CanUndoMoveGroup checks if a move group can be undone.  Groups with permanent
+// results (eg attacks) or that end a turn cannot be undone.
+func CanUndoMoveGroup(group *v1.GameMoveGroup) error {
+	for _, result := range group.MoveResults {
+		if result.IsPermanent {
+			return fmt.Errorf("move %d is permanent", result.SequenceNum)
+		}
+		for _, change := range result.Changes {
+			if change.GetPlayerChanged() != nil {
+				return fmt.Errorf("move %d ended the turn", result.SequenceNum)
+			}
+		}
+	}
+	return nil
+}
+
+// CanUndo checks if move groups can still be undone in a game.  Nothing can be undone once the
+// game has been won as the latest group decided it and the victory is not one of its changes.
+func (g *Game) CanUndo() error {
+	if g.hasWinner {
+		return fmt.Errorf("game is over")
+	}
+	return nil
+}
+
+// InvertMoveGroup returns the changes that undo all the moves in a group, in the
+// order they should be applied
+func InvertMoveGroup(group *v1.GameMoveGroup) ([]*v1.WorldChange, error) {
+	if err := CanUndoMoveGroup(group); err != nil {
+		return nil, err
+	}
+
+	inverse := []*v1.WorldChange{}
+	for i := len(group.MoveResults) - 1; i >= 0; i-- {
+		changes := group.MoveResults[i].Changes
+		for j := len(changes) - 1; j >= 0; j-- {
+			inverted, err := InvertWorldChange(changes[j])
+			if err != nil {
+				return nil, err
+			}
+			inverse = append(inverse, inverted)
+		}
+	}
+	return inverse, nil
+}
+
+// InvertWorldChange returns the change that reverts the given change using its
+// recorded before/after snapshots
+func InvertWorldChange(change *v1.WorldChange) (*v1.WorldChange, error) {
+	switch c := change.ChangeType.(type) {
+	case *v1.WorldChange_UnitMoved:
+		return &v1.WorldChange{
+			ChangeType: &v1.WorldChange_UnitMoved{
+				UnitMoved: &v1.UnitMovedChange{
+					PreviousUnit: c.UnitMoved.UpdatedUnit,
+					UpdatedUnit:  c.UnitMoved.PreviousUnit,
+				},
+			},
+		}, nil
+	case *v1.WorldChange_UnitDamaged:
+		return &v1.WorldChange{
+			ChangeType: &v1.WorldChange_UnitDamaged{
+				UnitDamaged: &v1.UnitDamagedChange{
+					PreviousUnit:     c.UnitDamaged.UpdatedUnit,
+					UpdatedUnit:      c.UnitDamaged.PreviousUnit,
+					PreviousAttacker: c.UnitDamaged.UpdatedAttacker,
+					UpdatedAttacker:  c.UnitDamaged.PreviousAttacker,
+				},
+			},
+		}, nil
+	case *v1.WorldChange_UnitHealed:
+		return &v1.WorldChange{
+			ChangeType: &v1.WorldChange_UnitHealed{
+				UnitHealed: &v1.UnitHealedChange{
+					PreviousUnit:   c.UnitHealed.UpdatedUnit,
+					UpdatedUnit:    c.UnitHealed.PreviousUnit,
+					PreviousHealer: c.UnitHealed.UpdatedHealer,
+					UpdatedHealer:  c.UnitHealed.PreviousHealer,
+				},
+			},
+		}, nil
+	case *v1.WorldChange_UnitKilled:
+		return &v1.WorldChange{
+			ChangeType: &v1.WorldChange_UnitCreated{
+				UnitCreated: &v1.UnitCreatedChange{
+					Unit: c.UnitKilled.PreviousUnit,
+				},
+			},
+		}, nil
+	case *v1.WorldChange_UnitCreated:
+		return &v1.WorldChange{
+			ChangeType: &v1.WorldChange_UnitKilled{
+				UnitKilled: &v1.UnitKilledChange{
+					PreviousUnit: c.UnitCreated.Unit,
+				},
+			},
+		}, nil
+	case *v1.WorldChange_CoinsChanged:
+		return &v1.WorldChange{
+			ChangeType: &v1.WorldChange_CoinsChanged{
+				CoinsChanged: &v1.CoinsChangedChange{
+					Player:        c.CoinsChanged.Player,
+					PreviousCoins: c.CoinsChanged.NewCoins,
+					NewCoins:      c.CoinsChanged.PreviousCoins,
+				},
+			},
+		}, nil
+	case *v1.WorldChange_TileCaptured:
+		return &v1.WorldChange{
+			ChangeType: &v1.WorldChange_TileCaptured{
+				TileCaptured: &v1.TileCapturedChange{
+					PreviousTile: c.TileCaptured.UpdatedTile,
+					UpdatedTile:  c.TileCaptured.PreviousTile,
+					PreviousUnit: c.TileCaptured.UpdatedUnit,
+					UpdatedUnit:  c.TileCaptured.PreviousUnit,
+				},
+			},
+		}, nil
+	case *v1.WorldChange_TileChanged:
+		return &v1.WorldChange{
+			ChangeType: &v1.WorldChange_TileChanged{
+				TileChanged: &v1.TileChangedChange{
+					PreviousTile: c.TileChanged.UpdatedTile,
+					UpdatedTile:  c.TileChanged.PreviousTile,
+					PreviousUnit: c.TileChanged.UpdatedUnit,
+					UpdatedUnit:  c.TileChanged.PreviousUnit,
+				},
+			},
+		}, nil
+	case *v1.WorldChange_UnitLoaded:
+		return &v1.WorldChange{
+			ChangeType: &v1.WorldChange_UnitUnloaded{
+				UnitUnloaded: &v1.UnitUnloadedChange{
+					Unit:              c.UnitLoaded.Unit,
+					PreviousTransport: c.UnitLoaded.UpdatedTransport,
+					UpdatedTransport:  c.UnitLoaded.PreviousTransport,
+				},
+			},
+		}, nil
+	case *v1.WorldChange_UnitUnloaded:
+		return &v1.WorldChange{
+			ChangeType: &v1.WorldChange_UnitLoaded{
+				UnitLoaded: &v1.UnitLoadedChange{
+					Unit:              c.UnitUnloaded.Unit,
+					PreviousTransport: c.UnitUnloaded.UpdatedTransport,
+					UpdatedTransport:  c.UnitUnloaded.PreviousTransport,
+				},
+			},
+		}, nil
+	case *v1.WorldChange_PlayerChanged:
+		return nil, fmt.Errorf("cannot undo a turn change")
+	default:
+		return nil, fmt.Errorf("unknown world change type")
+	}
+}
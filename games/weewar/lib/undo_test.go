@@ -0,0 +1,97 @@
+package weewar
+
+import (
+	"testing"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+func TestInvertMoveGroup(t *testing.T) {
+	game := newTestGame(t)
+	game.PlayerCoins[1] = 100
+
+	var dmp DefaultMoveProcessor
+	moves := []*v1.GameMove{
+		{Player: 1, MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{FromQ: 2, FromR: 0, ToQ: 3, ToR: 0}}},
+		{Player: 1, MoveType: &v1.GameMove_BuildUnit{BuildUnit: &v1.BuildUnitAction{Q: 0, R: 0, UnitType: 1}}},
+	}
+	results, err := dmp.ProcessMoves(game, moves)
+	if err != nil {
+		t.Fatalf("Failed to process moves: %v", err)
+	}
+
+	inverse, err := InvertMoveGroup(&v1.GameMoveGroup{Moves: moves, MoveResults: results})
+	if err != nil {
+		t.Fatalf("Failed to invert move group: %v", err)
+	}
+
+	// The build is undone first: the unit is removed then the coins are refunded
+	if len(inverse) != 3 {
+		t.Fatalf("Expected 3 inverse changes, got %d", len(inverse))
+	}
+	if killed := inverse[0].GetUnitKilled(); killed == nil || killed.PreviousUnit.UnitType != 1 {
+		t.Errorf("Expected built unit to be removed first, got %v", inverse[0])
+	}
+	if coins := inverse[1].GetCoinsChanged(); coins == nil || coins.NewCoins != 100 {
+		t.Errorf("Expected coins to be refunded, got %v", inverse[1])
+	}
+	if moved := inverse[2].GetUnitMoved(); moved == nil || moved.UpdatedUnit.Q != 2 || moved.PreviousUnit.Q != 3 {
+		t.Errorf("Expected unit to be moved back, got %v", inverse[2])
+	}
+}
+
+func TestCanUndoMoveGroup(t *testing.T) {
+	permanent := &v1.GameMoveGroup{MoveResults: []*v1.GameMoveResult{{IsPermanent: true}}}
+	if err := CanUndoMoveGroup(permanent); err == nil {
+		t.Error("Expected permanent moves to not be undoable")
+	}
+
+	endTurn := &v1.GameMoveGroup{MoveResults: []*v1.GameMoveResult{{
+		Changes: []*v1.WorldChange{{ChangeType: &v1.WorldChange_PlayerChanged{PlayerChanged: &v1.PlayerChangedChange{}}}},
+	}}}
+	if err := CanUndoMoveGroup(endTurn); err == nil {
+		t.Error("Expected undo to not cross a turn boundary")
+	}
+}
+
+func TestCanUndoFinishedGame(t *testing.T) {
+	game := newTestGame(t)
+	if err := game.CanUndo(); err != nil {
+		t.Errorf("Expected a game in progress to allow undo, got %v", err)
+	}
+
+	game.SetWinners([]int32{1}, "elimination")
+	if err := game.CanUndo(); err == nil {
+		t.Error("Expected the move that won the game to not be undoable")
+	}
+}
+
+func TestFogOfWarRevealingMovesArePermanent(t *testing.T) {
+	tests := []struct {
+		name          string
+		fogOfWar      bool
+		toQ           int
+		wantPermanent bool
+	}{
+		{name: "reveals an enemy", fogOfWar: true, toQ: 0, wantPermanent: true},
+		{name: "reveals nothing", fogOfWar: true, toQ: 3},
+		{name: "without fog of war", toQ: 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Player 2's soldier at (-2, 0) is out of sight of player 1's soldier and base
+			game := newTestGame(t)
+			game.FogOfWar = test.fogOfWar
+
+			var dmp DefaultMoveProcessor
+			move := &v1.GameMove{Player: 1, MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{FromQ: 2, FromR: 0, ToQ: int32(test.toQ), ToR: 0}}}
+			results, err := dmp.ProcessMoves(game, []*v1.GameMove{move})
+			if err != nil {
+				t.Fatalf("Failed to process move: %v", err)
+			}
+			if results[0].IsPermanent != test.wantPermanent {
+				t.Errorf("Expected permanent %t, got %t", test.wantPermanent, results[0].IsPermanent)
+			}
+		})
+	}
+}
@@ -21,19 +21,7 @@ type UnitData struct {
 	// Note: Movement costs and attack data managed separately by RulesEngine
 }
 
-// DamageDistribution represents combat damage in canonical format
-type DamageDistribution struct {
-	MinDamage      int            `json:"minDamage"`
-	MaxDamage      int            `json:"maxDamage"`
-	DamageBuckets  []DamageBucket `json:"damageBuckets"`
-	ExpectedDamage float64        `json:"expectedDamage"`
-}
-
-// DamageBucket represents a damage value with its probability weight
-type DamageBucket struct {
-	Damage int     `json:"damage"`
-	Weight float64 `json:"weight"`
-}
+// DamageDistribution and DamageBucket are now defined in protos/weewar/v1/models.proto
 
 // Basic unit data map - matches weewar-data.json
 var unitDataMap = map[int]UnitData{
@@ -0,0 +1,279 @@
+package weewar
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/panyam/turnengine/internal/turnengine"
+)
+
+// =============================================================================
+// Victory Conditions
+// =============================================================================
+
+// Objective types the capture conditions count
+const (
+	baseObjective         = "base"
+	headquartersObjective = "headquarters"
+)
+
+// newVictoryManager creates an engine victory manager with the conditions enabled in the game's settings
+func (g *Game) newVictoryManager() *turnengine.VictoryManager {
+	vm := turnengine.NewVictoryManager()
+	settings := g.VictorySettings
+	numConditions := 0
+	if settings.GetCaptureBases() > 0 {
+		vm.AddCondition(turnengine.NewCaptureObjectiveCondition(baseObjective, int(settings.CaptureBases)))
+		numConditions++
+	}
+	if settings.GetHeadquartersTileType() > 0 {
+		if count := g.countTiles(settings.HeadquartersTileType); count > 0 {
+			vm.AddCondition(turnengine.NewCaptureObjectiveCondition(headquartersObjective, count))
+			numConditions++
+		}
+	}
+	if settings.GetScoreAtMaxTurns() && g.MaxTurns > 0 {
+		vm.AddCondition(&scoreAtTurnLimitCondition{survival: turnengine.NewSurvivalCondition(int(g.MaxTurns)), game: g})
+		numConditions++
+	}
+
+	// Last player standing is the default when no other condition was chosen
+	if settings.GetElimination() || numConditions == 0 {
+		vm.AddCondition(turnengine.NewEliminateAllCondition())
+	}
+	return vm
+}
+
+// checkVictoryConditions checks if any side has won and returns all the players on the winning side
+// and the condition that decided it.  Players are checked in order with only their side's objectives
+// counted so that the result does not depend on the engine's map ordering when several sides meet a
+// condition at once.
+func (g *Game) checkVictoryConditions() (winners []int32, condition string, hasWinner bool) {
+	vm := g.newVictoryManager()
+	for playerID := int32(1); playerID <= g.World.PlayerCount(); playerID++ {
+		result := vm.CheckVictory(g.victoryState(playerID))
+		if result != nil && len(result.Winners) > 0 {
+			return g.sidePlayers(victorySideID(result.Winners[0])), result.Condition, true
+		}
+	}
+	return nil, "", false
+}
+
+// updateVictory ends the game if a victory condition has been met
+func (g *Game) updateVictory() {
+	if g.hasWinner {
+		return
+	}
+	if winners, condition, hasWinner := g.checkVictoryConditions(); hasWinner {
+		g.SetWinners(winners, condition)
+	}
+}
+
+// VictoryCondition returns the name of the condition that decided the game (empty while in progress)
+func (g *Game) VictoryCondition() string {
+	return g.victoryCondition
+}
+
+// SetWinners marks the game as won by a player and their teammates, eg when restoring a finished game
+func (g *Game) SetWinners(winners []int32, condition string) {
+	if len(winners) == 0 {
+		return
+	}
+	g.winner = winners[0]
+	g.winners = winners
+	g.hasWinner = true
+	g.victoryCondition = condition
+	g.Status = GameStatusEnded
+}
+
+// GetWinners returns all the players on the winning side if the game ended
+func (g *Game) GetWinners() []int32 {
+	return g.winners
+}
+
+// VictoryProgress returns how close a player's side is to meeting each of the game's victory conditions
+func (g *Game) VictoryProgress(playerID int32) map[string]float64 {
+	return g.newVictoryManager().GetProgress(g.victoryState(playerID))
+}
+
+// PlayerScore returns a player's score - their coins plus the build cost of their units
+func (g *Game) PlayerScore(playerID int32) int32 {
+	score := g.PlayerCoins[playerID]
+	for _, unit := range g.World.GetPlayerUnits(int(playerID)) {
+		if unitData, err := g.rulesEngine.GetUnitData(unit.UnitType); err == nil {
+			score += unitData.Coins
+		}
+	}
+	return score
+}
+
+// TeamScore returns the combined score of a player and their teammates
+func (g *Game) TeamScore(playerID int32) int32 {
+	score := int32(0)
+	for _, member := range g.sidePlayers(g.victorySide(playerID)) {
+		score += g.PlayerScore(member)
+	}
+	return score
+}
+
+// =============================================================================
+// Engine adapter
+// =============================================================================
+
+// Components the engine's victory conditions look for
+type victoryTeamComponent struct {
+	TeamID int `json:"teamId"`
+}
+
+func (c victoryTeamComponent) Type() string { return "team" }
+
+type victoryHealthComponent struct {
+	Current int `json:"current"`
+}
+
+func (c victoryHealthComponent) Type() string { return "health" }
+
+type victoryTerrainComponent struct {
+	TerrainType string `json:"terrainType"`
+	Owner       int    `json:"owner"`
+}
+
+func (c victoryTerrainComponent) Type() string { return "terrain" }
+
+// Sides of players without a team are offset past any team ID so the two never collide
+const soloVictorySide = 1 << 16
+
+// victorySide returns the side a player wins or loses with in the engine - their team if
+// they are on one, otherwise a side of their own
+func (g *Game) victorySide(playerID int32) int32 {
+	if team := g.PlayerTeams.TeamOf(playerID); team > 0 {
+		return team
+	}
+	return soloVictorySide + playerID
+}
+
+// sidePlayers returns the players on a side in player order
+func (g *Game) sidePlayers(side int32) (players []int32) {
+	for playerID := int32(1); playerID <= g.World.PlayerCount(); playerID++ {
+		if g.victorySide(playerID) == side {
+			players = append(players, playerID)
+		}
+	}
+	return
+}
+
+// victorySideKey is how a side is identified in the engine's victory results
+func victorySideKey(side int32) string {
+	return fmt.Sprintf("team_%d", side)
+}
+
+// victorySideID converts a key from an engine victory result back to a side
+func victorySideID(key string) int32 {
+	id, err := strconv.Atoi(strings.TrimPrefix(key, "team_"))
+	if err != nil {
+		return -1
+	}
+	return int32(id)
+}
+
+// victoryState converts the game into the engine's entity model.  Units become entities with their
+// side and health and objective tiles become terrain entities with their owner's side.  If forPlayer
+// is set only the objectives held by that player's side count as owned so the engine's progress for
+// the capture conditions is that side's own progress.
+func (g *Game) victoryState(forPlayer int32) *turnengine.GameState {
+	state := &turnengine.GameState{
+		CurrentTurn: int(g.TurnCounter) - 1, // Turns completed by all players
+		World:       turnengine.NewWorld(),
+	}
+	for playerID := int32(1); playerID <= g.World.PlayerCount(); playerID++ {
+		state.Players = append(state.Players, turnengine.Player{
+			ID:        fmt.Sprintf("player_%d", playerID),
+			Team:      int(g.victorySide(playerID)),
+			Resources: map[string]interface{}{"score": float64(g.TeamScore(playerID))},
+		})
+	}
+
+	for coord, unit := range g.World.UnitsByCoord() {
+		entity := state.World.CreateEntity(fmt.Sprintf("unit:%d,%d", coord.Q, coord.R))
+		entity.AddComponent(victoryTeamComponent{TeamID: int(g.victorySide(unit.Player))})
+		entity.AddComponent(victoryHealthComponent{Current: int(unit.AvailableHealth)})
+	}
+
+	headquartersType := g.VictorySettings.GetHeadquartersTileType()
+	for coord, tile := range g.World.TilesByCoord() {
+		owner := 0
+		if tile.Player > 0 && (forPlayer <= 0 || g.PlayerTeams.AreAllies(tile.Player, forPlayer)) {
+			owner = int(g.victorySide(tile.Player))
+		}
+		if g.rulesEngine.IsPlayerTerrain(tile.TileType) {
+			entity := state.World.CreateEntity(fmt.Sprintf("base:%d,%d", coord.Q, coord.R))
+			entity.AddComponent(victoryTerrainComponent{TerrainType: baseObjective, Owner: owner})
+		}
+		if headquartersType > 0 && tile.TileType == headquartersType {
+			entity := state.World.CreateEntity(fmt.Sprintf("headquarters:%d,%d", coord.Q, coord.R))
+			entity.AddComponent(victoryTerrainComponent{TerrainType: headquartersObjective, Owner: owner})
+		}
+	}
+	return state
+}
+
+// countTiles returns the number of tiles of a type in the world
+func (g *Game) countTiles(tileType int32) int {
+	count := 0
+	for _, tile := range g.World.TilesByCoord() {
+		if tile.TileType == tileType {
+			count++
+		}
+	}
+	return count
+}
+
+// scoreAtTurnLimitCondition uses the engine's survival condition to end the game at the turn
+// limit and then awards it to the surviving side with the highest combined score
+type scoreAtTurnLimitCondition struct {
+	survival *turnengine.SurvivalCondition
+	game     *Game
+}
+
+func (c *scoreAtTurnLimitCondition) Name() string {
+	return "score_at_max_turns"
+}
+
+func (c *scoreAtTurnLimitCondition) Description() string {
+	return "Have the highest score when the turn limit is reached"
+}
+
+func (c *scoreAtTurnLimitCondition) IsActive() bool {
+	return c.survival.IsActive()
+}
+
+func (c *scoreAtTurnLimitCondition) CheckVictory(gameState *turnengine.GameState) *turnengine.VictoryResult {
+	result := c.survival.CheckVictory(gameState)
+	result.Condition = c.Name()
+	result.Description = c.Description()
+	if !result.Achieved {
+		return result
+	}
+
+	// Ties go to the side with the lowest player ID so replays always pick the same winner
+	best, bestScore := int32(-1), int32(0)
+	for _, key := range result.Winners {
+		players := c.game.sidePlayers(victorySideID(key))
+		if len(players) == 0 {
+			continue
+		}
+		score := c.game.TeamScore(players[0])
+		if best < 0 || score > bestScore || (score == bestScore && players[0] < best) {
+			best, bestScore = players[0], score
+		}
+	}
+	if best < 0 {
+		result.Achieved = false
+		return result
+	}
+	result.Winners = []string{victorySideKey(c.game.victorySide(best))}
+	return result
+}
+
+var _ turnengine.VictoryCondition = (*scoreAtTurnLimitCondition)(nil)
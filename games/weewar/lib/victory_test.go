@@ -0,0 +1,104 @@
+package weewar
+
+import (
+	"testing"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+func endTurn(t *testing.T, game *Game) {
+	var dmp DefaultMoveProcessor
+	move := &v1.GameMove{Player: game.CurrentPlayer, MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}
+	if _, err := dmp.ProcessMoves(game, []*v1.GameMove{move}); err != nil {
+		t.Fatalf("Failed to end turn: %v", err)
+	}
+}
+
+func TestEliminationVictory(t *testing.T) {
+	game := newTestGame(t)
+	game.World.RemoveUnit(game.World.UnitAt(AxialCoord{Q: -2, R: 0}))
+	endTurn(t, game)
+
+	winner, hasWinner := game.GetWinner()
+	if !hasWinner || winner != 1 {
+		t.Fatalf("Expected player 1 to win, got %d (%t)", winner, hasWinner)
+	}
+	if game.VictoryCondition() != "eliminate_all" {
+		t.Errorf("Expected elimination victory, got %q", game.VictoryCondition())
+	}
+
+	var dmp DefaultMoveProcessor
+	move := &v1.GameMove{Player: game.CurrentPlayer, MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}
+	if _, err := dmp.ProcessMoves(game, []*v1.GameMove{move}); err == nil {
+		t.Error("Expected moves to be rejected once the game is over")
+	}
+}
+
+func TestCaptureBasesVictory(t *testing.T) {
+	game := newTestGame(t)
+	game.VictorySettings = &v1.VictorySettings{CaptureBases: 2}
+	second := game.World.TileAt(AxialCoord{Q: 0, R: 2})
+	second.TileType = 1
+
+	progress := game.VictoryProgress(1)
+	if progress["capture_base"] != 0.5 {
+		t.Errorf("Expected player 1 to be half way to capturing 2 bases, got %v", progress)
+	}
+	if _, ok := progress["eliminate_all"]; ok {
+		t.Error("Expected elimination to be disabled when other conditions are chosen")
+	}
+	if progress := game.VictoryProgress(2); progress["capture_base"] != 0 {
+		t.Errorf("Expected player 2 to have no progress, got %v", progress)
+	}
+
+	endTurn(t, game)
+	if _, hasWinner := game.GetWinner(); hasWinner {
+		t.Fatal("Expected no winner with one base")
+	}
+
+	second.Player = 1
+	endTurn(t, game)
+	winner, hasWinner := game.GetWinner()
+	if !hasWinner || winner != 1 || game.VictoryCondition() != "capture_base" {
+		t.Errorf("Expected player 1 to win by capturing bases, got %d (%t) %q", winner, hasWinner, game.VictoryCondition())
+	}
+}
+
+func TestHeadquartersVictory(t *testing.T) {
+	game := newTestGame(t)
+	game.VictorySettings = &v1.VictorySettings{HeadquartersTileType: 21}
+	hq1 := game.World.TileAt(AxialCoord{Q: 3, R: 0})
+	hq1.TileType, hq1.Player = 21, 1
+	hq2 := game.World.TileAt(AxialCoord{Q: -3, R: 0})
+	hq2.TileType, hq2.Player = 21, 2
+
+	endTurn(t, game)
+	if _, hasWinner := game.GetWinner(); hasWinner {
+		t.Fatal("Expected no winner while both headquarters are held")
+	}
+
+	hq2.Player = 1
+	endTurn(t, game)
+	winner, hasWinner := game.GetWinner()
+	if !hasWinner || winner != 1 || game.VictoryCondition() != "capture_headquarters" {
+		t.Errorf("Expected player 1 to win by capturing headquarters, got %d (%t) %q", winner, hasWinner, game.VictoryCondition())
+	}
+}
+
+func TestScoreAtMaxTurnsVictory(t *testing.T) {
+	game := newTestGame(t)
+	game.VictorySettings = &v1.VictorySettings{ScoreAtMaxTurns: true}
+	game.MaxTurns = 1
+	game.PlayerCoins[2] = 100
+
+	endTurn(t, game)
+	if _, hasWinner := game.GetWinner(); hasWinner {
+		t.Fatal("Expected no winner before the turn limit")
+	}
+
+	endTurn(t, game)
+	winner, hasWinner := game.GetWinner()
+	if !hasWinner || winner != 2 || game.VictoryCondition() != "score_at_max_turns" {
+		t.Errorf("Expected player 2 to win on score, got %d (%t) %q", winner, hasWinner, game.VictoryCondition())
+	}
+}
@@ -0,0 +1,359 @@
+package weewar
+
+import (
+	"slices"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+// =============================================================================
+// Fog of War - What each player can see
+// =============================================================================
+
+const (
+	DefaultSightRange  = 2 // Sight range for units without one in the rules data
+	BuildingSightRange = 1 // Owned buildings reveal themselves and their neighbors
+)
+
+// Visibility records the tiles a player can currently see
+type Visibility struct {
+	Player  int32
+	visible map[AxialCoord]bool
+}
+
+// NewVisibility creates an empty visibility map for a player
+func NewVisibility(playerID int32) *Visibility {
+	return &Visibility{
+		Player:  playerID,
+		visible: map[AxialCoord]bool{},
+	}
+}
+
+// IsVisible checks if a tile is visible
+func (v *Visibility) IsVisible(coord AxialCoord) bool {
+	return v.visible[coord]
+}
+
+// CanSeeUnit checks if a unit is visible.  Players can always see their own units.
+func (v *Visibility) CanSeeUnit(unit *v1.Unit) bool {
+	if unit == nil {
+		return false
+	}
+	return unit.Player == v.Player || v.IsVisible(UnitGetCoord(unit))
+}
+
+// Merge adds everything visible in another visibility map to this one
+func (v *Visibility) Merge(other *Visibility) *Visibility {
+	for coord := range other.visible {
+		v.visible[coord] = true
+	}
+	return v
+}
+
+// reveal marks all tiles within sightRange of center as visible
+func (v *Visibility) reveal(center AxialCoord, sightRange int) {
+	for _, coord := range center.Range(sightRange) {
+		v.visible[coord] = true
+	}
+}
+
+// ComputeVisibility computes the tiles a player can see from their units and owned tiles
+func (g *Game) ComputeVisibility(playerID int32) *Visibility {
+	v := NewVisibility(playerID)
+
+	for coord, unit := range g.World.UnitsByCoord() {
+		if unit.Player != playerID {
+			continue
+		}
+		v.reveal(coord, g.getUnitSightRange(unit))
+	}
+
+	for coord, tile := range g.World.TilesByCoord() {
+		if tile.Player == playerID {
+			v.reveal(coord, BuildingSightRange)
+		}
+	}
+	return v
+}
+
+// revealsEnemies returns whether a player's later visibility uncovers enemy units or buildings
+// that were hidden from their earlier visibility
+func (g *Game) revealsEnemies(before, after *Visibility) bool {
+	uncovered := func(owner int32, coord AxialCoord) bool {
+		return owner != 0 && !g.PlayerTeams.AreAllies(owner, before.Player) && !before.IsVisible(coord) && after.IsVisible(coord)
+	}
+	for coord, unit := range g.World.UnitsByCoord() {
+		if uncovered(unit.Player, coord) {
+			return true
+		}
+	}
+	for coord, tile := range g.World.TilesByCoord() {
+		if uncovered(tile.Player, coord) {
+			return true
+		}
+	}
+	return false
+}
+
+// getUnitSightRange returns how far a unit can see
+func (g *Game) getUnitSightRange(unit *v1.Unit) int {
+	if g.rulesEngine != nil {
+		if unitData, err := g.rulesEngine.GetUnitData(unit.UnitType); err == nil && unitData.SightRange > 0 {
+			return int(unitData.SightRange)
+		}
+	}
+	return DefaultSightRange
+}
+
+// FoggedView returns a view of the game where units hidden from the given visibility are removed.
+// The view shares everything but the World with the original game and should only be used for queries.
+func (g *Game) FoggedView(v *Visibility) *Game {
+	world := g.World.Clone()
+	for _, unit := range g.World.UnitsByCoord() {
+		if !v.CanSeeUnit(unit) {
+			world.RemoveUnit(world.UnitAt(UnitGetCoord(unit)))
+		}
+	}
+
+	view := *g
+	view.World = world
+	return &view
+}
+
+// =============================================================================
+// Filtering game data for a player
+// =============================================================================
+
+// FilterWorldData returns a copy of the world data with hidden enemy units removed and
+// capture progress on hidden tiles cleared.  The map itself is always visible.
+func FilterWorldData(worldData *v1.WorldData, v *Visibility) *v1.WorldData {
+	if worldData == nil {
+		return nil
+	}
+
+	out := &v1.WorldData{
+		Tiles: []*v1.Tile{},
+		Units: []*v1.Unit{},
+	}
+	for _, tile := range worldData.Tiles {
+		filtered := CopyTile(tile)
+		if tile.Player != v.Player && !v.IsVisible(TileGetCoord(tile)) {
+			filtered.CapturePlayer = 0
+			filtered.CaptureProgress = 0
+			filtered.TerrainAction = ""
+			filtered.TerrainActionProgress = 0
+		}
+		out.Tiles = append(out.Tiles, filtered)
+	}
+	for _, unit := range worldData.Units {
+		if v.CanSeeUnit(unit) {
+			out.Units = append(out.Units, unit)
+		}
+	}
+	return out
+}
+
+// FilterWorldChanges returns the changes that are visible to a player
+func FilterWorldChanges(changes []*v1.WorldChange, v *Visibility) []*v1.WorldChange {
+	out := []*v1.WorldChange{}
+	for _, change := range changes {
+		if filtered := filterWorldChange(change, v); filtered != nil {
+			out = append(out, filtered)
+		}
+	}
+	return out
+}
+
+// filterWorldChange returns the part of a change visible to a player or nil if none of it is.
+// The change itself is returned only if all of it is visible.
+func filterWorldChange(change *v1.WorldChange, v *Visibility) *v1.WorldChange {
+	switch c := change.ChangeType.(type) {
+	case *v1.WorldChange_UnitMoved:
+		// A unit moving in or out of sight is reported without the end that cannot be seen
+		seenBefore, seenAfter := v.CanSeeUnit(c.UnitMoved.PreviousUnit), v.CanSeeUnit(c.UnitMoved.UpdatedUnit)
+		if seenBefore && seenAfter {
+			return change
+		}
+		if seenBefore || seenAfter {
+			moved := &v1.UnitMovedChange{}
+			if seenBefore {
+				moved.PreviousUnit = c.UnitMoved.PreviousUnit
+			} else {
+				moved.UpdatedUnit = c.UnitMoved.UpdatedUnit
+			}
+			return &v1.WorldChange{ChangeType: &v1.WorldChange_UnitMoved{UnitMoved: moved}}
+		}
+	case *v1.WorldChange_UnitDamaged:
+		// Likewise an attacker firing from out of sight is reported without the attacker
+		damaged := c.UnitDamaged
+		seenUnit, seenAttacker := v.CanSeeUnit(damaged.UpdatedUnit), v.CanSeeUnit(damaged.UpdatedAttacker)
+		if seenUnit && (seenAttacker || damaged.UpdatedAttacker == nil) {
+			return change
+		}
+		if seenUnit {
+			return &v1.WorldChange{ChangeType: &v1.WorldChange_UnitDamaged{UnitDamaged: &v1.UnitDamagedChange{
+				PreviousUnit: damaged.PreviousUnit,
+				UpdatedUnit:  damaged.UpdatedUnit,
+			}}}
+		}
+		if seenAttacker {
+			return &v1.WorldChange{ChangeType: &v1.WorldChange_UnitDamaged{UnitDamaged: &v1.UnitDamagedChange{
+				PreviousAttacker: damaged.PreviousAttacker,
+				UpdatedAttacker:  damaged.UpdatedAttacker,
+			}}}
+		}
+	case *v1.WorldChange_UnitHealed:
+		// A healer at the edge of sight is reported without the side that cannot be seen
+		healed := c.UnitHealed
+		seenUnit, seenHealer := v.CanSeeUnit(healed.UpdatedUnit), v.CanSeeUnit(healed.UpdatedHealer)
+		if seenUnit && (seenHealer || healed.UpdatedHealer == nil) {
+			return change
+		}
+		if seenUnit {
+			return &v1.WorldChange{ChangeType: &v1.WorldChange_UnitHealed{UnitHealed: &v1.UnitHealedChange{
+				PreviousUnit: healed.PreviousUnit,
+				UpdatedUnit:  healed.UpdatedUnit,
+			}}}
+		}
+		if seenHealer {
+			return &v1.WorldChange{ChangeType: &v1.WorldChange_UnitHealed{UnitHealed: &v1.UnitHealedChange{
+				PreviousHealer: healed.PreviousHealer,
+				UpdatedHealer:  healed.UpdatedHealer,
+			}}}
+		}
+	case *v1.WorldChange_UnitKilled:
+		if v.CanSeeUnit(c.UnitKilled.PreviousUnit) {
+			return change
+		}
+	case *v1.WorldChange_UnitCreated:
+		if v.CanSeeUnit(c.UnitCreated.Unit) {
+			return change
+		}
+	case *v1.WorldChange_UnitLoaded:
+		// A unit boarding a hidden transport just disappears and a visible transport picking up
+		// a hidden unit just gains cargo
+		loaded := c.UnitLoaded
+		seenUnit, seenTransport := v.CanSeeUnit(loaded.Unit), v.CanSeeUnit(loaded.UpdatedTransport)
+		if seenUnit && seenTransport {
+			return change
+		}
+		if seenUnit {
+			return &v1.WorldChange{ChangeType: &v1.WorldChange_UnitLoaded{UnitLoaded: &v1.UnitLoadedChange{Unit: loaded.Unit}}}
+		}
+		if seenTransport {
+			return &v1.WorldChange{ChangeType: &v1.WorldChange_UnitLoaded{UnitLoaded: &v1.UnitLoadedChange{
+				PreviousTransport: loaded.PreviousTransport,
+				UpdatedTransport:  loaded.UpdatedTransport,
+			}}}
+		}
+	case *v1.WorldChange_UnitUnloaded:
+		// Likewise a unit unloaded from a hidden transport just appears
+		unloaded := c.UnitUnloaded
+		seenUnit, seenTransport := v.CanSeeUnit(unloaded.Unit), v.CanSeeUnit(unloaded.UpdatedTransport)
+		if seenUnit && seenTransport {
+			return change
+		}
+		if seenUnit {
+			return &v1.WorldChange{ChangeType: &v1.WorldChange_UnitUnloaded{UnitUnloaded: &v1.UnitUnloadedChange{Unit: unloaded.Unit}}}
+		}
+		if seenTransport {
+			return &v1.WorldChange{ChangeType: &v1.WorldChange_UnitUnloaded{UnitUnloaded: &v1.UnitUnloadedChange{
+				PreviousTransport: unloaded.PreviousTransport,
+				UpdatedTransport:  unloaded.UpdatedTransport,
+			}}}
+		}
+	case *v1.WorldChange_TileCaptured:
+		tile := c.TileCaptured.UpdatedTile
+		if tile.Player == v.Player || c.TileCaptured.PreviousTile.Player == v.Player || v.IsVisible(TileGetCoord(tile)) {
+			return change
+		}
+	case *v1.WorldChange_TileChanged:
+		if v.IsVisible(TileGetCoord(c.TileChanged.UpdatedTile)) {
+			return change
+		}
+		// The map is always visible so finished terrain changes are reported without the hidden work
+		if c.TileChanged.UpdatedTile.TileType != c.TileChanged.PreviousTile.TileType {
+			previousTile, updatedTile := CopyTile(c.TileChanged.PreviousTile), CopyTile(c.TileChanged.UpdatedTile)
+			previousTile.TerrainAction, previousTile.TerrainActionProgress = "", 0
+			return &v1.WorldChange{
+				ChangeType: &v1.WorldChange_TileChanged{
+					TileChanged: &v1.TileChangedChange{
+						PreviousTile: previousTile,
+						UpdatedTile:  updatedTile,
+					},
+				},
+			}
+		}
+	case *v1.WorldChange_PlayerChanged:
+		// Turn changes are public but only visible units are reported as reset
+		resetUnits := []*v1.Unit{}
+		for _, unit := range c.PlayerChanged.ResetUnits {
+			if v.CanSeeUnit(unit) {
+				resetUnits = append(resetUnits, unit)
+			}
+		}
+		if len(resetUnits) == len(c.PlayerChanged.ResetUnits) {
+			return change
+		}
+		return &v1.WorldChange{
+			ChangeType: &v1.WorldChange_PlayerChanged{
+				PlayerChanged: &v1.PlayerChangedChange{
+					PreviousPlayer: c.PlayerChanged.PreviousPlayer,
+					NewPlayer:      c.PlayerChanged.NewPlayer,
+					PreviousTurn:   c.PlayerChanged.PreviousTurn,
+					NewTurn:        c.PlayerChanged.NewTurn,
+					ResetUnits:     resetUnits,
+				},
+			},
+		}
+	default:
+		// Everything else (eg coin balances) is public
+		return change
+	}
+	return nil
+}
+
+// FilterMoveGroup returns a copy of a move group as seen by a player.  A player sees all of
+// their own moves.  Moves by other players are reported with only their visible changes and
+// the move details are redacted unless every change the move caused was visible.
+func FilterMoveGroup(group *v1.GameMoveGroup, v *Visibility) *v1.GameMoveGroup {
+	out := &v1.GameMoveGroup{
+		StartedAt: group.StartedAt,
+		EndedAt:   group.EndedAt,
+	}
+	for i, move := range group.Moves {
+		var result *v1.GameMoveResult
+		if i < len(group.MoveResults) {
+			result = group.MoveResults[i]
+		}
+		if move.Player == v.Player {
+			out.Moves = append(out.Moves, move)
+			out.MoveResults = append(out.MoveResults, result)
+			continue
+		}
+
+		if result == nil {
+			continue
+		}
+		changes := FilterWorldChanges(result.Changes, v)
+		if len(changes) == 0 {
+			continue
+		}
+
+		filteredMove := move
+		if !slices.Equal(changes, result.Changes) {
+			filteredMove = &v1.GameMove{
+				Player:      move.Player,
+				Timestamp:   move.Timestamp,
+				SequenceNum: move.SequenceNum,
+			}
+		}
+		out.Moves = append(out.Moves, filteredMove)
+		out.MoveResults = append(out.MoveResults, &v1.GameMoveResult{
+			IsPermanent: result.IsPermanent,
+			SequenceNum: result.SequenceNum,
+			Changes:     changes,
+		})
+	}
+	return out
+}
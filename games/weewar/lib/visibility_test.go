@@ -0,0 +1,179 @@
+package weewar
+
+import (
+	"testing"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+// newFoggedTestGame creates a wide strip of grass with units at either end so
+// neither player can see the other
+func newFoggedTestGame(t *testing.T) *Game {
+	world := NewWorld("test")
+	for q := -8; q <= 8; q++ {
+		for r := -1; r <= 1; r++ {
+			world.AddTile(NewTile(AxialCoord{Q: q, R: r}, 5))
+		}
+	}
+	base := world.TileAt(AxialCoord{Q: 8, R: 0})
+	base.TileType = 1
+	base.Player = 2
+
+	world.AddUnit(NewUnit(1, 1, AxialCoord{Q: -6, R: 0}))
+	world.AddUnit(NewUnit(1, 2, AxialCoord{Q: 6, R: 0}))
+
+	game, err := NewGame(world, DefaultRulesEngine(), 42)
+	if err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+	return game
+}
+
+func TestComputeVisibility(t *testing.T) {
+	game := newFoggedTestGame(t)
+	v := game.ComputeVisibility(1)
+
+	if !v.IsVisible(AxialCoord{Q: -6, R: 0}) || !v.IsVisible(AxialCoord{Q: -4, R: 0}) {
+		t.Error("Expected tiles around player 1's soldier to be visible")
+	}
+	if v.IsVisible(AxialCoord{Q: 6, R: 0}) {
+		t.Error("Expected player 2's soldier to be hidden from player 1")
+	}
+	if !v.CanSeeUnit(game.World.UnitAt(AxialCoord{Q: -6, R: 0})) {
+		t.Error("Expected player 1 to see their own unit")
+	}
+
+	// Owned buildings reveal their neighbours
+	v2 := game.ComputeVisibility(2)
+	if !v2.IsVisible(AxialCoord{Q: 7, R: 1}) {
+		t.Error("Expected tiles next to player 2's base to be visible")
+	}
+}
+
+func TestFilterWorldDataAndChanges(t *testing.T) {
+	game := newFoggedTestGame(t)
+	v := game.ComputeVisibility(1)
+
+	worldData := &v1.WorldData{}
+	for _, tile := range game.World.TilesByCoord() {
+		worldData.Tiles = append(worldData.Tiles, tile)
+	}
+	for _, unit := range game.World.UnitsByCoord() {
+		worldData.Units = append(worldData.Units, unit)
+	}
+
+	filtered := FilterWorldData(worldData, v)
+	if len(filtered.Tiles) != len(worldData.Tiles) {
+		t.Errorf("Expected all %d tiles, got %d", len(worldData.Tiles), len(filtered.Tiles))
+	}
+	if len(filtered.Units) != 1 || filtered.Units[0].Player != 1 {
+		t.Fatalf("Expected only player 1's unit, got %v", filtered.Units)
+	}
+
+	// A hidden enemy moving around stays hidden, one moving into view does not
+	enemy := game.World.UnitAt(AxialCoord{Q: 6, R: 0})
+	hiddenMove := CopyUnit(enemy)
+	hiddenMove.Q = 5
+	visibleMove := CopyUnit(enemy)
+	visibleMove.Q = -4
+	changes := []*v1.WorldChange{
+		{ChangeType: &v1.WorldChange_UnitMoved{UnitMoved: &v1.UnitMovedChange{PreviousUnit: enemy, UpdatedUnit: hiddenMove}}},
+		{ChangeType: &v1.WorldChange_UnitMoved{UnitMoved: &v1.UnitMovedChange{PreviousUnit: enemy, UpdatedUnit: visibleMove}}},
+		{ChangeType: &v1.WorldChange_PlayerChanged{PlayerChanged: &v1.PlayerChangedChange{PreviousPlayer: 2, NewPlayer: 1, ResetUnits: []*v1.Unit{enemy}}}},
+	}
+	visible := FilterWorldChanges(changes, v)
+	if len(visible) != 2 {
+		t.Fatalf("Expected 2 visible changes, got %d", len(visible))
+	}
+	if moved := visible[0].GetUnitMoved(); moved.UpdatedUnit.GetQ() != -4 || moved.PreviousUnit != nil {
+		t.Errorf("Expected the move into view without where it came from, got %v", visible[0])
+	}
+	if reset := visible[1].GetPlayerChanged().ResetUnits; len(reset) != 0 {
+		t.Errorf("Expected hidden reset units to be removed, got %v", reset)
+	}
+}
+
+func TestFilterWorldChangesRedactsHiddenEnds(t *testing.T) {
+	game := newFoggedTestGame(t)
+	v := game.ComputeVisibility(1)
+	own := game.World.UnitAt(AxialCoord{Q: -6, R: 0})
+	enemy := game.World.UnitAt(AxialCoord{Q: 6, R: 0})
+
+	// The enemy moves out of view from next to player 1's soldier
+	seen := CopyUnit(enemy)
+	seen.Q = -5
+	moveOut := &v1.WorldChange{ChangeType: &v1.WorldChange_UnitMoved{UnitMoved: &v1.UnitMovedChange{PreviousUnit: seen, UpdatedUnit: enemy}}}
+	if moved := filterWorldChange(moveOut, v).GetUnitMoved(); moved.PreviousUnit != seen || moved.UpdatedUnit != nil {
+		t.Errorf("Expected only the visible start of the move, got %v", moved)
+	}
+
+	// Player 1's soldier boards a hidden transport and is unloaded by it somewhere hidden
+	transport := CopyUnit(enemy)
+	loadedTransport := CopyUnit(enemy)
+	loadedTransport.Cargo = []*v1.Unit{CopyUnit(own)}
+	loaded := filterWorldChange(&v1.WorldChange{ChangeType: &v1.WorldChange_UnitLoaded{UnitLoaded: &v1.UnitLoadedChange{
+		Unit: own, PreviousTransport: transport, UpdatedTransport: loadedTransport,
+	}}}, v).GetUnitLoaded()
+	if loaded.Unit != own || loaded.PreviousTransport != nil || loaded.UpdatedTransport != nil {
+		t.Errorf("Expected the hidden transport to be redacted from the load, got %v", loaded)
+	}
+	unloaded := filterWorldChange(&v1.WorldChange{ChangeType: &v1.WorldChange_UnitUnloaded{UnitUnloaded: &v1.UnitUnloadedChange{
+		Unit: own, PreviousTransport: loadedTransport, UpdatedTransport: transport,
+	}}}, v).GetUnitUnloaded()
+	if unloaded.Unit != own || unloaded.PreviousTransport != nil || unloaded.UpdatedTransport != nil {
+		t.Errorf("Expected the hidden transport to be redacted from the unload, got %v", unloaded)
+	}
+
+	// Player 1's soldier as a transport picking up and dropping off a hidden enemy
+	ownLoaded := CopyUnit(own)
+	ownLoaded.Cargo = []*v1.Unit{CopyUnit(enemy)}
+	loaded = filterWorldChange(&v1.WorldChange{ChangeType: &v1.WorldChange_UnitLoaded{UnitLoaded: &v1.UnitLoadedChange{
+		Unit: enemy, PreviousTransport: own, UpdatedTransport: ownLoaded,
+	}}}, v).GetUnitLoaded()
+	if loaded.Unit != nil || loaded.PreviousTransport != own || loaded.UpdatedTransport != ownLoaded {
+		t.Errorf("Expected the hidden unit to be redacted from the load, got %v", loaded)
+	}
+	unloaded = filterWorldChange(&v1.WorldChange{ChangeType: &v1.WorldChange_UnitUnloaded{UnitUnloaded: &v1.UnitUnloadedChange{
+		Unit: enemy, PreviousTransport: ownLoaded, UpdatedTransport: own,
+	}}}, v).GetUnitUnloaded()
+	if unloaded.Unit != nil || unloaded.PreviousTransport != ownLoaded || unloaded.UpdatedTransport != own {
+		t.Errorf("Expected the hidden unit to be redacted from the unload, got %v", unloaded)
+	}
+
+	// Player 1's soldier healed by a hidden unit and healing a hidden unit
+	healed := filterWorldChange(&v1.WorldChange{ChangeType: &v1.WorldChange_UnitHealed{UnitHealed: &v1.UnitHealedChange{
+		PreviousUnit: own, UpdatedUnit: own, PreviousHealer: enemy, UpdatedHealer: enemy,
+	}}}, v).GetUnitHealed()
+	if healed.UpdatedUnit != own || healed.PreviousHealer != nil || healed.UpdatedHealer != nil {
+		t.Errorf("Expected the hidden healer to be redacted from the heal, got %v", healed)
+	}
+	healed = filterWorldChange(&v1.WorldChange{ChangeType: &v1.WorldChange_UnitHealed{UnitHealed: &v1.UnitHealedChange{
+		PreviousUnit: enemy, UpdatedUnit: enemy, PreviousHealer: own, UpdatedHealer: own,
+	}}}, v).GetUnitHealed()
+	if healed.UpdatedUnit != nil || healed.PreviousHealer != own || healed.UpdatedHealer != own {
+		t.Errorf("Expected the hidden unit to be redacted from the heal, got %v", healed)
+	}
+
+	// Player 1's soldier shelled by a hidden attacker
+	damaged := filterWorldChange(&v1.WorldChange{ChangeType: &v1.WorldChange_UnitDamaged{UnitDamaged: &v1.UnitDamagedChange{
+		PreviousUnit: own, UpdatedUnit: own, PreviousAttacker: enemy, UpdatedAttacker: enemy,
+	}}}, v).GetUnitDamaged()
+	if damaged.UpdatedUnit != own || damaged.PreviousAttacker != nil || damaged.UpdatedAttacker != nil {
+		t.Errorf("Expected the hidden attacker to be redacted from the damage, got %v", damaged)
+	}
+}
+
+func TestFoggedViewHidesUnits(t *testing.T) {
+	game := newFoggedTestGame(t)
+	view := game.FoggedView(game.ComputeVisibility(1))
+
+	if view.World.UnitAt(AxialCoord{Q: 6, R: 0}) != nil {
+		t.Error("Expected hidden enemy unit to be removed from the fogged view")
+	}
+	if game.World.UnitAt(AxialCoord{Q: 6, R: 0}) == nil {
+		t.Error("Expected the original game to be unchanged")
+	}
+	if view.World.UnitAt(AxialCoord{Q: -6, R: 0}) == nil {
+		t.Error("Expected own unit in the fogged view")
+	}
+}
@@ -79,56 +79,157 @@ func NewWorld(name string) *World {
 	return w
 }
 
+// Push creates an overlay on top of this world.  Changes made to the overlay are not seen
+// by this world until the overlay is committed.  Tiles and units read through the overlay
+// are copied into it so they can be modified in place without affecting this world.
 func (w *World) Push() *World {
 	out := NewWorld(w.Name)
 	out.parent = w
 	return out
 }
 
+// Parent returns the world this overlay was pushed on or nil if this is not an overlay
+func (w *World) Parent() *World {
+	return w.parent
+}
+
+// Commit flattens all the changes in this overlay into its parent.  The overlay should not
+// be used after it is committed.
+func (w *World) Commit() error {
+	p := w.parent
+	if p == nil {
+		return fmt.Errorf("world has no parent to commit to")
+	}
+
+	// Tiles are updated in place so references held to the parent's tiles stay valid
+	for coord, deleted := range w.tileDeleted {
+		if deleted {
+			p.DeleteTile(coord)
+		}
+	}
+	for coord, tile := range w.tilesByCoord {
+		if existing := p.TileAt(coord); existing != nil {
+			existing.TileType = tile.TileType
+			existing.Player = tile.Player
+			existing.CapturePlayer = tile.CapturePlayer
+			existing.CaptureProgress = tile.CaptureProgress
+			existing.TerrainAction = tile.TerrainAction
+			existing.TerrainActionProgress = tile.TerrainActionProgress
+		} else {
+			p.AddTile(tile)
+		}
+	}
+
+	// Remove units that were deleted or moved away before placing units at their final positions
+	for coord, deleted := range w.unitDeleted {
+		if deleted {
+			if existing := p.UnitAt(coord); existing != nil {
+				p.RemoveUnit(existing)
+			}
+		}
+	}
+	for coord, unit := range w.unitsByCoord {
+		existing := p.UnitAt(coord)
+		if existing != nil && existing.Player == unit.Player {
+			existing.UnitType = unit.UnitType
+			existing.AvailableHealth = unit.AvailableHealth
+			existing.DistanceLeft = unit.DistanceLeft
+			existing.TurnCounter = unit.TurnCounter
+			existing.HasMoved = unit.HasMoved
+			existing.HasAttacked = unit.HasAttacked
+			existing.ActionsRemaining = unit.ActionsRemaining
+			existing.Cargo = unit.Cargo
+			continue
+		}
+		if existing != nil {
+			p.RemoveUnit(existing)
+		}
+		if _, err := p.AddUnit(unit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // =============================================================================
 // World State Access Methods
 // =============================================================================
 
 func (w *World) PlayerCount() int32 {
+	count := int32(len(w.unitsByPlayer) - 1)
 	if w.parent != nil {
-		return w.PlayerCount()
+		count = max(count, w.parent.PlayerCount())
 	}
-	return int32(len(w.unitsByPlayer) - 1)
+	return count
 }
 
+// TilesByCoord iterates over all tiles in this world and any parents it was pushed on.
+// Tiles from a parent are yielded only if they are not overridden or deleted in this layer.
 func (w *World) TilesByCoord() iter.Seq2[AxialCoord, *v1.Tile] {
-	// TODO - handle the case of doing a "merged" iteration with parents if anything is missing here
-	// or conversely iterate parent and only return parent's K,V value if it is not in this layer
 	return func(yield func(AxialCoord, *v1.Tile) bool) {
 		for k, v := range w.tilesByCoord {
 			if !yield(k, v) {
 				return
 			}
 		}
+		if w.parent == nil {
+			return
+		}
+		for k := range w.parent.TilesByCoord() {
+			if _, ok := w.tilesByCoord[k]; ok || w.tileDeleted[k] {
+				continue
+			}
+			if !yield(k, w.TileAt(k)) {
+				return
+			}
+		}
 	}
 }
 
 func (w *World) NumUnits() int32 {
-	return int32(len(w.unitsByCoord))
+	if w.parent == nil {
+		return int32(len(w.unitsByCoord))
+	}
+	count := int32(0)
+	for range w.UnitsByCoord() {
+		count++
+	}
+	return count
 }
 
+// UnitsByCoord iterates over all units in this world and any parents it was pushed on.
+// Units from a parent are yielded only if they are not overridden or deleted in this layer.
 func (w *World) UnitsByCoord() iter.Seq2[AxialCoord, *v1.Unit] {
-	// TODO - handle the case of doing a "merged" iteration with parents if anything is missing here
-	// or conversely iterate parent and only return parent's K,V value if it is not in this layer
 	return func(yield func(AxialCoord, *v1.Unit) bool) {
 		for k, v := range w.unitsByCoord {
 			if !yield(k, v) {
 				return
 			}
 		}
+		if w.parent == nil {
+			return
+		}
+		for k := range w.parent.UnitsByCoord() {
+			if _, ok := w.unitsByCoord[k]; ok || w.unitDeleted[k] {
+				continue
+			}
+			if !yield(k, w.UnitAt(k)) {
+				return
+			}
+		}
 	}
 }
 
-// Getize returns the dimensions of the world map
+// UnitAt returns the unit at the specified cube coordinates
 func (w *World) UnitAt(coord AxialCoord) (out *v1.Unit) {
 	out = w.unitsByCoord[coord]
-	if out == nil && w.parent != nil {
-		out = w.parent.UnitAt(coord)
+	if out == nil && w.parent != nil && !w.unitDeleted[coord] {
+		// Copy into this layer so changes to the unit are not seen by the parent
+		if parentUnit := w.parent.UnitAt(coord); parentUnit != nil {
+			out = CopyUnit(parentUnit)
+			w.unitsByCoord[coord] = out
+			w.addPlayerUnit(out)
+		}
 	}
 	return
 }
@@ -136,17 +237,31 @@ func (w *World) UnitAt(coord AxialCoord) (out *v1.Unit) {
 // TileAt returns the tile at the specified cube coordinates
 func (w *World) TileAt(coord AxialCoord) (out *v1.Tile) {
 	out = w.tilesByCoord[coord]
-	if out == nil && w.parent != nil {
-		out = w.parent.TileAt(coord)
+	if out == nil && w.parent != nil && !w.tileDeleted[coord] {
+		// Copy into this layer so changes to the tile are not seen by the parent
+		if parentTile := w.parent.TileAt(coord); parentTile != nil {
+			out = CopyTile(parentTile)
+			w.tilesByCoord[coord] = out
+		}
 	}
 	return
 }
 
 // GetPlayerUnits returns all units belonging to the specified player
 func (w *World) GetPlayerUnits(playerID int) []*v1.Unit {
-	// TODO - handle the case of doing a "merged" iteration with parents if anything is missing here
-	// or conversely iterate parent and only return parent's K,V value if it is not in this layer
-	return w.unitsByPlayer[playerID]
+	if w.parent == nil {
+		if playerID < 0 || playerID >= len(w.unitsByPlayer) {
+			return nil
+		}
+		return w.unitsByPlayer[playerID]
+	}
+	var units []*v1.Unit
+	for _, unit := range w.UnitsByCoord() {
+		if int(unit.Player) == playerID {
+			units = append(units, unit)
+		}
+	}
+	return units
 }
 
 // =============================================================================
@@ -192,12 +307,8 @@ func (w *World) AddUnit(unit *v1.Unit) (oldunit *v1.Unit, err error) {
 		return nil, fmt.Errorf("unit is nil")
 	}
 
-	playerID := int(unit.Player)
-	if playerID < 0 {
-		return nil, fmt.Errorf("invalid player ID: %d", playerID)
-	}
-	for playerID >= len(w.unitsByPlayer) {
-		w.unitsByPlayer = append(w.unitsByPlayer, nil)
+	if unit.Player < 0 {
+		return nil, fmt.Errorf("invalid player ID: %d", unit.Player)
 	}
 
 	coord := UnitGetCoord(unit)
@@ -205,13 +316,22 @@ func (w *World) AddUnit(unit *v1.Unit) (oldunit *v1.Unit, err error) {
 
 	// make sure to replace a unit here
 	w.unitDeleted[coord] = false
-	w.unitsByPlayer[playerID] = append(w.unitsByPlayer[playerID], unit)
+	w.addPlayerUnit(unit)
 	w.unitsByCoord[coord] = unit
 
 	// Now give this unit a unique ID
 	return
 }
 
+// addPlayerUnit tracks a unit in its player's unit list
+func (w *World) addPlayerUnit(unit *v1.Unit) {
+	playerID := int(unit.Player)
+	for playerID >= len(w.unitsByPlayer) {
+		w.unitsByPlayer = append(w.unitsByPlayer, nil)
+	}
+	w.unitsByPlayer[playerID] = append(w.unitsByPlayer[playerID], unit)
+}
+
 // RemoveUnit removes a unit from the world
 func (w *World) RemoveUnit(unit *v1.Unit) error {
 	if unit == nil {
@@ -226,6 +346,9 @@ func (w *World) RemoveUnit(unit *v1.Unit) error {
 	p := int(unit.Player)
 	w.unitDeleted[coord] = true
 	delete(w.unitsByCoord, coord)
+	if p >= len(w.unitsByPlayer) {
+		return nil
+	}
 	for i, u := range w.unitsByPlayer[p] {
 		if u == unit {
 			// Remove unit from slice
@@ -244,12 +367,14 @@ func (w *World) MoveUnit(unit *v1.Unit, newCoord AxialCoord) error {
 
 	// Remove from old position
 	oldCoord := UnitGetCoord(unit)
+	w.unitDeleted[oldCoord] = true
 	delete(w.unitsByCoord, oldCoord)
 
 	// Update unit position
 	UnitSetCoord(unit, newCoord)
 
 	// Add to new position
+	w.unitDeleted[newCoord] = false
 	w.unitsByCoord[newCoord] = unit
 
 	return nil
@@ -270,26 +395,24 @@ func (w *World) Clone() *World {
 	for _, tile := range w.tilesByCoord {
 		if tile != nil {
 			// Create a copy of the proto tile
-			clonedTile := &v1.Tile{
-				Q:        tile.Q,
-				R:        tile.R,
-				TileType: tile.TileType,
-				Player:   tile.Player,
-			}
-			out.AddTile(clonedTile)
+			out.AddTile(CopyTile(tile))
 		}
 	}
 	for _, unit := range w.unitsByCoord {
 		if unit != nil {
 			// Create a copy of the proto unit
 			clonedUnit := &v1.Unit{
-				Q:               unit.Q,
-				R:               unit.R,
-				Player:          unit.Player,
-				UnitType:        unit.UnitType,
-				AvailableHealth: unit.AvailableHealth,
-				DistanceLeft:    unit.DistanceLeft,
-				TurnCounter:     unit.TurnCounter,
+				Q:                unit.Q,
+				R:                unit.R,
+				Player:           unit.Player,
+				UnitType:         unit.UnitType,
+				AvailableHealth:  unit.AvailableHealth,
+				DistanceLeft:     unit.DistanceLeft,
+				TurnCounter:      unit.TurnCounter,
+				HasMoved:         unit.HasMoved,
+				HasAttacked:      unit.HasAttacked,
+				ActionsRemaining: unit.ActionsRemaining,
+				Cargo:            CopyUnits(unit.Cargo),
 			}
 			out.AddUnit(clonedUnit)
 		}
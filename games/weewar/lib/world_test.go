@@ -0,0 +1,86 @@
+package weewar
+
+import (
+	"testing"
+)
+
+func newOverlayTestWorld() *World {
+	world := NewWorld("test")
+	for q := 0; q < 3; q++ {
+		world.AddTile(NewTile(AxialCoord{Q: q, R: 0}, 5))
+	}
+	world.AddUnit(NewUnit(1, 1, AxialCoord{Q: 0, R: 0}))
+	world.AddUnit(NewUnit(1, 2, AxialCoord{Q: 2, R: 0}))
+	return world
+}
+
+func TestPushedWorldIsolatesChanges(t *testing.T) {
+	world := newOverlayTestWorld()
+	overlay := world.Push()
+
+	unit := overlay.UnitAt(AxialCoord{Q: 0, R: 0})
+	unit.AvailableHealth = 50
+	overlay.MoveUnit(unit, AxialCoord{Q: 1, R: 0})
+	overlay.RemoveUnit(overlay.UnitAt(AxialCoord{Q: 2, R: 0}))
+	overlay.TileAt(AxialCoord{Q: 1, R: 0}).Player = 1
+
+	// Parent is untouched
+	if parentUnit := world.UnitAt(AxialCoord{Q: 0, R: 0}); parentUnit == nil || parentUnit.AvailableHealth != 100 {
+		t.Errorf("Expected parent unit to be unchanged, got %v", parentUnit)
+	}
+	if world.UnitAt(AxialCoord{Q: 2, R: 0}) == nil {
+		t.Error("Expected removed unit to still be in parent")
+	}
+	if world.TileAt(AxialCoord{Q: 1, R: 0}).Player != 0 {
+		t.Error("Expected parent tile to be unchanged")
+	}
+
+	// Overlay sees the merged view
+	if overlay.UnitAt(AxialCoord{Q: 0, R: 0}) != nil {
+		t.Error("Expected moved unit to be gone from its old position in the overlay")
+	}
+	if overlay.NumUnits() != 1 {
+		t.Errorf("Expected 1 unit in overlay, got %d", overlay.NumUnits())
+	}
+	numTiles := 0
+	for range overlay.TilesByCoord() {
+		numTiles++
+	}
+	if numTiles != 3 {
+		t.Errorf("Expected 3 tiles in overlay, got %d", numTiles)
+	}
+	if overlay.PlayerCount() != 2 {
+		t.Errorf("Expected 2 players in overlay, got %d", overlay.PlayerCount())
+	}
+}
+
+func TestPushedWorldCommit(t *testing.T) {
+	world := newOverlayTestWorld()
+	tile := world.TileAt(AxialCoord{Q: 1, R: 0})
+	overlay := world.Push()
+
+	unit := overlay.UnitAt(AxialCoord{Q: 0, R: 0})
+	overlay.MoveUnit(unit, AxialCoord{Q: 1, R: 0})
+	overlay.RemoveUnit(overlay.UnitAt(AxialCoord{Q: 2, R: 0}))
+	overlay.TileAt(AxialCoord{Q: 1, R: 0}).Player = 1
+
+	if err := overlay.Commit(); err != nil {
+		t.Fatalf("Failed to commit: %v", err)
+	}
+	if world.UnitAt(AxialCoord{Q: 0, R: 0}) != nil || world.UnitAt(AxialCoord{Q: 2, R: 0}) != nil {
+		t.Error("Expected moved and removed units to be gone from the parent")
+	}
+	if moved := world.UnitAt(AxialCoord{Q: 1, R: 0}); moved == nil || moved.Player != 1 {
+		t.Errorf("Expected moved unit in the parent, got %v", moved)
+	}
+	if len(world.GetPlayerUnits(2)) != 0 {
+		t.Error("Expected player 2 to have no units")
+	}
+	if tile.Player != 1 {
+		t.Error("Expected tile to be updated in place")
+	}
+
+	if err := world.Commit(); err == nil {
+		t.Error("Expected committing a world without a parent to fail")
+	}
+}
@@ -2,17 +2,25 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"slices"
 	"time"
 
 	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
 	weewar "github.com/panyam/turnengine/games/weewar/lib"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type GamesServiceImpl interface {
 	v1.GamesServiceServer
 	GetRuntimeGame(game *v1.Game, gameState *v1.GameState) (*weewar.Game, error)
+
+	// LoadGame loads a game with its complete state and history.  Unlike GetGame nothing
+	// is hidden so it is only for use within the service.
+	LoadGame(ctx context.Context, gameId string) (*v1.GetGameResponse, error)
 }
 
 type BaseGamesServiceImpl struct {
@@ -20,6 +28,13 @@ type BaseGamesServiceImpl struct {
 	Self GamesServiceImpl // The actual implementation
 }
 
+// ErrSequenceConflict is returned when moves are submitted against a stale game state
+var ErrSequenceConflict = errors.New("moves submitted against a stale game state")
+
+// NoMovesSequenceNum is the expected last sequence number of moves submitted to a game that
+// must not have any moves yet
+const NoMovesSequenceNum int64 = -1
+
 type WorldsServiceImpl interface {
 	v1.WorldsServiceServer
 }
@@ -36,7 +51,9 @@ func (s *BaseGamesServiceImpl) ProcessMoves(ctx context.Context, req *v1.Process
 		return nil, fmt.Errorf("at least one move is required")
 	}
 
-	gameresp, err := s.Self.GetGame(ctx, &v1.GetGameRequest{Id: req.GameId})
+	defer gameLocks.Lock(req.GameId)()
+
+	gameresp, err := s.Self.LoadGame(ctx, req.GameId)
 	if err != nil || gameresp.Game == nil {
 		return nil, err
 	}
@@ -47,6 +64,33 @@ func (s *BaseGamesServiceImpl) ProcessMoves(ctx context.Context, req *v1.Process
 		panic("Game history cannot cannot be nil")
 	}
 
+	// A turn that ran out of time is ended before any new moves are looked at so moves the
+	// player submitted too late are rejected
+	if _, err := s.expireTurn(ctx, gameresp, time.Now()); err != nil {
+		return nil, err
+	}
+	for _, move := range req.Moves {
+		if move.Player != gameresp.State.CurrentPlayer {
+			return nil, fmt.Errorf("move is by player %d, but it's player %d's turn", move.Player, gameresp.State.CurrentPlayer)
+		}
+	}
+
+	// Reject moves made against a state the client has not seen yet
+	lastSequenceNum := gameresp.State.LastSequenceNum
+	if expected := req.ExpectedLastSequenceNum; expected != 0 {
+		if expected == NoMovesSequenceNum {
+			expected = 0
+		}
+		if expected != lastSequenceNum {
+			return nil, fmt.Errorf("%w: expected last sequence number %d, game is at %d", ErrSequenceConflict, expected, lastSequenceNum)
+		}
+	}
+
+	// Sequence numbers are assigned by the server and not taken from the client
+	for i, move := range req.Moves {
+		move.SequenceNum = lastSequenceNum + int64(i) + 1
+	}
+
 	// Get the runtime game corresponding to this game Id, we can create it on the fly
 	// or we can cache it somewhere, or in the case of wasm just have a singleton
 	rtGame, err := s.Self.GetRuntimeGame(gameresp.Game, gameresp.State)
@@ -63,6 +107,16 @@ func (s *BaseGamesServiceImpl) ProcessMoves(ctx context.Context, req *v1.Process
 	for _, move := range req.Moves {
 		fmt.Print("Found Move: ", move, move.MoveType)
 	}
+
+	// With fog of war players may only see what was visible to them before or after the moves
+	fogOfWar := gameresp.Game.GetConfig().GetSettings().GetFogOfWar()
+	mover := rtGame.CurrentPlayer
+	var visibilities map[int32]*weewar.Visibility
+	if fogOfWar {
+		visibilities = playerVisibilities(rtGame)
+	}
+
+	startTime := time.Now()
 	results, err := dmp.ProcessMoves(rtGame, req.Moves)
 	if err != nil {
 		return nil, err
@@ -70,44 +124,569 @@ func (s *BaseGamesServiceImpl) ProcessMoves(ctx context.Context, req *v1.Process
 	resp = &v1.ProcessMovesResponse{
 		MoveResults: results,
 	}
+	if fogOfWar {
+		mergePlayerVisibilities(visibilities, rtGame)
+		resp.MoveResults = filterMoveResults(results, visibilities[mover])
+	}
 
-	// Create a new move group to track this batch of processed moves
-	startTime := time.Now()
+	moveGroup := s.recordMoveGroup(gameresp, rtGame, req.Moves, results, startTime)
+	update := &GameUpdate{MoveGroup: moveGroup}
+	if fogOfWar {
+		update.PlayerViews = moveGroupViews(moveGroup, visibilities)
+	}
+
+	// And then save it
+	_, err = s.Self.UpdateGame(ctx, &v1.UpdateGameRequest{
+		GameId:     req.GameId,
+		NewGame:    gameresp.Game,
+		NewState:   gameresp.State,
+		NewHistory: gameresp.History,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gameUpdates.Publish(req.GameId, update)
+	return resp, nil
+}
+
+// recordMoveGroup adds processed moves to a game's history as a new group and brings the
+// game state up to date with the runtime game they were processed on.  The turn clock is
+// restarted if the moves ended the turn.
+func (s *BaseGamesServiceImpl) recordMoveGroup(gameresp *v1.GetGameResponse, rtGame *weewar.Game, moves []*v1.GameMove, results []*v1.GameMoveResult, startTime time.Time) *v1.GameMoveGroup {
 	moveGroup := &v1.GameMoveGroup{
 		StartedAt:   timestamppb.New(startTime),
-		EndedAt:     timestamppb.New(startTime), // TODO: Set proper end time after processing
-		Moves:       req.Moves,
+		Moves:       moves,
 		MoveResults: results,
 	}
-
-	// Add the move group to history
 	gameresp.History.Groups = append(gameresp.History.Groups, moveGroup)
 
-	// Now that we have the results, we want to update our gamestate by applying the
-	// results - this would also set the next "checkoint" to after the reuslts.
-	// It is upto the storage to see how the runtime game is also updated.  For example
-	// a storage that persists the gameState may just not do anythign and let it be
-	// reconstructed on the next load
-	s.ApplyChangeResults(results, rtGame, gameresp.Game, gameresp.State, gameresp.History)
+	// The move processor has already committed the results to the runtime game so the
+	// game state is brought up to date from it - this also sets the next "checkpoint"
+	// to after the results.
+	state := gameresp.State
+	previousPlayer, previousTurn := state.CurrentPlayer, state.TurnCounter
+	s.SyncGameState(rtGame, state)
+	state.LastSequenceNum += int64(len(moves))
 
-	// Update the end time after processing is complete
-	moveGroup.EndedAt = timestamppb.New(time.Now())
+	now := time.Now()
+	if state.CurrentPlayer != previousPlayer || state.TurnCounter != previousTurn {
+		startTurnClock(gameresp.Game, state, now)
+	}
+	moveGroup.EndedAt = timestamppb.New(now)
+	return moveGroup
+}
+
+// VerifyGame replays a game's move history from its initial state and reports the first
+// place where the recomputed results or the final state differ from what was saved
+func (s *BaseGamesServiceImpl) VerifyGame(ctx context.Context, req *v1.VerifyGameRequest) (*v1.VerifyGameResponse, error) {
+	gameresp, err := s.Self.LoadGame(ctx, req.GameId)
+	if err != nil || gameresp.Game == nil {
+		return nil, err
+	}
+	if gameresp.State == nil || gameresp.History == nil {
+		return nil, fmt.Errorf("game %s has no state or history", req.GameId)
+	}
+	if gameresp.History.InitialState == nil {
+		return nil, fmt.Errorf("game %s has no initial state recorded and cannot be replayed", req.GameId)
+	}
+
+	// Always replay on a fresh runtime game so a cached one is not disturbed
+	rtGame, err := ProtoToRuntimeGame(gameresp.Game, gameresp.History.InitialState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create game from initial state: %w", err)
+	}
+
+	replay := weewar.ReplayHistory(rtGame, gameresp.History)
+	resp := &v1.VerifyGameResponse{
+		GroupsReplayed: int32(replay.GroupsReplayed),
+		MovesReplayed:  int32(replay.MovesReplayed),
+	}
+	if d := replay.Divergence; d != nil {
+		resp.Divergence = &v1.GameDivergence{
+			GroupIndex:     int32(d.GroupIndex),
+			MoveIndex:      int32(d.MoveIndex),
+			ChangeIndex:    int32(d.ChangeIndex),
+			SequenceNum:    d.SequenceNum,
+			Reason:         d.Reason,
+			ExpectedChange: d.Expected,
+			ActualChange:   d.Actual,
+		}
+		return resp, nil
+	}
+
+	// The replayed game must also end up where the saved state is
+	replayed := &v1.GameState{}
+	s.SyncGameState(rtGame, replayed)
+	saved := gameresp.State
+	reason := weewar.DiffWorldData(saved.WorldData, replayed.WorldData)
+	switch {
+	case reason != "":
+	case saved.CurrentPlayer != replayed.CurrentPlayer || saved.TurnCounter != replayed.TurnCounter:
+		reason = fmt.Sprintf("expected player %d on turn %d, replay is at player %d on turn %d",
+			saved.CurrentPlayer, saved.TurnCounter, replayed.CurrentPlayer, replayed.TurnCounter)
+	case saved.RngPosition != replayed.RngPosition:
+		reason = fmt.Sprintf("expected random stream at position %d, replay is at %d", saved.RngPosition, replayed.RngPosition)
+	case !slices.Equal(saved.Winners, replayed.Winners):
+		reason = fmt.Sprintf("expected winners %v, replay has winners %v", saved.Winners, replayed.Winners)
+	default:
+		for player, coins := range replayed.PlayerCoins {
+			if saved.PlayerCoins[player] != coins {
+				reason = fmt.Sprintf("expected player %d to have %d coins, replay has %d", player, saved.PlayerCoins[player], coins)
+				break
+			}
+		}
+	}
+	if reason != "" {
+		resp.Divergence = &v1.GameDivergence{
+			GroupIndex:  int32(len(gameresp.History.Groups)),
+			MoveIndex:   -1,
+			ChangeIndex: -1,
+			Reason:      "final state differs: " + reason,
+		}
+		return resp, nil
+	}
+
+	resp.Verified = true
+	return resp, nil
+}
+
+// UndoMoves rolls back the latest move groups in a game by applying the inverse of their
+// recorded changes.  Fails without changing anything if any of the groups cannot be undone
+// or the game is over.
+func (s *BaseGamesServiceImpl) UndoMoves(ctx context.Context, req *v1.UndoMovesRequest) (*v1.UndoMovesResponse, error) {
+	count := int(req.Count)
+	if count <= 0 {
+		count = 1
+	}
+
+	defer gameLocks.Lock(req.GameId)()
+
+	gameresp, err := s.Self.LoadGame(ctx, req.GameId)
+	if err != nil || gameresp.Game == nil {
+		return nil, err
+	}
+	if gameresp.State == nil {
+		panic("Game state cannot be nil")
+	}
+	if gameresp.History == nil {
+		panic("Game history cannot cannot be nil")
+	}
+
+	// As with new moves, a turn that ran out of time is ended first so its moves can no
+	// longer be taken back
+	if _, err := s.expireTurn(ctx, gameresp, time.Now()); err != nil {
+		return nil, err
+	}
+
+	groups := gameresp.History.Groups
+	if count > len(groups) {
+		return nil, fmt.Errorf("cannot undo %d move groups, only %d in history", count, len(groups))
+	}
+
+	// Collect the inverse changes for all groups first so nothing is applied unless all can be undone
+	inverses := [][]*v1.WorldChange{}
+	for i := len(groups) - 1; i >= len(groups)-count; i-- {
+		inverse, err := weewar.InvertMoveGroup(groups[i])
+		if err != nil {
+			return nil, fmt.Errorf("cannot undo move group %d: %w", i, err)
+		}
+		inverses = append(inverses, inverse)
+	}
+
+	rtGame, err := s.Self.GetRuntimeGame(gameresp.Game, gameresp.State)
+	if err != nil {
+		return nil, err
+	}
+	if err := rtGame.CanUndo(); err != nil {
+		return nil, fmt.Errorf("cannot undo moves: %w", err)
+	}
+
+	// Groups are undone one at a time so with fog of war each player sees an undone group and
+	// its inverse as they saw the group when it was made
+	fogOfWar := gameresp.Game.GetConfig().GetSettings().GetFogOfWar()
+	mover := rtGame.CurrentPlayer
+	undone := &v1.UndoMovesResponse{}
+	update := &GameUpdate{Undo: undone}
+	if fogOfWar {
+		update.PlayerViews = map[int32]*v1.SubscribeGameResponse{}
+	}
+	for i, inverse := range inverses {
+		group := groups[len(groups)-1-i]
+		var visibilities map[int32]*weewar.Visibility
+		if fogOfWar {
+			visibilities = playerVisibilities(rtGame)
+		}
+		if err := s.ApplyChangeResults([]*v1.GameMoveResult{{Changes: inverse}}, rtGame, gameresp.Game, gameresp.State, gameresp.History); err != nil {
+			return nil, err
+		}
+		undone.UndoneGroups = append(undone.UndoneGroups, group)
+		undone.Changes = append(undone.Changes, inverse...)
+
+		if fogOfWar {
+			mergePlayerVisibilities(visibilities, rtGame)
+			for player, visibility := range visibilities {
+				view := update.PlayerViews[player]
+				if view == nil {
+					view = &v1.SubscribeGameResponse{Undo: &v1.UndoMovesResponse{}}
+					update.PlayerViews[player] = view
+				}
+				view.Undo.UndoneGroups = append(view.Undo.UndoneGroups, weewar.FilterMoveGroup(group, visibility))
+				view.Undo.Changes = append(view.Undo.Changes, weewar.FilterWorldChanges(inverse, visibility)...)
+			}
+		}
+	}
+	gameresp.History.Groups = groups[:len(groups)-count]
+
+	resp := undone
+	if fogOfWar {
+		resp = &v1.UndoMovesResponse{}
+		if view := update.PlayerViews[mover]; view != nil {
+			resp = view.Undo
+		}
+	}
 
-	// And then save it
 	_, err = s.Self.UpdateGame(ctx, &v1.UpdateGameRequest{
 		GameId:     req.GameId,
 		NewGame:    gameresp.Game,
 		NewState:   gameresp.State,
 		NewHistory: gameresp.History,
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	gameUpdates.Publish(req.GameId, update)
+	return resp, nil
+}
+
+// SubscribeGame streams the move groups of a game as they are committed.  Groups in the
+// history with moves after req.FromSequenceNum are sent first so a client can resume from
+// the last move it has seen.  Undos are only streamed live so a client that may have
+// missed one should reload the game state before resubscribing.
+func (s *BaseGamesServiceImpl) SubscribeGame(req *v1.SubscribeGameRequest, stream v1.GamesService_SubscribeGameServer) error {
+	ctx := stream.Context()
+
+	// Subscribe before reading the history so no group committed in between is missed
+	updates, unsubscribe := gameUpdates.Subscribe(req.GameId)
+	defer unsubscribe()
+
+	gameresp, err := s.Self.LoadGame(ctx, req.GameId)
+	if err != nil || gameresp.Game == nil {
+		if err == nil {
+			err = fmt.Errorf("game not found: %s", req.GameId)
+		}
+		return err
+	}
+
+	visibility, err := s.playerVisibility(gameresp.Game, gameresp.State, req.Player)
+	if err != nil {
+		return err
+	}
+	groups, err := s.playerHistory(gameresp.Game, gameresp.History, visibility)
+	if err != nil {
+		return err
+	}
+
+	lastSent := req.FromSequenceNum
+	for i, group := range groups {
+		groupSequenceNum := lastMoveSequenceNum(gameresp.History.Groups[i])
+		if groupSequenceNum <= lastSent {
+			continue
+		}
+		if err := stream.Send(&v1.SubscribeGameResponse{MoveGroup: group}); err != nil {
+			return err
+		}
+		lastSent = groupSequenceNum
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case update, ok := <-updates:
+			if !ok {
+				return fmt.Errorf("subscriber fell too far behind, resubscribe from sequence number %d", lastSent)
+			}
+
+			// Skip groups already sent from the history
+			if update.MoveGroup != nil {
+				groupSequenceNum := lastMoveSequenceNum(update.MoveGroup)
+				if groupSequenceNum <= lastSent {
+					continue
+				}
+				lastSent = groupSequenceNum
+			}
+
+			resp := &v1.SubscribeGameResponse{MoveGroup: update.MoveGroup, Undo: update.Undo}
+			if visibility != nil {
+				if resp = update.PlayerViews[req.Player]; resp == nil {
+					continue
+				}
+			}
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// lastMoveSequenceNum returns the sequence number of the last move in a group
+func lastMoveSequenceNum(group *v1.GameMoveGroup) int64 {
+	if len(group.Moves) == 0 {
+		return 0
+	}
+	return group.Moves[len(group.Moves)-1].SequenceNum
+}
+
+// GetGame returns a game with its state and history.  The game's random stream is never
+// returned.  When fog of war is enabled the state and the initial state only have what the
+// requesting player can see and the history is as they saw it when each move was made.
+// Without a player only the game's metadata is returned.
+func (s *BaseGamesServiceImpl) GetGame(ctx context.Context, req *v1.GetGameRequest) (*v1.GetGameResponse, error) {
+	gameresp, err := s.Self.LoadGame(ctx, req.Id)
+	if err != nil || gameresp.Game == nil {
+		return nil, err
+	}
+	if !gameresp.Game.GetConfig().GetSettings().GetFogOfWar() {
+		resp := &v1.GetGameResponse{Game: gameresp.Game, State: publicGameState(gameresp.State)}
+		if history := gameresp.History; history != nil {
+			resp.History = &v1.GameMoveHistory{
+				GameId:       history.GameId,
+				Groups:       history.Groups,
+				InitialState: publicGameState(history.InitialState),
+			}
+		}
+		return resp, nil
+	}
+	if req.Player <= 0 {
+		return &v1.GetGameResponse{Game: gameresp.Game}, nil
+	}
+
+	visibility, err := s.playerVisibility(gameresp.Game, gameresp.State, req.Player)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.GetGameResponse{
+		Game:  gameresp.Game,
+		State: foggedGameState(gameresp.State, visibility),
+	}
+	if history := gameresp.History; history != nil {
+		groups, err := s.playerHistory(gameresp.Game, history, visibility)
+		if err != nil {
+			return nil, err
+		}
+		resp.History = &v1.GameMoveHistory{GameId: history.GameId, Groups: groups}
+		if history.InitialState != nil {
+			initialGame, err := ProtoToRuntimeGame(gameresp.Game, history.InitialState)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create game from initial state: %w", err)
+			}
+			resp.History.InitialState = foggedGameState(history.InitialState, initialGame.ComputeVisibility(req.Player))
+		}
+	}
+	return resp, nil
+}
+
+// GetGameState returns the latest state of a game without its random stream.  When fog of
+// war is enabled only the parts of the world visible to the requesting player are returned.
+func (s *BaseGamesServiceImpl) GetGameState(ctx context.Context, req *v1.GetGameStateRequest) (*v1.GetGameStateResponse, error) {
+	gameresp, err := s.Self.LoadGame(ctx, req.GameId)
+	if err != nil || gameresp.Game == nil {
+		return nil, err
+	}
+	if gameresp.State == nil {
+		return nil, fmt.Errorf("game state not found: %s", req.GameId)
+	}
+
+	visibility, err := s.playerVisibility(gameresp.Game, gameresp.State, req.Player)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.GetGameStateResponse{}
+	if visibility != nil {
+		resp.State = foggedGameState(gameresp.State, visibility)
+	} else {
+		resp.State = publicGameState(gameresp.State)
+	}
+	resp.TurnTimeRemaining = turnTimeRemaining(resp.State, time.Now())
+
+	// With fog of war players only learn about their own progress
+	rtGame, err := s.Self.GetRuntimeGame(gameresp.Game, gameresp.State)
+	if err != nil {
+		return nil, err
+	}
+	for player := int32(1); player <= rtGame.World.PlayerCount(); player++ {
+		if visibility == nil || player == visibility.Player {
+			resp.VictoryProgress = append(resp.VictoryProgress, &v1.VictoryProgress{
+				Player:   player,
+				Progress: rtGame.VictoryProgress(player),
+			})
+		}
+	}
+	return resp, nil
+}
+
+// ListMoves returns the move groups of a game, latest last.  When fog of war is enabled
+// moves by other players are reduced to what the requesting player could see when they were made.
+func (s *BaseGamesServiceImpl) ListMoves(ctx context.Context, req *v1.ListMovesRequest) (*v1.ListMovesResponse, error) {
+	gameresp, err := s.Self.LoadGame(ctx, req.GameId)
+	if err != nil || gameresp.Game == nil {
+		return nil, err
+	}
+
+	var groups []*v1.GameMoveGroup
+	if gameresp.History != nil {
+		groups = gameresp.History.Groups
+	}
+
+	// Offset and last_n are counted back from the latest group
+	end := len(groups) - int(req.Offset)
+	if end < 0 {
+		end = 0
+	}
+	start := 0
+	if req.LastN > 0 && end-int(req.LastN) > 0 {
+		start = end - int(req.LastN)
+	}
+	visibility, err := s.playerVisibility(gameresp.Game, gameresp.State, req.Player)
+	if err != nil {
+		return nil, err
+	}
+	if visibility != nil {
+		if groups, err = s.playerHistory(gameresp.Game, gameresp.History, visibility); err != nil {
+			return nil, err
+		}
+	}
+	return &v1.ListMovesResponse{
+		HasMore:    start > 0,
+		MoveGroups: groups[start:end],
+	}, nil
+}
 
-	return resp, err
+// playerVisibility returns what a player can currently see in a game or nil if the game
+// does not use fog of war
+func (s *BaseGamesServiceImpl) playerVisibility(game *v1.Game, state *v1.GameState, player int32) (*weewar.Visibility, error) {
+	if !game.GetConfig().GetSettings().GetFogOfWar() {
+		return nil, nil
+	}
+	if player <= 0 {
+		return nil, fmt.Errorf("player is required when fog of war is enabled")
+	}
+	if state == nil {
+		return nil, fmt.Errorf("game state not found: %s", game.Id)
+	}
+	rtGame, err := s.Self.GetRuntimeGame(game, state)
+	if err != nil {
+		return nil, err
+	}
+	return rtGame.ComputeVisibility(player), nil
+}
+
+// playerHistory returns the move groups of a game as a player saw them when each was made.
+// Without fog of war this is the whole history.  Otherwise the history is replayed from its
+// initial state so each group is filtered with what the player could see before or after it
+// rather than with what they can see now.  Histories recorded without an initial state can
+// only be filtered with the player's current visibility.
+func (s *BaseGamesServiceImpl) playerHistory(game *v1.Game, history *v1.GameMoveHistory, visibility *weewar.Visibility) ([]*v1.GameMoveGroup, error) {
+	groups := history.GetGroups()
+	if visibility == nil {
+		return groups, nil
+	}
+
+	out := make([]*v1.GameMoveGroup, 0, len(groups))
+	if history.GetInitialState() == nil {
+		for _, group := range groups {
+			out = append(out, weewar.FilterMoveGroup(group, visibility))
+		}
+		return out, nil
+	}
+
+	// Replay on a fresh runtime game so a cached one is not disturbed
+	state := proto.Clone(history.InitialState).(*v1.GameState)
+	rtGame, err := ProtoToRuntimeGame(game, state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create game from initial state: %w", err)
+	}
+	for i, group := range groups {
+		seen := rtGame.ComputeVisibility(visibility.Player)
+		for _, result := range group.MoveResults {
+			for _, change := range result.Changes {
+				if err := s.applyWorldChange(change, rtGame, state); err != nil {
+					return nil, fmt.Errorf("failed to replay move group %d: %w", i, err)
+				}
+			}
+		}
+		seen.Merge(rtGame.ComputeVisibility(visibility.Player))
+		out = append(out, weewar.FilterMoveGroup(group, seen))
+	}
+	return out, nil
+}
+
+// playerVisibilities returns what each player in a game can currently see
+func playerVisibilities(rtGame *weewar.Game) map[int32]*weewar.Visibility {
+	visibilities := map[int32]*weewar.Visibility{}
+	for player := int32(1); player <= rtGame.World.PlayerCount(); player++ {
+		visibilities[player] = rtGame.ComputeVisibility(player)
+	}
+	return visibilities
+}
+
+// mergePlayerVisibilities adds what each player can currently see to what they could see before
+func mergePlayerVisibilities(visibilities map[int32]*weewar.Visibility, rtGame *weewar.Game) {
+	for player, visibility := range visibilities {
+		visibility.Merge(rtGame.ComputeVisibility(player))
+	}
+}
+
+// moveGroupViews returns each player's view of a move group given what they could see
+// before or after it
+func moveGroupViews(group *v1.GameMoveGroup, visibilities map[int32]*weewar.Visibility) map[int32]*v1.SubscribeGameResponse {
+	views := map[int32]*v1.SubscribeGameResponse{}
+	for player, visibility := range visibilities {
+		views[player] = &v1.SubscribeGameResponse{MoveGroup: weewar.FilterMoveGroup(group, visibility)}
+	}
+	return views
+}
+
+// publicGameState returns a game state without its random stream so players cannot predict
+// the outcome of their moves.  The state is only copied if it has a random stream.
+func publicGameState(state *v1.GameState) *v1.GameState {
+	if state.GetRngSeed() == 0 && state.GetRngPosition() == 0 {
+		return state
+	}
+	public := proto.Clone(state).(*v1.GameState)
+	public.RngSeed, public.RngPosition = 0, 0
+	return public
+}
+
+// foggedGameState returns a copy of a game state with only the parts of the world visible
+// to a player.  Everything else in the state but its random stream is public.
+func foggedGameState(state *v1.GameState, visibility *weewar.Visibility) *v1.GameState {
+	fogged := proto.Clone(state).(*v1.GameState)
+	fogged.WorldData = weewar.FilterWorldData(state.WorldData, visibility)
+	fogged.RngSeed, fogged.RngPosition = 0, 0
+	return fogged
+}
+
+// filterMoveResults returns the move results with only the changes visible to a player
+func filterMoveResults(results []*v1.GameMoveResult, visibility *weewar.Visibility) []*v1.GameMoveResult {
+	filtered := []*v1.GameMoveResult{}
+	for _, result := range results {
+		filtered = append(filtered, &v1.GameMoveResult{
+			IsPermanent: result.IsPermanent,
+			SequenceNum: result.SequenceNum,
+			Changes:     weewar.FilterWorldChanges(result.Changes, visibility),
+		})
+	}
+	return filtered
 }
 
 // GetOptionsAt returns all available options at a specific position
 func (s *BaseGamesServiceImpl) GetOptionsAt(ctx context.Context, req *v1.GetOptionsAtRequest) (*v1.GetOptionsAtResponse, error) {
 	// Load game data using the service implementation
-	gameresp, err := s.Self.GetGame(ctx, &v1.GetGameRequest{Id: req.GameId})
+	gameresp, err := s.Self.LoadGame(ctx, req.GameId)
 	if err != nil || gameresp.Game == nil {
 		return &v1.GetOptionsAtResponse{
 			Options:         []*v1.GameOption{},
@@ -133,15 +712,45 @@ func (s *BaseGamesServiceImpl) GetOptionsAt(ctx context.Context, req *v1.GetOpti
 		}, nil
 	}
 
+	// Options are computed on the current player's view so hidden units are never revealed
+	if gameresp.Game.GetConfig().GetSettings().GetFogOfWar() {
+		rtGame = rtGame.FoggedView(rtGame.ComputeVisibility(rtGame.CurrentPlayer))
+	}
+
 	var options []*v1.GameOption
 
 	// Check what's at this position
 	unit := rtGame.World.UnitAt(weewar.AxialCoord{Q: int(req.Q), R: int(req.R)})
 
 	if unit == nil {
-		// Empty tile - check for building/capture options, then end turn
-		// TODO: Add building construction options if this is a city/factory tile
-		// For now, just add end turn
+		// Empty tile - check for building options, then end turn
+		var dmp weewar.DefaultMoveProcessor
+		tile := rtGame.World.TileAt(weewar.AxialCoord{Q: int(req.Q), R: int(req.R)})
+		buildOptions, err := dmp.GetBuildOptions(rtGame, req.Q, req.R)
+		if err == nil {
+			for _, unitData := range buildOptions {
+				// Create ready-to-use BuildUnitAction
+				buildAction := &v1.BuildUnitAction{
+					Q:        req.Q,
+					R:        req.R,
+					UnitType: unitData.Id,
+				}
+
+				options = append(options, &v1.GameOption{
+					OptionType: &v1.GameOption_Build{
+						Build: &v1.BuildUnitOption{
+							Q:         req.Q,
+							R:         req.R,
+							TileType:  tile.TileType,
+							BuildCost: unitData.Coins,
+							UnitType:  unitData.Id,
+							Action:    buildAction,
+						},
+					},
+				})
+			}
+		}
+
 		options = append(options, &v1.GameOption{
 			OptionType: &v1.GameOption_EndTurn{
 				EndTurn: &v1.EndTurnOption{},
@@ -163,12 +772,13 @@ func (s *BaseGamesServiceImpl) GetOptionsAt(ctx context.Context, req *v1.GetOpti
 			tileOptions, err := dmp.GetMovementOptions(rtGame, req.Q, req.R)
 			if err == nil {
 				for _, tileOption := range tileOptions {
-					// Create ready-to-use MoveUnitAction
+					// Create ready-to-use MoveUnitAction along the previewed path
 					moveAction := &v1.MoveUnitAction{
 						FromQ: req.Q,
 						FromR: req.R,
 						ToQ:   int32(tileOption.Coord.Q),
 						ToR:   int32(tileOption.Coord.R),
+						Path:  hexCoords(tileOption.Path),
 					}
 
 					options = append(options, &v1.GameOption{
@@ -176,7 +786,7 @@ func (s *BaseGamesServiceImpl) GetOptionsAt(ctx context.Context, req *v1.GetOpti
 							Move: &v1.MoveOption{
 								Q:            int32(tileOption.Coord.Q),
 								R:            int32(tileOption.Coord.R),
-								MovementCost: int32(tileOption.Cost),
+								MovementCost: weewar.MovementPoints(tileOption.Cost),
 								Action:       moveAction,
 							},
 						},
@@ -193,8 +803,11 @@ func (s *BaseGamesServiceImpl) GetOptionsAt(ctx context.Context, req *v1.GetOpti
 					// Get target unit info for rich attack option data
 					targetUnit := rtGame.World.UnitAt(coord)
 					if targetUnit != nil {
-						// Calculate estimated damage (simplified for now)
-						damageEstimate := int32(50) // TODO: Use proper damage calculation from rules engine
+						// Predict the outcome of the attack including the target's counter attack
+						outcome, err := rtGame.SimulateCombat(unit, targetUnit)
+						if err != nil {
+							continue
+						}
 
 						// Create ready-to-use AttackUnitAction
 						attackAction := &v1.AttackUnitAction{
@@ -207,13 +820,17 @@ func (s *BaseGamesServiceImpl) GetOptionsAt(ctx context.Context, req *v1.GetOpti
 						options = append(options, &v1.GameOption{
 							OptionType: &v1.GameOption_Attack{
 								Attack: &v1.AttackOption{
-									Q:                int32(coord.Q),
-									R:                int32(coord.R),
-									TargetUnitType:   targetUnit.UnitType,
-									TargetUnitHealth: targetUnit.AvailableHealth,
-									CanAttack:        true,
-									DamageEstimate:   damageEstimate,
-									Action:           attackAction,
+									Q:                   int32(coord.Q),
+									R:                   int32(coord.R),
+									TargetUnitType:      targetUnit.UnitType,
+									TargetUnitHealth:    targetUnit.AvailableHealth,
+									CanAttack:           true,
+									DamageEstimate:      int32(math.Round(outcome.ExpectedDamageDealt)),
+									Action:              attackAction,
+									ExpectedDamageDealt: outcome.ExpectedDamageDealt,
+									ExpectedDamageTaken: outcome.ExpectedDamageTaken,
+									KillProbability:     outcome.KillProbability,
+									LossProbability:     outcome.LossProbability,
 								},
 							},
 						})
@@ -222,8 +839,95 @@ func (s *BaseGamesServiceImpl) GetOptionsAt(ctx context.Context, req *v1.GetOpti
 			}
 		}
 
-		// TODO: Add capture building options if unit can capture buildings at this location
-		// TODO: Add build unit options if this is a production facility
+		// Add capture option if unit can capture the building it is on
+		if tile, err := dmp.GetCaptureOption(rtGame, req.Q, req.R); err == nil {
+			captureProgress := int32(0)
+			if tile.CapturePlayer == unit.Player {
+				captureProgress = tile.CaptureProgress
+			}
+
+			// Create ready-to-use CaptureBuildingAction
+			captureAction := &v1.CaptureBuildingAction{
+				Q: req.Q,
+				R: req.R,
+			}
+
+			options = append(options, &v1.GameOption{
+				OptionType: &v1.GameOption_Capture{
+					Capture: &v1.CaptureBuildingOption{
+						Q:               req.Q,
+						R:               req.R,
+						TileType:        tile.TileType,
+						CaptureProgress: captureProgress,
+						CaptureTurns:    rtGame.GetRulesEngine().GetCaptureTurns(tile.TileType),
+						Action:          captureAction,
+					},
+				},
+			})
+		}
+
+		// Add options to change the terrain on or around the unit
+		if terrainOptions, err := dmp.GetTerrainActionOptions(rtGame, req.Q, req.R); err == nil {
+			for _, terrainOption := range terrainOptions {
+				options = append(options, &v1.GameOption{
+					OptionType: &v1.GameOption_ModifyTerrain{
+						ModifyTerrain: terrainOption,
+					},
+				})
+			}
+		}
+
+		// Add options to board adjacent transports
+		if transportCoords, err := dmp.GetLoadOptions(rtGame, req.Q, req.R); err == nil {
+			for _, coord := range transportCoords {
+				// Create ready-to-use LoadUnitAction
+				loadAction := &v1.LoadUnitAction{
+					UnitQ:      req.Q,
+					UnitR:      req.R,
+					TransportQ: int32(coord.Q),
+					TransportR: int32(coord.R),
+				}
+
+				options = append(options, &v1.GameOption{
+					OptionType: &v1.GameOption_Load{
+						Load: &v1.LoadUnitOption{
+							Q:                 int32(coord.Q),
+							R:                 int32(coord.R),
+							TransportUnitType: rtGame.World.UnitAt(coord).UnitType,
+							Action:            loadAction,
+						},
+					},
+				})
+			}
+		}
+
+		// Add options to unload the units a transport is carrying
+		if unloadActions, err := dmp.GetUnloadOptions(rtGame, req.Q, req.R); err == nil {
+			for _, unloadAction := range unloadActions {
+				options = append(options, &v1.GameOption{
+					OptionType: &v1.GameOption_Unload{
+						Unload: &v1.UnloadUnitOption{
+							Q:          unloadAction.ToQ,
+							R:          unloadAction.ToR,
+							CargoIndex: unloadAction.CargoIndex,
+							UnitType:   unit.Cargo[unloadAction.CargoIndex].UnitType,
+							Action:     unloadAction,
+						},
+					},
+				})
+			}
+		}
+
+		// Add options to heal adjacent friendly units
+		if healOptions, err := dmp.GetHealOptions(rtGame, req.Q, req.R); err == nil {
+			for _, healOption := range healOptions {
+				options = append(options, &v1.GameOption{
+					OptionType: &v1.GameOption_Heal{
+						Heal: healOption,
+					},
+				})
+			}
+		}
 
 		// Always add end turn option
 		options = append(options, &v1.GameOption{
@@ -240,6 +944,60 @@ func (s *BaseGamesServiceImpl) GetOptionsAt(ctx context.Context, req *v1.GetOpti
 	}, nil
 }
 
+// GetPath returns the cheapest path for a unit to a destination with the cost of each step
+func (s *BaseGamesServiceImpl) GetPath(ctx context.Context, req *v1.GetPathRequest) (*v1.GetPathResponse, error) {
+	gameresp, err := s.Self.LoadGame(ctx, req.GameId)
+	if err != nil || gameresp.Game == nil || gameresp.State == nil {
+		return nil, err
+	}
+
+	rtGame, err := s.Self.GetRuntimeGame(gameresp.Game, gameresp.State)
+	if err != nil {
+		return nil, err
+	}
+
+	// Paths are computed on the current player's view so hidden units are never revealed
+	if gameresp.Game.GetConfig().GetSettings().GetFogOfWar() {
+		rtGame = rtGame.FoggedView(rtGame.ComputeVisibility(rtGame.CurrentPlayer))
+	}
+
+	from := weewar.CoordFromInt32(req.FromQ, req.FromR)
+	unit := rtGame.World.UnitAt(from)
+	if unit == nil {
+		return nil, fmt.Errorf("no unit at %v", from)
+	}
+	path, err := rtGame.FindPath(from, weewar.CoordFromInt32(req.ToQ, req.ToR))
+	if err != nil {
+		return nil, err
+	}
+	stepCosts, err := rtGame.GetRulesEngine().PathStepCosts(rtGame.World, unit.UnitType, path)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.GetPathResponse{
+		Action: &v1.MoveUnitAction{
+			FromQ: req.FromQ,
+			FromR: req.FromR,
+			ToQ:   req.ToQ,
+			ToR:   req.ToR,
+			Path:  hexCoords(path),
+		},
+	}
+	for i, cost := range stepCosts {
+		resp.TotalCost += cost
+		resp.Steps = append(resp.Steps, &v1.PathStep{
+			Q:         int32(path[i+1].Q),
+			R:         int32(path[i+1].R),
+			Cost:      cost,
+			TotalCost: resp.TotalCost,
+		})
+	}
+	resp.MovementCost = weewar.MovementPoints(resp.TotalCost)
+	resp.Reachable = unit.Player == rtGame.CurrentPlayer && resp.MovementCost <= unit.DistanceLeft
+	return resp, nil
+}
+
 func (b *BaseGamesServiceImpl) ApplyChangeResults(changes []*v1.GameMoveResult, rtGame *weewar.Game, game *v1.Game, state *v1.GameState, history *v1.GameMoveHistory) error {
 	// Apply each change to both runtime game and protobuf data structures
 	for _, moveResult := range changes {
@@ -253,11 +1011,36 @@ func (b *BaseGamesServiceImpl) ApplyChangeResults(changes []*v1.GameMoveResult,
 
 	// Update protobuf GameState with final runtime world state
 	state.WorldData = b.convertRuntimeWorldToProto(rtGame.World)
+	syncWinners(rtGame, state)
 	state.UpdatedAt = timestamppb.New(time.Now())
 
 	return nil
 }
 
+// SyncGameState updates the protobuf GameState from a runtime game that already has all changes applied
+func (b *BaseGamesServiceImpl) SyncGameState(rtGame *weewar.Game, state *v1.GameState) {
+	state.CurrentPlayer = rtGame.CurrentPlayer
+	state.TurnCounter = rtGame.TurnCounter
+	state.PlayerCoins = map[int32]int32{}
+	for player, coins := range rtGame.PlayerCoins {
+		state.PlayerCoins[player] = coins
+	}
+	state.RngSeed = rtGame.Seed
+	state.RngPosition = rtGame.RNGPosition()
+	state.WorldData = b.convertRuntimeWorldToProto(rtGame.World)
+	syncWinners(rtGame, state)
+	state.UpdatedAt = timestamppb.New(time.Now())
+}
+
+// syncWinners copies the outcome of a finished runtime game to the protobuf GameState
+func syncWinners(rtGame *weewar.Game, state *v1.GameState) {
+	if winner, hasWinner := rtGame.GetWinner(); hasWinner {
+		state.Winner = winner
+		state.Winners = rtGame.GetWinners()
+		state.VictoryCondition = rtGame.VictoryCondition()
+	}
+}
+
 // applyWorldChange applies a single WorldChange to both runtime game and protobuf state
 func (b *BaseGamesServiceImpl) applyWorldChange(change *v1.WorldChange, rtGame *weewar.Game, state *v1.GameState) error {
 	switch changeType := change.ChangeType.(type) {
@@ -269,6 +1052,20 @@ func (b *BaseGamesServiceImpl) applyWorldChange(change *v1.WorldChange, rtGame *
 		return b.applyUnitKilled(changeType.UnitKilled, rtGame)
 	case *v1.WorldChange_PlayerChanged:
 		return b.applyPlayerChanged(changeType.PlayerChanged, rtGame, state)
+	case *v1.WorldChange_CoinsChanged:
+		return b.applyCoinsChanged(changeType.CoinsChanged, rtGame, state)
+	case *v1.WorldChange_UnitCreated:
+		return b.applyUnitCreated(changeType.UnitCreated, rtGame)
+	case *v1.WorldChange_TileCaptured:
+		return b.applyTileCaptured(changeType.TileCaptured, rtGame)
+	case *v1.WorldChange_UnitLoaded:
+		return b.applyUnitLoaded(changeType.UnitLoaded, rtGame)
+	case *v1.WorldChange_UnitUnloaded:
+		return b.applyUnitUnloaded(changeType.UnitUnloaded, rtGame)
+	case *v1.WorldChange_UnitHealed:
+		return b.applyUnitHealed(changeType.UnitHealed, rtGame)
+	case *v1.WorldChange_TileChanged:
+		return b.applyTileChanged(changeType.TileChanged, rtGame, state)
 	default:
 		return fmt.Errorf("unknown world change type")
 	}
@@ -276,8 +1073,19 @@ func (b *BaseGamesServiceImpl) applyWorldChange(change *v1.WorldChange, rtGame *
 
 // applyUnitMoved moves a unit in the runtime game
 func (b *BaseGamesServiceImpl) applyUnitMoved(change *v1.UnitMovedChange, rtGame *weewar.Game) error {
-	if change.PreviousUnit == nil || change.UpdatedUnit == nil {
+	// With fog of war units moving into or out of sight only have the visible end of the move
+	switch {
+	case change.PreviousUnit == nil && change.UpdatedUnit == nil:
 		return fmt.Errorf("missing unit data in UnitMovedChange")
+	case change.PreviousUnit == nil:
+		_, err := rtGame.World.AddUnit(weewar.CopyUnit(change.UpdatedUnit))
+		return err
+	case change.UpdatedUnit == nil:
+		unit := rtGame.World.UnitAt(weewar.UnitGetCoord(change.PreviousUnit))
+		if unit == nil {
+			return fmt.Errorf("unit not found at %v", weewar.UnitGetCoord(change.PreviousUnit))
+		}
+		return rtGame.World.RemoveUnit(unit)
 	}
 
 	fromCoord := weewar.AxialCoord{Q: int(change.PreviousUnit.Q), R: int(change.PreviousUnit.R)}
@@ -293,6 +1101,9 @@ func (b *BaseGamesServiceImpl) applyUnitMoved(change *v1.UnitMovedChange, rtGame
 	unit.AvailableHealth = change.UpdatedUnit.AvailableHealth
 	unit.DistanceLeft = change.UpdatedUnit.DistanceLeft
 	unit.TurnCounter = change.UpdatedUnit.TurnCounter
+	unit.HasMoved = change.UpdatedUnit.HasMoved
+	unit.HasAttacked = change.UpdatedUnit.HasAttacked
+	unit.ActionsRemaining = change.UpdatedUnit.ActionsRemaining
 
 	// Remove from old position and add to new position
 	return rtGame.World.MoveUnit(unit, toCoord)
@@ -300,21 +1111,57 @@ func (b *BaseGamesServiceImpl) applyUnitMoved(change *v1.UnitMovedChange, rtGame
 
 // applyUnitDamaged updates unit health in the runtime game
 func (b *BaseGamesServiceImpl) applyUnitDamaged(change *v1.UnitDamagedChange, rtGame *weewar.Game) error {
-	if change.UpdatedUnit == nil {
+	// Either side may be missing from a change filtered for a player who cannot see it
+	if change.UpdatedUnit == nil && change.UpdatedAttacker == nil {
 		return fmt.Errorf("missing updated unit data in UnitDamagedChange")
 	}
-	
-	coord := weewar.AxialCoord{Q: int(change.UpdatedUnit.Q), R: int(change.UpdatedUnit.R)}
 
-	unit := rtGame.World.UnitAt(coord)
-	if unit == nil {
-		return fmt.Errorf("unit not found at %v", coord)
+	// Update the units with their complete state from the change
+	for _, updated := range []*v1.Unit{change.UpdatedUnit, change.UpdatedAttacker} {
+		if updated == nil {
+			continue
+		}
+		coord := weewar.AxialCoord{Q: int(updated.Q), R: int(updated.R)}
+		unit := rtGame.World.UnitAt(coord)
+		if unit == nil {
+			return fmt.Errorf("unit not found at %v", coord)
+		}
+		unit.AvailableHealth = updated.AvailableHealth
+		unit.DistanceLeft = updated.DistanceLeft
+		unit.TurnCounter = updated.TurnCounter
+		unit.HasMoved = updated.HasMoved
+		unit.HasAttacked = updated.HasAttacked
+		unit.ActionsRemaining = updated.ActionsRemaining
+	}
+	return nil
+}
+
+// applyUnitHealed restores unit health in the runtime game
+func (b *BaseGamesServiceImpl) applyUnitHealed(change *v1.UnitHealedChange, rtGame *weewar.Game) error {
+	// Either side may be missing from a change filtered for a player who cannot see it
+	if change.UpdatedUnit == nil && change.UpdatedHealer == nil {
+		return fmt.Errorf("missing updated unit data in UnitHealedChange")
 	}
 
-	// Update unit with complete state from the change
-	unit.AvailableHealth = change.UpdatedUnit.AvailableHealth
-	unit.DistanceLeft = change.UpdatedUnit.DistanceLeft
-	unit.TurnCounter = change.UpdatedUnit.TurnCounter
+	if change.UpdatedUnit != nil {
+		coord := weewar.UnitGetCoord(change.UpdatedUnit)
+		unit := rtGame.World.UnitAt(coord)
+		if unit == nil {
+			return fmt.Errorf("unit not found at %v", coord)
+		}
+		unit.AvailableHealth = change.UpdatedUnit.AvailableHealth
+	}
+
+	if change.UpdatedHealer != nil {
+		coord := weewar.UnitGetCoord(change.UpdatedHealer)
+		healer := rtGame.World.UnitAt(coord)
+		if healer == nil {
+			return fmt.Errorf("healer not found at %v", coord)
+		}
+		healer.DistanceLeft = change.UpdatedHealer.DistanceLeft
+		healer.ActionsRemaining = change.UpdatedHealer.ActionsRemaining
+		healer.HasAttacked = change.UpdatedHealer.HasAttacked
+	}
 	return nil
 }
 
@@ -346,6 +1193,170 @@ func (b *BaseGamesServiceImpl) applyPlayerChanged(change *v1.PlayerChangedChange
 	return nil
 }
 
+// applyCoinsChanged updates a player's coin balance
+func (b *BaseGamesServiceImpl) applyCoinsChanged(change *v1.CoinsChangedChange, rtGame *weewar.Game, state *v1.GameState) error {
+	if rtGame.PlayerCoins == nil {
+		rtGame.PlayerCoins = map[int32]int32{}
+	}
+	rtGame.PlayerCoins[change.Player] = change.NewCoins
+
+	// Also update the protobuf GameState
+	if state.PlayerCoins == nil {
+		state.PlayerCoins = map[int32]int32{}
+	}
+	state.PlayerCoins[change.Player] = change.NewCoins
+
+	return nil
+}
+
+// applyUnitCreated adds a newly built unit to the runtime game
+func (b *BaseGamesServiceImpl) applyUnitCreated(change *v1.UnitCreatedChange, rtGame *weewar.Game) error {
+	if change.Unit == nil {
+		return fmt.Errorf("missing unit data in UnitCreatedChange")
+	}
+
+	coord := weewar.AxialCoord{Q: int(change.Unit.Q), R: int(change.Unit.R)}
+	if rtGame.World.UnitAt(coord) != nil {
+		return fmt.Errorf("tile %v is already occupied", coord)
+	}
+
+	unit := &v1.Unit{
+		Q:                change.Unit.Q,
+		R:                change.Unit.R,
+		Player:           change.Unit.Player,
+		UnitType:         change.Unit.UnitType,
+		AvailableHealth:  change.Unit.AvailableHealth,
+		DistanceLeft:     change.Unit.DistanceLeft,
+		TurnCounter:      change.Unit.TurnCounter,
+		HasMoved:         change.Unit.HasMoved,
+		HasAttacked:      change.Unit.HasAttacked,
+		ActionsRemaining: change.Unit.ActionsRemaining,
+		Cargo:            weewar.CopyUnits(change.Unit.Cargo),
+	}
+	_, err := rtGame.World.AddUnit(unit)
+	return err
+}
+
+// applyUnitLoaded takes a unit off the map and onto its transport in the runtime game
+// With fog of war either the unit or the transport may be missing if it cannot be seen.
+func (b *BaseGamesServiceImpl) applyUnitLoaded(change *v1.UnitLoadedChange, rtGame *weewar.Game) error {
+	if change.Unit == nil && change.UpdatedTransport == nil {
+		return fmt.Errorf("missing unit data in UnitLoadedChange")
+	}
+
+	if change.UpdatedTransport != nil {
+		transportCoord := weewar.UnitGetCoord(change.UpdatedTransport)
+		transport := rtGame.World.UnitAt(transportCoord)
+		if transport == nil {
+			return fmt.Errorf("transport not found at %v", transportCoord)
+		}
+		transport.Cargo = weewar.CopyUnits(change.UpdatedTransport.Cargo)
+	}
+
+	if change.Unit != nil {
+		coord := weewar.UnitGetCoord(change.Unit)
+		unit := rtGame.World.UnitAt(coord)
+		if unit == nil {
+			return fmt.Errorf("unit not found at %v", coord)
+		}
+		return rtGame.World.RemoveUnit(unit)
+	}
+	return nil
+}
+
+// applyUnitUnloaded puts a carried unit from its transport back on the map in the runtime game
+// With fog of war either the unit or the transport may be missing if it cannot be seen.
+func (b *BaseGamesServiceImpl) applyUnitUnloaded(change *v1.UnitUnloadedChange, rtGame *weewar.Game) error {
+	if change.Unit == nil && change.UpdatedTransport == nil {
+		return fmt.Errorf("missing unit data in UnitUnloadedChange")
+	}
+
+	if change.UpdatedTransport != nil {
+		transportCoord := weewar.UnitGetCoord(change.UpdatedTransport)
+		transport := rtGame.World.UnitAt(transportCoord)
+		if transport == nil {
+			return fmt.Errorf("transport not found at %v", transportCoord)
+		}
+		transport.Cargo = weewar.CopyUnits(change.UpdatedTransport.Cargo)
+	}
+
+	if change.Unit != nil {
+		coord := weewar.UnitGetCoord(change.Unit)
+		if rtGame.World.UnitAt(coord) != nil {
+			return fmt.Errorf("tile %v is already occupied", coord)
+		}
+		_, err := rtGame.World.AddUnit(weewar.CopyUnit(change.Unit))
+		return err
+	}
+	return nil
+}
+
+// applyTileCaptured updates a tile's ownership/capture progress and the capturing unit
+func (b *BaseGamesServiceImpl) applyTileCaptured(change *v1.TileCapturedChange, rtGame *weewar.Game) error {
+	if change.UpdatedTile == nil {
+		return fmt.Errorf("missing updated tile data in TileCapturedChange")
+	}
+
+	coord := weewar.AxialCoord{Q: int(change.UpdatedTile.Q), R: int(change.UpdatedTile.R)}
+	tile := rtGame.World.TileAt(coord)
+	if tile == nil {
+		return fmt.Errorf("tile not found at %v", coord)
+	}
+	tile.Player = change.UpdatedTile.Player
+	tile.CapturePlayer = change.UpdatedTile.CapturePlayer
+	tile.CaptureProgress = change.UpdatedTile.CaptureProgress
+
+	if change.UpdatedUnit != nil {
+		unit := rtGame.World.UnitAt(coord)
+		if unit == nil {
+			return fmt.Errorf("unit not found at %v", coord)
+		}
+		unit.DistanceLeft = change.UpdatedUnit.DistanceLeft
+		unit.HasMoved = change.UpdatedUnit.HasMoved
+		unit.ActionsRemaining = change.UpdatedUnit.ActionsRemaining
+	}
+	return nil
+}
+
+// applyTileChanged updates a tile's terrain and terrain action progress in both the runtime
+// world and the protobuf WorldData, and the working unit in the runtime game
+func (b *BaseGamesServiceImpl) applyTileChanged(change *v1.TileChangedChange, rtGame *weewar.Game, state *v1.GameState) error {
+	if change.UpdatedTile == nil {
+		return fmt.Errorf("missing updated tile data in TileChangedChange")
+	}
+
+	coord := weewar.TileGetCoord(change.UpdatedTile)
+	tile := rtGame.World.TileAt(coord)
+	if tile == nil {
+		return fmt.Errorf("tile not found at %v", coord)
+	}
+	tile.TileType = change.UpdatedTile.TileType
+	tile.TerrainAction = change.UpdatedTile.TerrainAction
+	tile.TerrainActionProgress = change.UpdatedTile.TerrainActionProgress
+
+	if state.WorldData != nil {
+		for _, protoTile := range state.WorldData.Tiles {
+			if protoTile.Q == change.UpdatedTile.Q && protoTile.R == change.UpdatedTile.R {
+				protoTile.TileType = change.UpdatedTile.TileType
+				protoTile.TerrainAction = change.UpdatedTile.TerrainAction
+				protoTile.TerrainActionProgress = change.UpdatedTile.TerrainActionProgress
+			}
+		}
+	}
+
+	if change.UpdatedUnit != nil {
+		unitCoord := weewar.UnitGetCoord(change.UpdatedUnit)
+		unit := rtGame.World.UnitAt(unitCoord)
+		if unit == nil {
+			return fmt.Errorf("unit not found at %v", unitCoord)
+		}
+		unit.DistanceLeft = change.UpdatedUnit.DistanceLeft
+		unit.HasMoved = change.UpdatedUnit.HasMoved
+		unit.ActionsRemaining = change.UpdatedUnit.ActionsRemaining
+	}
+	return nil
+}
+
 // convertRuntimeWorldToProto converts runtime world state to protobuf WorldData
 func (b *BaseGamesServiceImpl) convertRuntimeWorldToProto(world *weewar.World) *v1.WorldData {
 	worldData := &v1.WorldData{
@@ -356,10 +1367,14 @@ func (b *BaseGamesServiceImpl) convertRuntimeWorldToProto(world *weewar.World) *
 	// Convert runtime tiles to protobuf tiles
 	for coord, tile := range world.TilesByCoord() {
 		protoTile := &v1.Tile{
-			Q:        int32(coord.Q),
-			R:        int32(coord.R),
-			TileType: int32(tile.TileType),
-			Player:   int32(tile.Player),
+			Q:                     int32(coord.Q),
+			R:                     int32(coord.R),
+			TileType:              int32(tile.TileType),
+			Player:                int32(tile.Player),
+			CapturePlayer:         tile.CapturePlayer,
+			CaptureProgress:       tile.CaptureProgress,
+			TerrainAction:         tile.TerrainAction,
+			TerrainActionProgress: tile.TerrainActionProgress,
 		}
 		worldData.Tiles = append(worldData.Tiles, protoTile)
 	}
@@ -367,13 +1382,17 @@ func (b *BaseGamesServiceImpl) convertRuntimeWorldToProto(world *weewar.World) *
 	// Convert runtime units to protobuf units
 	for coord, unit := range world.UnitsByCoord() {
 		protoUnit := &v1.Unit{
-			Q:               int32(coord.Q),
-			R:               int32(coord.R),
-			Player:          int32(unit.Player),
-			UnitType:        int32(unit.UnitType),
-			AvailableHealth: int32(unit.AvailableHealth),
-			DistanceLeft:    int32(unit.DistanceLeft),
-			TurnCounter:     int32(unit.TurnCounter),
+			Q:                int32(coord.Q),
+			R:                int32(coord.R),
+			Player:           int32(unit.Player),
+			UnitType:         int32(unit.UnitType),
+			AvailableHealth:  int32(unit.AvailableHealth),
+			DistanceLeft:     int32(unit.DistanceLeft),
+			TurnCounter:      int32(unit.TurnCounter),
+			HasMoved:         unit.HasMoved,
+			HasAttacked:      unit.HasAttacked,
+			ActionsRemaining: unit.ActionsRemaining,
+			Cargo:            weewar.CopyUnits(unit.Cargo),
 		}
 		worldData.Units = append(worldData.Units, protoUnit)
 	}
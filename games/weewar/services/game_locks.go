@@ -0,0 +1,47 @@
+package services
+
+import (
+	"sync"
+)
+
+// GameLocks serializes loading, changing and saving each game so sequence numbers are checked
+// and assigned against the latest saved state
+type GameLocks struct {
+	mu    sync.Mutex
+	locks map[string]*gameLock
+}
+
+// gameLock is a game's lock and the number of callers holding or waiting for it
+type gameLock struct {
+	sync.Mutex
+	refs int
+}
+
+// All service instances share the same storage so they also share the game locks
+var gameLocks = NewGameLocks()
+
+func NewGameLocks() *GameLocks {
+	return &GameLocks{locks: map[string]*gameLock{}}
+}
+
+// Lock locks a game and returns the function to unlock it
+func (l *GameLocks) Lock(gameId string) func() {
+	l.mu.Lock()
+	lock := l.locks[gameId]
+	if lock == nil {
+		lock = &gameLock{}
+		l.locks[gameId] = lock
+	}
+	lock.refs++
+	l.mu.Unlock()
+
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if lock.refs--; lock.refs == 0 {
+			delete(l.locks, gameId)
+		}
+	}
+}
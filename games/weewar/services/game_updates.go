@@ -0,0 +1,76 @@
+package services
+
+import (
+	"sync"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+// Number of updates a subscriber can fall behind by before it is dropped
+const gameUpdatesBufferSize = 64
+
+// GameUpdate is a change committed to a game.  With fog of war it also has each player's
+// view of the change, filtered with what they could see when it was made.
+type GameUpdate struct {
+	MoveGroup   *v1.GameMoveGroup
+	Undo        *v1.UndoMovesResponse
+	PlayerViews map[int32]*v1.SubscribeGameResponse
+}
+
+// GameUpdates fans out committed game updates to subscribers of each game
+type GameUpdates struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan *GameUpdate]bool
+}
+
+// All service instances share the same storage so they also share the updates broker
+var gameUpdates = NewGameUpdates()
+
+func NewGameUpdates() *GameUpdates {
+	return &GameUpdates{subscribers: map[string]map[chan *GameUpdate]bool{}}
+}
+
+// Subscribe returns a channel receiving the updates for a game and a function to stop the
+// subscription.  The channel is closed if the subscriber falls too far behind, in which
+// case it should resubscribe from the last sequence number it has seen.
+func (u *GameUpdates) Subscribe(gameId string) (<-chan *GameUpdate, func()) {
+	ch := make(chan *GameUpdate, gameUpdatesBufferSize)
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.subscribers[gameId] == nil {
+		u.subscribers[gameId] = map[chan *GameUpdate]bool{}
+	}
+	u.subscribers[gameId][ch] = true
+
+	return ch, func() {
+		u.mu.Lock()
+		defer u.mu.Unlock()
+		u.remove(gameId, ch)
+	}
+}
+
+// Publish sends an update to all subscribers of a game without blocking
+func (u *GameUpdates) Publish(gameId string, update *GameUpdate) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	for ch := range u.subscribers[gameId] {
+		select {
+		case ch <- update:
+		default:
+			u.remove(gameId, ch)
+		}
+	}
+}
+
+// remove closes and forgets a subscriber channel.  Must be called with the lock held.
+func (u *GameUpdates) remove(gameId string, ch chan *GameUpdate) {
+	subs := u.subscribers[gameId]
+	if !subs[ch] {
+		return
+	}
+	delete(subs, ch)
+	close(ch)
+	if len(subs) == 0 {
+		delete(u.subscribers, gameId)
+	}
+}
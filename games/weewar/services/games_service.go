@@ -8,11 +8,15 @@ import (
 
 	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
 	weewar "github.com/panyam/turnengine/games/weewar/lib"
+	"google.golang.org/protobuf/proto"
 	tspb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var GAMES_STORAGE_DIR = ""
 
+// Directory where the rule sets games are played under are archived
+var RULES_STORAGE_DIR = ""
+
 // FSGamesServiceImpl implements the GamesService gRPC interface
 type FSGamesServiceImpl struct {
 	BaseGamesServiceImpl
@@ -32,6 +36,14 @@ func NewFSGamesService() *FSGamesServiceImpl {
 	}
 	service.Self = service
 
+	// Games created under older rules are still played and verified under them
+	if RULES_STORAGE_DIR == "" {
+		RULES_STORAGE_DIR = weewar.DevDataPath("storage/rules")
+	}
+	if err := weewar.DefaultRulesRegistry().LoadDir(RULES_STORAGE_DIR); err != nil {
+		log.Printf("Failed to load archived rules from %s: %v", RULES_STORAGE_DIR, err)
+	}
+
 	return service
 }
 
@@ -62,6 +74,19 @@ func (s *FSGamesServiceImpl) CreateGame(ctx context.Context, req *v1.CreateGameR
 		return nil, fmt.Errorf("game data is required")
 	}
 
+	// Pin the game to the rules it is created under and archive them so later rule changes
+	// do not change how the game plays
+	if req.Game.RulesId == "" {
+		req.Game.RulesId = weewar.DefaultRulesRegistry().DefaultID()
+	}
+	rulesEngine, err := GameRulesEngine(req.Game)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create game: %w", err)
+	}
+	if err := weewar.DefaultRulesRegistry().Save(RULES_STORAGE_DIR, req.Game.RulesId); err != nil {
+		log.Printf("Failed to archive rules %s: %v", req.Game.RulesId, err)
+	}
+
 	req.Game.Id, err = s.storage.CreateEntity(req.Game.Id)
 	if err != nil {
 		return resp, err
@@ -87,11 +112,12 @@ func (s *FSGamesServiceImpl) CreateGame(ctx context.Context, req *v1.CreateGameR
 		CurrentPlayer: 1, // Game starts with player 1
 		TurnCounter:   1, // First turn
 		WorldData:     world.WorldData,
+		RngSeed:       now.UnixNano(), // Each game gets its own random stream
 	}
+	startTurnClock(req.Game, gs, now)
 	
 	// Initialize units with default stats from rules engine for new games
 	if gs.WorldData != nil && gs.WorldData.Units != nil {
-		rulesEngine := weewar.DefaultRulesEngine()
 		for _, unit := range gs.WorldData.Units {
 			// Get unit defaults from rules engine
 			unitData, err := rulesEngine.GetUnitData(unit.UnitType)
@@ -104,42 +130,54 @@ func (s *FSGamesServiceImpl) CreateGame(ctx context.Context, req *v1.CreateGameR
 			unit.AvailableHealth = unitData.Health
 			unit.DistanceLeft = unitData.MovementPoints
 			unit.TurnCounter = gs.TurnCounter
+			unit.ActionsRemaining = rulesEngine.GetActionsPerTurn(unit.UnitType)
 		}
 	}
+
+	// Every player starts with the same coins
+	gs.PlayerCoins = map[int32]int32{}
+	for _, player := range req.Game.GetConfig().GetPlayers() {
+		gs.PlayerCoins[player.PlayerId] = req.Game.GetConfig().GetSettings().GetCoins().GetStartOfGame()
+	}
 	if err := s.storage.SaveArtifact(req.Game.Id, "state", gs); err != nil {
 		log.Printf("Failed to create state for game %s: %v", req.Game.Id, err)
 	}
 
-	// Save a new empty game history and a new move list
-	if err := s.storage.SaveArtifact(req.Game.Id, "history", &v1.GameMoveHistory{GameId: req.Game.Id}); err != nil {
+	// Save a new empty game history and a new move list.  The initial state is kept so the
+	// game can be replayed from the start
+	history := &v1.GameMoveHistory{
+		GameId:       req.Game.Id,
+		InitialState: proto.Clone(gs).(*v1.GameState),
+	}
+	if err := s.storage.SaveArtifact(req.Game.Id, "history", history); err != nil {
 		log.Printf("Failed to create state for game %s: %v", req.Game.Id, err)
 	}
 
 	resp = &v1.CreateGameResponse{
 		Game:      req.Game,
-		GameState: gs,
+		GameState: publicGameState(gs),
 	}
 
 	return resp, nil
 }
 
-// GetGame returns a specific game with complete data including tiles and units
-func (s *FSGamesServiceImpl) GetGame(ctx context.Context, req *v1.GetGameRequest) (resp *v1.GetGameResponse, err error) {
-	if req.Id == "" {
+// LoadGame loads a specific game with complete data including tiles and units
+func (s *FSGamesServiceImpl) LoadGame(ctx context.Context, gameId string) (resp *v1.GetGameResponse, err error) {
+	if gameId == "" {
 		return nil, fmt.Errorf("game ID is required")
 	}
 
-	game, err := LoadFSArtifact[*v1.Game](s.storage, req.Id, "metadata")
+	game, err := LoadFSArtifact[*v1.Game](s.storage, gameId, "metadata")
 	if err != nil {
 		return nil, fmt.Errorf("game metadata not found: %w", err)
 	}
 
-	gameState, err := LoadFSArtifact[*v1.GameState](s.storage, req.Id, "state")
+	gameState, err := LoadFSArtifact[*v1.GameState](s.storage, gameId, "state")
 	if err != nil {
 		return nil, fmt.Errorf("game state not found: %w", err)
 	}
 
-	gameHistory, err := LoadFSArtifact[*v1.GameMoveHistory](s.storage, req.Id, "history")
+	gameHistory, err := LoadFSArtifact[*v1.GameMoveHistory](s.storage, gameId, "history")
 	if err != nil {
 		return nil, fmt.Errorf("game state not found: %w", err)
 	}
@@ -202,6 +240,34 @@ func (s *FSGamesServiceImpl) UpdateGame(ctx context.Context, req *v1.UpdateGameR
 	return resp, err
 }
 
+// RunTurnTimer periodically ends turns whose time limit has passed in all stored games
+// until the context is cancelled
+func (s *FSGamesServiceImpl) RunTurnTimer(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		games, err := ListFSEntities[*v1.Game](s.storage, nil)
+		if err != nil {
+			log.Printf("Turn timer failed to list games: %v", err)
+			continue
+		}
+		for _, game := range games {
+			if game.GetConfig().GetSettings().GetTurnTimeLimit() <= 0 {
+				continue
+			}
+			if _, err := s.ExpireTurn(ctx, game.Id); err != nil {
+				log.Printf("Turn timer failed to expire turn in game %s: %v", game.Id, err)
+			}
+		}
+	}
+}
+
 func (w *FSGamesServiceImpl) GetRuntimeGame(game *v1.Game, gameState *v1.GameState) (out *weewar.Game, err error) {
 	return ProtoToRuntimeGame(game, gameState)
 }
@@ -0,0 +1,491 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+const testGameId = "test"
+
+// newTestGamesService creates a games service storing its games in a temporary directory
+// with a single game on a wide strip of grass.  Each player has a soldier at either end so
+// neither can see the other with fog of war.
+func newTestGamesService(t *testing.T, settings *v1.GameSettings) *FSGamesServiceImpl {
+	service := &FSGamesServiceImpl{storage: NewFileStorage(t.TempDir())}
+	service.Self = service
+
+	game := &v1.Game{
+		Id: testGameId,
+		Config: &v1.GameConfiguration{
+			Players:  []*v1.GamePlayer{{PlayerId: 1}, {PlayerId: 2}},
+			Settings: settings,
+		},
+	}
+	rulesEngine, err := GameRulesEngine(game)
+	if err != nil {
+		t.Fatalf("Failed to get rules: %v", err)
+	}
+
+	state := &v1.GameState{
+		GameId:        testGameId,
+		CurrentPlayer: 1,
+		TurnCounter:   1,
+		WorldData:     &v1.WorldData{},
+		RngSeed:       42,
+	}
+	startTurnClock(game, state, time.Now())
+	for q := int32(-8); q <= 8; q++ {
+		for r := int32(-1); r <= 1; r++ {
+			state.WorldData.Tiles = append(state.WorldData.Tiles, &v1.Tile{Q: q, R: r, TileType: 5})
+		}
+	}
+	for _, unit := range []*v1.Unit{{Q: -6, Player: 1, UnitType: 1}, {Q: 6, Player: 2, UnitType: 1}} {
+		unitData, err := rulesEngine.GetUnitData(unit.UnitType)
+		if err != nil {
+			t.Fatalf("Failed to get unit data: %v", err)
+		}
+		unit.AvailableHealth = unitData.Health
+		unit.DistanceLeft = unitData.MovementPoints
+		unit.TurnCounter = 1
+		unit.ActionsRemaining = rulesEngine.GetActionsPerTurn(unit.UnitType)
+		state.WorldData.Units = append(state.WorldData.Units, unit)
+	}
+	history := &v1.GameMoveHistory{
+		GameId:       testGameId,
+		InitialState: proto.Clone(state).(*v1.GameState),
+	}
+
+	for name, artifact := range map[string]proto.Message{"metadata": game, "state": state, "history": history} {
+		if err := service.storage.SaveArtifact(testGameId, name, artifact); err != nil {
+			t.Fatalf("Failed to save %s: %v", name, err)
+		}
+	}
+	return service
+}
+
+// moveUnit returns a player's move of a unit along the middle row of the test game
+func moveUnit(player int32, fromQ, toQ int32) *v1.GameMove {
+	return &v1.GameMove{
+		Player:   player,
+		MoveType: &v1.GameMove_MoveUnit{MoveUnit: &v1.MoveUnitAction{FromQ: fromQ, ToQ: toQ}},
+	}
+}
+
+// endTurn returns an end of turn move by a player
+func endTurn(player int32) *v1.GameMove {
+	return &v1.GameMove{Player: player, MoveType: &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{}}}
+}
+
+func TestGetGameStateWithFogOfWar(t *testing.T) {
+	service := newTestGamesService(t, &v1.GameSettings{FogOfWar: true})
+	ctx := context.Background()
+
+	if _, err := service.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: testGameId, Moves: []*v1.GameMove{moveUnit(1, -6, -5)}}); err != nil {
+		t.Fatalf("Failed to process moves: %v", err)
+	}
+
+	if _, err := service.GetGameState(ctx, &v1.GetGameStateRequest{GameId: testGameId}); err == nil {
+		t.Error("Expected the state of a fogged game to need a player")
+	}
+
+	resp, err := service.GetGameState(ctx, &v1.GetGameStateRequest{GameId: testGameId, Player: 1})
+	if err != nil {
+		t.Fatalf("Failed to get game state: %v", err)
+	}
+	if units := resp.State.WorldData.Units; len(units) != 1 || units[0].Player != 1 {
+		t.Errorf("Expected only player 1's unit, got %v", units)
+	}
+	if resp.State.LastSequenceNum != 1 {
+		t.Errorf("Expected last sequence number 1, got %d", resp.State.LastSequenceNum)
+	}
+	if resp.State.TurnStartedAt == nil || resp.State.UpdatedAt == nil {
+		t.Error("Expected the turn clock and update time to be kept")
+	}
+}
+
+func TestListMovesWithFogOfWarUsesVisibilityWhenMade(t *testing.T) {
+	service := newTestGamesService(t, &v1.GameSettings{FogOfWar: true})
+	ctx := context.Background()
+
+	// Player 2 sneaks up while hidden and player 1 then moves close enough to see where they went
+	for _, move := range []*v1.GameMove{
+		endTurn(1),
+		moveUnit(2, 6, 3), endTurn(2),
+		moveUnit(1, -6, -3), endTurn(1),
+		moveUnit(2, 3, 2), endTurn(2),
+		moveUnit(1, -3, 0),
+	} {
+		if _, err := service.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: testGameId, Moves: []*v1.GameMove{move}}); err != nil {
+			t.Fatalf("Failed to process move %v: %v", move, err)
+		}
+	}
+
+	resp, err := service.ListMoves(ctx, &v1.ListMovesRequest{GameId: testGameId, Player: 1})
+	if err != nil {
+		t.Fatalf("Failed to list moves: %v", err)
+	}
+	if len(resp.MoveGroups) != 8 {
+		t.Fatalf("Expected 8 move groups, got %d", len(resp.MoveGroups))
+	}
+	for _, i := range []int{1, 5} {
+		if moves := resp.MoveGroups[i].Moves; len(moves) != 0 {
+			t.Errorf("Expected player 2's hidden move in group %d to stay hidden, got %v", i, moves)
+		}
+	}
+	if moves := resp.MoveGroups[7].Moves; len(moves) != 1 || moves[0].GetMoveUnit() == nil {
+		t.Errorf("Expected player 1's own move, got %v", moves)
+	}
+
+	// Player 2 now sees player 1's soldier but not its earlier moves
+	resp, err = service.ListMoves(ctx, &v1.ListMovesRequest{GameId: testGameId, Player: 2})
+	if err != nil {
+		t.Fatalf("Failed to list moves: %v", err)
+	}
+	if moves := resp.MoveGroups[3].Moves; len(moves) != 0 {
+		t.Errorf("Expected player 1's hidden move to stay hidden, got %v", moves)
+	}
+	if moves := resp.MoveGroups[7].Moves; len(moves) != 1 || moves[0].GetMoveUnit() != nil {
+		t.Errorf("Expected player 1's move into view to be redacted, got %v", moves)
+	}
+}
+
+func TestGetGameWithFogOfWar(t *testing.T) {
+	service := newTestGamesService(t, &v1.GameSettings{FogOfWar: true})
+	ctx := context.Background()
+	for _, move := range []*v1.GameMove{endTurn(1), moveUnit(2, 6, 3)} {
+		if _, err := service.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: testGameId, Moves: []*v1.GameMove{move}}); err != nil {
+			t.Fatalf("Failed to process move %v: %v", move, err)
+		}
+	}
+
+	resp, err := service.GetGame(ctx, &v1.GetGameRequest{Id: testGameId})
+	if err != nil {
+		t.Fatalf("Failed to get game: %v", err)
+	}
+	if resp.Game == nil || resp.State != nil || resp.History != nil {
+		t.Errorf("Expected only the game's metadata without a player, got %v", resp)
+	}
+
+	resp, err = service.GetGame(ctx, &v1.GetGameRequest{Id: testGameId, Player: 1})
+	if err != nil {
+		t.Fatalf("Failed to get game: %v", err)
+	}
+	for name, state := range map[string]*v1.GameState{"state": resp.State, "initial state": resp.History.GetInitialState()} {
+		if units := state.GetWorldData().GetUnits(); len(units) != 1 || units[0].Player != 1 {
+			t.Errorf("Expected only player 1's unit in the %s, got %v", name, units)
+		}
+	}
+	if groups := resp.History.GetGroups(); len(groups) != 2 || len(groups[1].Moves) != 0 {
+		t.Errorf("Expected player 2's move to be hidden in the history, got %v", groups)
+	}
+
+	// The full game is still available within the service
+	loaded, err := service.LoadGame(ctx, testGameId)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	if len(loaded.State.WorldData.Units) != 2 || len(loaded.History.Groups[1].Moves) != 1 {
+		t.Errorf("Expected the loaded game to have everything, got %v", loaded)
+	}
+}
+
+func TestGetGameWithoutFogOfWar(t *testing.T) {
+	service := newTestGamesService(t, &v1.GameSettings{})
+	resp, err := service.GetGame(context.Background(), &v1.GetGameRequest{Id: testGameId})
+	if err != nil {
+		t.Fatalf("Failed to get game: %v", err)
+	}
+	if resp.State == nil || len(resp.State.WorldData.Units) != 2 || resp.History == nil {
+		t.Errorf("Expected the whole game, got %v", resp)
+	}
+}
+
+func TestProcessMovesExpectedLastSequenceNum(t *testing.T) {
+	tests := []struct {
+		name      string
+		previous  []*v1.GameMove // Moves made before the checked one
+		expected  int64
+		conflicts bool
+	}{
+		{name: "unchecked", previous: []*v1.GameMove{moveUnit(1, -6, -5)}, expected: 0},
+		{name: "no moves yet", expected: NoMovesSequenceNum},
+		{name: "moves already made", previous: []*v1.GameMove{moveUnit(1, -6, -5)}, expected: NoMovesSequenceNum, conflicts: true},
+		{name: "latest", previous: []*v1.GameMove{moveUnit(1, -6, -5)}, expected: 1},
+		{name: "stale", previous: []*v1.GameMove{moveUnit(1, -6, -5), moveUnit(1, -5, -4)}, expected: 1, conflicts: true},
+		{name: "ahead", expected: 3, conflicts: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := newTestGamesService(t, &v1.GameSettings{})
+			ctx := context.Background()
+			for _, move := range test.previous {
+				if _, err := service.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: testGameId, Moves: []*v1.GameMove{move}}); err != nil {
+					t.Fatalf("Failed to process move %v: %v", move, err)
+				}
+			}
+
+			_, err := service.ProcessMoves(ctx, &v1.ProcessMovesRequest{
+				GameId:                  testGameId,
+				Moves:                   []*v1.GameMove{endTurn(1)},
+				ExpectedLastSequenceNum: test.expected,
+			})
+			if conflicts := errors.Is(err, ErrSequenceConflict); conflicts != test.conflicts {
+				t.Fatalf("Expected conflict %t, got error %v", test.conflicts, err)
+			}
+			if !test.conflicts && err != nil {
+				t.Fatalf("Failed to process moves: %v", err)
+			}
+
+			// A rejected move is not recorded
+			loaded, err := service.LoadGame(ctx, testGameId)
+			if err != nil {
+				t.Fatalf("Failed to load game: %v", err)
+			}
+			want := int64(len(test.previous))
+			if !test.conflicts {
+				want++
+			}
+			if loaded.State.LastSequenceNum != want || len(loaded.History.Groups) != int(want) {
+				t.Errorf("Expected %d moves recorded, game is at %d with %d groups", want, loaded.State.LastSequenceNum, len(loaded.History.Groups))
+			}
+		})
+	}
+}
+
+func TestResponsesHideRandomStream(t *testing.T) {
+	for _, fogOfWar := range []bool{false, true} {
+		service := newTestGamesService(t, &v1.GameSettings{FogOfWar: fogOfWar})
+		ctx := context.Background()
+
+		gameResp, err := service.GetGame(ctx, &v1.GetGameRequest{Id: testGameId, Player: 1})
+		if err != nil {
+			t.Fatalf("Failed to get game: %v", err)
+		}
+		stateResp, err := service.GetGameState(ctx, &v1.GetGameStateRequest{GameId: testGameId, Player: 1})
+		if err != nil {
+			t.Fatalf("Failed to get game state: %v", err)
+		}
+		for name, state := range map[string]*v1.GameState{
+			"game state":    gameResp.State,
+			"initial state": gameResp.History.InitialState,
+			"latest state":  stateResp.State,
+		} {
+			if state.RngSeed != 0 || state.RngPosition != 0 {
+				t.Errorf("Expected no random stream in the %s with fog of war %t, got seed %d at %d", name, fogOfWar, state.RngSeed, state.RngPosition)
+			}
+		}
+
+		// The seed is still kept on the server
+		loaded, err := service.LoadGame(ctx, testGameId)
+		if err != nil {
+			t.Fatalf("Failed to load game: %v", err)
+		}
+		if loaded.State.RngSeed != 42 {
+			t.Errorf("Expected the saved seed to be kept, got %d", loaded.State.RngSeed)
+		}
+	}
+}
+
+func TestUndoMovesAfterGameWon(t *testing.T) {
+	service := newTestGamesService(t, &v1.GameSettings{})
+	ctx := context.Background()
+	if _, err := service.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: testGameId, Moves: []*v1.GameMove{moveUnit(1, -6, -5)}}); err != nil {
+		t.Fatalf("Failed to process moves: %v", err)
+	}
+
+	// Mark the move as the one that won the game
+	state, err := LoadFSArtifact[*v1.GameState](service.storage, testGameId, "state")
+	if err != nil {
+		t.Fatalf("Failed to load state: %v", err)
+	}
+	state.Winner, state.Winners, state.VictoryCondition = 1, []int32{1}, "elimination"
+	if err := service.storage.SaveArtifact(testGameId, "state", state); err != nil {
+		t.Fatalf("Failed to save state: %v", err)
+	}
+
+	if _, err := service.UndoMoves(ctx, &v1.UndoMovesRequest{GameId: testGameId}); err == nil {
+		t.Fatal("Expected the winning move to not be undoable")
+	}
+	loaded, err := service.LoadGame(ctx, testGameId)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	if len(loaded.History.Groups) != 1 || loaded.State.Winner != 1 {
+		t.Errorf("Expected the finished game to be unchanged, got winner %d with %d groups", loaded.State.Winner, len(loaded.History.Groups))
+	}
+}
+
+// testSubscribeStream collects the responses sent to a game subscriber
+type testSubscribeStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *v1.SubscribeGameResponse
+}
+
+func (s *testSubscribeStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testSubscribeStream) Send(resp *v1.SubscribeGameResponse) error {
+	s.sent <- resp
+	return nil
+}
+
+// receive waits for the next response sent to the subscriber
+func (s *testSubscribeStream) receive(t *testing.T) *v1.SubscribeGameResponse {
+	t.Helper()
+	select {
+	case resp := <-s.sent:
+		return resp
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected a response to be sent to the subscriber")
+		return nil
+	}
+}
+
+func TestSubscribeGame(t *testing.T) {
+	tests := []struct {
+		name            string
+		fogOfWar        bool
+		player          int32
+		fromSequenceNum int64
+		fails           bool
+		wantHistory     []int // Moves in each group sent from the history
+		wantLive        int   // Moves seen in player 2's live move and its undo
+	}{
+		{name: "whole history", wantHistory: []int{1, 1}, wantLive: 1},
+		{name: "resumed", fromSequenceNum: 1, wantHistory: []int{1}, wantLive: 1},
+		{name: "fog of war hides the opponent", fogOfWar: true, player: 1, wantHistory: []int{1, 1}},
+		{name: "fog of war shows the player's own moves", fogOfWar: true, player: 2, wantHistory: []int{0, 1}, wantLive: 1},
+		{name: "fog of war needs a player", fogOfWar: true, fails: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := newTestGamesService(t, &v1.GameSettings{FogOfWar: test.fogOfWar})
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			for _, move := range []*v1.GameMove{moveUnit(1, -6, -5), endTurn(1)} {
+				if _, err := service.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: testGameId, Moves: []*v1.GameMove{move}}); err != nil {
+					t.Fatalf("Failed to process move %v: %v", move, err)
+				}
+			}
+
+			stream := &testSubscribeStream{ctx: ctx, sent: make(chan *v1.SubscribeGameResponse, 10)}
+			done := make(chan error, 1)
+			go func() {
+				done <- service.SubscribeGame(&v1.SubscribeGameRequest{
+					GameId:          testGameId,
+					FromSequenceNum: test.fromSequenceNum,
+					Player:          test.player,
+				}, stream)
+			}()
+			if test.fails {
+				select {
+				case err := <-done:
+					if err == nil {
+						t.Error("Expected the subscription to fail")
+					}
+				case <-time.After(5 * time.Second):
+					t.Fatal("Expected the subscription to fail")
+				}
+				return
+			}
+
+			// Every case is sent some history so the subscriber is known to be listening before the live updates
+			for i, want := range test.wantHistory {
+				if moves := stream.receive(t).GetMoveGroup().GetMoves(); len(moves) != want {
+					t.Errorf("Expected %d moves in history group %d, got %v", want, i, moves)
+				}
+			}
+
+			// Player 2's move and its undo are sent live
+			if _, err := service.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: testGameId, Moves: []*v1.GameMove{moveUnit(2, 6, 5)}}); err != nil {
+				t.Fatalf("Failed to process moves: %v", err)
+			}
+			if moves := stream.receive(t).GetMoveGroup().GetMoves(); len(moves) != test.wantLive {
+				t.Errorf("Expected %d moves in the live group, got %v", test.wantLive, moves)
+			}
+			if _, err := service.UndoMoves(ctx, &v1.UndoMovesRequest{GameId: testGameId}); err != nil {
+				t.Fatalf("Failed to undo moves: %v", err)
+			}
+			undo := stream.receive(t).GetUndo()
+			if len(undo.GetUndoneGroups()) != 1 || len(undo.UndoneGroups[0].Moves) != test.wantLive {
+				t.Errorf("Expected the undone group with %d moves, got %v", test.wantLive, undo)
+			}
+
+			cancel()
+			select {
+			case err := <-done:
+				if err != nil {
+					t.Errorf("Expected the subscription to end cleanly, got %v", err)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("Expected the subscription to end when cancelled")
+			}
+			if len(stream.sent) != 0 {
+				t.Errorf("Expected nothing else to be sent, got %d more responses", len(stream.sent))
+			}
+		})
+	}
+}
+
+// slowLoadGamesService pauses after loading a game so concurrent requests interleave
+type slowLoadGamesService struct {
+	*FSGamesServiceImpl
+}
+
+func (s *slowLoadGamesService) LoadGame(ctx context.Context, gameId string) (*v1.GetGameResponse, error) {
+	resp, err := s.FSGamesServiceImpl.LoadGame(ctx, gameId)
+	time.Sleep(20 * time.Millisecond)
+	return resp, err
+}
+
+func TestProcessMovesAcrossServiceInstances(t *testing.T) {
+	// The gRPC and Connect servers each create their own service over the same storage
+	first := newTestGamesService(t, &v1.GameSettings{})
+	second := &FSGamesServiceImpl{storage: first.storage}
+	first.Self = &slowLoadGamesService{first}
+	second.Self = &slowLoadGamesService{second}
+	ctx := context.Background()
+
+	// Both services are sent the first move of the game at once and only one may make it
+	var wg sync.WaitGroup
+	errs := make(chan error, 2)
+	for _, service := range []*FSGamesServiceImpl{first, second} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := service.ProcessMoves(ctx, &v1.ProcessMovesRequest{
+				GameId:                  testGameId,
+				Moves:                   []*v1.GameMove{moveUnit(1, -6, -5)},
+				ExpectedLastSequenceNum: NoMovesSequenceNum,
+			})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	made := 0
+	for err := range errs {
+		if err == nil {
+			made++
+		} else if !errors.Is(err, ErrSequenceConflict) {
+			t.Errorf("Expected a sequence conflict, got %v", err)
+		}
+	}
+	loaded, err := second.LoadGame(ctx, testGameId)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	if made != 1 || loaded.State.LastSequenceNum != 1 || len(loaded.History.Groups) != 1 {
+		t.Errorf("Expected the move to be made once, %d were accepted and the game is at %d with %d groups", made, loaded.State.LastSequenceNum, len(loaded.History.Groups))
+	}
+}
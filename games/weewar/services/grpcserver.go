@@ -6,12 +6,16 @@ import (
 	"log"
 	"log/slog"
 	"net"
+	"time"
 
 	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
 
+// How often stored games are checked for turns that ran out of time
+var TURN_TIMER_INTERVAL = 5 * time.Second
+
 type Server struct {
 	Address string
 }
@@ -24,8 +28,10 @@ func (s *Server) Start(ctx context.Context, srvErr chan error, srvChan chan bool
 	)
 
 	// Register services
-	v1.RegisterGamesServiceServer(server, NewFSGamesService())
+	gamesService := NewFSGamesService()
+	v1.RegisterGamesServiceServer(server, gamesService)
 	v1.RegisterWorldsServiceServer(server, NewFSWorldsService())
+	v1.RegisterRulesServiceServer(server, NewRulesService())
 
 	l, err := net.Listen("tcp", s.Address)
 	if err != nil {
@@ -46,9 +52,14 @@ func (s *Server) Start(ctx context.Context, srvErr chan error, srvChan chan bool
 		}
 	}()
 
+	// End turns that run out of time
+	timerCtx, stopTimer := context.WithCancel(ctx)
+	go gamesService.RunTurnTimer(timerCtx, TURN_TIMER_INTERVAL)
+
 	// Handle shutdown signal
 	go func() {
 		<-srvChan // Wait for shutdown signal from main app
+		stopTimer()
 		slog.Info("Shutting down gRPC server...")
 		server.GracefulStop()
 		slog.Info("gRPC server stopped.")
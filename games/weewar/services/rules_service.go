@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+	weewar "github.com/panyam/turnengine/games/weewar/lib"
+)
+
+// RulesServiceImpl implements the RulesService gRPC interface over a rules registry
+type RulesServiceImpl struct {
+	v1.UnimplementedRulesServiceServer
+	Registry *weewar.RulesRegistry
+}
+
+// NewRulesService creates a RulesService serving the default rules registry
+func NewRulesService() *RulesServiceImpl {
+	return &RulesServiceImpl{Registry: weewar.DefaultRulesRegistry()}
+}
+
+// getRules returns the rules engine for a rules ID along with the resolved ID
+func (s *RulesServiceImpl) getRules(rulesId string) (string, *weewar.RulesEngine, error) {
+	if rulesId == "" {
+		rulesId = s.Registry.DefaultID()
+	}
+	rulesEngine, err := s.Registry.Get(rulesId)
+	return rulesId, rulesEngine, err
+}
+
+// GetRules returns a complete rule set
+func (s *RulesServiceImpl) GetRules(ctx context.Context, req *v1.GetRulesRequest) (resp *v1.GetRulesResponse, err error) {
+	rulesId, rulesEngine, err := s.getRules(req.RulesId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetRulesResponse{RulesId: rulesId, Rules: rulesEngine.RuleSet()}, nil
+}
+
+// GetUnit returns a unit's definition with its movement costs and attacks
+func (s *RulesServiceImpl) GetUnit(ctx context.Context, req *v1.GetUnitRequest) (resp *v1.GetUnitResponse, err error) {
+	rulesId, rulesEngine, err := s.getRules(req.RulesId)
+	if err != nil {
+		return nil, err
+	}
+	unit, err := rulesEngine.GetUnitData(req.UnitId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetUnitResponse{
+		RulesId:       rulesId,
+		Unit:          unit,
+		MovementCosts: rulesEngine.MovementMatrix.GetCosts()[req.UnitId],
+		Attacks:       rulesEngine.AttackMatrix.GetAttacks()[req.UnitId],
+	}, nil
+}
+
+// GetCombatTable returns the attack matrix, optionally for a single attacker or defender
+func (s *RulesServiceImpl) GetCombatTable(ctx context.Context, req *v1.GetCombatTableRequest) (resp *v1.GetCombatTableResponse, err error) {
+	rulesId, rulesEngine, err := s.getRules(req.RulesId)
+	if err != nil {
+		return nil, err
+	}
+	if req.AttackerId != 0 {
+		if _, err := rulesEngine.GetUnitData(req.AttackerId); err != nil {
+			return nil, fmt.Errorf("attacker: %w", err)
+		}
+	}
+	if req.DefenderId != 0 {
+		if _, err := rulesEngine.GetUnitData(req.DefenderId); err != nil {
+			return nil, fmt.Errorf("defender: %w", err)
+		}
+	}
+
+	table := &v1.AttackMatrix{Attacks: make(map[int32]*v1.DefenderDamageMap)}
+	for attackerId, defenders := range rulesEngine.AttackMatrix.GetAttacks() {
+		if req.AttackerId != 0 && attackerId != req.AttackerId {
+			continue
+		}
+		if req.DefenderId == 0 {
+			table.Attacks[attackerId] = defenders
+		} else if dist, exists := defenders.GetDefenderDamages()[req.DefenderId]; exists {
+			table.Attacks[attackerId] = &v1.DefenderDamageMap{DefenderDamages: map[int32]*v1.DamageDistribution{req.DefenderId: dist}}
+		}
+	}
+	return &v1.GetCombatTableResponse{RulesId: rulesId, AttackMatrix: table}, nil
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+func TestGetUnit(t *testing.T) {
+	service := NewRulesService()
+	defaultId := service.Registry.DefaultID()
+	tests := []struct {
+		name    string
+		rulesId string
+		unitId  int32
+		fails   bool
+	}{
+		{name: "default rules", unitId: 1},
+		{name: "named rules", rulesId: defaultId, unitId: 1},
+		{name: "unknown unit", unitId: 9999, fails: true},
+		{name: "unknown rules", rulesId: "unknown", unitId: 1, fails: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := service.GetUnit(context.Background(), &v1.GetUnitRequest{RulesId: test.rulesId, UnitId: test.unitId})
+			if test.fails {
+				if err == nil {
+					t.Errorf("Expected an error, got %v", resp)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed to get unit: %v", err)
+			}
+			if resp.RulesId != defaultId || resp.Unit.GetId() != test.unitId {
+				t.Errorf("Expected unit %d from rules %q, got unit %d from %q", test.unitId, defaultId, resp.Unit.GetId(), resp.RulesId)
+			}
+			if len(resp.Attacks.GetDefenderDamages()) == 0 {
+				t.Errorf("Expected the unit's attacks, got %v", resp.Attacks)
+			}
+		})
+	}
+}
+
+func TestGetCombatTable(t *testing.T) {
+	service := NewRulesService()
+	defaultId := service.Registry.DefaultID()
+	rulesEngine, err := service.Registry.Get(defaultId)
+	if err != nil {
+		t.Fatalf("Failed to get default rules: %v", err)
+	}
+	attacks := rulesEngine.AttackMatrix.GetAttacks()
+
+	// Attackers that can attack the soldier
+	soldierAttackers := 0
+	for _, defenders := range attacks {
+		if _, exists := defenders.GetDefenderDamages()[1]; exists {
+			soldierAttackers++
+		}
+	}
+
+	tests := []struct {
+		name          string
+		req           *v1.GetCombatTableRequest
+		fails         bool
+		wantAttackers int
+		wantDefenders int // Defenders per attacker, 0 for all of them
+	}{
+		{name: "whole table", req: &v1.GetCombatTableRequest{}, wantAttackers: len(attacks)},
+		{name: "named rules", req: &v1.GetCombatTableRequest{RulesId: defaultId}, wantAttackers: len(attacks)},
+		{name: "one attacker", req: &v1.GetCombatTableRequest{AttackerId: 1}, wantAttackers: 1},
+		{name: "one defender", req: &v1.GetCombatTableRequest{DefenderId: 1}, wantAttackers: soldierAttackers, wantDefenders: 1},
+		{name: "one attack", req: &v1.GetCombatTableRequest{AttackerId: 1, DefenderId: 1}, wantAttackers: 1, wantDefenders: 1},
+		{name: "unknown attacker", req: &v1.GetCombatTableRequest{AttackerId: 9999}, fails: true},
+		{name: "unknown defender", req: &v1.GetCombatTableRequest{DefenderId: 9999}, fails: true},
+		{name: "unknown rules", req: &v1.GetCombatTableRequest{RulesId: "unknown"}, fails: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := service.GetCombatTable(context.Background(), test.req)
+			if test.fails {
+				if err == nil {
+					t.Errorf("Expected an error, got %v", resp)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed to get combat table: %v", err)
+			}
+			if resp.RulesId != defaultId {
+				t.Errorf("Expected rules %q, got %q", defaultId, resp.RulesId)
+			}
+
+			table := resp.AttackMatrix.GetAttacks()
+			if len(table) != test.wantAttackers {
+				t.Fatalf("Expected %d attackers, got %d", test.wantAttackers, len(table))
+			}
+			for attackerId, defenders := range table {
+				if test.req.AttackerId != 0 && attackerId != test.req.AttackerId {
+					t.Errorf("Expected only attacker %d, got %d", test.req.AttackerId, attackerId)
+				}
+				want := len(attacks[attackerId].GetDefenderDamages())
+				if test.wantDefenders != 0 {
+					want = test.wantDefenders
+				}
+				if got := len(defenders.GetDefenderDamages()); got != want {
+					t.Errorf("Expected %d defenders for attacker %d, got %d", want, attackerId, got)
+				}
+				if _, exists := defenders.GetDefenderDamages()[test.req.DefenderId]; test.req.DefenderId != 0 && !exists {
+					t.Errorf("Expected attacker %d's damage against defender %d, got %v", attackerId, test.req.DefenderId, defenders)
+				}
+			}
+		})
+	}
+}
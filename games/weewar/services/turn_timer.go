@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"log"
+	"math"
+	"time"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+	weewar "github.com/panyam/turnengine/games/weewar/lib"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// startTurnClock starts the current turn's clock and sets its deadline if the game has a turn time limit
+func startTurnClock(game *v1.Game, state *v1.GameState, now time.Time) {
+	state.TurnStartedAt = timestamppb.New(now)
+	state.TurnDeadline = nil
+	if limit := game.GetConfig().GetSettings().GetTurnTimeLimit(); limit > 0 {
+		state.TurnDeadline = timestamppb.New(now.Add(time.Duration(limit) * time.Second))
+	}
+}
+
+// turnTimeRemaining returns the seconds left in the current turn or -1 if turns are not timed
+func turnTimeRemaining(state *v1.GameState, now time.Time) int32 {
+	if state.GetTurnDeadline() == nil {
+		return -1
+	}
+	remaining := state.TurnDeadline.AsTime().Sub(now).Seconds()
+	if remaining <= 0 {
+		return 0
+	}
+	return int32(math.Ceil(remaining))
+}
+
+// ExpireTurn ends the current turn of a game on the player's behalf if its deadline has
+// passed.  Returns whether the turn was ended.
+func (s *BaseGamesServiceImpl) ExpireTurn(ctx context.Context, gameId string) (bool, error) {
+	defer gameLocks.Lock(gameId)()
+
+	gameresp, err := s.Self.LoadGame(ctx, gameId)
+	if err != nil || gameresp.Game == nil || gameresp.State == nil || gameresp.History == nil {
+		return false, err
+	}
+	return s.expireTurn(ctx, gameresp, time.Now())
+}
+
+// expireTurn submits an EndTurn for the idle player of a loaded game whose turn deadline has
+// passed and saves the game.  Must be called with the game locked.
+func (s *BaseGamesServiceImpl) expireTurn(ctx context.Context, gameresp *v1.GetGameResponse, now time.Time) (bool, error) {
+	state := gameresp.State
+	if state.GetTurnDeadline() == nil || now.Before(state.TurnDeadline.AsTime()) {
+		return false, nil
+	}
+
+	rtGame, err := s.Self.GetRuntimeGame(gameresp.Game, state)
+	if err != nil {
+		return false, err
+	}
+	if _, hasWinner := rtGame.GetWinner(); hasWinner {
+		return false, nil
+	}
+
+	log.Printf("Turn %d of player %d in game %s timed out", state.TurnCounter, state.CurrentPlayer, gameresp.Game.Id)
+	moves := []*v1.GameMove{{
+		Player:      state.CurrentPlayer,
+		Timestamp:   timestamppb.New(now),
+		SequenceNum: state.LastSequenceNum + 1,
+		MoveType:    &v1.GameMove_EndTurn{EndTurn: &v1.EndTurnAction{TimedOut: true}},
+	}}
+
+	fogOfWar := gameresp.Game.GetConfig().GetSettings().GetFogOfWar()
+	var visibilities map[int32]*weewar.Visibility
+	if fogOfWar {
+		visibilities = playerVisibilities(rtGame)
+	}
+
+	var dmp weewar.DefaultMoveProcessor
+	results, err := dmp.ProcessMoves(rtGame, moves)
+	if err != nil {
+		return false, err
+	}
+	moveGroup := s.recordMoveGroup(gameresp, rtGame, moves, results, now)
+	update := &GameUpdate{MoveGroup: moveGroup}
+	if fogOfWar {
+		mergePlayerVisibilities(visibilities, rtGame)
+		update.PlayerViews = moveGroupViews(moveGroup, visibilities)
+	}
+
+	_, err = s.Self.UpdateGame(ctx, &v1.UpdateGameRequest{
+		GameId:     gameresp.Game.Id,
+		NewGame:    gameresp.Game,
+		NewState:   state,
+		NewHistory: gameresp.History,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	gameUpdates.Publish(gameresp.Game.Id, update)
+	return true, nil
+}
@@ -0,0 +1,246 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// timeOutTestTurn moves the current turn's deadline of the test game into the past
+func timeOutTestTurn(t *testing.T, service *FSGamesServiceImpl) {
+	state, err := LoadFSArtifact[*v1.GameState](service.storage, testGameId, "state")
+	if err != nil {
+		t.Fatalf("Failed to load state: %v", err)
+	}
+	state.TurnDeadline = timestamppb.New(time.Now().Add(-time.Second))
+	if err := service.storage.SaveArtifact(testGameId, "state", state); err != nil {
+		t.Fatalf("Failed to save state: %v", err)
+	}
+}
+
+// timedOutGroups returns the move groups of the test game that ended a turn that timed out
+func timedOutGroups(t *testing.T, service *FSGamesServiceImpl) (groups []*v1.GameMoveGroup) {
+	loaded, err := service.LoadGame(context.Background(), testGameId)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	for _, group := range loaded.History.Groups {
+		for _, move := range group.Moves {
+			if move.GetEndTurn().GetTimedOut() {
+				groups = append(groups, group)
+			}
+		}
+	}
+	return groups
+}
+
+func TestStartTurnClock(t *testing.T) {
+	now := time.Now()
+	state := &v1.GameState{}
+
+	startTurnClock(&v1.Game{}, state, now)
+	if !state.TurnStartedAt.AsTime().Equal(now) || state.TurnDeadline != nil {
+		t.Errorf("Expected an untimed turn to start now without a deadline, got %v", state)
+	}
+	if remaining := turnTimeRemaining(state, now); remaining != -1 {
+		t.Errorf("Expected -1 seconds remaining in an untimed turn, got %d", remaining)
+	}
+
+	game := &v1.Game{Config: &v1.GameConfiguration{Settings: &v1.GameSettings{TurnTimeLimit: 30}}}
+	startTurnClock(game, state, now)
+	if !state.TurnDeadline.AsTime().Equal(now.Add(30 * time.Second)) {
+		t.Errorf("Expected the deadline 30 seconds from now, got %v", state.TurnDeadline.AsTime())
+	}
+	for _, test := range []struct {
+		elapsed   time.Duration
+		remaining int32
+	}{
+		{0, 30},
+		{10*time.Second + time.Millisecond, 20},
+		{30 * time.Second, 0},
+		{time.Minute, 0},
+	} {
+		if remaining := turnTimeRemaining(state, now.Add(test.elapsed)); remaining != test.remaining {
+			t.Errorf("Expected %d seconds remaining after %v, got %d", test.remaining, test.elapsed, remaining)
+		}
+	}
+}
+
+func TestExpireTurn(t *testing.T) {
+	service := newTestGamesService(t, &v1.GameSettings{TurnTimeLimit: 30})
+	ctx := context.Background()
+
+	if expired, err := service.ExpireTurn(ctx, testGameId); err != nil || expired {
+		t.Fatalf("Expected a turn within its time limit to continue, got %t, %v", expired, err)
+	}
+
+	timeOutTestTurn(t, service)
+	if expired, err := service.ExpireTurn(ctx, testGameId); err != nil || !expired {
+		t.Fatalf("Expected the timed out turn to end, got %t, %v", expired, err)
+	}
+
+	loaded, err := service.LoadGame(ctx, testGameId)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	if loaded.State.CurrentPlayer != 2 || loaded.State.LastSequenceNum != 1 {
+		t.Errorf("Expected player 2's turn after one move, got player %d after %d", loaded.State.CurrentPlayer, loaded.State.LastSequenceNum)
+	}
+	groups := timedOutGroups(t, service)
+	if len(groups) != 1 || groups[0].Moves[0].Player != 1 || groups[0].Moves[0].SequenceNum != 1 {
+		t.Fatalf("Expected one timed out end of turn for player 1, got %v", groups)
+	}
+	if deadline := loaded.State.TurnDeadline.AsTime(); !deadline.After(time.Now()) {
+		t.Errorf("Expected player 2's turn to have a new deadline, got %v", deadline)
+	}
+
+	// The new turn has not timed out yet
+	if expired, err := service.ExpireTurn(ctx, testGameId); err != nil || expired {
+		t.Errorf("Expected player 2's turn to continue, got %t, %v", expired, err)
+	}
+}
+
+func TestProcessMovesAfterTurnTimedOut(t *testing.T) {
+	service := newTestGamesService(t, &v1.GameSettings{TurnTimeLimit: 30})
+	ctx := context.Background()
+	timeOutTestTurn(t, service)
+
+	// Player 1's move came in too late, their turn is ended instead
+	if _, err := service.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: testGameId, Moves: []*v1.GameMove{moveUnit(1, -6, -5)}}); err == nil {
+		t.Fatal("Expected a move after the turn timed out to be rejected")
+	}
+	if _, err := service.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: testGameId, Moves: []*v1.GameMove{endTurn(1)}}); err == nil {
+		t.Fatal("Expected ending the turn after it timed out to be rejected")
+	}
+	loaded, err := service.LoadGame(ctx, testGameId)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	if loaded.State.CurrentPlayer != 2 || len(loaded.History.Groups) != 1 || len(timedOutGroups(t, service)) != 1 {
+		t.Errorf("Expected only the timed out end of turn, got player %d with history %v", loaded.State.CurrentPlayer, loaded.History.Groups)
+	}
+
+	// Player 2 plays on
+	if _, err := service.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: testGameId, Moves: []*v1.GameMove{moveUnit(2, 6, 5)}}); err != nil {
+		t.Errorf("Failed to process player 2's move: %v", err)
+	}
+}
+
+func TestExpireTurnRacesWithMoves(t *testing.T) {
+	service := newTestGamesService(t, &v1.GameSettings{TurnTimeLimit: 30})
+	ctx := context.Background()
+	timeOutTestTurn(t, service)
+
+	// The timer and a late move arrive together and the turn is only ended once
+	var wg sync.WaitGroup
+	moveErrs := make(chan error, 4)
+	for range 4 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if _, err := service.ExpireTurn(ctx, testGameId); err != nil {
+				t.Errorf("Failed to expire turn: %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := service.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: testGameId, Moves: []*v1.GameMove{moveUnit(1, -6, -5)}})
+			moveErrs <- err
+		}()
+	}
+	wg.Wait()
+	close(moveErrs)
+
+	for err := range moveErrs {
+		if err == nil {
+			t.Error("Expected the late move to be rejected")
+		}
+	}
+	if groups := timedOutGroups(t, service); len(groups) != 1 {
+		t.Errorf("Expected the turn to be ended once, got %d timed out turns", len(groups))
+	}
+	loaded, err := service.LoadGame(ctx, testGameId)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	if loaded.State.CurrentPlayer != 2 || loaded.State.LastSequenceNum != 1 {
+		t.Errorf("Expected player 2's turn after one move, got player %d after %d", loaded.State.CurrentPlayer, loaded.State.LastSequenceNum)
+	}
+}
+
+func TestRunTurnTimer(t *testing.T) {
+	service := newTestGamesService(t, &v1.GameSettings{TurnTimeLimit: 30})
+	timeOutTestTurn(t, service)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan bool)
+	go func() {
+		service.RunTurnTimer(ctx, 10*time.Millisecond)
+		close(done)
+	}()
+
+	// The game is only read between the timer's saves
+	timedOut := func() bool {
+		defer gameLocks.Lock(testGameId)()
+		return len(timedOutGroups(t, service)) > 0
+	}
+	for start := time.Now(); !timedOut(); time.Sleep(10 * time.Millisecond) {
+		if time.Since(start) > 5*time.Second {
+			t.Fatal("Expected the turn timer to end the timed out turn")
+		}
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the turn timer to stop when cancelled")
+	}
+	if groups := timedOutGroups(t, service); len(groups) != 1 {
+		t.Errorf("Expected the turn to be ended once, got %d timed out turns", len(groups))
+	}
+}
+
+func TestExpireTurnAcrossServiceInstances(t *testing.T) {
+	// The turn timer runs on the gRPC server's service while moves also come in through Connect
+	timer := newTestGamesService(t, &v1.GameSettings{TurnTimeLimit: 30})
+	connect := &FSGamesServiceImpl{storage: timer.storage}
+	timer.Self = &slowLoadGamesService{timer}
+	connect.Self = &slowLoadGamesService{connect}
+	ctx := context.Background()
+	timeOutTestTurn(t, timer)
+
+	// Player 2 moves as soon as player 1's turn times out
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		if _, err := timer.ExpireTurn(ctx, testGameId); err != nil {
+			t.Errorf("Failed to expire turn: %v", err)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		if _, err := connect.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: testGameId, Moves: []*v1.GameMove{moveUnit(2, 6, 5)}}); err != nil {
+			t.Errorf("Failed to process player 2's move: %v", err)
+		}
+	}()
+	wg.Wait()
+
+	loaded, err := connect.LoadGame(ctx, testGameId)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	if groups := timedOutGroups(t, connect); len(groups) != 1 || loaded.State.LastSequenceNum != 2 || len(loaded.History.Groups) != 2 {
+		t.Errorf("Expected one timed out turn then player 2's move, got %d timed out turns and %d groups at %d", len(groups), len(loaded.History.Groups), loaded.State.LastSequenceNum)
+	}
+	for i, group := range loaded.History.Groups {
+		if seq := group.Moves[0].SequenceNum; seq != int64(i+1) {
+			t.Errorf("Expected group %d to have sequence number %d, got %d", i, i+1, seq)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+)
+
+func TestUndoMoves(t *testing.T) {
+	tests := []struct {
+		name       string
+		fogOfWar   bool
+		moves      []*v1.GameMove
+		count      int32
+		fails      bool
+		wantUndone []int // Moves in each undone group of the response, latest first
+		wantGroups int   // Groups left in the history
+		wantQ      int32 // Where player 1's soldier ends up
+	}{
+		{name: "last group", moves: []*v1.GameMove{moveUnit(1, -6, -5), moveUnit(1, -5, -4)}, wantUndone: []int{1}, wantGroups: 1, wantQ: -5},
+		{name: "several groups", moves: []*v1.GameMove{moveUnit(1, -6, -5), moveUnit(1, -5, -4)}, count: 2, wantUndone: []int{1, 1}, wantQ: -6},
+		{name: "more than the history", moves: []*v1.GameMove{moveUnit(1, -6, -5)}, count: 2, fails: true, wantGroups: 1, wantQ: -5},
+		{name: "empty history", fails: true, wantQ: -6},
+		{name: "fog of war shows the mover's view", fogOfWar: true, moves: []*v1.GameMove{moveUnit(1, -6, -5)}, count: 1, wantUndone: []int{1}, wantQ: -6},
+		{name: "past the end of a turn", moves: []*v1.GameMove{moveUnit(1, -6, -5), endTurn(1)}, fails: true, wantGroups: 2, wantQ: -5},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := newTestGamesService(t, &v1.GameSettings{FogOfWar: test.fogOfWar})
+			ctx := context.Background()
+			for _, move := range test.moves {
+				if _, err := service.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: testGameId, Moves: []*v1.GameMove{move}}); err != nil {
+					t.Fatalf("Failed to process move %v: %v", move, err)
+				}
+			}
+
+			resp, err := service.UndoMoves(ctx, &v1.UndoMovesRequest{GameId: testGameId, Count: test.count})
+			if test.fails != (err != nil) {
+				t.Fatalf("Expected failure %t, got error %v", test.fails, err)
+			}
+			if !test.fails {
+				if len(resp.UndoneGroups) != len(test.wantUndone) || len(resp.Changes) == 0 {
+					t.Fatalf("Expected %d undone groups with their changes, got %v", len(test.wantUndone), resp)
+				}
+				for i, want := range test.wantUndone {
+					if moves := resp.UndoneGroups[i].Moves; len(moves) != want {
+						t.Errorf("Expected %d moves in undone group %d, got %v", want, i, moves)
+					}
+				}
+			}
+
+			loaded, err := service.LoadGame(ctx, testGameId)
+			if err != nil {
+				t.Fatalf("Failed to load game: %v", err)
+			}
+			if len(loaded.History.Groups) != test.wantGroups {
+				t.Errorf("Expected %d groups left in the history, got %d", test.wantGroups, len(loaded.History.Groups))
+			}
+			for _, unit := range loaded.State.WorldData.Units {
+				if unit.Player == 1 && unit.Q != test.wantQ {
+					t.Errorf("Expected player 1's soldier at %d, got %d", test.wantQ, unit.Q)
+				}
+			}
+		})
+	}
+}
+
+func TestUndoMovesRevealingEnemies(t *testing.T) {
+	service := newTestGamesService(t, &v1.GameSettings{FogOfWar: true})
+	ctx := context.Background()
+
+	// Bring player 2's soldier within sight of where player 1's soldier moves to
+	state, err := LoadFSArtifact[*v1.GameState](service.storage, testGameId, "state")
+	if err != nil {
+		t.Fatalf("Failed to load state: %v", err)
+	}
+	for _, unit := range state.WorldData.Units {
+		if unit.Player == 2 {
+			unit.Q = -2
+		}
+	}
+	if err := service.storage.SaveArtifact(testGameId, "state", state); err != nil {
+		t.Fatalf("Failed to save state: %v", err)
+	}
+
+	if _, err := service.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: testGameId, Moves: []*v1.GameMove{moveUnit(1, -6, -4)}}); err != nil {
+		t.Fatalf("Failed to process moves: %v", err)
+	}
+	if _, err := service.UndoMoves(ctx, &v1.UndoMovesRequest{GameId: testGameId}); err == nil {
+		t.Fatal("Expected a move that revealed an enemy to not be undoable")
+	}
+	loaded, err := service.LoadGame(ctx, testGameId)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	if len(loaded.History.Groups) != 1 {
+		t.Errorf("Expected the revealing move to be kept, got %d groups", len(loaded.History.Groups))
+	}
+}
+
+func TestUndoMovesAfterTurnTimedOut(t *testing.T) {
+	service := newTestGamesService(t, &v1.GameSettings{TurnTimeLimit: 30})
+	ctx := context.Background()
+	if _, err := service.ProcessMoves(ctx, &v1.ProcessMovesRequest{GameId: testGameId, Moves: []*v1.GameMove{moveUnit(1, -6, -5)}}); err != nil {
+		t.Fatalf("Failed to process moves: %v", err)
+	}
+	timeOutTestTurn(t, service)
+
+	// The turn is ended before the timer gets to it so its moves stay
+	if _, err := service.UndoMoves(ctx, &v1.UndoMovesRequest{GameId: testGameId}); err == nil {
+		t.Fatal("Expected moves of a timed out turn to not be undoable")
+	}
+	loaded, err := service.LoadGame(ctx, testGameId)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	if loaded.State.CurrentPlayer != 2 || len(loaded.History.Groups) != 2 || len(timedOutGroups(t, service)) != 1 {
+		t.Errorf("Expected the move and the timed out end of turn, got player %d with history %v", loaded.State.CurrentPlayer, loaded.History.Groups)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"reflect"
+	"slices"
 
 	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
 	weewar "github.com/panyam/turnengine/games/weewar/lib"
@@ -45,6 +46,17 @@ func newProtoInstance[T proto.Message]() (out T) {
 	return
 }
 
+// GameRulesEngine returns the rules a game is played under - the registered rule set it was created
+// with and its settings' allowed units and rules overlay
+func GameRulesEngine(game *v1.Game) (*weewar.RulesEngine, error) {
+	rulesEngine, err := weewar.DefaultRulesRegistry().Get(game.GetRulesId())
+	if err != nil {
+		return nil, fmt.Errorf("game %s: %w", game.GetId(), err)
+	}
+	settings := game.GetConfig().GetSettings()
+	return rulesEngine.WithOverlay(settings.GetAllowedUnits(), settings.GetRulesOverlay()), nil
+}
+
 func ProtoToRuntimeGame(game *v1.Game, gameState *v1.GameState) (*weewar.Game, error) {
 	// Create the runtime game from the protobuf data
 	world := weewar.NewWorld(game.Name)
@@ -52,8 +64,7 @@ func ProtoToRuntimeGame(game *v1.Game, gameState *v1.GameState) (*weewar.Game, e
 	// Convert protobuf tiles to runtime tiles
 	if gameState.WorldData != nil {
 		for _, protoTile := range gameState.WorldData.Tiles {
-			coord := weewar.AxialCoord{Q: int(protoTile.Q), R: int(protoTile.R)}
-			world.SetTileType(coord, int(protoTile.TileType))
+			world.AddTile(weewar.CopyTile(protoTile))
 		}
 
 		// Convert protobuf units to runtime units
@@ -74,13 +85,48 @@ func ProtoToRuntimeGame(game *v1.Game, gameState *v1.GameState) (*weewar.Game, e
 		}
 	}
 
-	// Create the runtime game with loaded default rules engine
-	rulesEngine := weewar.DefaultRulesEngine()           // Use loaded default rules engine
-	out, err := weewar.NewGame(world, rulesEngine, 12345) // Default seed
+	// Create the runtime game with the rules it was created under and the game's own changes to them
+	rulesEngine, err := GameRulesEngine(game)
+	if err != nil {
+		return nil, err
+	}
+	out, err := weewar.NewGame(world, rulesEngine, gameState.RngSeed)
 	if err != nil {
 		return nil, err
 	}
 
+	// Continue the random stream from where the last request left it
+	out.SeekRNG(gameState.RngPosition)
+
+	// NewGame initializes units as if the game just started so restore their saved health, movement, actions and cargo
+	if gameState.WorldData != nil {
+		// Games saved before units had a number of actions have none recorded for any unit.
+		// Their units get a turn's actions unless they have already attacked this turn.
+		legacyActions := !slices.ContainsFunc(gameState.WorldData.Units, func(unit *v1.Unit) bool {
+			return unit.ActionsRemaining > 0
+		})
+		migrateActions := func(unit *v1.Unit) {
+			if legacyActions && unit.ActionsRemaining == 0 && !unit.HasAttacked {
+				unit.ActionsRemaining = rulesEngine.GetActionsPerTurn(unit.UnitType)
+			}
+		}
+
+		for _, protoUnit := range gameState.WorldData.Units {
+			if unit := out.World.UnitAt(weewar.UnitGetCoord(protoUnit)); unit != nil {
+				unit.AvailableHealth = protoUnit.AvailableHealth
+				unit.DistanceLeft = protoUnit.DistanceLeft
+				unit.HasMoved = protoUnit.HasMoved
+				unit.HasAttacked = protoUnit.HasAttacked
+				unit.ActionsRemaining = protoUnit.ActionsRemaining
+				unit.Cargo = weewar.CopyUnits(protoUnit.Cargo)
+				migrateActions(unit)
+				for _, cargo := range unit.Cargo {
+					migrateActions(cargo)
+				}
+			}
+		}
+	}
+
 	// Debug: Check unit movement points after NewGame initialization
 	if out.World != nil {
 		for playerId := 1; playerId <= int(out.World.PlayerCount()); playerId++ {
@@ -98,7 +144,41 @@ func ProtoToRuntimeGame(game *v1.Game, gameState *v1.GameState) (*weewar.Game, e
 		// Set current player and turn counter from GameState
 		out.CurrentPlayer = gameState.CurrentPlayer
 		out.TurnCounter = gameState.TurnCounter
+
+		// Restore the economy
+		out.CoinSettings = game.GetConfig().GetSettings().GetCoins()
+		for playerId, coins := range gameState.PlayerCoins {
+			out.PlayerCoins[playerId] = coins
+		}
+
+		out.FogOfWar = game.GetConfig().GetSettings().GetFogOfWar()
+
+		// And how the game is won
+		out.VictorySettings = game.GetConfig().GetSettings().GetVictory()
+		out.MaxTurns = game.GetConfig().GetSettings().GetMaxTurns()
+		if game.GetConfig().GetSettings().GetTeamMode() == "teams" {
+			out.PlayerTeams = weewar.Teams{}
+			for _, player := range game.GetConfig().GetPlayers() {
+				if player.TeamId > 0 {
+					out.PlayerTeams[player.PlayerId] = player.TeamId
+				}
+			}
+		}
+		if len(gameState.Winners) > 0 {
+			out.SetWinners(gameState.Winners, gameState.VictoryCondition)
+		} else if gameState.Winner > 0 {
+			out.SetWinners([]int32{gameState.Winner}, gameState.VictoryCondition)
+		}
 	}
 
 	return out, nil
 }
+
+// hexCoords converts a path of runtime coordinates to its protobuf form
+func hexCoords(path []weewar.AxialCoord) []*v1.HexCoord {
+	out := make([]*v1.HexCoord, len(path))
+	for i, coord := range path {
+		out[i] = &v1.HexCoord{Q: int32(coord.Q), R: int32(coord.R)}
+	}
+	return out
+}
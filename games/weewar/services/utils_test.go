@@ -0,0 +1,75 @@
+package services
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
+	weewar "github.com/panyam/turnengine/games/weewar/lib"
+)
+
+// A game state saved before units had a number of actions.  The soldier at (1, 0) has
+// already attacked this turn.
+const legacyGameState = `{
+  "game_id": "legacy",
+  "turn_counter": 3,
+  "current_player": 1,
+  "world_data": {
+    "tiles": [
+      {"q": 0, "r": 0, "tile_type": 5},
+      {"q": 1, "r": 0, "tile_type": 5},
+      {"q": 2, "r": 0, "tile_type": 5},
+      {"q": 3, "r": 0, "tile_type": 5}
+    ],
+    "units": [
+      {"q": 0, "r": 0, "player": 1, "unit_type": 1, "available_health": 100, "distance_left": 3, "turn_counter": 3},
+      {"q": 1, "r": 0, "player": 1, "unit_type": 1, "available_health": 100, "distance_left": 3, "turn_counter": 3, "has_attacked": true},
+      {"q": 3, "r": 0, "player": 2, "unit_type": 1, "available_health": 100, "distance_left": 3, "turn_counter": 2}
+    ]
+  }
+}`
+
+func TestProtoToRuntimeGameMigratesLegacyActions(t *testing.T) {
+	storage := NewFileStorage(t.TempDir())
+	if err := os.MkdirAll(filepath.Join(storage.storageDir, "legacy"), 0755); err != nil {
+		t.Fatalf("Failed to create game directory: %v", err)
+	}
+	if err := os.WriteFile(storage.getArtifactPath("legacy", "state"), []byte(legacyGameState), 0644); err != nil {
+		t.Fatalf("Failed to write legacy state: %v", err)
+	}
+	state, err := LoadFSArtifact[*v1.GameState](storage, "legacy", "state")
+	if err != nil {
+		t.Fatalf("Failed to load legacy state: %v", err)
+	}
+
+	game := &v1.Game{Id: "legacy"}
+	rtGame, err := ProtoToRuntimeGame(game, state)
+	if err != nil {
+		t.Fatalf("Failed to create runtime game: %v", err)
+	}
+	actionsPerTurn := rtGame.GetRulesEngine().GetActionsPerTurn(1)
+	for _, test := range []struct {
+		q       int
+		actions int32
+	}{
+		{0, actionsPerTurn}, // Has not attacked yet
+		{1, 0},              // Already attacked this turn
+		{3, actionsPerTurn}, // Waiting for its turn
+	} {
+		if unit := rtGame.World.UnitAt(weewar.AxialCoord{Q: test.q}); unit.ActionsRemaining != test.actions {
+			t.Errorf("Expected unit at %d to have %d actions, got %d", test.q, test.actions, unit.ActionsRemaining)
+		}
+	}
+
+	// Units in current saves that have used up their actions keep them used up
+	state.WorldData.Units[0].ActionsRemaining = 0
+	state.WorldData.Units[2].ActionsRemaining = actionsPerTurn
+	rtGame, err = ProtoToRuntimeGame(game, state)
+	if err != nil {
+		t.Fatalf("Failed to create runtime game: %v", err)
+	}
+	if unit := rtGame.World.UnitAt(weewar.AxialCoord{Q: 0}); unit.ActionsRemaining != 0 {
+		t.Errorf("Expected the spent unit to keep no actions, got %d", unit.ActionsRemaining)
+	}
+}
@@ -71,7 +71,7 @@ func (w *WasmGamesServiceImpl) Load(
 
 // WASM-specific implementations that operate on singleton data
 
-func (w *WasmGamesServiceImpl) GetGame(ctx context.Context, req *v1.GetGameRequest) (*v1.GetGameResponse, error) {
+func (w *WasmGamesServiceImpl) LoadGame(ctx context.Context, gameId string) (*v1.GetGameResponse, error) {
 	return &v1.GetGameResponse{
 		Game:    w.SingletonGame,
 		State:   w.SingletonGameState,
@@ -81,7 +81,7 @@ func (w *WasmGamesServiceImpl) GetGame(ctx context.Context, req *v1.GetGameReque
 
 func (w *WasmGamesServiceImpl) GetGameState(ctx context.Context, req *v1.GetGameStateRequest) (*v1.GetGameStateResponse, error) {
 	return &v1.GetGameStateResponse{
-		State: w.SingletonGameState,
+		State: publicGameState(w.SingletonGameState),
 	}, nil
 }
 
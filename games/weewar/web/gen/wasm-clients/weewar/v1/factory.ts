@@ -2,10 +2,10 @@
 
 
 
-import { User as UserInterface, Pagination as PaginationInterface, PaginationResponse as PaginationResponseInterface, World as WorldInterface, WorldData as WorldDataInterface, Tile as TileInterface, Unit as UnitInterface, TerrainDefinition as TerrainDefinitionInterface, UnitDefinition as UnitDefinitionInterface, MovementMatrix as MovementMatrixInterface, TerrainCostMap as TerrainCostMapInterface, Game as GameInterface, GameConfiguration as GameConfigurationInterface, GamePlayer as GamePlayerInterface, GameSettings as GameSettingsInterface, GameState as GameStateInterface, GameMoveHistory as GameMoveHistoryInterface, GameMoveGroup as GameMoveGroupInterface, GameMove as GameMoveInterface, GameMoveResult as GameMoveResultInterface, MoveUnitAction as MoveUnitActionInterface, AttackUnitAction as AttackUnitActionInterface, EndTurnAction as EndTurnActionInterface, WorldChange as WorldChangeInterface, UnitMovedChange as UnitMovedChangeInterface, UnitDamagedChange as UnitDamagedChangeInterface, UnitKilledChange as UnitKilledChangeInterface, PlayerChangedChange as PlayerChangedChangeInterface, GameInfo as GameInfoInterface, ListGamesRequest as ListGamesRequestInterface, ListGamesResponse as ListGamesResponseInterface, GetGameRequest as GetGameRequestInterface, GetGameResponse as GetGameResponseInterface, GetGameContentRequest as GetGameContentRequestInterface, GetGameContentResponse as GetGameContentResponseInterface, UpdateGameRequest as UpdateGameRequestInterface, UpdateGameResponse as UpdateGameResponseInterface, DeleteGameRequest as DeleteGameRequestInterface, DeleteGameResponse as DeleteGameResponseInterface, GetGamesRequest as GetGamesRequestInterface, GetGamesResponse as GetGamesResponseInterface, CreateGameRequest as CreateGameRequestInterface, CreateGameResponse as CreateGameResponseInterface, ProcessMovesRequest as ProcessMovesRequestInterface, ProcessMovesResponse as ProcessMovesResponseInterface, GetGameStateRequest as GetGameStateRequestInterface, GetGameStateResponse as GetGameStateResponseInterface, ListMovesRequest as ListMovesRequestInterface, ListMovesResponse as ListMovesResponseInterface, GetOptionsAtRequest as GetOptionsAtRequestInterface, GetOptionsAtResponse as GetOptionsAtResponseInterface, GameOption as GameOptionInterface, EndTurnOption as EndTurnOptionInterface, MoveOption as MoveOptionInterface, AttackOption as AttackOptionInterface, BuildUnitOption as BuildUnitOptionInterface, CaptureBuildingOption as CaptureBuildingOptionInterface, UserInfo as UserInfoInterface, ListUsersRequest as ListUsersRequestInterface, ListUsersResponse as ListUsersResponseInterface, GetUserRequest as GetUserRequestInterface, GetUserResponse as GetUserResponseInterface, GetUserContentRequest as GetUserContentRequestInterface, GetUserContentResponse as GetUserContentResponseInterface, UpdateUserRequest as UpdateUserRequestInterface, UpdateUserResponse as UpdateUserResponseInterface, DeleteUserRequest as DeleteUserRequestInterface, DeleteUserResponse as DeleteUserResponseInterface, GetUsersRequest as GetUsersRequestInterface, GetUsersResponse as GetUsersResponseInterface, CreateUserRequest as CreateUserRequestInterface, CreateUserResponse as CreateUserResponseInterface, WorldInfo as WorldInfoInterface, ListWorldsRequest as ListWorldsRequestInterface, ListWorldsResponse as ListWorldsResponseInterface, GetWorldRequest as GetWorldRequestInterface, GetWorldResponse as GetWorldResponseInterface, UpdateWorldRequest as UpdateWorldRequestInterface, UpdateWorldResponse as UpdateWorldResponseInterface, DeleteWorldRequest as DeleteWorldRequestInterface, DeleteWorldResponse as DeleteWorldResponseInterface, GetWorldsRequest as GetWorldsRequestInterface, GetWorldsResponse as GetWorldsResponseInterface, CreateWorldRequest as CreateWorldRequestInterface, CreateWorldResponse as CreateWorldResponseInterface } from "./interfaces";
+import { User as UserInterface, Pagination as PaginationInterface, PaginationResponse as PaginationResponseInterface, World as WorldInterface, WorldData as WorldDataInterface, Tile as TileInterface, Unit as UnitInterface, TerrainDefinition as TerrainDefinitionInterface, UnitDefinition as UnitDefinitionInterface, MovementRules as MovementRulesInterface, CombatRules as CombatRulesInterface, TerrainActionRules as TerrainActionRulesInterface, TerrainAction as TerrainActionInterface, ClassDamageModifiers as ClassDamageModifiersInterface, MovementMatrix as MovementMatrixInterface, TerrainCostMap as TerrainCostMapInterface, AttackMatrix as AttackMatrixInterface, DefenderDamageMap as DefenderDamageMapInterface, DamageDistribution as DamageDistributionInterface, DamageBucket as DamageBucketInterface, RuleSet as RuleSetInterface, Game as GameInterface, GameConfiguration as GameConfigurationInterface, GamePlayer as GamePlayerInterface, GameSettings as GameSettingsInterface, RulesOverlay as RulesOverlayInterface, VictorySettings as VictorySettingsInterface, VictoryProgress as VictoryProgressInterface, CoinSettings as CoinSettingsInterface, GameState as GameStateInterface, GameMoveHistory as GameMoveHistoryInterface, GameMoveGroup as GameMoveGroupInterface, GameMove as GameMoveInterface, GameMoveResult as GameMoveResultInterface, HexCoord as HexCoordInterface, MoveUnitAction as MoveUnitActionInterface, AttackUnitAction as AttackUnitActionInterface, EndTurnAction as EndTurnActionInterface, BuildUnitAction as BuildUnitActionInterface, CaptureBuildingAction as CaptureBuildingActionInterface, ModifyTerrainAction as ModifyTerrainActionInterface, LoadUnitAction as LoadUnitActionInterface, UnloadUnitAction as UnloadUnitActionInterface, HealUnitAction as HealUnitActionInterface, WorldChange as WorldChangeInterface, UnitMovedChange as UnitMovedChangeInterface, UnitDamagedChange as UnitDamagedChangeInterface, UnitHealedChange as UnitHealedChangeInterface, UnitKilledChange as UnitKilledChangeInterface, PlayerChangedChange as PlayerChangedChangeInterface, CoinsChangedChange as CoinsChangedChangeInterface, UnitCreatedChange as UnitCreatedChangeInterface, TileCapturedChange as TileCapturedChangeInterface, TileChangedChange as TileChangedChangeInterface, UnitLoadedChange as UnitLoadedChangeInterface, UnitUnloadedChange as UnitUnloadedChangeInterface, GameInfo as GameInfoInterface, ListGamesRequest as ListGamesRequestInterface, ListGamesResponse as ListGamesResponseInterface, GetGameRequest as GetGameRequestInterface, GetGameResponse as GetGameResponseInterface, GetGameContentRequest as GetGameContentRequestInterface, GetGameContentResponse as GetGameContentResponseInterface, UpdateGameRequest as UpdateGameRequestInterface, UpdateGameResponse as UpdateGameResponseInterface, DeleteGameRequest as DeleteGameRequestInterface, DeleteGameResponse as DeleteGameResponseInterface, GetGamesRequest as GetGamesRequestInterface, GetGamesResponse as GetGamesResponseInterface, CreateGameRequest as CreateGameRequestInterface, CreateGameResponse as CreateGameResponseInterface, ProcessMovesRequest as ProcessMovesRequestInterface, ProcessMovesResponse as ProcessMovesResponseInterface, VerifyGameRequest as VerifyGameRequestInterface, VerifyGameResponse as VerifyGameResponseInterface, GameDivergence as GameDivergenceInterface, UndoMovesRequest as UndoMovesRequestInterface, UndoMovesResponse as UndoMovesResponseInterface, SubscribeGameRequest as SubscribeGameRequestInterface, SubscribeGameResponse as SubscribeGameResponseInterface, GetGameStateRequest as GetGameStateRequestInterface, GetGameStateResponse as GetGameStateResponseInterface, ListMovesRequest as ListMovesRequestInterface, ListMovesResponse as ListMovesResponseInterface, GetOptionsAtRequest as GetOptionsAtRequestInterface, GetOptionsAtResponse as GetOptionsAtResponseInterface, GetPathRequest as GetPathRequestInterface, GetPathResponse as GetPathResponseInterface, PathStep as PathStepInterface, GameOption as GameOptionInterface, EndTurnOption as EndTurnOptionInterface, MoveOption as MoveOptionInterface, AttackOption as AttackOptionInterface, BuildUnitOption as BuildUnitOptionInterface, CaptureBuildingOption as CaptureBuildingOptionInterface, ModifyTerrainOption as ModifyTerrainOptionInterface, LoadUnitOption as LoadUnitOptionInterface, UnloadUnitOption as UnloadUnitOptionInterface, HealUnitOption as HealUnitOptionInterface, GetRulesRequest as GetRulesRequestInterface, GetRulesResponse as GetRulesResponseInterface, GetUnitRequest as GetUnitRequestInterface, GetUnitResponse as GetUnitResponseInterface, GetCombatTableRequest as GetCombatTableRequestInterface, GetCombatTableResponse as GetCombatTableResponseInterface, UserInfo as UserInfoInterface, ListUsersRequest as ListUsersRequestInterface, ListUsersResponse as ListUsersResponseInterface, GetUserRequest as GetUserRequestInterface, GetUserResponse as GetUserResponseInterface, GetUserContentRequest as GetUserContentRequestInterface, GetUserContentResponse as GetUserContentResponseInterface, UpdateUserRequest as UpdateUserRequestInterface, UpdateUserResponse as UpdateUserResponseInterface, DeleteUserRequest as DeleteUserRequestInterface, DeleteUserResponse as DeleteUserResponseInterface, GetUsersRequest as GetUsersRequestInterface, GetUsersResponse as GetUsersResponseInterface, CreateUserRequest as CreateUserRequestInterface, CreateUserResponse as CreateUserResponseInterface, WorldInfo as WorldInfoInterface, ListWorldsRequest as ListWorldsRequestInterface, ListWorldsResponse as ListWorldsResponseInterface, GetWorldRequest as GetWorldRequestInterface, GetWorldResponse as GetWorldResponseInterface, UpdateWorldRequest as UpdateWorldRequestInterface, UpdateWorldResponse as UpdateWorldResponseInterface, DeleteWorldRequest as DeleteWorldRequestInterface, DeleteWorldResponse as DeleteWorldResponseInterface, GetWorldsRequest as GetWorldsRequestInterface, GetWorldsResponse as GetWorldsResponseInterface, CreateWorldRequest as CreateWorldRequestInterface, CreateWorldResponse as CreateWorldResponseInterface } from "./interfaces";
 
 
-import { User as ConcreteUser, Pagination as ConcretePagination, PaginationResponse as ConcretePaginationResponse, World as ConcreteWorld, WorldData as ConcreteWorldData, Tile as ConcreteTile, Unit as ConcreteUnit, TerrainDefinition as ConcreteTerrainDefinition, UnitDefinition as ConcreteUnitDefinition, MovementMatrix as ConcreteMovementMatrix, TerrainCostMap as ConcreteTerrainCostMap, Game as ConcreteGame, GameConfiguration as ConcreteGameConfiguration, GamePlayer as ConcreteGamePlayer, GameSettings as ConcreteGameSettings, GameState as ConcreteGameState, GameMoveHistory as ConcreteGameMoveHistory, GameMoveGroup as ConcreteGameMoveGroup, GameMove as ConcreteGameMove, GameMoveResult as ConcreteGameMoveResult, MoveUnitAction as ConcreteMoveUnitAction, AttackUnitAction as ConcreteAttackUnitAction, EndTurnAction as ConcreteEndTurnAction, WorldChange as ConcreteWorldChange, UnitMovedChange as ConcreteUnitMovedChange, UnitDamagedChange as ConcreteUnitDamagedChange, UnitKilledChange as ConcreteUnitKilledChange, PlayerChangedChange as ConcretePlayerChangedChange, GameInfo as ConcreteGameInfo, ListGamesRequest as ConcreteListGamesRequest, ListGamesResponse as ConcreteListGamesResponse, GetGameRequest as ConcreteGetGameRequest, GetGameResponse as ConcreteGetGameResponse, GetGameContentRequest as ConcreteGetGameContentRequest, GetGameContentResponse as ConcreteGetGameContentResponse, UpdateGameRequest as ConcreteUpdateGameRequest, UpdateGameResponse as ConcreteUpdateGameResponse, DeleteGameRequest as ConcreteDeleteGameRequest, DeleteGameResponse as ConcreteDeleteGameResponse, GetGamesRequest as ConcreteGetGamesRequest, GetGamesResponse as ConcreteGetGamesResponse, CreateGameRequest as ConcreteCreateGameRequest, CreateGameResponse as ConcreteCreateGameResponse, ProcessMovesRequest as ConcreteProcessMovesRequest, ProcessMovesResponse as ConcreteProcessMovesResponse, GetGameStateRequest as ConcreteGetGameStateRequest, GetGameStateResponse as ConcreteGetGameStateResponse, ListMovesRequest as ConcreteListMovesRequest, ListMovesResponse as ConcreteListMovesResponse, GetOptionsAtRequest as ConcreteGetOptionsAtRequest, GetOptionsAtResponse as ConcreteGetOptionsAtResponse, GameOption as ConcreteGameOption, EndTurnOption as ConcreteEndTurnOption, MoveOption as ConcreteMoveOption, AttackOption as ConcreteAttackOption, BuildUnitOption as ConcreteBuildUnitOption, CaptureBuildingOption as ConcreteCaptureBuildingOption, UserInfo as ConcreteUserInfo, ListUsersRequest as ConcreteListUsersRequest, ListUsersResponse as ConcreteListUsersResponse, GetUserRequest as ConcreteGetUserRequest, GetUserResponse as ConcreteGetUserResponse, GetUserContentRequest as ConcreteGetUserContentRequest, GetUserContentResponse as ConcreteGetUserContentResponse, UpdateUserRequest as ConcreteUpdateUserRequest, UpdateUserResponse as ConcreteUpdateUserResponse, DeleteUserRequest as ConcreteDeleteUserRequest, DeleteUserResponse as ConcreteDeleteUserResponse, GetUsersRequest as ConcreteGetUsersRequest, GetUsersResponse as ConcreteGetUsersResponse, CreateUserRequest as ConcreteCreateUserRequest, CreateUserResponse as ConcreteCreateUserResponse, WorldInfo as ConcreteWorldInfo, ListWorldsRequest as ConcreteListWorldsRequest, ListWorldsResponse as ConcreteListWorldsResponse, GetWorldRequest as ConcreteGetWorldRequest, GetWorldResponse as ConcreteGetWorldResponse, UpdateWorldRequest as ConcreteUpdateWorldRequest, UpdateWorldResponse as ConcreteUpdateWorldResponse, DeleteWorldRequest as ConcreteDeleteWorldRequest, DeleteWorldResponse as ConcreteDeleteWorldResponse, GetWorldsRequest as ConcreteGetWorldsRequest, GetWorldsResponse as ConcreteGetWorldsResponse, CreateWorldRequest as ConcreteCreateWorldRequest, CreateWorldResponse as ConcreteCreateWorldResponse } from "./models";
+import { User as ConcreteUser, Pagination as ConcretePagination, PaginationResponse as ConcretePaginationResponse, World as ConcreteWorld, WorldData as ConcreteWorldData, Tile as ConcreteTile, Unit as ConcreteUnit, TerrainDefinition as ConcreteTerrainDefinition, UnitDefinition as ConcreteUnitDefinition, MovementRules as ConcreteMovementRules, CombatRules as ConcreteCombatRules, TerrainActionRules as ConcreteTerrainActionRules, TerrainAction as ConcreteTerrainAction, ClassDamageModifiers as ConcreteClassDamageModifiers, MovementMatrix as ConcreteMovementMatrix, TerrainCostMap as ConcreteTerrainCostMap, AttackMatrix as ConcreteAttackMatrix, DefenderDamageMap as ConcreteDefenderDamageMap, DamageDistribution as ConcreteDamageDistribution, DamageBucket as ConcreteDamageBucket, RuleSet as ConcreteRuleSet, Game as ConcreteGame, GameConfiguration as ConcreteGameConfiguration, GamePlayer as ConcreteGamePlayer, GameSettings as ConcreteGameSettings, RulesOverlay as ConcreteRulesOverlay, VictorySettings as ConcreteVictorySettings, VictoryProgress as ConcreteVictoryProgress, CoinSettings as ConcreteCoinSettings, GameState as ConcreteGameState, GameMoveHistory as ConcreteGameMoveHistory, GameMoveGroup as ConcreteGameMoveGroup, GameMove as ConcreteGameMove, GameMoveResult as ConcreteGameMoveResult, HexCoord as ConcreteHexCoord, MoveUnitAction as ConcreteMoveUnitAction, AttackUnitAction as ConcreteAttackUnitAction, EndTurnAction as ConcreteEndTurnAction, BuildUnitAction as ConcreteBuildUnitAction, CaptureBuildingAction as ConcreteCaptureBuildingAction, ModifyTerrainAction as ConcreteModifyTerrainAction, LoadUnitAction as ConcreteLoadUnitAction, UnloadUnitAction as ConcreteUnloadUnitAction, HealUnitAction as ConcreteHealUnitAction, WorldChange as ConcreteWorldChange, UnitMovedChange as ConcreteUnitMovedChange, UnitDamagedChange as ConcreteUnitDamagedChange, UnitHealedChange as ConcreteUnitHealedChange, UnitKilledChange as ConcreteUnitKilledChange, PlayerChangedChange as ConcretePlayerChangedChange, CoinsChangedChange as ConcreteCoinsChangedChange, UnitCreatedChange as ConcreteUnitCreatedChange, TileCapturedChange as ConcreteTileCapturedChange, TileChangedChange as ConcreteTileChangedChange, UnitLoadedChange as ConcreteUnitLoadedChange, UnitUnloadedChange as ConcreteUnitUnloadedChange, GameInfo as ConcreteGameInfo, ListGamesRequest as ConcreteListGamesRequest, ListGamesResponse as ConcreteListGamesResponse, GetGameRequest as ConcreteGetGameRequest, GetGameResponse as ConcreteGetGameResponse, GetGameContentRequest as ConcreteGetGameContentRequest, GetGameContentResponse as ConcreteGetGameContentResponse, UpdateGameRequest as ConcreteUpdateGameRequest, UpdateGameResponse as ConcreteUpdateGameResponse, DeleteGameRequest as ConcreteDeleteGameRequest, DeleteGameResponse as ConcreteDeleteGameResponse, GetGamesRequest as ConcreteGetGamesRequest, GetGamesResponse as ConcreteGetGamesResponse, CreateGameRequest as ConcreteCreateGameRequest, CreateGameResponse as ConcreteCreateGameResponse, ProcessMovesRequest as ConcreteProcessMovesRequest, ProcessMovesResponse as ConcreteProcessMovesResponse, VerifyGameRequest as ConcreteVerifyGameRequest, VerifyGameResponse as ConcreteVerifyGameResponse, GameDivergence as ConcreteGameDivergence, UndoMovesRequest as ConcreteUndoMovesRequest, UndoMovesResponse as ConcreteUndoMovesResponse, SubscribeGameRequest as ConcreteSubscribeGameRequest, SubscribeGameResponse as ConcreteSubscribeGameResponse, GetGameStateRequest as ConcreteGetGameStateRequest, GetGameStateResponse as ConcreteGetGameStateResponse, ListMovesRequest as ConcreteListMovesRequest, ListMovesResponse as ConcreteListMovesResponse, GetOptionsAtRequest as ConcreteGetOptionsAtRequest, GetOptionsAtResponse as ConcreteGetOptionsAtResponse, GetPathRequest as ConcreteGetPathRequest, GetPathResponse as ConcreteGetPathResponse, PathStep as ConcretePathStep, GameOption as ConcreteGameOption, EndTurnOption as ConcreteEndTurnOption, MoveOption as ConcreteMoveOption, AttackOption as ConcreteAttackOption, BuildUnitOption as ConcreteBuildUnitOption, CaptureBuildingOption as ConcreteCaptureBuildingOption, ModifyTerrainOption as ConcreteModifyTerrainOption, LoadUnitOption as ConcreteLoadUnitOption, UnloadUnitOption as ConcreteUnloadUnitOption, HealUnitOption as ConcreteHealUnitOption, GetRulesRequest as ConcreteGetRulesRequest, GetRulesResponse as ConcreteGetRulesResponse, GetUnitRequest as ConcreteGetUnitRequest, GetUnitResponse as ConcreteGetUnitResponse, GetCombatTableRequest as ConcreteGetCombatTableRequest, GetCombatTableResponse as ConcreteGetCombatTableResponse, UserInfo as ConcreteUserInfo, ListUsersRequest as ConcreteListUsersRequest, ListUsersResponse as ConcreteListUsersResponse, GetUserRequest as ConcreteGetUserRequest, GetUserResponse as ConcreteGetUserResponse, GetUserContentRequest as ConcreteGetUserContentRequest, GetUserContentResponse as ConcreteGetUserContentResponse, UpdateUserRequest as ConcreteUpdateUserRequest, UpdateUserResponse as ConcreteUpdateUserResponse, DeleteUserRequest as ConcreteDeleteUserRequest, DeleteUserResponse as ConcreteDeleteUserResponse, GetUsersRequest as ConcreteGetUsersRequest, GetUsersResponse as ConcreteGetUsersResponse, CreateUserRequest as ConcreteCreateUserRequest, CreateUserResponse as ConcreteCreateUserResponse, WorldInfo as ConcreteWorldInfo, ListWorldsRequest as ConcreteListWorldsRequest, ListWorldsResponse as ConcreteListWorldsResponse, GetWorldRequest as ConcreteGetWorldRequest, GetWorldResponse as ConcreteGetWorldResponse, UpdateWorldRequest as ConcreteUpdateWorldRequest, UpdateWorldResponse as ConcreteUpdateWorldResponse, DeleteWorldRequest as ConcreteDeleteWorldRequest, DeleteWorldResponse as ConcreteDeleteWorldResponse, GetWorldsRequest as ConcreteGetWorldsRequest, GetWorldsResponse as ConcreteGetWorldsResponse, CreateWorldRequest as ConcreteCreateWorldRequest, CreateWorldResponse as ConcreteCreateWorldResponse } from "./models";
 
 
 
@@ -204,900 +204,1660 @@ export class WeewarV1Factory {
   }
 
   /**
-   * Enhanced factory method for MovementMatrix
+   * Enhanced factory method for MovementRules
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newMovementMatrix = (
+  newMovementRules = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<MovementMatrixInterface> => {
-    const out = new ConcreteMovementMatrix();
+  ): FactoryResult<MovementRulesInterface> => {
+    const out = new ConcreteMovementRules();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for TerrainCostMap
+   * Enhanced factory method for CombatRules
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newTerrainCostMap = (
+  newCombatRules = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<TerrainCostMapInterface> => {
-    const out = new ConcreteTerrainCostMap();
+  ): FactoryResult<CombatRulesInterface> => {
+    const out = new ConcreteCombatRules();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for Game
+   * Enhanced factory method for TerrainActionRules
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGame = (
+  newTerrainActionRules = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GameInterface> => {
-    const out = new ConcreteGame();
+  ): FactoryResult<TerrainActionRulesInterface> => {
+    const out = new ConcreteTerrainActionRules();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for GameConfiguration
+   * Enhanced factory method for TerrainAction
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGameConfiguration = (
+  newTerrainAction = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GameConfigurationInterface> => {
-    const out = new ConcreteGameConfiguration();
+  ): FactoryResult<TerrainActionInterface> => {
+    const out = new ConcreteTerrainAction();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for GamePlayer
+   * Enhanced factory method for ClassDamageModifiers
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGamePlayer = (
+  newClassDamageModifiers = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GamePlayerInterface> => {
-    const out = new ConcreteGamePlayer();
+  ): FactoryResult<ClassDamageModifiersInterface> => {
+    const out = new ConcreteClassDamageModifiers();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for GameSettings
+   * Enhanced factory method for MovementMatrix
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGameSettings = (
+  newMovementMatrix = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GameSettingsInterface> => {
-    const out = new ConcreteGameSettings();
+  ): FactoryResult<MovementMatrixInterface> => {
+    const out = new ConcreteMovementMatrix();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for GameState
+   * Enhanced factory method for TerrainCostMap
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGameState = (
+  newTerrainCostMap = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GameStateInterface> => {
-    const out = new ConcreteGameState();
+  ): FactoryResult<TerrainCostMapInterface> => {
+    const out = new ConcreteTerrainCostMap();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for GameMoveHistory
+   * Enhanced factory method for AttackMatrix
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGameMoveHistory = (
+  newAttackMatrix = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GameMoveHistoryInterface> => {
-    const out = new ConcreteGameMoveHistory();
+  ): FactoryResult<AttackMatrixInterface> => {
+    const out = new ConcreteAttackMatrix();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for GameMoveGroup
+   * Enhanced factory method for DefenderDamageMap
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGameMoveGroup = (
+  newDefenderDamageMap = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GameMoveGroupInterface> => {
-    const out = new ConcreteGameMoveGroup();
+  ): FactoryResult<DefenderDamageMapInterface> => {
+    const out = new ConcreteDefenderDamageMap();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for GameMove
+   * Enhanced factory method for DamageDistribution
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGameMove = (
+  newDamageDistribution = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GameMoveInterface> => {
-    const out = new ConcreteGameMove();
+  ): FactoryResult<DamageDistributionInterface> => {
+    const out = new ConcreteDamageDistribution();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for GameMoveResult
+   * Enhanced factory method for DamageBucket
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGameMoveResult = (
+  newDamageBucket = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GameMoveResultInterface> => {
-    const out = new ConcreteGameMoveResult();
+  ): FactoryResult<DamageBucketInterface> => {
+    const out = new ConcreteDamageBucket();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for MoveUnitAction
+   * Enhanced factory method for RuleSet
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newMoveUnitAction = (
+  newRuleSet = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<MoveUnitActionInterface> => {
-    const out = new ConcreteMoveUnitAction();
+  ): FactoryResult<RuleSetInterface> => {
+    const out = new ConcreteRuleSet();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for AttackUnitAction
+   * Enhanced factory method for Game
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newAttackUnitAction = (
+  newGame = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<AttackUnitActionInterface> => {
-    const out = new ConcreteAttackUnitAction();
+  ): FactoryResult<GameInterface> => {
+    const out = new ConcreteGame();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for EndTurnAction
+   * Enhanced factory method for GameConfiguration
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newEndTurnAction = (
+  newGameConfiguration = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<EndTurnActionInterface> => {
-    const out = new ConcreteEndTurnAction();
+  ): FactoryResult<GameConfigurationInterface> => {
+    const out = new ConcreteGameConfiguration();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for WorldChange
+   * Enhanced factory method for GamePlayer
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newWorldChange = (
+  newGamePlayer = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<WorldChangeInterface> => {
-    const out = new ConcreteWorldChange();
+  ): FactoryResult<GamePlayerInterface> => {
+    const out = new ConcreteGamePlayer();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for UnitMovedChange
+   * Enhanced factory method for GameSettings
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newUnitMovedChange = (
+  newGameSettings = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<UnitMovedChangeInterface> => {
-    const out = new ConcreteUnitMovedChange();
+  ): FactoryResult<GameSettingsInterface> => {
+    const out = new ConcreteGameSettings();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for UnitDamagedChange
+   * Enhanced factory method for RulesOverlay
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newUnitDamagedChange = (
+  newRulesOverlay = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<UnitDamagedChangeInterface> => {
-    const out = new ConcreteUnitDamagedChange();
+  ): FactoryResult<RulesOverlayInterface> => {
+    const out = new ConcreteRulesOverlay();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for UnitKilledChange
+   * Enhanced factory method for VictorySettings
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newUnitKilledChange = (
+  newVictorySettings = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<UnitKilledChangeInterface> => {
-    const out = new ConcreteUnitKilledChange();
+  ): FactoryResult<VictorySettingsInterface> => {
+    const out = new ConcreteVictorySettings();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for PlayerChangedChange
+   * Enhanced factory method for VictoryProgress
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newPlayerChangedChange = (
+  newVictoryProgress = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<PlayerChangedChangeInterface> => {
-    const out = new ConcretePlayerChangedChange();
+  ): FactoryResult<VictoryProgressInterface> => {
+    const out = new ConcreteVictoryProgress();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for GameInfo
+   * Enhanced factory method for CoinSettings
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGameInfo = (
+  newCoinSettings = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GameInfoInterface> => {
-    const out = new ConcreteGameInfo();
+  ): FactoryResult<CoinSettingsInterface> => {
+    const out = new ConcreteCoinSettings();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for ListGamesRequest
+   * Enhanced factory method for GameState
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newListGamesRequest = (
+  newGameState = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<ListGamesRequestInterface> => {
-    const out = new ConcreteListGamesRequest();
+  ): FactoryResult<GameStateInterface> => {
+    const out = new ConcreteGameState();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for ListGamesResponse
+   * Enhanced factory method for GameMoveHistory
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newListGamesResponse = (
+  newGameMoveHistory = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<ListGamesResponseInterface> => {
-    const out = new ConcreteListGamesResponse();
+  ): FactoryResult<GameMoveHistoryInterface> => {
+    const out = new ConcreteGameMoveHistory();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for GetGameRequest
+   * Enhanced factory method for GameMoveGroup
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGetGameRequest = (
+  newGameMoveGroup = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GetGameRequestInterface> => {
-    const out = new ConcreteGetGameRequest();
+  ): FactoryResult<GameMoveGroupInterface> => {
+    const out = new ConcreteGameMoveGroup();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for GetGameResponse
+   * Enhanced factory method for GameMove
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGetGameResponse = (
+  newGameMove = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GetGameResponseInterface> => {
-    const out = new ConcreteGetGameResponse();
+  ): FactoryResult<GameMoveInterface> => {
+    const out = new ConcreteGameMove();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for GetGameContentRequest
+   * Enhanced factory method for GameMoveResult
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGetGameContentRequest = (
+  newGameMoveResult = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GetGameContentRequestInterface> => {
-    const out = new ConcreteGetGameContentRequest();
+  ): FactoryResult<GameMoveResultInterface> => {
+    const out = new ConcreteGameMoveResult();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for GetGameContentResponse
+   * Enhanced factory method for HexCoord
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGetGameContentResponse = (
+  newHexCoord = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GetGameContentResponseInterface> => {
-    const out = new ConcreteGetGameContentResponse();
+  ): FactoryResult<HexCoordInterface> => {
+    const out = new ConcreteHexCoord();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for UpdateGameRequest
+   * Enhanced factory method for MoveUnitAction
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newUpdateGameRequest = (
+  newMoveUnitAction = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<UpdateGameRequestInterface> => {
-    const out = new ConcreteUpdateGameRequest();
+  ): FactoryResult<MoveUnitActionInterface> => {
+    const out = new ConcreteMoveUnitAction();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for UpdateGameResponse
+   * Enhanced factory method for AttackUnitAction
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newUpdateGameResponse = (
+  newAttackUnitAction = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<UpdateGameResponseInterface> => {
-    const out = new ConcreteUpdateGameResponse();
+  ): FactoryResult<AttackUnitActionInterface> => {
+    const out = new ConcreteAttackUnitAction();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for DeleteGameRequest
+   * Enhanced factory method for EndTurnAction
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newDeleteGameRequest = (
+  newEndTurnAction = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<DeleteGameRequestInterface> => {
-    const out = new ConcreteDeleteGameRequest();
+  ): FactoryResult<EndTurnActionInterface> => {
+    const out = new ConcreteEndTurnAction();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for DeleteGameResponse
+   * Enhanced factory method for BuildUnitAction
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newDeleteGameResponse = (
+  newBuildUnitAction = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<DeleteGameResponseInterface> => {
-    const out = new ConcreteDeleteGameResponse();
+  ): FactoryResult<BuildUnitActionInterface> => {
+    const out = new ConcreteBuildUnitAction();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for GetGamesRequest
+   * Enhanced factory method for CaptureBuildingAction
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGetGamesRequest = (
+  newCaptureBuildingAction = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GetGamesRequestInterface> => {
-    const out = new ConcreteGetGamesRequest();
+  ): FactoryResult<CaptureBuildingActionInterface> => {
+    const out = new ConcreteCaptureBuildingAction();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for GetGamesResponse
+   * Enhanced factory method for ModifyTerrainAction
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGetGamesResponse = (
+  newModifyTerrainAction = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GetGamesResponseInterface> => {
-    const out = new ConcreteGetGamesResponse();
+  ): FactoryResult<ModifyTerrainActionInterface> => {
+    const out = new ConcreteModifyTerrainAction();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for CreateGameRequest
+   * Enhanced factory method for LoadUnitAction
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newCreateGameRequest = (
+  newLoadUnitAction = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<CreateGameRequestInterface> => {
-    const out = new ConcreteCreateGameRequest();
+  ): FactoryResult<LoadUnitActionInterface> => {
+    const out = new ConcreteLoadUnitAction();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for CreateGameResponse
+   * Enhanced factory method for UnloadUnitAction
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newCreateGameResponse = (
+  newUnloadUnitAction = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<CreateGameResponseInterface> => {
-    const out = new ConcreteCreateGameResponse();
+  ): FactoryResult<UnloadUnitActionInterface> => {
+    const out = new ConcreteUnloadUnitAction();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for ProcessMovesRequest
+   * Enhanced factory method for HealUnitAction
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newProcessMovesRequest = (
+  newHealUnitAction = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<ProcessMovesRequestInterface> => {
-    const out = new ConcreteProcessMovesRequest();
+  ): FactoryResult<HealUnitActionInterface> => {
+    const out = new ConcreteHealUnitAction();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for ProcessMovesResponse
+   * Enhanced factory method for WorldChange
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newProcessMovesResponse = (
+  newWorldChange = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<ProcessMovesResponseInterface> => {
-    const out = new ConcreteProcessMovesResponse();
+  ): FactoryResult<WorldChangeInterface> => {
+    const out = new ConcreteWorldChange();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for GetGameStateRequest
+   * Enhanced factory method for UnitMovedChange
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGetGameStateRequest = (
+  newUnitMovedChange = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GetGameStateRequestInterface> => {
-    const out = new ConcreteGetGameStateRequest();
+  ): FactoryResult<UnitMovedChangeInterface> => {
+    const out = new ConcreteUnitMovedChange();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for GetGameStateResponse
+   * Enhanced factory method for UnitDamagedChange
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGetGameStateResponse = (
+  newUnitDamagedChange = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GetGameStateResponseInterface> => {
-    const out = new ConcreteGetGameStateResponse();
+  ): FactoryResult<UnitDamagedChangeInterface> => {
+    const out = new ConcreteUnitDamagedChange();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for ListMovesRequest
+   * Enhanced factory method for UnitHealedChange
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newListMovesRequest = (
+  newUnitHealedChange = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<ListMovesRequestInterface> => {
-    const out = new ConcreteListMovesRequest();
+  ): FactoryResult<UnitHealedChangeInterface> => {
+    const out = new ConcreteUnitHealedChange();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for ListMovesResponse
+   * Enhanced factory method for UnitKilledChange
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newListMovesResponse = (
+  newUnitKilledChange = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<ListMovesResponseInterface> => {
-    const out = new ConcreteListMovesResponse();
+  ): FactoryResult<UnitKilledChangeInterface> => {
+    const out = new ConcreteUnitKilledChange();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for GetOptionsAtRequest
+   * Enhanced factory method for PlayerChangedChange
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGetOptionsAtRequest = (
+  newPlayerChangedChange = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GetOptionsAtRequestInterface> => {
-    const out = new ConcreteGetOptionsAtRequest();
+  ): FactoryResult<PlayerChangedChangeInterface> => {
+    const out = new ConcretePlayerChangedChange();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for GetOptionsAtResponse
+   * Enhanced factory method for CoinsChangedChange
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGetOptionsAtResponse = (
+  newCoinsChangedChange = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GetOptionsAtResponseInterface> => {
-    const out = new ConcreteGetOptionsAtResponse();
+  ): FactoryResult<CoinsChangedChangeInterface> => {
+    const out = new ConcreteCoinsChangedChange();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for GameOption
+   * Enhanced factory method for UnitCreatedChange
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newGameOption = (
+  newUnitCreatedChange = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<GameOptionInterface> => {
-    const out = new ConcreteGameOption();
+  ): FactoryResult<UnitCreatedChangeInterface> => {
+    const out = new ConcreteUnitCreatedChange();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for EndTurnOption
+   * Enhanced factory method for TileCapturedChange
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newEndTurnOption = (
+  newTileCapturedChange = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<EndTurnOptionInterface> => {
-    const out = new ConcreteEndTurnOption();
+  ): FactoryResult<TileCapturedChangeInterface> => {
+    const out = new ConcreteTileCapturedChange();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
   }
 
   /**
-   * Enhanced factory method for MoveOption
+   * Enhanced factory method for TileChangedChange
    * @param parent Parent object containing this field
    * @param attributeName Field name in parent object
    * @param attributeKey Array index, map key, or union tag (for containers)
    * @param data Raw data to potentially populate from
    * @returns Factory result with instance and population status
    */
-  newMoveOption = (
+  newTileChangedChange = (
     parent?: any,
     attributeName?: string,
     attributeKey?: string | number,
     data?: any
-  ): FactoryResult<MoveOptionInterface> => {
-    const out = new ConcreteMoveOption();
+  ): FactoryResult<TileChangedChangeInterface> => {
+    const out = new ConcreteTileChangedChange();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for UnitLoadedChange
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newUnitLoadedChange = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<UnitLoadedChangeInterface> => {
+    const out = new ConcreteUnitLoadedChange();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for UnitUnloadedChange
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newUnitUnloadedChange = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<UnitUnloadedChangeInterface> => {
+    const out = new ConcreteUnitUnloadedChange();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GameInfo
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGameInfo = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GameInfoInterface> => {
+    const out = new ConcreteGameInfo();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for ListGamesRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newListGamesRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<ListGamesRequestInterface> => {
+    const out = new ConcreteListGamesRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for ListGamesResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newListGamesResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<ListGamesResponseInterface> => {
+    const out = new ConcreteListGamesResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GetGameRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGetGameRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GetGameRequestInterface> => {
+    const out = new ConcreteGetGameRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GetGameResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGetGameResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GetGameResponseInterface> => {
+    const out = new ConcreteGetGameResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GetGameContentRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGetGameContentRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GetGameContentRequestInterface> => {
+    const out = new ConcreteGetGameContentRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GetGameContentResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGetGameContentResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GetGameContentResponseInterface> => {
+    const out = new ConcreteGetGameContentResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for UpdateGameRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newUpdateGameRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<UpdateGameRequestInterface> => {
+    const out = new ConcreteUpdateGameRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for UpdateGameResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newUpdateGameResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<UpdateGameResponseInterface> => {
+    const out = new ConcreteUpdateGameResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for DeleteGameRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newDeleteGameRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<DeleteGameRequestInterface> => {
+    const out = new ConcreteDeleteGameRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for DeleteGameResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newDeleteGameResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<DeleteGameResponseInterface> => {
+    const out = new ConcreteDeleteGameResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GetGamesRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGetGamesRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GetGamesRequestInterface> => {
+    const out = new ConcreteGetGamesRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GetGamesResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGetGamesResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GetGamesResponseInterface> => {
+    const out = new ConcreteGetGamesResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for CreateGameRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newCreateGameRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<CreateGameRequestInterface> => {
+    const out = new ConcreteCreateGameRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for CreateGameResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newCreateGameResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<CreateGameResponseInterface> => {
+    const out = new ConcreteCreateGameResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for ProcessMovesRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newProcessMovesRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<ProcessMovesRequestInterface> => {
+    const out = new ConcreteProcessMovesRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for ProcessMovesResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newProcessMovesResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<ProcessMovesResponseInterface> => {
+    const out = new ConcreteProcessMovesResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for VerifyGameRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newVerifyGameRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<VerifyGameRequestInterface> => {
+    const out = new ConcreteVerifyGameRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for VerifyGameResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newVerifyGameResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<VerifyGameResponseInterface> => {
+    const out = new ConcreteVerifyGameResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GameDivergence
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGameDivergence = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GameDivergenceInterface> => {
+    const out = new ConcreteGameDivergence();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for UndoMovesRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newUndoMovesRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<UndoMovesRequestInterface> => {
+    const out = new ConcreteUndoMovesRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for UndoMovesResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newUndoMovesResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<UndoMovesResponseInterface> => {
+    const out = new ConcreteUndoMovesResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for SubscribeGameRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newSubscribeGameRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<SubscribeGameRequestInterface> => {
+    const out = new ConcreteSubscribeGameRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for SubscribeGameResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newSubscribeGameResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<SubscribeGameResponseInterface> => {
+    const out = new ConcreteSubscribeGameResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GetGameStateRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGetGameStateRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GetGameStateRequestInterface> => {
+    const out = new ConcreteGetGameStateRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GetGameStateResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGetGameStateResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GetGameStateResponseInterface> => {
+    const out = new ConcreteGetGameStateResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for ListMovesRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newListMovesRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<ListMovesRequestInterface> => {
+    const out = new ConcreteListMovesRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for ListMovesResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newListMovesResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<ListMovesResponseInterface> => {
+    const out = new ConcreteListMovesResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GetOptionsAtRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGetOptionsAtRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GetOptionsAtRequestInterface> => {
+    const out = new ConcreteGetOptionsAtRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GetOptionsAtResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGetOptionsAtResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GetOptionsAtResponseInterface> => {
+    const out = new ConcreteGetOptionsAtResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GetPathRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGetPathRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GetPathRequestInterface> => {
+    const out = new ConcreteGetPathRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GetPathResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGetPathResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GetPathResponseInterface> => {
+    const out = new ConcreteGetPathResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for PathStep
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newPathStep = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<PathStepInterface> => {
+    const out = new ConcretePathStep();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GameOption
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGameOption = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GameOptionInterface> => {
+    const out = new ConcreteGameOption();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for EndTurnOption
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newEndTurnOption = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<EndTurnOptionInterface> => {
+    const out = new ConcreteEndTurnOption();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for MoveOption
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newMoveOption = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<MoveOptionInterface> => {
+    const out = new ConcreteMoveOption();
     
     // Factory does not populate by default - let deserializer handle it
     return { instance: out, fullyLoaded: false };
@@ -1163,6 +1923,206 @@ export class WeewarV1Factory {
     return { instance: out, fullyLoaded: false };
   }
 
+  /**
+   * Enhanced factory method for ModifyTerrainOption
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newModifyTerrainOption = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<ModifyTerrainOptionInterface> => {
+    const out = new ConcreteModifyTerrainOption();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for LoadUnitOption
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newLoadUnitOption = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<LoadUnitOptionInterface> => {
+    const out = new ConcreteLoadUnitOption();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for UnloadUnitOption
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newUnloadUnitOption = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<UnloadUnitOptionInterface> => {
+    const out = new ConcreteUnloadUnitOption();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for HealUnitOption
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newHealUnitOption = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<HealUnitOptionInterface> => {
+    const out = new ConcreteHealUnitOption();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GetRulesRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGetRulesRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GetRulesRequestInterface> => {
+    const out = new ConcreteGetRulesRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GetRulesResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGetRulesResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GetRulesResponseInterface> => {
+    const out = new ConcreteGetRulesResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GetUnitRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGetUnitRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GetUnitRequestInterface> => {
+    const out = new ConcreteGetUnitRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GetUnitResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGetUnitResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GetUnitResponseInterface> => {
+    const out = new ConcreteGetUnitResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GetCombatTableRequest
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGetCombatTableRequest = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GetCombatTableRequestInterface> => {
+    const out = new ConcreteGetCombatTableRequest();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
+  /**
+   * Enhanced factory method for GetCombatTableResponse
+   * @param parent Parent object containing this field
+   * @param attributeName Field name in parent object
+   * @param attributeKey Array index, map key, or union tag (for containers)
+   * @param data Raw data to potentially populate from
+   * @returns Factory result with instance and population status
+   */
+  newGetCombatTableResponse = (
+    parent?: any,
+    attributeName?: string,
+    attributeKey?: string | number,
+    data?: any
+  ): FactoryResult<GetCombatTableResponseInterface> => {
+    const out = new ConcreteGetCombatTableResponse();
+    
+    // Factory does not populate by default - let deserializer handle it
+    return { instance: out, fullyLoaded: false };
+  }
+
   /**
    * Enhanced factory method for UserInfo
    * @param parent Parent object containing this field
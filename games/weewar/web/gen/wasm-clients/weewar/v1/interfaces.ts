@@ -97,6 +97,14 @@ export interface Tile {
   tileType: number;
   /** Whether the tile itself belongs to a player */
   player: number;
+  /** Player currently capturing this tile (0 = not being captured) */
+  capturePlayer: number;
+  /** Number of turns of capture progress made by the capturing player */
+  captureProgress: number;
+  /** Terrain action being worked on this tile ("" = none) */
+  terrainAction: string;
+  /** Number of turns of work done on the terrain action */
+  terrainActionProgress: number;
 }
 
 
@@ -111,6 +119,13 @@ export interface Unit {
   availableHealth: number;
   distanceLeft: number;
   turnCounter: number;
+  hasMoved: boolean;
+  hasAttacked: boolean;
+  actionsRemaining: number;
+  /** Units being carried by this unit if it is a transport.  Carried units are not on
+ the map (their coordinates are only meaningful once unloaded) and are destroyed
+ along with their transport. */
+  cargo?: Unit[];
 }
 
 
@@ -124,6 +139,11 @@ export interface TerrainDefinition {
   defenseBonus: number;
   type: number;
   description: string;
+  buildableUnits: number[];
+  captureTurns: number;
+  repairAmount: number;
+  repairClasses: string[];
+  repairCost: number;
 }
 
 
@@ -137,6 +157,80 @@ export interface UnitDefinition {
   attackRange: number;
   health: number;
   properties: string[];
+  coins: number;
+  canCapture: boolean;
+  sightRange: number;
+  unitClass: string;
+  canMoveAfterAttack: boolean;
+  actionsPerTurn: number;
+  minAttackRange: number;
+  indirectFire: boolean;
+  transportCapacity: number;
+  cargoClasses: string[];
+  healAmount: number;
+}
+
+
+/**
+ * Rules that constrain how units move around other units
+ */
+export interface MovementRules {
+  /** Whether units can move through hexes held by their own and allied units.
+ Units can never end a move on another unit. */
+  passThroughAllies: boolean;
+  /** Unit classes that exert a zone of control.  An enemy unit entering a hex
+ next to one of these units must stop there. */
+  zocUnitClasses: string[];
+  /** Unit classes that ignore zones of control (eg "air") */
+  zocImmuneClasses: string[];
+}
+
+
+/**
+ * Rules that adjust the damage units deal in combat beyond the attack matrix.  An
+ attacker's damage is always scaled by its remaining health and reduced by the
+ defense bonus of the defender's terrain.
+ */
+export interface CombatRules {
+  /** Damage multipliers keyed by attacker unit class.  Missing entries leave the
+ damage unchanged. */
+  classModifiers?: Map<string, ClassDamageModifiers>;
+  /** Defender unit classes that get no defense bonus from terrain (eg "air") */
+  terrainDefenseIgnoredClasses: string[];
+}
+
+
+/**
+ * Actions units can take to change terrain during play (eg building bridges or laying roads)
+ */
+export interface TerrainActionRules {
+  /** Terrain actions keyed by their ID */
+  actions?: Map<string, TerrainAction>;
+}
+
+
+/**
+ * A change units with the right property can make to a tile over one or more turns
+ */
+export interface TerrainAction {
+  id: string;
+  name: string;
+  /** Terrain the action can be used on -> terrain it turns into */
+  terrainChanges?: Map<number, number>;
+  /** Turns of work needed to complete the change (0 = 1) */
+  turns: number;
+  /** Coins paid when work on a tile starts (0 = free) */
+  coins: number;
+  /** Unit property needed to perform the action (eg "engineer") */
+  unitProperty: string;
+}
+
+
+/**
+ * Damage multipliers an attacker class applies to each defender unit class
+ */
+export interface ClassDamageModifiers {
+  defenderClasses?: Map<string, number>;
 }
 
 
@@ -156,6 +250,56 @@ export interface TerrainCostMap {
 }
 
 
+/**
+ * Attack matrix of the damage each unit type deals to each other unit type
+ */
+export interface AttackMatrix {
+  /** Map of attacker unit_id -> (defender unit_id -> damage distribution) */
+  attacks?: Map<number, DefenderDamageMap>;
+}
+
+
+
+export interface DefenderDamageMap {
+  /** Map of defender unit_id -> damage distribution.  Missing defenders cannot be attacked. */
+  defenderDamages?: Map<number, DamageDistribution>;
+}
+
+
+/**
+ * Distribution of the damage an attack deals
+ */
+export interface DamageDistribution {
+  minDamage: number;
+  maxDamage: number;
+  damageBuckets?: DamageBucket[];
+  expectedDamage: number;
+}
+
+
+/**
+ * A damage value and the weight of it being rolled
+ */
+export interface DamageBucket {
+  damage: number;
+  weight: number;
+}
+
+
+/**
+ * A complete rule set as loaded by the rules engine
+ */
+export interface RuleSet {
+  units?: Map<number, UnitDefinition>;
+  terrains?: Map<number, TerrainDefinition>;
+  movementMatrix?: MovementMatrix;
+  attackMatrix?: AttackMatrix;
+  movementRules?: MovementRules;
+  combatRules?: CombatRules;
+  terrainActions?: TerrainActionRules;
+}
+
+
 /**
  * Describes a game and its metadata
  */
@@ -180,6 +324,9 @@ export interface Game {
   difficulty: string;
   /** Game configuration */
   config?: GameConfiguration;
+  /** ID of the rule set the game is played under ("" = the default rules, for games
+ created before rules were versioned) */
+  rulesId: string;
 }
 
 
@@ -215,6 +362,68 @@ export interface GameSettings {
   teamMode: string;
   /** Maximum number of turns (0 = unlimited) */
   maxTurns: number;
+  /** Coin economy settings */
+  coins?: CoinSettings;
+  /** Whether players can only see what their units and buildings can see */
+  fogOfWar: boolean;
+  /** How the game is won */
+  victory?: VictorySettings;
+  /** Changes to the default rules for this game */
+  rulesOverlay?: RulesOverlay;
+}
+
+
+/**
+ * Per game changes applied on top of the default rules
+ */
+export interface RulesOverlay {
+  /** Unit type IDs that cannot be built in this game */
+  disabledUnits: number[];
+  /** Build costs keyed by unit type ID, replacing the unit's default cost */
+  unitCoins?: Map<number, number>;
+  /** Damage multipliers keyed by attacker unit type ID */
+  damageMultipliers?: Map<number, number>;
+}
+
+
+/**
+ * The conditions under which a game is won.  The game ends as soon as any
+ enabled condition is met.  If none are enabled the last player with units
+ left wins.
+ */
+export interface VictorySettings {
+  /** Win by being the last player with units left */
+  elimination: boolean;
+  /** Win by owning this many bases (0 = disabled) */
+  captureBases: number;
+  /** Tile type of the players' headquarters.  Win by owning every headquarters
+ on the map (0 = disabled) */
+  headquartersTileType: number;
+  /** Win by having the highest score when GameSettings.max_turns is reached */
+  scoreAtMaxTurns: boolean;
+}
+
+
+/**
+ * How close a player is to meeting each of a game's victory conditions
+ */
+export interface VictoryProgress {
+  player: number;
+  /** Victory condition name -> progress (0 to 1) */
+  progress?: Map<string, number>;
+}
+
+
+/**
+ * Describes how players earn coins over the course of a game
+ */
+export interface CoinSettings {
+  /** Coins each player starts the game with */
+  startOfGame: number;
+  /** Coins credited to a player at the start of each of their turns */
+  perTurn: number;
+  /** Additional coins credited per base owned at the start of each turn */
+  perBase: number;
 }
 
 
@@ -229,6 +438,28 @@ export interface GameState {
   currentPlayer: number;
   /** Current world state */
   worldData?: WorldData;
+  /** Coins currently held by each player (player ID -> coins) */
+  playerCoins?: Map<number, number>;
+  /** Sequence number of the last move processed in this game (0 if no moves yet).
+ Sequence numbers are assigned by the server and never reused. */
+  lastSequenceNum: number;
+  /** Seed of the game's random stream (eg for combat) and how many values have
+ been drawn from it so far.  Together they let the exact stream be restored
+ when the game is reloaded or replayed.  They are kept on the server and
+ cleared in responses so players cannot predict the outcome of their moves. */
+  rngSeed: number;
+  rngPosition: number;
+  /** When the current player's turn started and when it ends if the game has a
+ turn time limit (unset otherwise).  Once the deadline passes the server ends
+ the turn on the player's behalf. */
+  turnStartedAt?: Date;
+  turnDeadline?: Date;
+  /** Player that won the game (0 while the game is in progress) and the victory
+ condition that decided it */
+  winner: number;
+  victoryCondition: string;
+  /** All the players that won - the winner and their teammates in team games */
+  winners: number[];
 }
 
 
@@ -240,6 +471,9 @@ export interface GameMoveHistory {
   gameId: string;
   /** Each entry in our history is a "group" of moves */
   groups?: GameMoveGroup[];
+  /** State of the game before any moves were made.  Replaying all the groups
+ from this state reproduces the game's current state. */
+  initialState?: GameState;
 }
 
 
@@ -272,6 +506,12 @@ export interface GameMove {
   moveUnit?: MoveUnitAction;
   attackUnit?: AttackUnitAction;
   endTurn?: EndTurnAction;
+  buildUnit?: BuildUnitAction;
+  captureBuilding?: CaptureBuildingAction;
+  loadUnit?: LoadUnitAction;
+  unloadUnit?: UnloadUnitAction;
+  healUnit?: HealUnitAction;
+  modifyTerrain?: ModifyTerrainAction;
 }
 
 
@@ -291,6 +531,16 @@ export interface GameMoveResult {
 }
 
 
+/**
+ * *
+ A hex coordinate
+ */
+export interface HexCoord {
+  q: number;
+  r: number;
+}
+
+
 /**
  * *
  Move unit from one position to another
@@ -300,6 +550,10 @@ export interface MoveUnitAction {
   fromR: number;
   toQ: number;
   toR: number;
+  /** The exact hexes the unit moves through, starting at (from_q, from_r) and ending
+ at (to_q, to_r).  Each step is validated and charged its terrain cost.  If empty
+ the cheapest path is used. */
+  path?: HexCoord[];
 }
 
 
@@ -320,6 +574,83 @@ export interface AttackUnitAction {
  End current player's turn
  */
 export interface EndTurnAction {
+  /** Set by the server when it ended the turn because the player ran out of time */
+  timedOut: boolean;
+}
+
+
+/**
+ * *
+ Build a new unit on a base owned by the player
+ */
+export interface BuildUnitAction {
+  q: number;
+  r: number;
+  unitType: number;
+}
+
+
+/**
+ * *
+ Capture (or continue capturing) the building under a unit
+ */
+export interface CaptureBuildingAction {
+  q: number;
+  r: number;
+}
+
+
+/**
+ * *
+ A unit works on a terrain action on its own or an adjacent hex
+ */
+export interface ModifyTerrainAction {
+  /** Unit performing the action */
+  q: number;
+  r: number;
+  /** Hex being changed */
+  targetQ: number;
+  targetR: number;
+  /** ID of the terrain action in the rules */
+  terrainAction: string;
+}
+
+
+/**
+ * *
+ Board a unit onto an adjacent transport owned by the same player
+ */
+export interface LoadUnitAction {
+  unitQ: number;
+  unitR: number;
+  transportQ: number;
+  transportR: number;
+}
+
+
+/**
+ * *
+ Unload a carried unit from a transport onto an adjacent empty hex
+ */
+export interface UnloadUnitAction {
+  transportQ: number;
+  transportR: number;
+  /** Index of the unit in the transport's cargo */
+  cargoIndex: number;
+  toQ: number;
+  toR: number;
+}
+
+
+/**
+ * *
+ A healer (eg a Medic) restores health to an adjacent friendly unit
+ */
+export interface HealUnitAction {
+  healerQ: number;
+  healerR: number;
+  targetQ: number;
+  targetR: number;
 }
 
 
@@ -332,6 +663,13 @@ export interface WorldChange {
   unitDamaged?: UnitDamagedChange;
   unitKilled?: UnitKilledChange;
   playerChanged?: PlayerChangedChange;
+  coinsChanged?: CoinsChangedChange;
+  unitCreated?: UnitCreatedChange;
+  tileCaptured?: TileCapturedChange;
+  unitLoaded?: UnitLoadedChange;
+  unitUnloaded?: UnitUnloadedChange;
+  unitHealed?: UnitHealedChange;
+  tileChanged?: TileChangedChange;
 }
 
 
@@ -349,13 +687,33 @@ export interface UnitMovedChange {
 
 /**
  * *
- A unit took damage
+ A unit was attacked.  The damage may be 0 so an attack that misses still
+ records the attacker using up its action.
  */
 export interface UnitDamagedChange {
   /** Complete unit state before taking damage */
   previousUnit?: Unit;
   /** Complete unit state after taking damage */
   updatedUnit?: Unit;
+  /** Attacking unit state before and after using up its action (not set for the
+ damage a counter attack deals to the attacker) */
+  previousAttacker?: Unit;
+  updatedAttacker?: Unit;
+}
+
+
+/**
+ * *
+ A unit regained health by repairing on a base or being healed
+ */
+export interface UnitHealedChange {
+  /** Complete unit state before healing */
+  previousUnit?: Unit;
+  /** Complete unit state after healing */
+  updatedUnit?: Unit;
+  /** Healing unit state before and after using up its action (not set for repairs) */
+  previousHealer?: Unit;
+  updatedHealer?: Unit;
 }
 
 
@@ -383,6 +741,85 @@ export interface PlayerChangedChange {
 }
 
 
+/**
+ * *
+ A player's coin balance changed
+ */
+export interface CoinsChangedChange {
+  player: number;
+  previousCoins: number;
+  newCoins: number;
+}
+
+
+/**
+ * *
+ A new unit was created (eg built on a base)
+ */
+export interface UnitCreatedChange {
+  /** Complete state of the newly created unit */
+  unit?: Unit;
+}
+
+
+/**
+ * *
+ A tile's capture state changed - either capture progressed, was abandoned
+ or completed (in which case the tile changes owner)
+ */
+export interface TileCapturedChange {
+  /** Complete tile state before the change */
+  previousTile?: Tile;
+  /** Complete tile state after the change */
+  updatedTile?: Tile;
+  /** Capturing unit state before and after capturing (not set when capture is abandoned) */
+  previousUnit?: Unit;
+  updatedUnit?: Unit;
+}
+
+
+/**
+ * *
+ Work on a terrain action progressed or completed (in which case the tile's
+ terrain type changes)
+ */
+export interface TileChangedChange {
+  /** Complete tile state before the change */
+  previousTile?: Tile;
+  /** Complete tile state after the change */
+  updatedTile?: Tile;
+  /** Working unit state before and after the work */
+  previousUnit?: Unit;
+  updatedUnit?: Unit;
+}
+
+
+/**
+ * *
+ A unit boarded a transport and left the map
+ */
+export interface UnitLoadedChange {
+  /** Complete unit state before boarding (on the map) */
+  unit?: Unit;
+  /** Complete transport state before and after the unit boarded */
+  previousTransport?: Unit;
+  updatedTransport?: Unit;
+}
+
+
+/**
+ * *
+ A unit was unloaded from a transport onto the map
+ */
+export interface UnitUnloadedChange {
+  /** Complete unit state after unloading (on the map) */
+  unit?: Unit;
+  /** Complete transport state before and after the unit was unloaded */
+  previousTransport?: Unit;
+  updatedTransport?: Unit;
+}
+
+
 /**
  * GameInfo represents a game in the catalog
  */
@@ -420,6 +857,10 @@ export interface ListGamesResponse {
 export interface GetGameRequest {
   id: string;
   version: string;
+  /** *
+ Player whose view of the game's state and history to return.  When fog of
+ war is enabled and no player is given only the game's metadata is returned. */
+  player: number;
 }
 
 
@@ -558,6 +999,12 @@ export interface ProcessMovesRequest {
   /** *
  List of moves to add */
   moves?: GameMove[];
+  /** *
+ If > 0, the moves are only processed if this is the sequence number of the
+ last move in the game.  This lets clients detect they are submitting moves
+ against a stale game state.  Sequence numbers start at 1 so 0 skips the check
+ and -1 requires that no moves have been made yet. */
+  expectedLastSequenceNum: number;
 }
 
 
@@ -581,6 +1028,118 @@ export interface ProcessMovesResponse {
 }
 
 
+/**
+ * *
+ Request to verify a game by replaying its move history
+ */
+export interface VerifyGameRequest {
+  /** *
+ Game ID to verify */
+  gameId: string;
+}
+
+
+/**
+ * *
+ Result of replaying a game's move history
+ */
+export interface VerifyGameResponse {
+  /** Whether the replay matched the recorded history and the saved game state */
+  verified: boolean;
+  groupsReplayed: number;
+  movesReplayed: number;
+  /** Where the replay first differed from the recording (unset if verified) */
+  divergence?: GameDivergence;
+}
+
+
+/**
+ * *
+ The first point where a replayed game differs from its recorded history
+ */
+export interface GameDivergence {
+  /** Index of the move group in the history.  Equal to the number of groups if
+ only the final game state differs. */
+  groupIndex: number;
+  /** Index of the move in the group (-1 if not specific to a move) */
+  moveIndex: number;
+  /** Index of the change in the move's result (-1 if not specific to a change) */
+  changeIndex: number;
+  /** Sequence number of the move */
+  sequenceNum: number;
+  /** Human readable description of the difference */
+  reason: string;
+  /** The recorded change */
+  expectedChange?: WorldChange;
+  /** The recomputed change */
+  actualChange?: WorldChange;
+}
+
+
+/**
+ * *
+ Request to undo the latest moves in a game.
+
+ Only move groups whose results are all non-permanent can be undone and
+ undoing never crosses a turn boundary.
+ */
+export interface UndoMovesRequest {
+  /** *
+ Game ID to undo moves in */
+  gameId: string;
+  /** *
+ Number of trailing move groups to undo.  if <= 0 the last group is undone */
+  count: number;
+}
+
+
+/**
+ * *
+ Response after undoing moves.
+ */
+export interface UndoMovesResponse {
+  /** *
+ The move groups that were undone and removed from the history, latest first */
+  undoneGroups?: GameMoveGroup[];
+  /** *
+ The inverse changes that were applied to the world, in the order they were applied */
+  changes?: WorldChange[];
+}
+
+
+/**
+ * *
+ Request to subscribe to a game's updates.
+ */
+export interface SubscribeGameRequest {
+  /** *
+ Game ID to subscribe to */
+  gameId: string;
+  /** *
+ Sequence number of the last move the client has seen.  Move groups in the
+ history with later moves are sent before live updates.  0 replays the
+ whole history. */
+  fromSequenceNum: number;
+  /** *
+ Player whose view the updates are filtered to when fog of war is enabled */
+  player: number;
+}
+
+
+/**
+ * *
+ An update to a subscribed game.  Exactly one of the fields is set.
+ */
+export interface SubscribeGameResponse {
+  /** *
+ A move group that was committed to the game */
+  moveGroup?: GameMoveGroup;
+  /** *
+ Move groups that were undone.  Clients should apply the inverse changes. */
+  undo?: UndoMovesResponse;
+}
+
+
 /**
  * *
  Request to get the game's latest state
@@ -589,6 +1148,10 @@ export interface GetGameStateRequest {
   /** *
  Game ID to add moves to */
   gameId: string;
+  /** *
+ Player whose view of the game state to return.
+ Required when fog of war is enabled. */
+  player: number;
 }
 
 
@@ -598,6 +1161,10 @@ export interface GetGameStateRequest {
  */
 export interface GetGameStateResponse {
   state?: GameState;
+  /** Seconds left in the current turn or -1 if turns are not timed */
+  turnTimeRemaining: number;
+  /** Each player's progress towards the game's victory conditions */
+  victoryProgress?: VictoryProgress[];
 }
 
 
@@ -615,6 +1182,10 @@ export interface ListMovesRequest {
   /** *
  Limit to last N moves (from offset).  if <= 0 return all moves */
   lastN: number;
+  /** *
+ Player whose view of the moves to return.
+ Required when fog of war is enabled. */
+  player: number;
 }
 
 
@@ -651,6 +1222,51 @@ export interface GetOptionsAtResponse {
 }
 
 
+/**
+ * *
+ Request for the cheapest path between two positions
+ */
+export interface GetPathRequest {
+  gameId: string;
+  fromQ: number;
+  fromR: number;
+  toQ: number;
+  toR: number;
+}
+
+
+/**
+ * *
+ The cheapest path for a unit between two positions
+ */
+export interface GetPathResponse {
+  /** Steps after the starting position in the order they are moved through */
+  steps?: PathStep[];
+  /** Total terrain cost of the path */
+  totalCost: number;
+  /** Movement points the move is charged (the total cost rounded) */
+  movementCost: number;
+  /** Whether the unit has enough movement left to make the move this turn */
+  reachable: boolean;
+  /** Ready-to-use action object for ProcessMoves */
+  action?: MoveUnitAction;
+}
+
+
+/**
+ * *
+ A single step of a path
+ */
+export interface PathStep {
+  q: number;
+  r: number;
+  /** Cost of entering this hex */
+  cost: number;
+  /** Cost of the path up to and including this hex */
+  totalCost: number;
+}
+
+
 /**
  * *
  A single game option available at a position
@@ -661,6 +1277,10 @@ export interface GameOption {
   endTurn?: EndTurnOption;
   build?: BuildUnitOption;
   capture?: CaptureBuildingOption;
+  load?: LoadUnitOption;
+  unload?: UnloadUnitOption;
+  heal?: HealUnitOption;
+  modifyTerrain?: ModifyTerrainOption;
 }
 
 
@@ -699,6 +1319,11 @@ export interface AttackOption {
   damageEstimate: number;
   /** Ready-to-use action object for ProcessMoves */
   action?: AttackUnitAction;
+  /** Predicted outcome of the attack with the target's counter attack */
+  expectedDamageDealt: number;
+  expectedDamageTaken: number;
+  killProbability: number;
+  lossProbability: number;
 }
 
 
@@ -711,6 +1336,9 @@ export interface BuildUnitOption {
   r: number;
   tileType: number;
   buildCost: number;
+  unitType: number;
+  /** Ready-to-use action object for ProcessMoves */
+  action?: BuildUnitAction;
 }
 
 
@@ -722,6 +1350,122 @@ export interface CaptureBuildingOption {
   q: number;
   r: number;
   tileType: number;
+  /** Turns of capture progress so far and turns needed to complete the capture */
+  captureProgress: number;
+  captureTurns: number;
+  /** Ready-to-use action object for ProcessMoves */
+  action?: CaptureBuildingAction;
+}
+
+
+/**
+ * *
+ A terrain action the unit can work on at a hex
+ */
+export interface ModifyTerrainOption {
+  q: number;
+  r: number;
+  terrainAction: string;
+  name: string;
+  /** Terrain type the tile will turn into */
+  newTileType: number;
+  /** Turns of work done so far and turns needed to complete the change */
+  progress: number;
+  turns: number;
+  /** Coins charged to start the work (0 if work is already under way) */
+  coins: number;
+  /** Ready-to-use action object for ProcessMoves */
+  action?: ModifyTerrainAction;
+}
+
+
+/**
+ * *
+ An adjacent transport the unit can board
+ */
+export interface LoadUnitOption {
+  /** Position and type of the transport */
+  q: number;
+  r: number;
+  transportUnitType: number;
+  /** Ready-to-use action object for ProcessMoves */
+  action?: LoadUnitAction;
+}
+
+
+/**
+ * *
+ A hex a transport can unload one of its carried units to
+ */
+export interface UnloadUnitOption {
+  q: number;
+  r: number;
+  /** Index and type of the carried unit */
+  cargoIndex: number;
+  unitType: number;
+  /** Ready-to-use action object for ProcessMoves */
+  action?: UnloadUnitAction;
+}
+
+
+/**
+ * *
+ Option to heal an adjacent friendly unit
+ */
+export interface HealUnitOption {
+  q: number;
+  r: number;
+  unitType: number;
+  /** Health the target would regain */
+  amount: number;
+  /** Ready-to-use action object for ProcessMoves */
+  action?: HealUnitAction;
+}
+
+
+
+export interface GetRulesRequest {
+  rulesId: string;
+}
+
+
+
+export interface GetRulesResponse {
+  rulesId: string;
+  rules?: RuleSet;
+}
+
+
+
+export interface GetUnitRequest {
+  rulesId: string;
+  unitId: number;
+}
+
+
+
+export interface GetUnitResponse {
+  rulesId: string;
+  unit?: UnitDefinition;
+  /** Cost of entering each terrain (missing terrains cost their base move cost) */
+  movementCosts?: TerrainCostMap;
+  /** Damage the unit deals to each unit it can attack */
+  attacks?: DefenderDamageMap;
+}
+
+
+
+export interface GetCombatTableRequest {
+  rulesId: string;
+  attackerId: number;
+  defenderId: number;
+}
+
+
+
+export interface GetCombatTableResponse {
+  rulesId: string;
+  attackMatrix?: AttackMatrix;
 }
 
 
@@ -1,6 +1,6 @@
 
 
-import { User as UserInterface, Pagination as PaginationInterface, PaginationResponse as PaginationResponseInterface, World as WorldInterface, WorldData as WorldDataInterface, Tile as TileInterface, Unit as UnitInterface, TerrainDefinition as TerrainDefinitionInterface, UnitDefinition as UnitDefinitionInterface, MovementMatrix as MovementMatrixInterface, TerrainCostMap as TerrainCostMapInterface, Game as GameInterface, GameConfiguration as GameConfigurationInterface, GamePlayer as GamePlayerInterface, GameSettings as GameSettingsInterface, GameState as GameStateInterface, GameMoveHistory as GameMoveHistoryInterface, GameMoveGroup as GameMoveGroupInterface, GameMove as GameMoveInterface, GameMoveResult as GameMoveResultInterface, MoveUnitAction as MoveUnitActionInterface, AttackUnitAction as AttackUnitActionInterface, EndTurnAction as EndTurnActionInterface, WorldChange as WorldChangeInterface, UnitMovedChange as UnitMovedChangeInterface, UnitDamagedChange as UnitDamagedChangeInterface, UnitKilledChange as UnitKilledChangeInterface, PlayerChangedChange as PlayerChangedChangeInterface, GameInfo as GameInfoInterface, ListGamesRequest as ListGamesRequestInterface, ListGamesResponse as ListGamesResponseInterface, GetGameRequest as GetGameRequestInterface, GetGameResponse as GetGameResponseInterface, GetGameContentRequest as GetGameContentRequestInterface, GetGameContentResponse as GetGameContentResponseInterface, UpdateGameRequest as UpdateGameRequestInterface, UpdateGameResponse as UpdateGameResponseInterface, DeleteGameRequest as DeleteGameRequestInterface, DeleteGameResponse as DeleteGameResponseInterface, GetGamesRequest as GetGamesRequestInterface, GetGamesResponse as GetGamesResponseInterface, CreateGameRequest as CreateGameRequestInterface, CreateGameResponse as CreateGameResponseInterface, ProcessMovesRequest as ProcessMovesRequestInterface, ProcessMovesResponse as ProcessMovesResponseInterface, GetGameStateRequest as GetGameStateRequestInterface, GetGameStateResponse as GetGameStateResponseInterface, ListMovesRequest as ListMovesRequestInterface, ListMovesResponse as ListMovesResponseInterface, GetOptionsAtRequest as GetOptionsAtRequestInterface, GetOptionsAtResponse as GetOptionsAtResponseInterface, GameOption as GameOptionInterface, EndTurnOption as EndTurnOptionInterface, MoveOption as MoveOptionInterface, AttackOption as AttackOptionInterface, BuildUnitOption as BuildUnitOptionInterface, CaptureBuildingOption as CaptureBuildingOptionInterface, UserInfo as UserInfoInterface, ListUsersRequest as ListUsersRequestInterface, ListUsersResponse as ListUsersResponseInterface, GetUserRequest as GetUserRequestInterface, GetUserResponse as GetUserResponseInterface, GetUserContentRequest as GetUserContentRequestInterface, GetUserContentResponse as GetUserContentResponseInterface, UpdateUserRequest as UpdateUserRequestInterface, UpdateUserResponse as UpdateUserResponseInterface, DeleteUserRequest as DeleteUserRequestInterface, DeleteUserResponse as DeleteUserResponseInterface, GetUsersRequest as GetUsersRequestInterface, GetUsersResponse as GetUsersResponseInterface, CreateUserRequest as CreateUserRequestInterface, CreateUserResponse as CreateUserResponseInterface, WorldInfo as WorldInfoInterface, ListWorldsRequest as ListWorldsRequestInterface, ListWorldsResponse as ListWorldsResponseInterface, GetWorldRequest as GetWorldRequestInterface, GetWorldResponse as GetWorldResponseInterface, UpdateWorldRequest as UpdateWorldRequestInterface, UpdateWorldResponse as UpdateWorldResponseInterface, DeleteWorldRequest as DeleteWorldRequestInterface, DeleteWorldResponse as DeleteWorldResponseInterface, GetWorldsRequest as GetWorldsRequestInterface, GetWorldsResponse as GetWorldsResponseInterface, CreateWorldRequest as CreateWorldRequestInterface, CreateWorldResponse as CreateWorldResponseInterface } from "./interfaces";
+import { User as UserInterface, Pagination as PaginationInterface, PaginationResponse as PaginationResponseInterface, World as WorldInterface, WorldData as WorldDataInterface, Tile as TileInterface, Unit as UnitInterface, TerrainDefinition as TerrainDefinitionInterface, UnitDefinition as UnitDefinitionInterface, MovementRules as MovementRulesInterface, CombatRules as CombatRulesInterface, TerrainActionRules as TerrainActionRulesInterface, TerrainAction as TerrainActionInterface, ClassDamageModifiers as ClassDamageModifiersInterface, MovementMatrix as MovementMatrixInterface, TerrainCostMap as TerrainCostMapInterface, AttackMatrix as AttackMatrixInterface, DefenderDamageMap as DefenderDamageMapInterface, DamageDistribution as DamageDistributionInterface, DamageBucket as DamageBucketInterface, RuleSet as RuleSetInterface, Game as GameInterface, GameConfiguration as GameConfigurationInterface, GamePlayer as GamePlayerInterface, GameSettings as GameSettingsInterface, RulesOverlay as RulesOverlayInterface, VictorySettings as VictorySettingsInterface, VictoryProgress as VictoryProgressInterface, CoinSettings as CoinSettingsInterface, GameState as GameStateInterface, GameMoveHistory as GameMoveHistoryInterface, GameMoveGroup as GameMoveGroupInterface, GameMove as GameMoveInterface, GameMoveResult as GameMoveResultInterface, HexCoord as HexCoordInterface, MoveUnitAction as MoveUnitActionInterface, AttackUnitAction as AttackUnitActionInterface, EndTurnAction as EndTurnActionInterface, BuildUnitAction as BuildUnitActionInterface, CaptureBuildingAction as CaptureBuildingActionInterface, ModifyTerrainAction as ModifyTerrainActionInterface, LoadUnitAction as LoadUnitActionInterface, UnloadUnitAction as UnloadUnitActionInterface, HealUnitAction as HealUnitActionInterface, WorldChange as WorldChangeInterface, UnitMovedChange as UnitMovedChangeInterface, UnitDamagedChange as UnitDamagedChangeInterface, UnitHealedChange as UnitHealedChangeInterface, UnitKilledChange as UnitKilledChangeInterface, PlayerChangedChange as PlayerChangedChangeInterface, CoinsChangedChange as CoinsChangedChangeInterface, UnitCreatedChange as UnitCreatedChangeInterface, TileCapturedChange as TileCapturedChangeInterface, TileChangedChange as TileChangedChangeInterface, UnitLoadedChange as UnitLoadedChangeInterface, UnitUnloadedChange as UnitUnloadedChangeInterface, GameInfo as GameInfoInterface, ListGamesRequest as ListGamesRequestInterface, ListGamesResponse as ListGamesResponseInterface, GetGameRequest as GetGameRequestInterface, GetGameResponse as GetGameResponseInterface, GetGameContentRequest as GetGameContentRequestInterface, GetGameContentResponse as GetGameContentResponseInterface, UpdateGameRequest as UpdateGameRequestInterface, UpdateGameResponse as UpdateGameResponseInterface, DeleteGameRequest as DeleteGameRequestInterface, DeleteGameResponse as DeleteGameResponseInterface, GetGamesRequest as GetGamesRequestInterface, GetGamesResponse as GetGamesResponseInterface, CreateGameRequest as CreateGameRequestInterface, CreateGameResponse as CreateGameResponseInterface, ProcessMovesRequest as ProcessMovesRequestInterface, ProcessMovesResponse as ProcessMovesResponseInterface, VerifyGameRequest as VerifyGameRequestInterface, VerifyGameResponse as VerifyGameResponseInterface, GameDivergence as GameDivergenceInterface, UndoMovesRequest as UndoMovesRequestInterface, UndoMovesResponse as UndoMovesResponseInterface, SubscribeGameRequest as SubscribeGameRequestInterface, SubscribeGameResponse as SubscribeGameResponseInterface, GetGameStateRequest as GetGameStateRequestInterface, GetGameStateResponse as GetGameStateResponseInterface, ListMovesRequest as ListMovesRequestInterface, ListMovesResponse as ListMovesResponseInterface, GetOptionsAtRequest as GetOptionsAtRequestInterface, GetOptionsAtResponse as GetOptionsAtResponseInterface, GetPathRequest as GetPathRequestInterface, GetPathResponse as GetPathResponseInterface, PathStep as PathStepInterface, GameOption as GameOptionInterface, EndTurnOption as EndTurnOptionInterface, MoveOption as MoveOptionInterface, AttackOption as AttackOptionInterface, BuildUnitOption as BuildUnitOptionInterface, CaptureBuildingOption as CaptureBuildingOptionInterface, ModifyTerrainOption as ModifyTerrainOptionInterface, LoadUnitOption as LoadUnitOptionInterface, UnloadUnitOption as UnloadUnitOptionInterface, HealUnitOption as HealUnitOptionInterface, GetRulesRequest as GetRulesRequestInterface, GetRulesResponse as GetRulesResponseInterface, GetUnitRequest as GetUnitRequestInterface, GetUnitResponse as GetUnitResponseInterface, GetCombatTableRequest as GetCombatTableRequestInterface, GetCombatTableResponse as GetCombatTableResponseInterface, UserInfo as UserInfoInterface, ListUsersRequest as ListUsersRequestInterface, ListUsersResponse as ListUsersResponseInterface, GetUserRequest as GetUserRequestInterface, GetUserResponse as GetUserResponseInterface, GetUserContentRequest as GetUserContentRequestInterface, GetUserContentResponse as GetUserContentResponseInterface, UpdateUserRequest as UpdateUserRequestInterface, UpdateUserResponse as UpdateUserResponseInterface, DeleteUserRequest as DeleteUserRequestInterface, DeleteUserResponse as DeleteUserResponseInterface, GetUsersRequest as GetUsersRequestInterface, GetUsersResponse as GetUsersResponseInterface, CreateUserRequest as CreateUserRequestInterface, CreateUserResponse as CreateUserResponseInterface, WorldInfo as WorldInfoInterface, ListWorldsRequest as ListWorldsRequestInterface, ListWorldsResponse as ListWorldsResponseInterface, GetWorldRequest as GetWorldRequestInterface, GetWorldResponse as GetWorldResponseInterface, UpdateWorldRequest as UpdateWorldRequestInterface, UpdateWorldResponse as UpdateWorldResponseInterface, DeleteWorldRequest as DeleteWorldRequestInterface, DeleteWorldResponse as DeleteWorldResponseInterface, GetWorldsRequest as GetWorldsRequestInterface, GetWorldsResponse as GetWorldsResponseInterface, CreateWorldRequest as CreateWorldRequestInterface, CreateWorldResponse as CreateWorldResponseInterface } from "./interfaces";
 import { WeewarV1Deserializer } from "./deserializer";
 
 
@@ -172,6 +172,14 @@ export class Tile implements TileInterface {
   tileType: number = 0;
   /** Whether the tile itself belongs to a player */
   player: number = 0;
+  /** Player currently capturing this tile (0 = not being captured) */
+  capturePlayer: number = 0;
+  /** Number of turns of capture progress made by the capturing player */
+  captureProgress: number = 0;
+  /** Terrain action being worked on this tile ("" = none) */
+  terrainAction: string = "";
+  /** Number of turns of work done on the terrain action */
+  terrainActionProgress: number = 0;
 
   /**
    * Create and deserialize an instance from raw data
@@ -200,6 +208,13 @@ export class Unit implements UnitInterface {
   availableHealth: number = 0;
   distanceLeft: number = 0;
   turnCounter: number = 0;
+  hasMoved: boolean = false;
+  hasAttacked: boolean = false;
+  actionsRemaining: number = 0;
+  /** Units being carried by this unit if it is a transport.  Carried units are not on
+ the map (their coordinates are only meaningful once unloaded) and are destroyed
+ along with their transport. */
+  cargo: Unit[] = [];
 
   /**
    * Create and deserialize an instance from raw data
@@ -227,6 +242,11 @@ export class TerrainDefinition implements TerrainDefinitionInterface {
   defenseBonus: number = 0;
   type: number = 0;
   description: string = "";
+  buildableUnits: number[] = [];
+  captureTurns: number = 0;
+  repairAmount: number = 0;
+  repairClasses: string[] = [];
+  repairCost: number = 0;
 
   /**
    * Create and deserialize an instance from raw data
@@ -254,6 +274,17 @@ export class UnitDefinition implements UnitDefinitionInterface {
   attackRange: number = 0;
   health: number = 0;
   properties: string[] = [];
+  coins: number = 0;
+  canCapture: boolean = false;
+  sightRange: number = 0;
+  unitClass: string = "";
+  canMoveAfterAttack: boolean = false;
+  actionsPerTurn: number = 0;
+  minAttackRange: number = 0;
+  indirectFire: boolean = false;
+  transportCapacity: number = 0;
+  cargoClasses: string[] = [];
+  healAmount: number = 0;
 
   /**
    * Create and deserialize an instance from raw data
@@ -266,6 +297,139 @@ export class UnitDefinition implements UnitDefinitionInterface {
 }
 
 
+/**
+ * Rules that constrain how units move around other units
+ */
+export class MovementRules implements MovementRulesInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.MovementRules";
+
+  /** Whether units can move through hexes held by their own and allied units.
+ Units can never end a move on another unit. */
+  passThroughAllies: boolean = false;
+  /** Unit classes that exert a zone of control.  An enemy unit entering a hex
+ next to one of these units must stop there. */
+  zocUnitClasses: string[] = [];
+  /** Unit classes that ignore zones of control (eg "air") */
+  zocImmuneClasses: string[] = [];
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized MovementRules instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<MovementRules>(MovementRules.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * Rules that adjust the damage units deal in combat beyond the attack matrix.  An
+ attacker's damage is always scaled by its remaining health and reduced by the
+ defense bonus of the defender's terrain.
+ */
+export class CombatRules implements CombatRulesInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.CombatRules";
+
+  /** Damage multipliers keyed by attacker unit class.  Missing entries leave the
+ damage unchanged. */
+  classModifiers?: Map<string, ClassDamageModifiers>;
+  /** Defender unit classes that get no defense bonus from terrain (eg "air") */
+  terrainDefenseIgnoredClasses: string[] = [];
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized CombatRules instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<CombatRules>(CombatRules.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * Actions units can take to change terrain during play (eg building bridges or laying roads)
+ */
+export class TerrainActionRules implements TerrainActionRulesInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.TerrainActionRules";
+
+  /** Terrain actions keyed by their ID */
+  actions?: Map<string, TerrainAction>;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized TerrainActionRules instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<TerrainActionRules>(TerrainActionRules.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * A change units with the right property can make to a tile over one or more turns
+ */
+export class TerrainAction implements TerrainActionInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.TerrainAction";
+
+  id: string = "";
+  name: string = "";
+  /** Terrain the action can be used on -> terrain it turns into */
+  terrainChanges?: Map<number, number>;
+  /** Turns of work needed to complete the change (0 = 1) */
+  turns: number = 0;
+  /** Coins paid when work on a tile starts (0 = free) */
+  coins: number = 0;
+  /** Unit property needed to perform the action (eg "engineer") */
+  unitProperty: string = "";
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized TerrainAction instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<TerrainAction>(TerrainAction.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * Damage multipliers an attacker class applies to each defender unit class
+ */
+export class ClassDamageModifiers implements ClassDamageModifiersInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.ClassDamageModifiers";
+
+  defenderClasses?: Map<string, number>;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized ClassDamageModifiers instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<ClassDamageModifiers>(ClassDamageModifiers.MESSAGE_TYPE, data);
+  }
+}
+
+
 /**
  * Movement cost matrix for unit types on terrain types
  */
@@ -310,6 +474,126 @@ export class TerrainCostMap implements TerrainCostMapInterface {
 }
 
 
+/**
+ * Attack matrix of the damage each unit type deals to each other unit type
+ */
+export class AttackMatrix implements AttackMatrixInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.AttackMatrix";
+
+  /** Map of attacker unit_id -> (defender unit_id -> damage distribution) */
+  attacks?: Map<number, DefenderDamageMap>;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized AttackMatrix instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<AttackMatrix>(AttackMatrix.MESSAGE_TYPE, data);
+  }
+}
+
+
+
+export class DefenderDamageMap implements DefenderDamageMapInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.DefenderDamageMap";
+
+  /** Map of defender unit_id -> damage distribution.  Missing defenders cannot be attacked. */
+  defenderDamages?: Map<number, DamageDistribution>;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized DefenderDamageMap instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<DefenderDamageMap>(DefenderDamageMap.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * Distribution of the damage an attack deals
+ */
+export class DamageDistribution implements DamageDistributionInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.DamageDistribution";
+
+  minDamage: number = 0;
+  maxDamage: number = 0;
+  damageBuckets: DamageBucket[] = [];
+  expectedDamage: number = 0;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized DamageDistribution instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<DamageDistribution>(DamageDistribution.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * A damage value and the weight of it being rolled
+ */
+export class DamageBucket implements DamageBucketInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.DamageBucket";
+
+  damage: number = 0;
+  weight: number = 0;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized DamageBucket instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<DamageBucket>(DamageBucket.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * A complete rule set as loaded by the rules engine
+ */
+export class RuleSet implements RuleSetInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.RuleSet";
+
+  units?: Map<number, UnitDefinition>;
+  terrains?: Map<number, TerrainDefinition>;
+  movementMatrix?: MovementMatrix;
+  attackMatrix?: AttackMatrix;
+  movementRules?: MovementRules;
+  combatRules?: CombatRules;
+  terrainActions?: TerrainActionRules;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized RuleSet instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<RuleSet>(RuleSet.MESSAGE_TYPE, data);
+  }
+}
+
+
 /**
  * Describes a game and its metadata
  */
@@ -339,6 +623,9 @@ export class Game implements GameInterface {
   difficulty: string = "";
   /** Game configuration */
   config?: GameConfiguration;
+  /** ID of the rule set the game is played under ("" = the default rules, for games
+ created before rules were versioned) */
+  rulesId: string = "";
 
   /**
    * Create and deserialize an instance from raw data
@@ -416,6 +703,14 @@ export class GameSettings implements GameSettingsInterface {
   teamMode: string = "";
   /** Maximum number of turns (0 = unlimited) */
   maxTurns: number = 0;
+  /** Coin economy settings */
+  coins?: CoinSettings;
+  /** Whether players can only see what their units and buildings can see */
+  fogOfWar: boolean = false;
+  /** How the game is won */
+  victory?: VictorySettings;
+  /** Changes to the default rules for this game */
+  rulesOverlay?: RulesOverlay;
 
   /**
    * Create and deserialize an instance from raw data
@@ -428,6 +723,116 @@ export class GameSettings implements GameSettingsInterface {
 }
 
 
+/**
+ * Per game changes applied on top of the default rules
+ */
+export class RulesOverlay implements RulesOverlayInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.RulesOverlay";
+
+  /** Unit type IDs that cannot be built in this game */
+  disabledUnits: number[] = [];
+  /** Build costs keyed by unit type ID, replacing the unit's default cost */
+  unitCoins?: Map<number, number>;
+  /** Damage multipliers keyed by attacker unit type ID */
+  damageMultipliers?: Map<number, number>;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized RulesOverlay instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<RulesOverlay>(RulesOverlay.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * The conditions under which a game is won.  The game ends as soon as any
+ enabled condition is met.  If none are enabled the last player with units
+ left wins.
+ */
+export class VictorySettings implements VictorySettingsInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.VictorySettings";
+
+  /** Win by being the last player with units left */
+  elimination: boolean = false;
+  /** Win by owning this many bases (0 = disabled) */
+  captureBases: number = 0;
+  /** Tile type of the players' headquarters.  Win by owning every headquarters
+ on the map (0 = disabled) */
+  headquartersTileType: number = 0;
+  /** Win by having the highest score when GameSettings.max_turns is reached */
+  scoreAtMaxTurns: boolean = false;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized VictorySettings instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<VictorySettings>(VictorySettings.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * How close a player is to meeting each of a game's victory conditions
+ */
+export class VictoryProgress implements VictoryProgressInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.VictoryProgress";
+
+  player: number = 0;
+  /** Victory condition name -> progress (0 to 1) */
+  progress?: Map<string, number>;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized VictoryProgress instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<VictoryProgress>(VictoryProgress.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * Describes how players earn coins over the course of a game
+ */
+export class CoinSettings implements CoinSettingsInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.CoinSettings";
+
+  /** Coins each player starts the game with */
+  startOfGame: number = 0;
+  /** Coins credited to a player at the start of each of their turns */
+  perTurn: number = 0;
+  /** Additional coins credited per base owned at the start of each turn */
+  perBase: number = 0;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized CoinSettings instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<CoinSettings>(CoinSettings.MESSAGE_TYPE, data);
+  }
+}
+
+
 /**
  * Holds the game's Active/Current state (eg world state)
  */
@@ -444,6 +849,28 @@ export class GameState implements GameStateInterface {
   currentPlayer: number = 0;
   /** Current world state */
   worldData?: WorldData;
+  /** Coins currently held by each player (player ID -> coins) */
+  playerCoins?: Map<number, number>;
+  /** Sequence number of the last move processed in this game (0 if no moves yet).
+ Sequence numbers are assigned by the server and never reused. */
+  lastSequenceNum: number = 0;
+  /** Seed of the game's random stream (eg for combat) and how many values have
+ been drawn from it so far.  Together they let the exact stream be restored
+ when the game is reloaded or replayed.  They are kept on the server and
+ cleared in responses so players cannot predict the outcome of their moves. */
+  rngSeed: number = 0;
+  rngPosition: number = 0;
+  /** When the current player's turn started and when it ends if the game has a
+ turn time limit (unset otherwise).  Once the deadline passes the server ends
+ the turn on the player's behalf. */
+  turnStartedAt?: Date;
+  turnDeadline?: Date;
+  /** Player that won the game (0 while the game is in progress) and the victory
+ condition that decided it */
+  winner: number = 0;
+  victoryCondition: string = "";
+  /** All the players that won - the winner and their teammates in team games */
+  winners: number[] = [];
 
   /**
    * Create and deserialize an instance from raw data
@@ -469,6 +896,9 @@ export class GameMoveHistory implements GameMoveHistoryInterface {
   gameId: string = "";
   /** Each entry in our history is a "group" of moves */
   groups: GameMoveGroup[] = [];
+  /** State of the game before any moves were made.  Replaying all the groups
+ from this state reproduces the game's current state. */
+  initialState?: GameState;
 
   /**
    * Create and deserialize an instance from raw data
@@ -529,6 +959,12 @@ export class GameMove implements GameMoveInterface {
   moveUnit?: MoveUnitAction;
   attackUnit?: AttackUnitAction;
   endTurn?: EndTurnAction;
+  buildUnit?: BuildUnitAction;
+  captureBuilding?: CaptureBuildingAction;
+  loadUnit?: LoadUnitAction;
+  unloadUnit?: UnloadUnitAction;
+  healUnit?: HealUnitAction;
+  modifyTerrain?: ModifyTerrainAction;
 
   /**
    * Create and deserialize an instance from raw data
@@ -571,6 +1007,30 @@ export class GameMoveResult implements GameMoveResultInterface {
 }
 
 
+/**
+ * *
+ A hex coordinate
+ */
+export class HexCoord implements HexCoordInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.HexCoord";
+
+  q: number = 0;
+  r: number = 0;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized HexCoord instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<HexCoord>(HexCoord.MESSAGE_TYPE, data);
+  }
+}
+
+
 /**
  * *
  Move unit from one position to another
@@ -585,6 +1045,10 @@ export class MoveUnitAction implements MoveUnitActionInterface {
   fromR: number = 0;
   toQ: number = 0;
   toR: number = 0;
+  /** The exact hexes the unit moves through, starting at (from_q, from_r) and ending
+ at (to_q, to_r).  Each step is validated and charged its terrain cost.  If empty
+ the cheapest path is used. */
+  path: HexCoord[] = [];
 
   /**
    * Create and deserialize an instance from raw data
@@ -633,6 +1097,8 @@ export class EndTurnAction implements EndTurnActionInterface {
    */
   static readonly MESSAGE_TYPE = "weewar.v1.EndTurnAction";
 
+  /** Set by the server when it ended the turn because the player ran out of time */
+  timedOut: boolean = false;
 
   /**
    * Create and deserialize an instance from raw data
@@ -647,23 +1113,189 @@ export class EndTurnAction implements EndTurnActionInterface {
 
 /**
  * *
- Represents a change to the game world
+ Build a new unit on a base owned by the player
  */
-export class WorldChange implements WorldChangeInterface {
+export class BuildUnitAction implements BuildUnitActionInterface {
   /**
    * Fully qualified message type for schema resolution
    */
-  static readonly MESSAGE_TYPE = "weewar.v1.WorldChange";
+  static readonly MESSAGE_TYPE = "weewar.v1.BuildUnitAction";
 
-  unitMoved?: UnitMovedChange;
-  unitDamaged?: UnitDamagedChange;
-  unitKilled?: UnitKilledChange;
-  playerChanged?: PlayerChangedChange;
+  q: number = 0;
+  r: number = 0;
+  unitType: number = 0;
 
   /**
    * Create and deserialize an instance from raw data
    * @param data Raw data to deserialize
-   * @returns Deserialized WorldChange instance or null if creation failed
+   * @returns Deserialized BuildUnitAction instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<BuildUnitAction>(BuildUnitAction.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ Capture (or continue capturing) the building under a unit
+ */
+export class CaptureBuildingAction implements CaptureBuildingActionInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.CaptureBuildingAction";
+
+  q: number = 0;
+  r: number = 0;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized CaptureBuildingAction instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<CaptureBuildingAction>(CaptureBuildingAction.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ A unit works on a terrain action on its own or an adjacent hex
+ */
+export class ModifyTerrainAction implements ModifyTerrainActionInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.ModifyTerrainAction";
+
+  /** Unit performing the action */
+  q: number = 0;
+  r: number = 0;
+  /** Hex being changed */
+  targetQ: number = 0;
+  targetR: number = 0;
+  /** ID of the terrain action in the rules */
+  terrainAction: string = "";
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized ModifyTerrainAction instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<ModifyTerrainAction>(ModifyTerrainAction.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ Board a unit onto an adjacent transport owned by the same player
+ */
+export class LoadUnitAction implements LoadUnitActionInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.LoadUnitAction";
+
+  unitQ: number = 0;
+  unitR: number = 0;
+  transportQ: number = 0;
+  transportR: number = 0;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized LoadUnitAction instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<LoadUnitAction>(LoadUnitAction.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ Unload a carried unit from a transport onto an adjacent empty hex
+ */
+export class UnloadUnitAction implements UnloadUnitActionInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.UnloadUnitAction";
+
+  transportQ: number = 0;
+  transportR: number = 0;
+  /** Index of the unit in the transport's cargo */
+  cargoIndex: number = 0;
+  toQ: number = 0;
+  toR: number = 0;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized UnloadUnitAction instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<UnloadUnitAction>(UnloadUnitAction.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ A healer (eg a Medic) restores health to an adjacent friendly unit
+ */
+export class HealUnitAction implements HealUnitActionInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.HealUnitAction";
+
+  healerQ: number = 0;
+  healerR: number = 0;
+  targetQ: number = 0;
+  targetR: number = 0;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized HealUnitAction instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<HealUnitAction>(HealUnitAction.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ Represents a change to the game world
+ */
+export class WorldChange implements WorldChangeInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.WorldChange";
+
+  unitMoved?: UnitMovedChange;
+  unitDamaged?: UnitDamagedChange;
+  unitKilled?: UnitKilledChange;
+  playerChanged?: PlayerChangedChange;
+  coinsChanged?: CoinsChangedChange;
+  unitCreated?: UnitCreatedChange;
+  tileCaptured?: TileCapturedChange;
+  unitLoaded?: UnitLoadedChange;
+  unitUnloaded?: UnitUnloadedChange;
+  unitHealed?: UnitHealedChange;
+  tileChanged?: TileChangedChange;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized WorldChange instance or null if creation failed
    */
   static from(data: any) {
     return WeewarV1Deserializer.from<WorldChange>(WorldChange.MESSAGE_TYPE, data);
@@ -699,7 +1331,8 @@ export class UnitMovedChange implements UnitMovedChangeInterface {
 
 /**
  * *
- A unit took damage
+ A unit was attacked.  The damage may be 0 so an attack that misses still
+ records the attacker using up its action.
  */
 export class UnitDamagedChange implements UnitDamagedChangeInterface {
   /**
@@ -711,6 +1344,10 @@ export class UnitDamagedChange implements UnitDamagedChangeInterface {
   previousUnit?: Unit;
   /** Complete unit state after taking damage */
   updatedUnit?: Unit;
+  /** Attacking unit state before and after using up its action (not set for the
+ damage a counter attack deals to the attacker) */
+  previousAttacker?: Unit;
+  updatedAttacker?: Unit;
 
   /**
    * Create and deserialize an instance from raw data
@@ -723,6 +1360,35 @@ export class UnitDamagedChange implements UnitDamagedChangeInterface {
 }
 
 
+/**
+ * *
+ A unit regained health by repairing on a base or being healed
+ */
+export class UnitHealedChange implements UnitHealedChangeInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.UnitHealedChange";
+
+  /** Complete unit state before healing */
+  previousUnit?: Unit;
+  /** Complete unit state after healing */
+  updatedUnit?: Unit;
+  /** Healing unit state before and after using up its action (not set for repairs) */
+  previousHealer?: Unit;
+  updatedHealer?: Unit;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized UnitHealedChange instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<UnitHealedChange>(UnitHealedChange.MESSAGE_TYPE, data);
+  }
+}
+
+
 /**
  * *
  A unit was killed
@@ -775,6 +1441,169 @@ export class PlayerChangedChange implements PlayerChangedChangeInterface {
 }
 
 
+/**
+ * *
+ A player's coin balance changed
+ */
+export class CoinsChangedChange implements CoinsChangedChangeInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.CoinsChangedChange";
+
+  player: number = 0;
+  previousCoins: number = 0;
+  newCoins: number = 0;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized CoinsChangedChange instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<CoinsChangedChange>(CoinsChangedChange.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ A new unit was created (eg built on a base)
+ */
+export class UnitCreatedChange implements UnitCreatedChangeInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.UnitCreatedChange";
+
+  /** Complete state of the newly created unit */
+  unit?: Unit;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized UnitCreatedChange instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<UnitCreatedChange>(UnitCreatedChange.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ A tile's capture state changed - either capture progressed, was abandoned
+ or completed (in which case the tile changes owner)
+ */
+export class TileCapturedChange implements TileCapturedChangeInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.TileCapturedChange";
+
+  /** Complete tile state before the change */
+  previousTile?: Tile;
+  /** Complete tile state after the change */
+  updatedTile?: Tile;
+  /** Capturing unit state before and after capturing (not set when capture is abandoned) */
+  previousUnit?: Unit;
+  updatedUnit?: Unit;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized TileCapturedChange instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<TileCapturedChange>(TileCapturedChange.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ Work on a terrain action progressed or completed (in which case the tile's
+ terrain type changes)
+ */
+export class TileChangedChange implements TileChangedChangeInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.TileChangedChange";
+
+  /** Complete tile state before the change */
+  previousTile?: Tile;
+  /** Complete tile state after the change */
+  updatedTile?: Tile;
+  /** Working unit state before and after the work */
+  previousUnit?: Unit;
+  updatedUnit?: Unit;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized TileChangedChange instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<TileChangedChange>(TileChangedChange.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ A unit boarded a transport and left the map
+ */
+export class UnitLoadedChange implements UnitLoadedChangeInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.UnitLoadedChange";
+
+  /** Complete unit state before boarding (on the map) */
+  unit?: Unit;
+  /** Complete transport state before and after the unit boarded */
+  previousTransport?: Unit;
+  updatedTransport?: Unit;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized UnitLoadedChange instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<UnitLoadedChange>(UnitLoadedChange.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ A unit was unloaded from a transport onto the map
+ */
+export class UnitUnloadedChange implements UnitUnloadedChangeInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.UnitUnloadedChange";
+
+  /** Complete unit state after unloading (on the map) */
+  unit?: Unit;
+  /** Complete transport state before and after the unit was unloaded */
+  previousTransport?: Unit;
+  updatedTransport?: Unit;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized UnitUnloadedChange instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<UnitUnloadedChange>(UnitUnloadedChange.MESSAGE_TYPE, data);
+  }
+}
+
+
 /**
  * GameInfo represents a game in the catalog
  */
@@ -859,6 +1688,10 @@ export class GetGameRequest implements GetGameRequestInterface {
 
   id: string = "";
   version: string = "";
+  /** *
+ Player whose view of the game's state and history to return.  When fog of
+ war is enabled and no player is given only the game's metadata is returned. */
+  player: number = 0;
 
   /**
    * Create and deserialize an instance from raw data
@@ -1165,6 +1998,12 @@ export class ProcessMovesRequest implements ProcessMovesRequestInterface {
   /** *
  List of moves to add */
   moves: GameMove[] = [];
+  /** *
+ If > 0, the moves are only processed if this is the sequence number of the
+ last move in the game.  This lets clients detect they are submitting moves
+ against a stale game state.  Sequence numbers start at 1 so 0 skips the check
+ and -1 requires that no moves have been made yet. */
+  expectedLastSequenceNum: number = 0;
 
   /**
    * Create and deserialize an instance from raw data
@@ -1213,45 +2052,263 @@ export class ProcessMovesResponse implements ProcessMovesResponseInterface {
 
 /**
  * *
- Request to get the game's latest state
+ Request to verify a game by replaying its move history
  */
-export class GetGameStateRequest implements GetGameStateRequestInterface {
+export class VerifyGameRequest implements VerifyGameRequestInterface {
   /**
    * Fully qualified message type for schema resolution
    */
-  static readonly MESSAGE_TYPE = "weewar.v1.GetGameStateRequest";
+  static readonly MESSAGE_TYPE = "weewar.v1.VerifyGameRequest";
 
   /** *
- Game ID to add moves to */
+ Game ID to verify */
   gameId: string = "";
 
   /**
    * Create and deserialize an instance from raw data
    * @param data Raw data to deserialize
-   * @returns Deserialized GetGameStateRequest instance or null if creation failed
+   * @returns Deserialized VerifyGameRequest instance or null if creation failed
    */
   static from(data: any) {
-    return WeewarV1Deserializer.from<GetGameStateRequest>(GetGameStateRequest.MESSAGE_TYPE, data);
+    return WeewarV1Deserializer.from<VerifyGameRequest>(VerifyGameRequest.MESSAGE_TYPE, data);
   }
 }
 
 
 /**
  * *
- Response holding latest game state
+ Result of replaying a game's move history
  */
-export class GetGameStateResponse implements GetGameStateResponseInterface {
+export class VerifyGameResponse implements VerifyGameResponseInterface {
   /**
    * Fully qualified message type for schema resolution
    */
-  static readonly MESSAGE_TYPE = "weewar.v1.GetGameStateResponse";
+  static readonly MESSAGE_TYPE = "weewar.v1.VerifyGameResponse";
 
-  state?: GameState;
+  /** Whether the replay matched the recorded history and the saved game state */
+  verified: boolean = false;
+  groupsReplayed: number = 0;
+  movesReplayed: number = 0;
+  /** Where the replay first differed from the recording (unset if verified) */
+  divergence?: GameDivergence;
 
   /**
    * Create and deserialize an instance from raw data
    * @param data Raw data to deserialize
-   * @returns Deserialized GetGameStateResponse instance or null if creation failed
+   * @returns Deserialized VerifyGameResponse instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<VerifyGameResponse>(VerifyGameResponse.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ The first point where a replayed game differs from its recorded history
+ */
+export class GameDivergence implements GameDivergenceInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.GameDivergence";
+
+  /** Index of the move group in the history.  Equal to the number of groups if
+ only the final game state differs. */
+  groupIndex: number = 0;
+  /** Index of the move in the group (-1 if not specific to a move) */
+  moveIndex: number = 0;
+  /** Index of the change in the move's result (-1 if not specific to a change) */
+  changeIndex: number = 0;
+  /** Sequence number of the move */
+  sequenceNum: number = 0;
+  /** Human readable description of the difference */
+  reason: string = "";
+  /** The recorded change */
+  expectedChange?: WorldChange;
+  /** The recomputed change */
+  actualChange?: WorldChange;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized GameDivergence instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<GameDivergence>(GameDivergence.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ Request to undo the latest moves in a game.
+
+ Only move groups whose results are all non-permanent can be undone and
+ undoing never crosses a turn boundary.
+ */
+export class UndoMovesRequest implements UndoMovesRequestInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.UndoMovesRequest";
+
+  /** *
+ Game ID to undo moves in */
+  gameId: string = "";
+  /** *
+ Number of trailing move groups to undo.  if <= 0 the last group is undone */
+  count: number = 0;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized UndoMovesRequest instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<UndoMovesRequest>(UndoMovesRequest.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ Response after undoing moves.
+ */
+export class UndoMovesResponse implements UndoMovesResponseInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.UndoMovesResponse";
+
+  /** *
+ The move groups that were undone and removed from the history, latest first */
+  undoneGroups: GameMoveGroup[] = [];
+  /** *
+ The inverse changes that were applied to the world, in the order they were applied */
+  changes: WorldChange[] = [];
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized UndoMovesResponse instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<UndoMovesResponse>(UndoMovesResponse.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ Request to subscribe to a game's updates.
+ */
+export class SubscribeGameRequest implements SubscribeGameRequestInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.SubscribeGameRequest";
+
+  /** *
+ Game ID to subscribe to */
+  gameId: string = "";
+  /** *
+ Sequence number of the last move the client has seen.  Move groups in the
+ history with later moves are sent before live updates.  0 replays the
+ whole history. */
+  fromSequenceNum: number = 0;
+  /** *
+ Player whose view the updates are filtered to when fog of war is enabled */
+  player: number = 0;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized SubscribeGameRequest instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<SubscribeGameRequest>(SubscribeGameRequest.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ An update to a subscribed game.  Exactly one of the fields is set.
+ */
+export class SubscribeGameResponse implements SubscribeGameResponseInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.SubscribeGameResponse";
+
+  /** *
+ A move group that was committed to the game */
+  moveGroup?: GameMoveGroup;
+  /** *
+ Move groups that were undone.  Clients should apply the inverse changes. */
+  undo?: UndoMovesResponse;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized SubscribeGameResponse instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<SubscribeGameResponse>(SubscribeGameResponse.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ Request to get the game's latest state
+ */
+export class GetGameStateRequest implements GetGameStateRequestInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.GetGameStateRequest";
+
+  /** *
+ Game ID to add moves to */
+  gameId: string = "";
+  /** *
+ Player whose view of the game state to return.
+ Required when fog of war is enabled. */
+  player: number = 0;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized GetGameStateRequest instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<GetGameStateRequest>(GetGameStateRequest.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ Response holding latest game state
+ */
+export class GetGameStateResponse implements GetGameStateResponseInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.GetGameStateResponse";
+
+  state?: GameState;
+  /** Seconds left in the current turn or -1 if turns are not timed */
+  turnTimeRemaining: number = 0;
+  /** Each player's progress towards the game's victory conditions */
+  victoryProgress: VictoryProgress[] = [];
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized GetGameStateResponse instance or null if creation failed
    */
   static from(data: any) {
     return WeewarV1Deserializer.from<GetGameStateResponse>(GetGameStateResponse.MESSAGE_TYPE, data);
@@ -1278,6 +2335,10 @@ export class ListMovesRequest implements ListMovesRequestInterface {
   /** *
  Limit to last N moves (from offset).  if <= 0 return all moves */
   lastN: number = 0;
+  /** *
+ Player whose view of the moves to return.
+ Required when fog of war is enabled. */
+  player: number = 0;
 
   /**
    * Create and deserialize an instance from raw data
@@ -1365,6 +2426,93 @@ export class GetOptionsAtResponse implements GetOptionsAtResponseInterface {
 }
 
 
+/**
+ * *
+ Request for the cheapest path between two positions
+ */
+export class GetPathRequest implements GetPathRequestInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.GetPathRequest";
+
+  gameId: string = "";
+  fromQ: number = 0;
+  fromR: number = 0;
+  toQ: number = 0;
+  toR: number = 0;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized GetPathRequest instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<GetPathRequest>(GetPathRequest.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ The cheapest path for a unit between two positions
+ */
+export class GetPathResponse implements GetPathResponseInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.GetPathResponse";
+
+  /** Steps after the starting position in the order they are moved through */
+  steps: PathStep[] = [];
+  /** Total terrain cost of the path */
+  totalCost: number = 0;
+  /** Movement points the move is charged (the total cost rounded) */
+  movementCost: number = 0;
+  /** Whether the unit has enough movement left to make the move this turn */
+  reachable: boolean = false;
+  /** Ready-to-use action object for ProcessMoves */
+  action?: MoveUnitAction;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized GetPathResponse instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<GetPathResponse>(GetPathResponse.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ A single step of a path
+ */
+export class PathStep implements PathStepInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.PathStep";
+
+  q: number = 0;
+  r: number = 0;
+  /** Cost of entering this hex */
+  cost: number = 0;
+  /** Cost of the path up to and including this hex */
+  totalCost: number = 0;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized PathStep instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<PathStep>(PathStep.MESSAGE_TYPE, data);
+  }
+}
+
+
 /**
  * *
  A single game option available at a position
@@ -1380,6 +2528,10 @@ export class GameOption implements GameOptionInterface {
   endTurn?: EndTurnOption;
   build?: BuildUnitOption;
   capture?: CaptureBuildingOption;
+  load?: LoadUnitOption;
+  unload?: UnloadUnitOption;
+  heal?: HealUnitOption;
+  modifyTerrain?: ModifyTerrainOption;
 
   /**
    * Create and deserialize an instance from raw data
@@ -1460,6 +2612,11 @@ export class AttackOption implements AttackOptionInterface {
   damageEstimate: number = 0;
   /** Ready-to-use action object for ProcessMoves */
   action?: AttackUnitAction;
+  /** Predicted outcome of the attack with the target's counter attack */
+  expectedDamageDealt: number = 0;
+  expectedDamageTaken: number = 0;
+  killProbability: number = 0;
+  lossProbability: number = 0;
 
   /**
    * Create and deserialize an instance from raw data
@@ -1486,6 +2643,9 @@ export class BuildUnitOption implements BuildUnitOptionInterface {
   r: number = 0;
   tileType: number = 0;
   buildCost: number = 0;
+  unitType: number = 0;
+  /** Ready-to-use action object for ProcessMoves */
+  action?: BuildUnitAction;
 
   /**
    * Create and deserialize an instance from raw data
@@ -1511,6 +2671,11 @@ export class CaptureBuildingOption implements CaptureBuildingOptionInterface {
   q: number = 0;
   r: number = 0;
   tileType: number = 0;
+  /** Turns of capture progress so far and turns needed to complete the capture */
+  captureProgress: number = 0;
+  captureTurns: number = 0;
+  /** Ready-to-use action object for ProcessMoves */
+  action?: CaptureBuildingAction;
 
   /**
    * Create and deserialize an instance from raw data
@@ -1523,6 +2688,257 @@ export class CaptureBuildingOption implements CaptureBuildingOptionInterface {
 }
 
 
+/**
+ * *
+ A terrain action the unit can work on at a hex
+ */
+export class ModifyTerrainOption implements ModifyTerrainOptionInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.ModifyTerrainOption";
+
+  q: number = 0;
+  r: number = 0;
+  terrainAction: string = "";
+  name: string = "";
+  /** Terrain type the tile will turn into */
+  newTileType: number = 0;
+  /** Turns of work done so far and turns needed to complete the change */
+  progress: number = 0;
+  turns: number = 0;
+  /** Coins charged to start the work (0 if work is already under way) */
+  coins: number = 0;
+  /** Ready-to-use action object for ProcessMoves */
+  action?: ModifyTerrainAction;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized ModifyTerrainOption instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<ModifyTerrainOption>(ModifyTerrainOption.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ An adjacent transport the unit can board
+ */
+export class LoadUnitOption implements LoadUnitOptionInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.LoadUnitOption";
+
+  /** Position and type of the transport */
+  q: number = 0;
+  r: number = 0;
+  transportUnitType: number = 0;
+  /** Ready-to-use action object for ProcessMoves */
+  action?: LoadUnitAction;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized LoadUnitOption instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<LoadUnitOption>(LoadUnitOption.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ A hex a transport can unload one of its carried units to
+ */
+export class UnloadUnitOption implements UnloadUnitOptionInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.UnloadUnitOption";
+
+  q: number = 0;
+  r: number = 0;
+  /** Index and type of the carried unit */
+  cargoIndex: number = 0;
+  unitType: number = 0;
+  /** Ready-to-use action object for ProcessMoves */
+  action?: UnloadUnitAction;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized UnloadUnitOption instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<UnloadUnitOption>(UnloadUnitOption.MESSAGE_TYPE, data);
+  }
+}
+
+
+/**
+ * *
+ Option to heal an adjacent friendly unit
+ */
+export class HealUnitOption implements HealUnitOptionInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.HealUnitOption";
+
+  q: number = 0;
+  r: number = 0;
+  unitType: number = 0;
+  /** Health the target would regain */
+  amount: number = 0;
+  /** Ready-to-use action object for ProcessMoves */
+  action?: HealUnitAction;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized HealUnitOption instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<HealUnitOption>(HealUnitOption.MESSAGE_TYPE, data);
+  }
+}
+
+
+
+export class GetRulesRequest implements GetRulesRequestInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.GetRulesRequest";
+
+  rulesId: string = "";
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized GetRulesRequest instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<GetRulesRequest>(GetRulesRequest.MESSAGE_TYPE, data);
+  }
+}
+
+
+
+export class GetRulesResponse implements GetRulesResponseInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.GetRulesResponse";
+
+  rulesId: string = "";
+  rules?: RuleSet;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized GetRulesResponse instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<GetRulesResponse>(GetRulesResponse.MESSAGE_TYPE, data);
+  }
+}
+
+
+
+export class GetUnitRequest implements GetUnitRequestInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.GetUnitRequest";
+
+  rulesId: string = "";
+  unitId: number = 0;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized GetUnitRequest instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<GetUnitRequest>(GetUnitRequest.MESSAGE_TYPE, data);
+  }
+}
+
+
+
+export class GetUnitResponse implements GetUnitResponseInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.GetUnitResponse";
+
+  rulesId: string = "";
+  unit?: UnitDefinition;
+  /** Cost of entering each terrain (missing terrains cost their base move cost) */
+  movementCosts?: TerrainCostMap;
+  /** Damage the unit deals to each unit it can attack */
+  attacks?: DefenderDamageMap;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized GetUnitResponse instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<GetUnitResponse>(GetUnitResponse.MESSAGE_TYPE, data);
+  }
+}
+
+
+
+export class GetCombatTableRequest implements GetCombatTableRequestInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.GetCombatTableRequest";
+
+  rulesId: string = "";
+  attackerId: number = 0;
+  defenderId: number = 0;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized GetCombatTableRequest instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<GetCombatTableRequest>(GetCombatTableRequest.MESSAGE_TYPE, data);
+  }
+}
+
+
+
+export class GetCombatTableResponse implements GetCombatTableResponseInterface {
+  /**
+   * Fully qualified message type for schema resolution
+   */
+  static readonly MESSAGE_TYPE = "weewar.v1.GetCombatTableResponse";
+
+  rulesId: string = "";
+  attackMatrix?: AttackMatrix;
+
+  /**
+   * Create and deserialize an instance from raw data
+   * @param data Raw data to deserialize
+   * @returns Deserialized GetCombatTableResponse instance or null if creation failed
+   */
+  static from(data: any) {
+    return WeewarV1Deserializer.from<GetCombatTableResponse>(GetCombatTableResponse.MESSAGE_TYPE, data);
+  }
+}
+
+
 /**
  * UserInfo represents a user in the catalog
  */
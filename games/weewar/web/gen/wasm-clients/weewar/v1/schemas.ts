@@ -227,6 +227,26 @@ export const TileSchema: MessageSchema = {
       type: FieldType.NUMBER,
       id: 4,
     },
+    {
+      name: "capturePlayer",
+      type: FieldType.NUMBER,
+      id: 5,
+    },
+    {
+      name: "captureProgress",
+      type: FieldType.NUMBER,
+      id: 6,
+    },
+    {
+      name: "terrainAction",
+      type: FieldType.STRING,
+      id: 7,
+    },
+    {
+      name: "terrainActionProgress",
+      type: FieldType.NUMBER,
+      id: 8,
+    },
   ],
 };
 
@@ -272,6 +292,28 @@ export const UnitSchema: MessageSchema = {
       type: FieldType.NUMBER,
       id: 7,
     },
+    {
+      name: "hasMoved",
+      type: FieldType.BOOLEAN,
+      id: 8,
+    },
+    {
+      name: "hasAttacked",
+      type: FieldType.BOOLEAN,
+      id: 9,
+    },
+    {
+      name: "actionsRemaining",
+      type: FieldType.NUMBER,
+      id: 10,
+    },
+    {
+      name: "cargo",
+      type: FieldType.MESSAGE,
+      id: 11,
+      messageType: "weewar.v1.Unit",
+      repeated: true,
+    },
   ],
 };
 
@@ -312,6 +354,33 @@ export const TerrainDefinitionSchema: MessageSchema = {
       type: FieldType.STRING,
       id: 6,
     },
+    {
+      name: "buildableUnits",
+      type: FieldType.REPEATED,
+      id: 7,
+      repeated: true,
+    },
+    {
+      name: "captureTurns",
+      type: FieldType.NUMBER,
+      id: 8,
+    },
+    {
+      name: "repairAmount",
+      type: FieldType.NUMBER,
+      id: 9,
+    },
+    {
+      name: "repairClasses",
+      type: FieldType.REPEATED,
+      id: 10,
+      repeated: true,
+    },
+    {
+      name: "repairCost",
+      type: FieldType.NUMBER,
+      id: 11,
+    },
   ],
 };
 
@@ -353,188 +422,277 @@ export const UnitDefinitionSchema: MessageSchema = {
       id: 6,
       repeated: true,
     },
+    {
+      name: "coins",
+      type: FieldType.NUMBER,
+      id: 7,
+    },
+    {
+      name: "canCapture",
+      type: FieldType.BOOLEAN,
+      id: 8,
+    },
+    {
+      name: "sightRange",
+      type: FieldType.NUMBER,
+      id: 9,
+    },
+    {
+      name: "unitClass",
+      type: FieldType.STRING,
+      id: 10,
+    },
+    {
+      name: "canMoveAfterAttack",
+      type: FieldType.BOOLEAN,
+      id: 11,
+    },
+    {
+      name: "actionsPerTurn",
+      type: FieldType.NUMBER,
+      id: 12,
+    },
+    {
+      name: "minAttackRange",
+      type: FieldType.NUMBER,
+      id: 13,
+    },
+    {
+      name: "indirectFire",
+      type: FieldType.BOOLEAN,
+      id: 14,
+    },
+    {
+      name: "transportCapacity",
+      type: FieldType.NUMBER,
+      id: 15,
+    },
+    {
+      name: "cargoClasses",
+      type: FieldType.REPEATED,
+      id: 16,
+      repeated: true,
+    },
+    {
+      name: "healAmount",
+      type: FieldType.NUMBER,
+      id: 17,
+    },
   ],
 };
 
 
 /**
- * Schema for MovementMatrix message
+ * Schema for MovementRules message
  */
-export const MovementMatrixSchema: MessageSchema = {
-  name: "MovementMatrix",
+export const MovementRulesSchema: MessageSchema = {
+  name: "MovementRules",
   fields: [
     {
-      name: "costs",
-      type: FieldType.MESSAGE,
+      name: "passThroughAllies",
+      type: FieldType.BOOLEAN,
       id: 1,
-      messageType: "weewar.v1.CostsEntry",
+    },
+    {
+      name: "zocUnitClasses",
+      type: FieldType.REPEATED,
+      id: 2,
+      repeated: true,
+    },
+    {
+      name: "zocImmuneClasses",
+      type: FieldType.REPEATED,
+      id: 3,
+      repeated: true,
     },
   ],
 };
 
 
 /**
- * Schema for TerrainCostMap message
+ * Schema for CombatRules message
  */
-export const TerrainCostMapSchema: MessageSchema = {
-  name: "TerrainCostMap",
+export const CombatRulesSchema: MessageSchema = {
+  name: "CombatRules",
   fields: [
     {
-      name: "terrainCosts",
+      name: "classModifiers",
       type: FieldType.MESSAGE,
       id: 1,
-      messageType: "weewar.v1.TerrainCostsEntry",
+      messageType: "weewar.v1.ClassModifiersEntry",
+    },
+    {
+      name: "terrainDefenseIgnoredClasses",
+      type: FieldType.REPEATED,
+      id: 2,
+      repeated: true,
     },
   ],
 };
 
 
 /**
- * Schema for Game message
+ * Schema for TerrainActionRules message
  */
-export const GameSchema: MessageSchema = {
-  name: "Game",
+export const TerrainActionRulesSchema: MessageSchema = {
+  name: "TerrainActionRules",
   fields: [
     {
-      name: "createdAt",
+      name: "actions",
       type: FieldType.MESSAGE,
       id: 1,
-      messageType: "google.protobuf.Timestamp",
-    },
-    {
-      name: "updatedAt",
-      type: FieldType.MESSAGE,
-      id: 2,
-      messageType: "google.protobuf.Timestamp",
+      messageType: "weewar.v1.ActionsEntry",
     },
+  ],
+};
+
+
+/**
+ * Schema for TerrainAction message
+ */
+export const TerrainActionSchema: MessageSchema = {
+  name: "TerrainAction",
+  fields: [
     {
       name: "id",
       type: FieldType.STRING,
-      id: 3,
-    },
-    {
-      name: "creatorId",
-      type: FieldType.STRING,
-      id: 4,
-    },
-    {
-      name: "worldId",
-      type: FieldType.STRING,
-      id: 5,
+      id: 1,
     },
     {
       name: "name",
       type: FieldType.STRING,
-      id: 6,
+      id: 2,
     },
     {
-      name: "description",
-      type: FieldType.STRING,
-      id: 7,
+      name: "terrainChanges",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "weewar.v1.TerrainChangesEntry",
     },
     {
-      name: "tags",
-      type: FieldType.REPEATED,
-      id: 8,
-      repeated: true,
+      name: "turns",
+      type: FieldType.NUMBER,
+      id: 4,
     },
     {
-      name: "imageUrl",
-      type: FieldType.STRING,
-      id: 9,
+      name: "coins",
+      type: FieldType.NUMBER,
+      id: 5,
     },
     {
-      name: "difficulty",
+      name: "unitProperty",
       type: FieldType.STRING,
-      id: 10,
+      id: 6,
     },
+  ],
+};
+
+
+/**
+ * Schema for ClassDamageModifiers message
+ */
+export const ClassDamageModifiersSchema: MessageSchema = {
+  name: "ClassDamageModifiers",
+  fields: [
     {
-      name: "config",
+      name: "defenderClasses",
       type: FieldType.MESSAGE,
-      id: 11,
-      messageType: "weewar.v1.GameConfiguration",
+      id: 1,
+      messageType: "weewar.v1.DefenderClassesEntry",
     },
   ],
 };
 
 
 /**
- * Schema for GameConfiguration message
+ * Schema for MovementMatrix message
  */
-export const GameConfigurationSchema: MessageSchema = {
-  name: "GameConfiguration",
+export const MovementMatrixSchema: MessageSchema = {
+  name: "MovementMatrix",
   fields: [
     {
-      name: "players",
+      name: "costs",
       type: FieldType.MESSAGE,
       id: 1,
-      messageType: "weewar.v1.GamePlayer",
-      repeated: true,
+      messageType: "weewar.v1.CostsEntry",
     },
+  ],
+};
+
+
+/**
+ * Schema for TerrainCostMap message
+ */
+export const TerrainCostMapSchema: MessageSchema = {
+  name: "TerrainCostMap",
+  fields: [
     {
-      name: "settings",
+      name: "terrainCosts",
       type: FieldType.MESSAGE,
-      id: 2,
-      messageType: "weewar.v1.GameSettings",
+      id: 1,
+      messageType: "weewar.v1.TerrainCostsEntry",
     },
   ],
 };
 
 
 /**
- * Schema for GamePlayer message
+ * Schema for AttackMatrix message
  */
-export const GamePlayerSchema: MessageSchema = {
-  name: "GamePlayer",
+export const AttackMatrixSchema: MessageSchema = {
+  name: "AttackMatrix",
   fields: [
     {
-      name: "playerId",
-      type: FieldType.NUMBER,
+      name: "attacks",
+      type: FieldType.MESSAGE,
       id: 1,
+      messageType: "weewar.v1.AttacksEntry",
     },
+  ],
+};
+
+
+/**
+ * Schema for DefenderDamageMap message
+ */
+export const DefenderDamageMapSchema: MessageSchema = {
+  name: "DefenderDamageMap",
+  fields: [
     {
-      name: "playerType",
-      type: FieldType.STRING,
-      id: 2,
-    },
-    {
-      name: "color",
-      type: FieldType.STRING,
-      id: 3,
-    },
-    {
-      name: "teamId",
-      type: FieldType.NUMBER,
-      id: 4,
+      name: "defenderDamages",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.DefenderDamagesEntry",
     },
   ],
 };
 
 
 /**
- * Schema for GameSettings message
+ * Schema for DamageDistribution message
  */
-export const GameSettingsSchema: MessageSchema = {
-  name: "GameSettings",
+export const DamageDistributionSchema: MessageSchema = {
+  name: "DamageDistribution",
   fields: [
     {
-      name: "allowedUnits",
-      type: FieldType.REPEATED,
+      name: "minDamage",
+      type: FieldType.NUMBER,
       id: 1,
-      repeated: true,
     },
     {
-      name: "turnTimeLimit",
+      name: "maxDamage",
       type: FieldType.NUMBER,
       id: 2,
     },
     {
-      name: "teamMode",
-      type: FieldType.STRING,
+      name: "damageBuckets",
+      type: FieldType.MESSAGE,
       id: 3,
+      messageType: "weewar.v1.DamageBucket",
+      repeated: true,
     },
     {
-      name: "maxTurns",
+      name: "expectedDamage",
       type: FieldType.NUMBER,
       id: 4,
     },
@@ -543,198 +701,197 @@ export const GameSettingsSchema: MessageSchema = {
 
 
 /**
- * Schema for GameState message
+ * Schema for DamageBucket message
  */
-export const GameStateSchema: MessageSchema = {
-  name: "GameState",
+export const DamageBucketSchema: MessageSchema = {
+  name: "DamageBucket",
   fields: [
     {
-      name: "updatedAt",
-      type: FieldType.MESSAGE,
-      id: 2,
-      messageType: "google.protobuf.Timestamp",
-    },
-    {
-      name: "gameId",
-      type: FieldType.STRING,
-      id: 3,
-    },
-    {
-      name: "turnCounter",
+      name: "damage",
       type: FieldType.NUMBER,
-      id: 4,
+      id: 1,
     },
     {
-      name: "currentPlayer",
+      name: "weight",
       type: FieldType.NUMBER,
-      id: 5,
-    },
-    {
-      name: "worldData",
-      type: FieldType.MESSAGE,
-      id: 6,
-      messageType: "weewar.v1.WorldData",
+      id: 2,
     },
   ],
 };
 
 
 /**
- * Schema for GameMoveHistory message
+ * Schema for RuleSet message
  */
-export const GameMoveHistorySchema: MessageSchema = {
-  name: "GameMoveHistory",
+export const RuleSetSchema: MessageSchema = {
+  name: "RuleSet",
   fields: [
     {
-      name: "gameId",
-      type: FieldType.STRING,
-      id: 1,
-    },
-    {
-      name: "groups",
+      name: "units",
       type: FieldType.MESSAGE,
-      id: 2,
-      messageType: "weewar.v1.GameMoveGroup",
-      repeated: true,
+      id: 1,
+      messageType: "weewar.v1.UnitsEntry",
     },
-  ],
-};
-
-
-/**
- * Schema for GameMoveGroup message
- */
-export const GameMoveGroupSchema: MessageSchema = {
-  name: "GameMoveGroup",
-  fields: [
     {
-      name: "startedAt",
+      name: "terrains",
       type: FieldType.MESSAGE,
       id: 2,
-      messageType: "google.protobuf.Timestamp",
+      messageType: "weewar.v1.TerrainsEntry",
     },
     {
-      name: "endedAt",
+      name: "movementMatrix",
       type: FieldType.MESSAGE,
       id: 3,
-      messageType: "google.protobuf.Timestamp",
+      messageType: "weewar.v1.MovementMatrix",
     },
     {
-      name: "moves",
+      name: "attackMatrix",
       type: FieldType.MESSAGE,
       id: 4,
-      messageType: "weewar.v1.GameMove",
-      repeated: true,
+      messageType: "weewar.v1.AttackMatrix",
     },
     {
-      name: "moveResults",
+      name: "movementRules",
       type: FieldType.MESSAGE,
       id: 5,
-      messageType: "weewar.v1.GameMoveResult",
-      repeated: true,
+      messageType: "weewar.v1.MovementRules",
+    },
+    {
+      name: "combatRules",
+      type: FieldType.MESSAGE,
+      id: 6,
+      messageType: "weewar.v1.CombatRules",
+    },
+    {
+      name: "terrainActions",
+      type: FieldType.MESSAGE,
+      id: 7,
+      messageType: "weewar.v1.TerrainActionRules",
     },
   ],
 };
 
 
 /**
- * Schema for GameMove message
+ * Schema for Game message
  */
-export const GameMoveSchema: MessageSchema = {
-  name: "GameMove",
+export const GameSchema: MessageSchema = {
+  name: "Game",
   fields: [
     {
-      name: "player",
-      type: FieldType.NUMBER,
+      name: "createdAt",
+      type: FieldType.MESSAGE,
       id: 1,
+      messageType: "google.protobuf.Timestamp",
     },
     {
-      name: "timestamp",
+      name: "updatedAt",
       type: FieldType.MESSAGE,
       id: 2,
       messageType: "google.protobuf.Timestamp",
     },
     {
-      name: "sequenceNum",
-      type: FieldType.NUMBER,
+      name: "id",
+      type: FieldType.STRING,
       id: 3,
     },
     {
-      name: "moveUnit",
-      type: FieldType.MESSAGE,
+      name: "creatorId",
+      type: FieldType.STRING,
       id: 4,
-      messageType: "weewar.v1.MoveUnitAction",
-      oneofGroup: "move_type",
     },
     {
-      name: "attackUnit",
-      type: FieldType.MESSAGE,
+      name: "worldId",
+      type: FieldType.STRING,
       id: 5,
-      messageType: "weewar.v1.AttackUnitAction",
-      oneofGroup: "move_type",
     },
     {
-      name: "endTurn",
-      type: FieldType.MESSAGE,
+      name: "name",
+      type: FieldType.STRING,
       id: 6,
-      messageType: "weewar.v1.EndTurnAction",
-      oneofGroup: "move_type",
+    },
+    {
+      name: "description",
+      type: FieldType.STRING,
+      id: 7,
+    },
+    {
+      name: "tags",
+      type: FieldType.REPEATED,
+      id: 8,
+      repeated: true,
+    },
+    {
+      name: "imageUrl",
+      type: FieldType.STRING,
+      id: 9,
+    },
+    {
+      name: "difficulty",
+      type: FieldType.STRING,
+      id: 10,
+    },
+    {
+      name: "config",
+      type: FieldType.MESSAGE,
+      id: 11,
+      messageType: "weewar.v1.GameConfiguration",
+    },
+    {
+      name: "rulesId",
+      type: FieldType.STRING,
+      id: 12,
     },
   ],
-  oneofGroups: ["move_type"],
 };
 
 
 /**
- * Schema for GameMoveResult message
+ * Schema for GameConfiguration message
  */
-export const GameMoveResultSchema: MessageSchema = {
-  name: "GameMoveResult",
+export const GameConfigurationSchema: MessageSchema = {
+  name: "GameConfiguration",
   fields: [
     {
-      name: "isPermanent",
-      type: FieldType.BOOLEAN,
+      name: "players",
+      type: FieldType.MESSAGE,
       id: 1,
+      messageType: "weewar.v1.GamePlayer",
+      repeated: true,
     },
     {
-      name: "sequenceNum",
-      type: FieldType.NUMBER,
-      id: 2,
-    },
-    {
-      name: "changes",
+      name: "settings",
       type: FieldType.MESSAGE,
-      id: 3,
-      messageType: "weewar.v1.WorldChange",
-      repeated: true,
+      id: 2,
+      messageType: "weewar.v1.GameSettings",
     },
   ],
 };
 
 
 /**
- * Schema for MoveUnitAction message
+ * Schema for GamePlayer message
  */
-export const MoveUnitActionSchema: MessageSchema = {
-  name: "MoveUnitAction",
+export const GamePlayerSchema: MessageSchema = {
+  name: "GamePlayer",
   fields: [
     {
-      name: "fromQ",
+      name: "playerId",
       type: FieldType.NUMBER,
       id: 1,
     },
     {
-      name: "fromR",
-      type: FieldType.NUMBER,
+      name: "playerType",
+      type: FieldType.STRING,
       id: 2,
     },
     {
-      name: "toQ",
-      type: FieldType.NUMBER,
+      name: "color",
+      type: FieldType.STRING,
       id: 3,
     },
     {
-      name: "toR",
+      name: "teamId",
       type: FieldType.NUMBER,
       id: 4,
     },
@@ -743,762 +900,2297 @@ export const MoveUnitActionSchema: MessageSchema = {
 
 
 /**
- * Schema for AttackUnitAction message
+ * Schema for GameSettings message
  */
-export const AttackUnitActionSchema: MessageSchema = {
-  name: "AttackUnitAction",
+export const GameSettingsSchema: MessageSchema = {
+  name: "GameSettings",
   fields: [
     {
-      name: "attackerQ",
-      type: FieldType.NUMBER,
+      name: "allowedUnits",
+      type: FieldType.REPEATED,
       id: 1,
+      repeated: true,
     },
     {
-      name: "attackerR",
+      name: "turnTimeLimit",
       type: FieldType.NUMBER,
       id: 2,
     },
     {
-      name: "defenderQ",
-      type: FieldType.NUMBER,
+      name: "teamMode",
+      type: FieldType.STRING,
       id: 3,
     },
     {
-      name: "defenderR",
+      name: "maxTurns",
       type: FieldType.NUMBER,
       id: 4,
     },
+    {
+      name: "coins",
+      type: FieldType.MESSAGE,
+      id: 5,
+      messageType: "weewar.v1.CoinSettings",
+    },
+    {
+      name: "fogOfWar",
+      type: FieldType.BOOLEAN,
+      id: 6,
+    },
+    {
+      name: "victory",
+      type: FieldType.MESSAGE,
+      id: 7,
+      messageType: "weewar.v1.VictorySettings",
+    },
+    {
+      name: "rulesOverlay",
+      type: FieldType.MESSAGE,
+      id: 8,
+      messageType: "weewar.v1.RulesOverlay",
+    },
   ],
 };
 
 
 /**
- * Schema for EndTurnAction message
+ * Schema for RulesOverlay message
  */
-export const EndTurnActionSchema: MessageSchema = {
-  name: "EndTurnAction",
+export const RulesOverlaySchema: MessageSchema = {
+  name: "RulesOverlay",
   fields: [
+    {
+      name: "disabledUnits",
+      type: FieldType.REPEATED,
+      id: 1,
+      repeated: true,
+    },
+    {
+      name: "unitCoins",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "weewar.v1.UnitCoinsEntry",
+    },
+    {
+      name: "damageMultipliers",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "weewar.v1.DamageMultipliersEntry",
+    },
   ],
 };
 
 
 /**
- * Schema for WorldChange message
+ * Schema for VictorySettings message
  */
-export const WorldChangeSchema: MessageSchema = {
-  name: "WorldChange",
+export const VictorySettingsSchema: MessageSchema = {
+  name: "VictorySettings",
   fields: [
     {
-      name: "unitMoved",
-      type: FieldType.MESSAGE,
+      name: "elimination",
+      type: FieldType.BOOLEAN,
       id: 1,
-      messageType: "weewar.v1.UnitMovedChange",
-      oneofGroup: "change_type",
     },
     {
-      name: "unitDamaged",
-      type: FieldType.MESSAGE,
+      name: "captureBases",
+      type: FieldType.NUMBER,
       id: 2,
-      messageType: "weewar.v1.UnitDamagedChange",
-      oneofGroup: "change_type",
     },
     {
-      name: "unitKilled",
-      type: FieldType.MESSAGE,
+      name: "headquartersTileType",
+      type: FieldType.NUMBER,
       id: 3,
-      messageType: "weewar.v1.UnitKilledChange",
-      oneofGroup: "change_type",
     },
     {
-      name: "playerChanged",
-      type: FieldType.MESSAGE,
+      name: "scoreAtMaxTurns",
+      type: FieldType.BOOLEAN,
       id: 4,
-      messageType: "weewar.v1.PlayerChangedChange",
-      oneofGroup: "change_type",
     },
   ],
-  oneofGroups: ["change_type"],
 };
 
 
 /**
- * Schema for UnitMovedChange message
+ * Schema for VictoryProgress message
  */
-export const UnitMovedChangeSchema: MessageSchema = {
-  name: "UnitMovedChange",
+export const VictoryProgressSchema: MessageSchema = {
+  name: "VictoryProgress",
   fields: [
     {
-      name: "previousUnit",
+      name: "player",
+      type: FieldType.NUMBER,
+      id: 1,
+    },
+    {
+      name: "progress",
       type: FieldType.MESSAGE,
-      id: 6,
-      messageType: "weewar.v1.Unit",
-    },
-    {
-      name: "updatedUnit",
-      type: FieldType.MESSAGE,
-      id: 7,
-      messageType: "weewar.v1.Unit",
-    },
-  ],
-};
-
-
-/**
- * Schema for UnitDamagedChange message
- */
-export const UnitDamagedChangeSchema: MessageSchema = {
-  name: "UnitDamagedChange",
-  fields: [
-    {
-      name: "previousUnit",
-      type: FieldType.MESSAGE,
-      id: 6,
-      messageType: "weewar.v1.Unit",
-    },
-    {
-      name: "updatedUnit",
-      type: FieldType.MESSAGE,
-      id: 7,
-      messageType: "weewar.v1.Unit",
-    },
-  ],
-};
-
-
-/**
- * Schema for UnitKilledChange message
- */
-export const UnitKilledChangeSchema: MessageSchema = {
-  name: "UnitKilledChange",
-  fields: [
-    {
-      name: "previousUnit",
-      type: FieldType.MESSAGE,
-      id: 6,
-      messageType: "weewar.v1.Unit",
+      id: 2,
+      messageType: "weewar.v1.ProgressEntry",
     },
   ],
 };
 
 
 /**
- * Schema for PlayerChangedChange message
+ * Schema for CoinSettings message
  */
-export const PlayerChangedChangeSchema: MessageSchema = {
-  name: "PlayerChangedChange",
+export const CoinSettingsSchema: MessageSchema = {
+  name: "CoinSettings",
   fields: [
     {
-      name: "previousPlayer",
+      name: "startOfGame",
       type: FieldType.NUMBER,
       id: 1,
     },
     {
-      name: "newPlayer",
+      name: "perTurn",
       type: FieldType.NUMBER,
       id: 2,
     },
     {
-      name: "previousTurn",
+      name: "perBase",
       type: FieldType.NUMBER,
       id: 3,
     },
-    {
-      name: "newTurn",
-      type: FieldType.NUMBER,
-      id: 4,
-    },
-    {
-      name: "resetUnits",
-      type: FieldType.MESSAGE,
-      id: 5,
-      messageType: "weewar.v1.Unit",
-      repeated: true,
-    },
   ],
 };
 
 
 /**
- * Schema for GameInfo message
+ * Schema for GameState message
  */
-export const GameInfoSchema: MessageSchema = {
-  name: "GameInfo",
+export const GameStateSchema: MessageSchema = {
+  name: "GameState",
   fields: [
     {
-      name: "id",
-      type: FieldType.STRING,
-      id: 1,
-    },
-    {
-      name: "name",
-      type: FieldType.STRING,
+      name: "updatedAt",
+      type: FieldType.MESSAGE,
       id: 2,
+      messageType: "google.protobuf.Timestamp",
     },
     {
-      name: "description",
+      name: "gameId",
       type: FieldType.STRING,
       id: 3,
     },
     {
-      name: "category",
-      type: FieldType.STRING,
+      name: "turnCounter",
+      type: FieldType.NUMBER,
       id: 4,
     },
     {
-      name: "difficulty",
-      type: FieldType.STRING,
+      name: "currentPlayer",
+      type: FieldType.NUMBER,
       id: 5,
     },
     {
-      name: "tags",
-      type: FieldType.REPEATED,
+      name: "worldData",
+      type: FieldType.MESSAGE,
       id: 6,
-      repeated: true,
+      messageType: "weewar.v1.WorldData",
     },
     {
-      name: "icon",
-      type: FieldType.STRING,
+      name: "playerCoins",
+      type: FieldType.MESSAGE,
       id: 7,
+      messageType: "weewar.v1.PlayerCoinsEntry",
     },
     {
-      name: "lastUpdated",
-      type: FieldType.STRING,
+      name: "lastSequenceNum",
+      type: FieldType.NUMBER,
       id: 8,
     },
-  ],
-};
-
-
-/**
- * Schema for ListGamesRequest message
- */
-export const ListGamesRequestSchema: MessageSchema = {
-  name: "ListGamesRequest",
-  fields: [
     {
-      name: "pagination",
-      type: FieldType.MESSAGE,
-      id: 1,
-      messageType: "weewar.v1.Pagination",
+      name: "rngSeed",
+      type: FieldType.NUMBER,
+      id: 9,
     },
     {
-      name: "ownerId",
-      type: FieldType.STRING,
-      id: 2,
+      name: "rngPosition",
+      type: FieldType.NUMBER,
+      id: 10,
     },
-  ],
-};
-
-
-/**
- * Schema for ListGamesResponse message
- */
-export const ListGamesResponseSchema: MessageSchema = {
-  name: "ListGamesResponse",
-  fields: [
     {
-      name: "items",
+      name: "turnStartedAt",
       type: FieldType.MESSAGE,
-      id: 1,
-      messageType: "weewar.v1.Game",
-      repeated: true,
+      id: 11,
+      messageType: "google.protobuf.Timestamp",
     },
     {
-      name: "pagination",
+      name: "turnDeadline",
       type: FieldType.MESSAGE,
-      id: 2,
-      messageType: "weewar.v1.PaginationResponse",
+      id: 12,
+      messageType: "google.protobuf.Timestamp",
     },
-  ],
-};
-
-
-/**
- * Schema for GetGameRequest message
- */
-export const GetGameRequestSchema: MessageSchema = {
-  name: "GetGameRequest",
-  fields: [
     {
-      name: "id",
-      type: FieldType.STRING,
-      id: 1,
+      name: "winner",
+      type: FieldType.NUMBER,
+      id: 13,
     },
     {
-      name: "version",
+      name: "victoryCondition",
       type: FieldType.STRING,
-      id: 2,
+      id: 14,
+    },
+    {
+      name: "winners",
+      type: FieldType.REPEATED,
+      id: 15,
+      repeated: true,
     },
   ],
 };
 
 
 /**
- * Schema for GetGameResponse message
+ * Schema for GameMoveHistory message
  */
-export const GetGameResponseSchema: MessageSchema = {
-  name: "GetGameResponse",
+export const GameMoveHistorySchema: MessageSchema = {
+  name: "GameMoveHistory",
   fields: [
     {
-      name: "game",
-      type: FieldType.MESSAGE,
+      name: "gameId",
+      type: FieldType.STRING,
       id: 1,
-      messageType: "weewar.v1.Game",
     },
     {
-      name: "state",
+      name: "groups",
       type: FieldType.MESSAGE,
       id: 2,
-      messageType: "weewar.v1.GameState",
+      messageType: "weewar.v1.GameMoveGroup",
+      repeated: true,
     },
     {
-      name: "history",
+      name: "initialState",
       type: FieldType.MESSAGE,
       id: 3,
-      messageType: "weewar.v1.GameMoveHistory",
+      messageType: "weewar.v1.GameState",
     },
   ],
 };
 
 
 /**
- * Schema for GetGameContentRequest message
+ * Schema for GameMoveGroup message
  */
-export const GetGameContentRequestSchema: MessageSchema = {
-  name: "GetGameContentRequest",
+export const GameMoveGroupSchema: MessageSchema = {
+  name: "GameMoveGroup",
   fields: [
     {
-      name: "id",
-      type: FieldType.STRING,
-      id: 1,
-    },
-    {
-      name: "version",
-      type: FieldType.STRING,
+      name: "startedAt",
+      type: FieldType.MESSAGE,
       id: 2,
+      messageType: "google.protobuf.Timestamp",
     },
-  ],
-};
-
-
-/**
- * Schema for GetGameContentResponse message
- */
-export const GetGameContentResponseSchema: MessageSchema = {
-  name: "GetGameContentResponse",
-  fields: [
     {
-      name: "weewarContent",
-      type: FieldType.STRING,
-      id: 1,
+      name: "endedAt",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "google.protobuf.Timestamp",
     },
     {
-      name: "recipeContent",
-      type: FieldType.STRING,
-      id: 2,
+      name: "moves",
+      type: FieldType.MESSAGE,
+      id: 4,
+      messageType: "weewar.v1.GameMove",
+      repeated: true,
     },
     {
-      name: "readmeContent",
-      type: FieldType.STRING,
-      id: 3,
+      name: "moveResults",
+      type: FieldType.MESSAGE,
+      id: 5,
+      messageType: "weewar.v1.GameMoveResult",
+      repeated: true,
     },
   ],
 };
 
 
 /**
- * Schema for UpdateGameRequest message
+ * Schema for GameMove message
  */
-export const UpdateGameRequestSchema: MessageSchema = {
-  name: "UpdateGameRequest",
+export const GameMoveSchema: MessageSchema = {
+  name: "GameMove",
   fields: [
     {
-      name: "gameId",
-      type: FieldType.STRING,
+      name: "player",
+      type: FieldType.NUMBER,
       id: 1,
     },
     {
-      name: "newGame",
+      name: "timestamp",
       type: FieldType.MESSAGE,
       id: 2,
-      messageType: "weewar.v1.Game",
+      messageType: "google.protobuf.Timestamp",
     },
     {
-      name: "newState",
-      type: FieldType.MESSAGE,
+      name: "sequenceNum",
+      type: FieldType.NUMBER,
       id: 3,
-      messageType: "weewar.v1.GameState",
     },
     {
-      name: "newHistory",
+      name: "moveUnit",
       type: FieldType.MESSAGE,
       id: 4,
-      messageType: "weewar.v1.GameMoveHistory",
+      messageType: "weewar.v1.MoveUnitAction",
+      oneofGroup: "move_type",
     },
     {
-      name: "updateMask",
+      name: "attackUnit",
       type: FieldType.MESSAGE,
       id: 5,
-      messageType: "google.protobuf.FieldMask",
+      messageType: "weewar.v1.AttackUnitAction",
+      oneofGroup: "move_type",
+    },
+    {
+      name: "endTurn",
+      type: FieldType.MESSAGE,
+      id: 6,
+      messageType: "weewar.v1.EndTurnAction",
+      oneofGroup: "move_type",
+    },
+    {
+      name: "buildUnit",
+      type: FieldType.MESSAGE,
+      id: 7,
+      messageType: "weewar.v1.BuildUnitAction",
+      oneofGroup: "move_type",
+    },
+    {
+      name: "captureBuilding",
+      type: FieldType.MESSAGE,
+      id: 8,
+      messageType: "weewar.v1.CaptureBuildingAction",
+      oneofGroup: "move_type",
+    },
+    {
+      name: "loadUnit",
+      type: FieldType.MESSAGE,
+      id: 9,
+      messageType: "weewar.v1.LoadUnitAction",
+      oneofGroup: "move_type",
+    },
+    {
+      name: "unloadUnit",
+      type: FieldType.MESSAGE,
+      id: 10,
+      messageType: "weewar.v1.UnloadUnitAction",
+      oneofGroup: "move_type",
+    },
+    {
+      name: "healUnit",
+      type: FieldType.MESSAGE,
+      id: 11,
+      messageType: "weewar.v1.HealUnitAction",
+      oneofGroup: "move_type",
+    },
+    {
+      name: "modifyTerrain",
+      type: FieldType.MESSAGE,
+      id: 12,
+      messageType: "weewar.v1.ModifyTerrainAction",
+      oneofGroup: "move_type",
     },
   ],
+  oneofGroups: ["move_type"],
 };
 
 
 /**
- * Schema for UpdateGameResponse message
+ * Schema for GameMoveResult message
  */
-export const UpdateGameResponseSchema: MessageSchema = {
-  name: "UpdateGameResponse",
+export const GameMoveResultSchema: MessageSchema = {
+  name: "GameMoveResult",
   fields: [
     {
-      name: "game",
-      type: FieldType.MESSAGE,
+      name: "isPermanent",
+      type: FieldType.BOOLEAN,
       id: 1,
-      messageType: "weewar.v1.Game",
+    },
+    {
+      name: "sequenceNum",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "changes",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "weewar.v1.WorldChange",
+      repeated: true,
     },
   ],
 };
 
 
 /**
- * Schema for DeleteGameRequest message
+ * Schema for HexCoord message
  */
-export const DeleteGameRequestSchema: MessageSchema = {
-  name: "DeleteGameRequest",
+export const HexCoordSchema: MessageSchema = {
+  name: "HexCoord",
   fields: [
     {
-      name: "id",
-      type: FieldType.STRING,
+      name: "q",
+      type: FieldType.NUMBER,
       id: 1,
     },
+    {
+      name: "r",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
   ],
 };
 
 
 /**
- * Schema for DeleteGameResponse message
+ * Schema for MoveUnitAction message
  */
-export const DeleteGameResponseSchema: MessageSchema = {
-  name: "DeleteGameResponse",
+export const MoveUnitActionSchema: MessageSchema = {
+  name: "MoveUnitAction",
   fields: [
+    {
+      name: "fromQ",
+      type: FieldType.NUMBER,
+      id: 1,
+    },
+    {
+      name: "fromR",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "toQ",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+    {
+      name: "toR",
+      type: FieldType.NUMBER,
+      id: 4,
+    },
+    {
+      name: "path",
+      type: FieldType.MESSAGE,
+      id: 5,
+      messageType: "weewar.v1.HexCoord",
+      repeated: true,
+    },
   ],
 };
 
 
 /**
- * Schema for GetGamesRequest message
+ * Schema for AttackUnitAction message
  */
-export const GetGamesRequestSchema: MessageSchema = {
-  name: "GetGamesRequest",
+export const AttackUnitActionSchema: MessageSchema = {
+  name: "AttackUnitAction",
   fields: [
     {
-      name: "ids",
-      type: FieldType.REPEATED,
+      name: "attackerQ",
+      type: FieldType.NUMBER,
       id: 1,
-      repeated: true,
+    },
+    {
+      name: "attackerR",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "defenderQ",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+    {
+      name: "defenderR",
+      type: FieldType.NUMBER,
+      id: 4,
     },
   ],
 };
 
 
 /**
- * Schema for GetGamesResponse message
+ * Schema for EndTurnAction message
  */
-export const GetGamesResponseSchema: MessageSchema = {
-  name: "GetGamesResponse",
+export const EndTurnActionSchema: MessageSchema = {
+  name: "EndTurnAction",
   fields: [
     {
-      name: "games",
-      type: FieldType.MESSAGE,
+      name: "timedOut",
+      type: FieldType.BOOLEAN,
       id: 1,
-      messageType: "weewar.v1.GamesEntry",
     },
   ],
 };
 
 
 /**
- * Schema for CreateGameRequest message
+ * Schema for BuildUnitAction message
  */
-export const CreateGameRequestSchema: MessageSchema = {
-  name: "CreateGameRequest",
+export const BuildUnitActionSchema: MessageSchema = {
+  name: "BuildUnitAction",
   fields: [
     {
-      name: "game",
-      type: FieldType.MESSAGE,
+      name: "q",
+      type: FieldType.NUMBER,
       id: 1,
-      messageType: "weewar.v1.Game",
+    },
+    {
+      name: "r",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "unitType",
+      type: FieldType.NUMBER,
+      id: 3,
     },
   ],
 };
 
 
 /**
- * Schema for CreateGameResponse message
+ * Schema for CaptureBuildingAction message
  */
-export const CreateGameResponseSchema: MessageSchema = {
-  name: "CreateGameResponse",
+export const CaptureBuildingActionSchema: MessageSchema = {
+  name: "CaptureBuildingAction",
   fields: [
     {
-      name: "game",
-      type: FieldType.MESSAGE,
+      name: "q",
+      type: FieldType.NUMBER,
       id: 1,
-      messageType: "weewar.v1.Game",
     },
     {
-      name: "gameState",
-      type: FieldType.MESSAGE,
+      name: "r",
+      type: FieldType.NUMBER,
       id: 2,
-      messageType: "weewar.v1.GameState",
-    },
-    {
-      name: "fieldErrors",
-      type: FieldType.MESSAGE,
-      id: 3,
-      messageType: "weewar.v1.FieldErrorsEntry",
     },
   ],
 };
 
 
 /**
- * Schema for ProcessMovesRequest message
+ * Schema for ModifyTerrainAction message
  */
-export const ProcessMovesRequestSchema: MessageSchema = {
-  name: "ProcessMovesRequest",
+export const ModifyTerrainActionSchema: MessageSchema = {
+  name: "ModifyTerrainAction",
   fields: [
     {
-      name: "gameId",
-      type: FieldType.STRING,
+      name: "q",
+      type: FieldType.NUMBER,
       id: 1,
     },
     {
-      name: "moves",
-      type: FieldType.MESSAGE,
+      name: "r",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "targetQ",
+      type: FieldType.NUMBER,
       id: 3,
-      messageType: "weewar.v1.GameMove",
-      repeated: true,
+    },
+    {
+      name: "targetR",
+      type: FieldType.NUMBER,
+      id: 4,
+    },
+    {
+      name: "terrainAction",
+      type: FieldType.STRING,
+      id: 5,
     },
   ],
 };
 
 
 /**
- * Schema for ProcessMovesResponse message
+ * Schema for LoadUnitAction message
  */
-export const ProcessMovesResponseSchema: MessageSchema = {
-  name: "ProcessMovesResponse",
+export const LoadUnitActionSchema: MessageSchema = {
+  name: "LoadUnitAction",
   fields: [
     {
-      name: "moveResults",
-      type: FieldType.MESSAGE,
+      name: "unitQ",
+      type: FieldType.NUMBER,
       id: 1,
-      messageType: "weewar.v1.GameMoveResult",
-      repeated: true,
     },
     {
-      name: "changes",
-      type: FieldType.MESSAGE,
+      name: "unitR",
+      type: FieldType.NUMBER,
       id: 2,
-      messageType: "weewar.v1.WorldChange",
-      repeated: true,
+    },
+    {
+      name: "transportQ",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+    {
+      name: "transportR",
+      type: FieldType.NUMBER,
+      id: 4,
     },
   ],
 };
 
 
 /**
- * Schema for GetGameStateRequest message
+ * Schema for UnloadUnitAction message
  */
-export const GetGameStateRequestSchema: MessageSchema = {
-  name: "GetGameStateRequest",
+export const UnloadUnitActionSchema: MessageSchema = {
+  name: "UnloadUnitAction",
   fields: [
     {
-      name: "gameId",
-      type: FieldType.STRING,
+      name: "transportQ",
+      type: FieldType.NUMBER,
       id: 1,
     },
+    {
+      name: "transportR",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "cargoIndex",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+    {
+      name: "toQ",
+      type: FieldType.NUMBER,
+      id: 4,
+    },
+    {
+      name: "toR",
+      type: FieldType.NUMBER,
+      id: 5,
+    },
   ],
 };
 
 
 /**
- * Schema for GetGameStateResponse message
+ * Schema for HealUnitAction message
  */
-export const GetGameStateResponseSchema: MessageSchema = {
-  name: "GetGameStateResponse",
+export const HealUnitActionSchema: MessageSchema = {
+  name: "HealUnitAction",
   fields: [
     {
-      name: "state",
-      type: FieldType.MESSAGE,
-      id: 1,
-      messageType: "weewar.v1.GameState",
+      name: "healerQ",
+      type: FieldType.NUMBER,
+      id: 1,
+    },
+    {
+      name: "healerR",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "targetQ",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+    {
+      name: "targetR",
+      type: FieldType.NUMBER,
+      id: 4,
+    },
+  ],
+};
+
+
+/**
+ * Schema for WorldChange message
+ */
+export const WorldChangeSchema: MessageSchema = {
+  name: "WorldChange",
+  fields: [
+    {
+      name: "unitMoved",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.UnitMovedChange",
+      oneofGroup: "change_type",
+    },
+    {
+      name: "unitDamaged",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "weewar.v1.UnitDamagedChange",
+      oneofGroup: "change_type",
+    },
+    {
+      name: "unitKilled",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "weewar.v1.UnitKilledChange",
+      oneofGroup: "change_type",
+    },
+    {
+      name: "playerChanged",
+      type: FieldType.MESSAGE,
+      id: 4,
+      messageType: "weewar.v1.PlayerChangedChange",
+      oneofGroup: "change_type",
+    },
+    {
+      name: "coinsChanged",
+      type: FieldType.MESSAGE,
+      id: 5,
+      messageType: "weewar.v1.CoinsChangedChange",
+      oneofGroup: "change_type",
+    },
+    {
+      name: "unitCreated",
+      type: FieldType.MESSAGE,
+      id: 6,
+      messageType: "weewar.v1.UnitCreatedChange",
+      oneofGroup: "change_type",
+    },
+    {
+      name: "tileCaptured",
+      type: FieldType.MESSAGE,
+      id: 7,
+      messageType: "weewar.v1.TileCapturedChange",
+      oneofGroup: "change_type",
+    },
+    {
+      name: "unitLoaded",
+      type: FieldType.MESSAGE,
+      id: 8,
+      messageType: "weewar.v1.UnitLoadedChange",
+      oneofGroup: "change_type",
+    },
+    {
+      name: "unitUnloaded",
+      type: FieldType.MESSAGE,
+      id: 9,
+      messageType: "weewar.v1.UnitUnloadedChange",
+      oneofGroup: "change_type",
+    },
+    {
+      name: "unitHealed",
+      type: FieldType.MESSAGE,
+      id: 10,
+      messageType: "weewar.v1.UnitHealedChange",
+      oneofGroup: "change_type",
+    },
+    {
+      name: "tileChanged",
+      type: FieldType.MESSAGE,
+      id: 11,
+      messageType: "weewar.v1.TileChangedChange",
+      oneofGroup: "change_type",
+    },
+  ],
+  oneofGroups: ["change_type"],
+};
+
+
+/**
+ * Schema for UnitMovedChange message
+ */
+export const UnitMovedChangeSchema: MessageSchema = {
+  name: "UnitMovedChange",
+  fields: [
+    {
+      name: "previousUnit",
+      type: FieldType.MESSAGE,
+      id: 6,
+      messageType: "weewar.v1.Unit",
+    },
+    {
+      name: "updatedUnit",
+      type: FieldType.MESSAGE,
+      id: 7,
+      messageType: "weewar.v1.Unit",
+    },
+  ],
+};
+
+
+/**
+ * Schema for UnitDamagedChange message
+ */
+export const UnitDamagedChangeSchema: MessageSchema = {
+  name: "UnitDamagedChange",
+  fields: [
+    {
+      name: "previousUnit",
+      type: FieldType.MESSAGE,
+      id: 6,
+      messageType: "weewar.v1.Unit",
+    },
+    {
+      name: "updatedUnit",
+      type: FieldType.MESSAGE,
+      id: 7,
+      messageType: "weewar.v1.Unit",
+    },
+    {
+      name: "previousAttacker",
+      type: FieldType.MESSAGE,
+      id: 8,
+      messageType: "weewar.v1.Unit",
+    },
+    {
+      name: "updatedAttacker",
+      type: FieldType.MESSAGE,
+      id: 9,
+      messageType: "weewar.v1.Unit",
+    },
+  ],
+};
+
+
+/**
+ * Schema for UnitHealedChange message
+ */
+export const UnitHealedChangeSchema: MessageSchema = {
+  name: "UnitHealedChange",
+  fields: [
+    {
+      name: "previousUnit",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.Unit",
+    },
+    {
+      name: "updatedUnit",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "weewar.v1.Unit",
+    },
+    {
+      name: "previousHealer",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "weewar.v1.Unit",
+    },
+    {
+      name: "updatedHealer",
+      type: FieldType.MESSAGE,
+      id: 4,
+      messageType: "weewar.v1.Unit",
+    },
+  ],
+};
+
+
+/**
+ * Schema for UnitKilledChange message
+ */
+export const UnitKilledChangeSchema: MessageSchema = {
+  name: "UnitKilledChange",
+  fields: [
+    {
+      name: "previousUnit",
+      type: FieldType.MESSAGE,
+      id: 6,
+      messageType: "weewar.v1.Unit",
+    },
+  ],
+};
+
+
+/**
+ * Schema for PlayerChangedChange message
+ */
+export const PlayerChangedChangeSchema: MessageSchema = {
+  name: "PlayerChangedChange",
+  fields: [
+    {
+      name: "previousPlayer",
+      type: FieldType.NUMBER,
+      id: 1,
+    },
+    {
+      name: "newPlayer",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "previousTurn",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+    {
+      name: "newTurn",
+      type: FieldType.NUMBER,
+      id: 4,
+    },
+    {
+      name: "resetUnits",
+      type: FieldType.MESSAGE,
+      id: 5,
+      messageType: "weewar.v1.Unit",
+      repeated: true,
+    },
+  ],
+};
+
+
+/**
+ * Schema for CoinsChangedChange message
+ */
+export const CoinsChangedChangeSchema: MessageSchema = {
+  name: "CoinsChangedChange",
+  fields: [
+    {
+      name: "player",
+      type: FieldType.NUMBER,
+      id: 1,
+    },
+    {
+      name: "previousCoins",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "newCoins",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+  ],
+};
+
+
+/**
+ * Schema for UnitCreatedChange message
+ */
+export const UnitCreatedChangeSchema: MessageSchema = {
+  name: "UnitCreatedChange",
+  fields: [
+    {
+      name: "unit",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.Unit",
+    },
+  ],
+};
+
+
+/**
+ * Schema for TileCapturedChange message
+ */
+export const TileCapturedChangeSchema: MessageSchema = {
+  name: "TileCapturedChange",
+  fields: [
+    {
+      name: "previousTile",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.Tile",
+    },
+    {
+      name: "updatedTile",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "weewar.v1.Tile",
+    },
+    {
+      name: "previousUnit",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "weewar.v1.Unit",
+    },
+    {
+      name: "updatedUnit",
+      type: FieldType.MESSAGE,
+      id: 4,
+      messageType: "weewar.v1.Unit",
+    },
+  ],
+};
+
+
+/**
+ * Schema for TileChangedChange message
+ */
+export const TileChangedChangeSchema: MessageSchema = {
+  name: "TileChangedChange",
+  fields: [
+    {
+      name: "previousTile",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.Tile",
+    },
+    {
+      name: "updatedTile",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "weewar.v1.Tile",
+    },
+    {
+      name: "previousUnit",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "weewar.v1.Unit",
+    },
+    {
+      name: "updatedUnit",
+      type: FieldType.MESSAGE,
+      id: 4,
+      messageType: "weewar.v1.Unit",
+    },
+  ],
+};
+
+
+/**
+ * Schema for UnitLoadedChange message
+ */
+export const UnitLoadedChangeSchema: MessageSchema = {
+  name: "UnitLoadedChange",
+  fields: [
+    {
+      name: "unit",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.Unit",
+    },
+    {
+      name: "previousTransport",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "weewar.v1.Unit",
+    },
+    {
+      name: "updatedTransport",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "weewar.v1.Unit",
+    },
+  ],
+};
+
+
+/**
+ * Schema for UnitUnloadedChange message
+ */
+export const UnitUnloadedChangeSchema: MessageSchema = {
+  name: "UnitUnloadedChange",
+  fields: [
+    {
+      name: "unit",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.Unit",
+    },
+    {
+      name: "previousTransport",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "weewar.v1.Unit",
+    },
+    {
+      name: "updatedTransport",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "weewar.v1.Unit",
+    },
+  ],
+};
+
+
+/**
+ * Schema for GameInfo message
+ */
+export const GameInfoSchema: MessageSchema = {
+  name: "GameInfo",
+  fields: [
+    {
+      name: "id",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "name",
+      type: FieldType.STRING,
+      id: 2,
+    },
+    {
+      name: "description",
+      type: FieldType.STRING,
+      id: 3,
+    },
+    {
+      name: "category",
+      type: FieldType.STRING,
+      id: 4,
+    },
+    {
+      name: "difficulty",
+      type: FieldType.STRING,
+      id: 5,
+    },
+    {
+      name: "tags",
+      type: FieldType.REPEATED,
+      id: 6,
+      repeated: true,
+    },
+    {
+      name: "icon",
+      type: FieldType.STRING,
+      id: 7,
+    },
+    {
+      name: "lastUpdated",
+      type: FieldType.STRING,
+      id: 8,
+    },
+  ],
+};
+
+
+/**
+ * Schema for ListGamesRequest message
+ */
+export const ListGamesRequestSchema: MessageSchema = {
+  name: "ListGamesRequest",
+  fields: [
+    {
+      name: "pagination",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.Pagination",
+    },
+    {
+      name: "ownerId",
+      type: FieldType.STRING,
+      id: 2,
+    },
+  ],
+};
+
+
+/**
+ * Schema for ListGamesResponse message
+ */
+export const ListGamesResponseSchema: MessageSchema = {
+  name: "ListGamesResponse",
+  fields: [
+    {
+      name: "items",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.Game",
+      repeated: true,
+    },
+    {
+      name: "pagination",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "weewar.v1.PaginationResponse",
+    },
+  ],
+};
+
+
+/**
+ * Schema for GetGameRequest message
+ */
+export const GetGameRequestSchema: MessageSchema = {
+  name: "GetGameRequest",
+  fields: [
+    {
+      name: "id",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "version",
+      type: FieldType.STRING,
+      id: 2,
+    },
+    {
+      name: "player",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+  ],
+};
+
+
+/**
+ * Schema for GetGameResponse message
+ */
+export const GetGameResponseSchema: MessageSchema = {
+  name: "GetGameResponse",
+  fields: [
+    {
+      name: "game",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.Game",
+    },
+    {
+      name: "state",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "weewar.v1.GameState",
+    },
+    {
+      name: "history",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "weewar.v1.GameMoveHistory",
+    },
+  ],
+};
+
+
+/**
+ * Schema for GetGameContentRequest message
+ */
+export const GetGameContentRequestSchema: MessageSchema = {
+  name: "GetGameContentRequest",
+  fields: [
+    {
+      name: "id",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "version",
+      type: FieldType.STRING,
+      id: 2,
+    },
+  ],
+};
+
+
+/**
+ * Schema for GetGameContentResponse message
+ */
+export const GetGameContentResponseSchema: MessageSchema = {
+  name: "GetGameContentResponse",
+  fields: [
+    {
+      name: "weewarContent",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "recipeContent",
+      type: FieldType.STRING,
+      id: 2,
+    },
+    {
+      name: "readmeContent",
+      type: FieldType.STRING,
+      id: 3,
+    },
+  ],
+};
+
+
+/**
+ * Schema for UpdateGameRequest message
+ */
+export const UpdateGameRequestSchema: MessageSchema = {
+  name: "UpdateGameRequest",
+  fields: [
+    {
+      name: "gameId",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "newGame",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "weewar.v1.Game",
+    },
+    {
+      name: "newState",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "weewar.v1.GameState",
+    },
+    {
+      name: "newHistory",
+      type: FieldType.MESSAGE,
+      id: 4,
+      messageType: "weewar.v1.GameMoveHistory",
+    },
+    {
+      name: "updateMask",
+      type: FieldType.MESSAGE,
+      id: 5,
+      messageType: "google.protobuf.FieldMask",
+    },
+  ],
+};
+
+
+/**
+ * Schema for UpdateGameResponse message
+ */
+export const UpdateGameResponseSchema: MessageSchema = {
+  name: "UpdateGameResponse",
+  fields: [
+    {
+      name: "game",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.Game",
+    },
+  ],
+};
+
+
+/**
+ * Schema for DeleteGameRequest message
+ */
+export const DeleteGameRequestSchema: MessageSchema = {
+  name: "DeleteGameRequest",
+  fields: [
+    {
+      name: "id",
+      type: FieldType.STRING,
+      id: 1,
+    },
+  ],
+};
+
+
+/**
+ * Schema for DeleteGameResponse message
+ */
+export const DeleteGameResponseSchema: MessageSchema = {
+  name: "DeleteGameResponse",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for GetGamesRequest message
+ */
+export const GetGamesRequestSchema: MessageSchema = {
+  name: "GetGamesRequest",
+  fields: [
+    {
+      name: "ids",
+      type: FieldType.REPEATED,
+      id: 1,
+      repeated: true,
+    },
+  ],
+};
+
+
+/**
+ * Schema for GetGamesResponse message
+ */
+export const GetGamesResponseSchema: MessageSchema = {
+  name: "GetGamesResponse",
+  fields: [
+    {
+      name: "games",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.GamesEntry",
+    },
+  ],
+};
+
+
+/**
+ * Schema for CreateGameRequest message
+ */
+export const CreateGameRequestSchema: MessageSchema = {
+  name: "CreateGameRequest",
+  fields: [
+    {
+      name: "game",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.Game",
+    },
+  ],
+};
+
+
+/**
+ * Schema for CreateGameResponse message
+ */
+export const CreateGameResponseSchema: MessageSchema = {
+  name: "CreateGameResponse",
+  fields: [
+    {
+      name: "game",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.Game",
+    },
+    {
+      name: "gameState",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "weewar.v1.GameState",
+    },
+    {
+      name: "fieldErrors",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "weewar.v1.FieldErrorsEntry",
+    },
+  ],
+};
+
+
+/**
+ * Schema for ProcessMovesRequest message
+ */
+export const ProcessMovesRequestSchema: MessageSchema = {
+  name: "ProcessMovesRequest",
+  fields: [
+    {
+      name: "gameId",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "moves",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "weewar.v1.GameMove",
+      repeated: true,
+    },
+    {
+      name: "expectedLastSequenceNum",
+      type: FieldType.NUMBER,
+      id: 4,
+    },
+  ],
+};
+
+
+/**
+ * Schema for ProcessMovesResponse message
+ */
+export const ProcessMovesResponseSchema: MessageSchema = {
+  name: "ProcessMovesResponse",
+  fields: [
+    {
+      name: "moveResults",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.GameMoveResult",
+      repeated: true,
+    },
+    {
+      name: "changes",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "weewar.v1.WorldChange",
+      repeated: true,
+    },
+  ],
+};
+
+
+/**
+ * Schema for VerifyGameRequest message
+ */
+export const VerifyGameRequestSchema: MessageSchema = {
+  name: "VerifyGameRequest",
+  fields: [
+    {
+      name: "gameId",
+      type: FieldType.STRING,
+      id: 1,
+    },
+  ],
+};
+
+
+/**
+ * Schema for VerifyGameResponse message
+ */
+export const VerifyGameResponseSchema: MessageSchema = {
+  name: "VerifyGameResponse",
+  fields: [
+    {
+      name: "verified",
+      type: FieldType.BOOLEAN,
+      id: 1,
+    },
+    {
+      name: "groupsReplayed",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "movesReplayed",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+    {
+      name: "divergence",
+      type: FieldType.MESSAGE,
+      id: 4,
+      messageType: "weewar.v1.GameDivergence",
+    },
+  ],
+};
+
+
+/**
+ * Schema for GameDivergence message
+ */
+export const GameDivergenceSchema: MessageSchema = {
+  name: "GameDivergence",
+  fields: [
+    {
+      name: "groupIndex",
+      type: FieldType.NUMBER,
+      id: 1,
+    },
+    {
+      name: "moveIndex",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "changeIndex",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+    {
+      name: "sequenceNum",
+      type: FieldType.NUMBER,
+      id: 4,
+    },
+    {
+      name: "reason",
+      type: FieldType.STRING,
+      id: 5,
+    },
+    {
+      name: "expectedChange",
+      type: FieldType.MESSAGE,
+      id: 6,
+      messageType: "weewar.v1.WorldChange",
+    },
+    {
+      name: "actualChange",
+      type: FieldType.MESSAGE,
+      id: 7,
+      messageType: "weewar.v1.WorldChange",
+    },
+  ],
+};
+
+
+/**
+ * Schema for UndoMovesRequest message
+ */
+export const UndoMovesRequestSchema: MessageSchema = {
+  name: "UndoMovesRequest",
+  fields: [
+    {
+      name: "gameId",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "count",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+  ],
+};
+
+
+/**
+ * Schema for UndoMovesResponse message
+ */
+export const UndoMovesResponseSchema: MessageSchema = {
+  name: "UndoMovesResponse",
+  fields: [
+    {
+      name: "undoneGroups",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.GameMoveGroup",
+      repeated: true,
+    },
+    {
+      name: "changes",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "weewar.v1.WorldChange",
+      repeated: true,
+    },
+  ],
+};
+
+
+/**
+ * Schema for SubscribeGameRequest message
+ */
+export const SubscribeGameRequestSchema: MessageSchema = {
+  name: "SubscribeGameRequest",
+  fields: [
+    {
+      name: "gameId",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "fromSequenceNum",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "player",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+  ],
+};
+
+
+/**
+ * Schema for SubscribeGameResponse message
+ */
+export const SubscribeGameResponseSchema: MessageSchema = {
+  name: "SubscribeGameResponse",
+  fields: [
+    {
+      name: "moveGroup",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.GameMoveGroup",
+    },
+    {
+      name: "undo",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "weewar.v1.UndoMovesResponse",
+    },
+  ],
+};
+
+
+/**
+ * Schema for GetGameStateRequest message
+ */
+export const GetGameStateRequestSchema: MessageSchema = {
+  name: "GetGameStateRequest",
+  fields: [
+    {
+      name: "gameId",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "player",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+  ],
+};
+
+
+/**
+ * Schema for GetGameStateResponse message
+ */
+export const GetGameStateResponseSchema: MessageSchema = {
+  name: "GetGameStateResponse",
+  fields: [
+    {
+      name: "state",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.GameState",
+    },
+    {
+      name: "turnTimeRemaining",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "victoryProgress",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "weewar.v1.VictoryProgress",
+      repeated: true,
+    },
+  ],
+};
+
+
+/**
+ * Schema for ListMovesRequest message
+ */
+export const ListMovesRequestSchema: MessageSchema = {
+  name: "ListMovesRequest",
+  fields: [
+    {
+      name: "gameId",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "offset",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "lastN",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+    {
+      name: "player",
+      type: FieldType.NUMBER,
+      id: 4,
+    },
+  ],
+};
+
+
+/**
+ * Schema for ListMovesResponse message
+ */
+export const ListMovesResponseSchema: MessageSchema = {
+  name: "ListMovesResponse",
+  fields: [
+    {
+      name: "hasMore",
+      type: FieldType.BOOLEAN,
+      id: 1,
+    },
+    {
+      name: "moveGroups",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "weewar.v1.GameMoveGroup",
+      repeated: true,
+    },
+  ],
+};
+
+
+/**
+ * Schema for GetOptionsAtRequest message
+ */
+export const GetOptionsAtRequestSchema: MessageSchema = {
+  name: "GetOptionsAtRequest",
+  fields: [
+    {
+      name: "gameId",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "q",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "r",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+  ],
+};
+
+
+/**
+ * Schema for GetOptionsAtResponse message
+ */
+export const GetOptionsAtResponseSchema: MessageSchema = {
+  name: "GetOptionsAtResponse",
+  fields: [
+    {
+      name: "options",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.GameOption",
+      repeated: true,
+    },
+    {
+      name: "currentPlayer",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "gameInitialized",
+      type: FieldType.BOOLEAN,
+      id: 3,
+    },
+  ],
+};
+
+
+/**
+ * Schema for GetPathRequest message
+ */
+export const GetPathRequestSchema: MessageSchema = {
+  name: "GetPathRequest",
+  fields: [
+    {
+      name: "gameId",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "fromQ",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "fromR",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+    {
+      name: "toQ",
+      type: FieldType.NUMBER,
+      id: 4,
+    },
+    {
+      name: "toR",
+      type: FieldType.NUMBER,
+      id: 5,
+    },
+  ],
+};
+
+
+/**
+ * Schema for GetPathResponse message
+ */
+export const GetPathResponseSchema: MessageSchema = {
+  name: "GetPathResponse",
+  fields: [
+    {
+      name: "steps",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.PathStep",
+      repeated: true,
+    },
+    {
+      name: "totalCost",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "movementCost",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+    {
+      name: "reachable",
+      type: FieldType.BOOLEAN,
+      id: 4,
+    },
+    {
+      name: "action",
+      type: FieldType.MESSAGE,
+      id: 5,
+      messageType: "weewar.v1.MoveUnitAction",
+    },
+  ],
+};
+
+
+/**
+ * Schema for PathStep message
+ */
+export const PathStepSchema: MessageSchema = {
+  name: "PathStep",
+  fields: [
+    {
+      name: "q",
+      type: FieldType.NUMBER,
+      id: 1,
+    },
+    {
+      name: "r",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "cost",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+    {
+      name: "totalCost",
+      type: FieldType.NUMBER,
+      id: 4,
+    },
+  ],
+};
+
+
+/**
+ * Schema for GameOption message
+ */
+export const GameOptionSchema: MessageSchema = {
+  name: "GameOption",
+  fields: [
+    {
+      name: "move",
+      type: FieldType.MESSAGE,
+      id: 1,
+      messageType: "weewar.v1.MoveOption",
+      oneofGroup: "option_type",
+    },
+    {
+      name: "attack",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "weewar.v1.AttackOption",
+      oneofGroup: "option_type",
+    },
+    {
+      name: "endTurn",
+      type: FieldType.MESSAGE,
+      id: 3,
+      messageType: "weewar.v1.EndTurnOption",
+      oneofGroup: "option_type",
+    },
+    {
+      name: "build",
+      type: FieldType.MESSAGE,
+      id: 4,
+      messageType: "weewar.v1.BuildUnitOption",
+      oneofGroup: "option_type",
+    },
+    {
+      name: "capture",
+      type: FieldType.MESSAGE,
+      id: 5,
+      messageType: "weewar.v1.CaptureBuildingOption",
+      oneofGroup: "option_type",
+    },
+    {
+      name: "load",
+      type: FieldType.MESSAGE,
+      id: 6,
+      messageType: "weewar.v1.LoadUnitOption",
+      oneofGroup: "option_type",
+    },
+    {
+      name: "unload",
+      type: FieldType.MESSAGE,
+      id: 7,
+      messageType: "weewar.v1.UnloadUnitOption",
+      oneofGroup: "option_type",
+    },
+    {
+      name: "heal",
+      type: FieldType.MESSAGE,
+      id: 8,
+      messageType: "weewar.v1.HealUnitOption",
+      oneofGroup: "option_type",
+    },
+    {
+      name: "modifyTerrain",
+      type: FieldType.MESSAGE,
+      id: 9,
+      messageType: "weewar.v1.ModifyTerrainOption",
+      oneofGroup: "option_type",
+    },
+  ],
+  oneofGroups: ["option_type"],
+};
+
+
+/**
+ * Schema for EndTurnOption message
+ */
+export const EndTurnOptionSchema: MessageSchema = {
+  name: "EndTurnOption",
+  fields: [
+  ],
+};
+
+
+/**
+ * Schema for MoveOption message
+ */
+export const MoveOptionSchema: MessageSchema = {
+  name: "MoveOption",
+  fields: [
+    {
+      name: "q",
+      type: FieldType.NUMBER,
+      id: 1,
+    },
+    {
+      name: "r",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "movementCost",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+    {
+      name: "action",
+      type: FieldType.MESSAGE,
+      id: 4,
+      messageType: "weewar.v1.MoveUnitAction",
+    },
+  ],
+};
+
+
+/**
+ * Schema for AttackOption message
+ */
+export const AttackOptionSchema: MessageSchema = {
+  name: "AttackOption",
+  fields: [
+    {
+      name: "q",
+      type: FieldType.NUMBER,
+      id: 1,
+    },
+    {
+      name: "r",
+      type: FieldType.NUMBER,
+      id: 2,
+    },
+    {
+      name: "targetUnitType",
+      type: FieldType.NUMBER,
+      id: 3,
+    },
+    {
+      name: "targetUnitHealth",
+      type: FieldType.NUMBER,
+      id: 4,
+    },
+    {
+      name: "canAttack",
+      type: FieldType.BOOLEAN,
+      id: 5,
+    },
+    {
+      name: "damageEstimate",
+      type: FieldType.NUMBER,
+      id: 6,
+    },
+    {
+      name: "action",
+      type: FieldType.MESSAGE,
+      id: 7,
+      messageType: "weewar.v1.AttackUnitAction",
+    },
+    {
+      name: "expectedDamageDealt",
+      type: FieldType.NUMBER,
+      id: 8,
+    },
+    {
+      name: "expectedDamageTaken",
+      type: FieldType.NUMBER,
+      id: 9,
+    },
+    {
+      name: "killProbability",
+      type: FieldType.NUMBER,
+      id: 10,
+    },
+    {
+      name: "lossProbability",
+      type: FieldType.NUMBER,
+      id: 11,
     },
   ],
 };
 
 
 /**
- * Schema for ListMovesRequest message
+ * Schema for BuildUnitOption message
  */
-export const ListMovesRequestSchema: MessageSchema = {
-  name: "ListMovesRequest",
+export const BuildUnitOptionSchema: MessageSchema = {
+  name: "BuildUnitOption",
   fields: [
     {
-      name: "gameId",
-      type: FieldType.STRING,
+      name: "q",
+      type: FieldType.NUMBER,
       id: 1,
     },
     {
-      name: "offset",
+      name: "r",
       type: FieldType.NUMBER,
       id: 2,
     },
     {
-      name: "lastN",
+      name: "tileType",
       type: FieldType.NUMBER,
       id: 3,
     },
-  ],
-};
-
-
-/**
- * Schema for ListMovesResponse message
- */
-export const ListMovesResponseSchema: MessageSchema = {
-  name: "ListMovesResponse",
-  fields: [
     {
-      name: "hasMore",
-      type: FieldType.BOOLEAN,
-      id: 1,
+      name: "buildCost",
+      type: FieldType.NUMBER,
+      id: 4,
     },
     {
-      name: "moveGroups",
+      name: "unitType",
+      type: FieldType.NUMBER,
+      id: 5,
+    },
+    {
+      name: "action",
       type: FieldType.MESSAGE,
-      id: 2,
-      messageType: "weewar.v1.GameMoveGroup",
-      repeated: true,
+      id: 6,
+      messageType: "weewar.v1.BuildUnitAction",
     },
   ],
 };
 
 
 /**
- * Schema for GetOptionsAtRequest message
+ * Schema for CaptureBuildingOption message
  */
-export const GetOptionsAtRequestSchema: MessageSchema = {
-  name: "GetOptionsAtRequest",
+export const CaptureBuildingOptionSchema: MessageSchema = {
+  name: "CaptureBuildingOption",
   fields: [
     {
-      name: "gameId",
-      type: FieldType.STRING,
+      name: "q",
+      type: FieldType.NUMBER,
       id: 1,
     },
     {
-      name: "q",
+      name: "r",
       type: FieldType.NUMBER,
       id: 2,
     },
     {
-      name: "r",
+      name: "tileType",
       type: FieldType.NUMBER,
       id: 3,
     },
+    {
+      name: "captureProgress",
+      type: FieldType.NUMBER,
+      id: 4,
+    },
+    {
+      name: "captureTurns",
+      type: FieldType.NUMBER,
+      id: 5,
+    },
+    {
+      name: "action",
+      type: FieldType.MESSAGE,
+      id: 6,
+      messageType: "weewar.v1.CaptureBuildingAction",
+    },
   ],
 };
 
 
 /**
- * Schema for GetOptionsAtResponse message
+ * Schema for ModifyTerrainOption message
  */
-export const GetOptionsAtResponseSchema: MessageSchema = {
-  name: "GetOptionsAtResponse",
+export const ModifyTerrainOptionSchema: MessageSchema = {
+  name: "ModifyTerrainOption",
   fields: [
     {
-      name: "options",
-      type: FieldType.MESSAGE,
+      name: "q",
+      type: FieldType.NUMBER,
       id: 1,
-      messageType: "weewar.v1.GameOption",
-      repeated: true,
     },
     {
-      name: "currentPlayer",
+      name: "r",
       type: FieldType.NUMBER,
       id: 2,
     },
     {
-      name: "gameInitialized",
-      type: FieldType.BOOLEAN,
+      name: "terrainAction",
+      type: FieldType.STRING,
       id: 3,
     },
+    {
+      name: "name",
+      type: FieldType.STRING,
+      id: 4,
+    },
+    {
+      name: "newTileType",
+      type: FieldType.NUMBER,
+      id: 5,
+    },
+    {
+      name: "progress",
+      type: FieldType.NUMBER,
+      id: 6,
+    },
+    {
+      name: "turns",
+      type: FieldType.NUMBER,
+      id: 7,
+    },
+    {
+      name: "coins",
+      type: FieldType.NUMBER,
+      id: 8,
+    },
+    {
+      name: "action",
+      type: FieldType.MESSAGE,
+      id: 9,
+      messageType: "weewar.v1.ModifyTerrainAction",
+    },
   ],
 };
 
 
 /**
- * Schema for GameOption message
+ * Schema for LoadUnitOption message
  */
-export const GameOptionSchema: MessageSchema = {
-  name: "GameOption",
+export const LoadUnitOptionSchema: MessageSchema = {
+  name: "LoadUnitOption",
   fields: [
     {
-      name: "move",
-      type: FieldType.MESSAGE,
+      name: "q",
+      type: FieldType.NUMBER,
       id: 1,
-      messageType: "weewar.v1.MoveOption",
-      oneofGroup: "option_type",
     },
     {
-      name: "attack",
-      type: FieldType.MESSAGE,
+      name: "r",
+      type: FieldType.NUMBER,
       id: 2,
-      messageType: "weewar.v1.AttackOption",
-      oneofGroup: "option_type",
     },
     {
-      name: "endTurn",
-      type: FieldType.MESSAGE,
+      name: "transportUnitType",
+      type: FieldType.NUMBER,
       id: 3,
-      messageType: "weewar.v1.EndTurnOption",
-      oneofGroup: "option_type",
     },
     {
-      name: "build",
+      name: "action",
       type: FieldType.MESSAGE,
       id: 4,
-      messageType: "weewar.v1.BuildUnitOption",
-      oneofGroup: "option_type",
-    },
-    {
-      name: "capture",
-      type: FieldType.MESSAGE,
-      id: 5,
-      messageType: "weewar.v1.CaptureBuildingOption",
-      oneofGroup: "option_type",
+      messageType: "weewar.v1.LoadUnitAction",
     },
   ],
-  oneofGroups: ["option_type"],
-};
-
-
-/**
- * Schema for EndTurnOption message
- */
-export const EndTurnOptionSchema: MessageSchema = {
-  name: "EndTurnOption",
-  fields: [
-  ],
 };
 
 
 /**
- * Schema for MoveOption message
+ * Schema for UnloadUnitOption message
  */
-export const MoveOptionSchema: MessageSchema = {
-  name: "MoveOption",
+export const UnloadUnitOptionSchema: MessageSchema = {
+  name: "UnloadUnitOption",
   fields: [
     {
       name: "q",
@@ -1511,25 +3203,30 @@ export const MoveOptionSchema: MessageSchema = {
       id: 2,
     },
     {
-      name: "movementCost",
+      name: "cargoIndex",
       type: FieldType.NUMBER,
       id: 3,
     },
+    {
+      name: "unitType",
+      type: FieldType.NUMBER,
+      id: 4,
+    },
     {
       name: "action",
       type: FieldType.MESSAGE,
-      id: 4,
-      messageType: "weewar.v1.MoveUnitAction",
+      id: 5,
+      messageType: "weewar.v1.UnloadUnitAction",
     },
   ],
 };
 
 
 /**
- * Schema for AttackOption message
+ * Schema for HealUnitOption message
  */
-export const AttackOptionSchema: MessageSchema = {
-  name: "AttackOption",
+export const HealUnitOptionSchema: MessageSchema = {
+  name: "HealUnitOption",
   fields: [
     {
       name: "q",
@@ -1542,83 +3239,132 @@ export const AttackOptionSchema: MessageSchema = {
       id: 2,
     },
     {
-      name: "targetUnitType",
+      name: "unitType",
       type: FieldType.NUMBER,
       id: 3,
     },
     {
-      name: "targetUnitHealth",
+      name: "amount",
       type: FieldType.NUMBER,
       id: 4,
     },
     {
-      name: "canAttack",
-      type: FieldType.BOOLEAN,
+      name: "action",
+      type: FieldType.MESSAGE,
       id: 5,
+      messageType: "weewar.v1.HealUnitAction",
     },
+  ],
+};
+
+
+/**
+ * Schema for GetRulesRequest message
+ */
+export const GetRulesRequestSchema: MessageSchema = {
+  name: "GetRulesRequest",
+  fields: [
     {
-      name: "damageEstimate",
-      type: FieldType.NUMBER,
-      id: 6,
+      name: "rulesId",
+      type: FieldType.STRING,
+      id: 1,
+    },
+  ],
+};
+
+
+/**
+ * Schema for GetRulesResponse message
+ */
+export const GetRulesResponseSchema: MessageSchema = {
+  name: "GetRulesResponse",
+  fields: [
+    {
+      name: "rulesId",
+      type: FieldType.STRING,
+      id: 1,
     },
     {
-      name: "action",
+      name: "rules",
       type: FieldType.MESSAGE,
-      id: 7,
-      messageType: "weewar.v1.AttackUnitAction",
+      id: 2,
+      messageType: "weewar.v1.RuleSet",
     },
   ],
 };
 
 
 /**
- * Schema for BuildUnitOption message
+ * Schema for GetUnitRequest message
  */
-export const BuildUnitOptionSchema: MessageSchema = {
-  name: "BuildUnitOption",
+export const GetUnitRequestSchema: MessageSchema = {
+  name: "GetUnitRequest",
   fields: [
     {
-      name: "q",
-      type: FieldType.NUMBER,
+      name: "rulesId",
+      type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "r",
+      name: "unitId",
       type: FieldType.NUMBER,
       id: 2,
     },
+  ],
+};
+
+
+/**
+ * Schema for GetUnitResponse message
+ */
+export const GetUnitResponseSchema: MessageSchema = {
+  name: "GetUnitResponse",
+  fields: [
     {
-      name: "tileType",
-      type: FieldType.NUMBER,
+      name: "rulesId",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "unit",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "weewar.v1.UnitDefinition",
+    },
+    {
+      name: "movementCosts",
+      type: FieldType.MESSAGE,
       id: 3,
+      messageType: "weewar.v1.TerrainCostMap",
     },
     {
-      name: "buildCost",
-      type: FieldType.NUMBER,
+      name: "attacks",
+      type: FieldType.MESSAGE,
       id: 4,
+      messageType: "weewar.v1.DefenderDamageMap",
     },
   ],
 };
 
 
 /**
- * Schema for CaptureBuildingOption message
+ * Schema for GetCombatTableRequest message
  */
-export const CaptureBuildingOptionSchema: MessageSchema = {
-  name: "CaptureBuildingOption",
+export const GetCombatTableRequestSchema: MessageSchema = {
+  name: "GetCombatTableRequest",
   fields: [
     {
-      name: "q",
-      type: FieldType.NUMBER,
+      name: "rulesId",
+      type: FieldType.STRING,
       id: 1,
     },
     {
-      name: "r",
+      name: "attackerId",
       type: FieldType.NUMBER,
       id: 2,
     },
     {
-      name: "tileType",
+      name: "defenderId",
       type: FieldType.NUMBER,
       id: 3,
     },
@@ -1626,6 +3372,27 @@ export const CaptureBuildingOptionSchema: MessageSchema = {
 };
 
 
+/**
+ * Schema for GetCombatTableResponse message
+ */
+export const GetCombatTableResponseSchema: MessageSchema = {
+  name: "GetCombatTableResponse",
+  fields: [
+    {
+      name: "rulesId",
+      type: FieldType.STRING,
+      id: 1,
+    },
+    {
+      name: "attackMatrix",
+      type: FieldType.MESSAGE,
+      id: 2,
+      messageType: "weewar.v1.AttackMatrix",
+    },
+  ],
+};
+
+
 /**
  * Schema for UserInfo message
  */
@@ -2248,25 +4015,53 @@ export const WeewarV1SchemaRegistry: Record<string, MessageSchema> = {
   "weewar.v1.Unit": UnitSchema,
   "weewar.v1.TerrainDefinition": TerrainDefinitionSchema,
   "weewar.v1.UnitDefinition": UnitDefinitionSchema,
+  "weewar.v1.MovementRules": MovementRulesSchema,
+  "weewar.v1.CombatRules": CombatRulesSchema,
+  "weewar.v1.TerrainActionRules": TerrainActionRulesSchema,
+  "weewar.v1.TerrainAction": TerrainActionSchema,
+  "weewar.v1.ClassDamageModifiers": ClassDamageModifiersSchema,
   "weewar.v1.MovementMatrix": MovementMatrixSchema,
   "weewar.v1.TerrainCostMap": TerrainCostMapSchema,
+  "weewar.v1.AttackMatrix": AttackMatrixSchema,
+  "weewar.v1.DefenderDamageMap": DefenderDamageMapSchema,
+  "weewar.v1.DamageDistribution": DamageDistributionSchema,
+  "weewar.v1.DamageBucket": DamageBucketSchema,
+  "weewar.v1.RuleSet": RuleSetSchema,
   "weewar.v1.Game": GameSchema,
   "weewar.v1.GameConfiguration": GameConfigurationSchema,
   "weewar.v1.GamePlayer": GamePlayerSchema,
   "weewar.v1.GameSettings": GameSettingsSchema,
+  "weewar.v1.RulesOverlay": RulesOverlaySchema,
+  "weewar.v1.VictorySettings": VictorySettingsSchema,
+  "weewar.v1.VictoryProgress": VictoryProgressSchema,
+  "weewar.v1.CoinSettings": CoinSettingsSchema,
   "weewar.v1.GameState": GameStateSchema,
   "weewar.v1.GameMoveHistory": GameMoveHistorySchema,
   "weewar.v1.GameMoveGroup": GameMoveGroupSchema,
   "weewar.v1.GameMove": GameMoveSchema,
   "weewar.v1.GameMoveResult": GameMoveResultSchema,
+  "weewar.v1.HexCoord": HexCoordSchema,
   "weewar.v1.MoveUnitAction": MoveUnitActionSchema,
   "weewar.v1.AttackUnitAction": AttackUnitActionSchema,
   "weewar.v1.EndTurnAction": EndTurnActionSchema,
+  "weewar.v1.BuildUnitAction": BuildUnitActionSchema,
+  "weewar.v1.CaptureBuildingAction": CaptureBuildingActionSchema,
+  "weewar.v1.ModifyTerrainAction": ModifyTerrainActionSchema,
+  "weewar.v1.LoadUnitAction": LoadUnitActionSchema,
+  "weewar.v1.UnloadUnitAction": UnloadUnitActionSchema,
+  "weewar.v1.HealUnitAction": HealUnitActionSchema,
   "weewar.v1.WorldChange": WorldChangeSchema,
   "weewar.v1.UnitMovedChange": UnitMovedChangeSchema,
   "weewar.v1.UnitDamagedChange": UnitDamagedChangeSchema,
+  "weewar.v1.UnitHealedChange": UnitHealedChangeSchema,
   "weewar.v1.UnitKilledChange": UnitKilledChangeSchema,
   "weewar.v1.PlayerChangedChange": PlayerChangedChangeSchema,
+  "weewar.v1.CoinsChangedChange": CoinsChangedChangeSchema,
+  "weewar.v1.UnitCreatedChange": UnitCreatedChangeSchema,
+  "weewar.v1.TileCapturedChange": TileCapturedChangeSchema,
+  "weewar.v1.TileChangedChange": TileChangedChangeSchema,
+  "weewar.v1.UnitLoadedChange": UnitLoadedChangeSchema,
+  "weewar.v1.UnitUnloadedChange": UnitUnloadedChangeSchema,
   "weewar.v1.GameInfo": GameInfoSchema,
   "weewar.v1.ListGamesRequest": ListGamesRequestSchema,
   "weewar.v1.ListGamesResponse": ListGamesResponseSchema,
@@ -2284,18 +4079,38 @@ export const WeewarV1SchemaRegistry: Record<string, MessageSchema> = {
   "weewar.v1.CreateGameResponse": CreateGameResponseSchema,
   "weewar.v1.ProcessMovesRequest": ProcessMovesRequestSchema,
   "weewar.v1.ProcessMovesResponse": ProcessMovesResponseSchema,
+  "weewar.v1.VerifyGameRequest": VerifyGameRequestSchema,
+  "weewar.v1.VerifyGameResponse": VerifyGameResponseSchema,
+  "weewar.v1.GameDivergence": GameDivergenceSchema,
+  "weewar.v1.UndoMovesRequest": UndoMovesRequestSchema,
+  "weewar.v1.UndoMovesResponse": UndoMovesResponseSchema,
+  "weewar.v1.SubscribeGameRequest": SubscribeGameRequestSchema,
+  "weewar.v1.SubscribeGameResponse": SubscribeGameResponseSchema,
   "weewar.v1.GetGameStateRequest": GetGameStateRequestSchema,
   "weewar.v1.GetGameStateResponse": GetGameStateResponseSchema,
   "weewar.v1.ListMovesRequest": ListMovesRequestSchema,
   "weewar.v1.ListMovesResponse": ListMovesResponseSchema,
   "weewar.v1.GetOptionsAtRequest": GetOptionsAtRequestSchema,
   "weewar.v1.GetOptionsAtResponse": GetOptionsAtResponseSchema,
+  "weewar.v1.GetPathRequest": GetPathRequestSchema,
+  "weewar.v1.GetPathResponse": GetPathResponseSchema,
+  "weewar.v1.PathStep": PathStepSchema,
   "weewar.v1.GameOption": GameOptionSchema,
   "weewar.v1.EndTurnOption": EndTurnOptionSchema,
   "weewar.v1.MoveOption": MoveOptionSchema,
   "weewar.v1.AttackOption": AttackOptionSchema,
   "weewar.v1.BuildUnitOption": BuildUnitOptionSchema,
   "weewar.v1.CaptureBuildingOption": CaptureBuildingOptionSchema,
+  "weewar.v1.ModifyTerrainOption": ModifyTerrainOptionSchema,
+  "weewar.v1.LoadUnitOption": LoadUnitOptionSchema,
+  "weewar.v1.UnloadUnitOption": UnloadUnitOptionSchema,
+  "weewar.v1.HealUnitOption": HealUnitOptionSchema,
+  "weewar.v1.GetRulesRequest": GetRulesRequestSchema,
+  "weewar.v1.GetRulesResponse": GetRulesResponseSchema,
+  "weewar.v1.GetUnitRequest": GetUnitRequestSchema,
+  "weewar.v1.GetUnitResponse": GetUnitResponseSchema,
+  "weewar.v1.GetCombatTableRequest": GetCombatTableRequestSchema,
+  "weewar.v1.GetCombatTableResponse": GetCombatTableResponseSchema,
   "weewar.v1.UserInfo": UserInfoSchema,
   "weewar.v1.ListUsersRequest": ListUsersRequestSchema,
   "weewar.v1.ListUsersResponse": ListUsersResponseSchema,
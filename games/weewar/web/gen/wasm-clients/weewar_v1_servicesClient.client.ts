@@ -34,6 +34,18 @@ export interface GamesServiceMethods {
 	listMoves(request: any): Promise<any>;
 	processMoves(request: any): Promise<any>;
 	getOptionsAt(request: any): Promise<any>;
+	getPath(request: any): Promise<any>;
+	verifyGame(request: any): Promise<any>;
+	undoMoves(request: any): Promise<any>;
+}
+/**
+ * RulesService service client interface
+ * Uses any types since we generate our own TypeScript interfaces
+ */
+export interface RulesServiceMethods {
+	getRules(request: any): Promise<any>;
+	getUnit(request: any): Promise<any>;
+	getCombatTable(request: any): Promise<any>;
 }
 /**
  * UsersService service client interface
@@ -70,11 +82,13 @@ export class Weewar_v1_servicesClient {
 
     // Service-specific clients
     public readonly gamesService: GamesServiceClientImpl;
+    public readonly rulesService: RulesServiceClientImpl;
     public readonly usersService: UsersServiceClientImpl;
     public readonly worldsService: WorldsServiceClientImpl;
 
     constructor() {
         this.gamesService = new GamesServiceClientImpl(this);
+        this.rulesService = new RulesServiceClientImpl(this);
         this.usersService = new UsersServiceClientImpl(this);
         this.worldsService = new WorldsServiceClientImpl(this);
     }
@@ -244,6 +258,30 @@ class GamesServiceClientImpl implements GamesServiceMethods {
     async getOptionsAt(request: any): Promise<any> {
         return this.parent.callMethod('gamesService.getOptionsAt', request);
     }
+    async getPath(request: any): Promise<any> {
+        return this.parent.callMethod('gamesService.getPath', request);
+    }
+    async verifyGame(request: any): Promise<any> {
+        return this.parent.callMethod('gamesService.verifyGame', request);
+    }
+    async undoMoves(request: any): Promise<any> {
+        return this.parent.callMethod('gamesService.undoMoves', request);
+    }
+}
+/**
+ * RulesService service client implementation
+ */
+class RulesServiceClientImpl implements RulesServiceMethods {
+    constructor(private parent: Weewar_v1_servicesClient) {}
+    async getRules(request: any): Promise<any> {
+        return this.parent.callMethod('rulesService.getRules', request);
+    }
+    async getUnit(request: any): Promise<any> {
+        return this.parent.callMethod('rulesService.getUnit', request);
+    }
+    async getCombatTable(request: any): Promise<any> {
+        return this.parent.callMethod('rulesService.getCombatTable', request);
+    }
 }
 /**
  * UsersService service client implementation
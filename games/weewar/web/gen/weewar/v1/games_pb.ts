@@ -6,7 +6,7 @@ import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegen
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { FieldMask } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
-import type { AttackUnitAction, Game, GameMove, GameMoveGroup, GameMoveHistory, GameMoveResult, GameState, MoveUnitAction, Pagination, PaginationResponse, WorldChange } from "./models_pb";
+import type { AttackUnitAction, BuildUnitAction, CaptureBuildingAction, Game, GameMove, GameMoveGroup, GameMoveHistory, GameMoveResult, GameState, HealUnitAction, LoadUnitAction, ModifyTerrainAction, MoveUnitAction, Pagination, PaginationResponse, UnloadUnitAction, VictoryProgress, WorldChange } from "./models_pb";
 import { file_weewar_v1_models } from "./models_pb";
 import { file_google_api_annotations } from "../../google/api/annotations_pb";
 import { file_protoc_gen_openapiv2_options_annotations } from "../../protoc-gen-openapiv2/options/annotations_pb";
@@ -16,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file weewar/v1/games.proto.
  */
 export const file_weewar_v1_games: GenFile = /*@__PURE__*/
-  fileDesc("ChV3ZWV3YXIvdjEvZ2FtZXMucHJvdG8SCXdlZXdhci52MSKRAQoIR2FtZUluZm8SCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIQCghjYXRlZ29yeRgEIAEoCRISCgpkaWZmaWN1bHR5GAUgASgJEgwKBHRhZ3MYBiADKAkSDAoEaWNvbhgHIAEoCRIUCgxsYXN0X3VwZGF0ZWQYCCABKAkiTwoQTGlzdEdhbWVzUmVxdWVzdBIpCgpwYWdpbmF0aW9uGAEgASgLMhUud2Vld2FyLnYxLlBhZ2luYXRpb24SEAoIb3duZXJfaWQYAiABKAkiZgoRTGlzdEdhbWVzUmVzcG9uc2USHgoFaXRlbXMYASADKAsyDy53ZWV3YXIudjEuR2FtZRIxCgpwYWdpbmF0aW9uGAIgASgLMh0ud2Vld2FyLnYxLlBhZ2luYXRpb25SZXNwb25zZSItCg5HZXRHYW1lUmVxdWVzdBIKCgJpZBgBIAEoCRIPCgd2ZXJzaW9uGAIgASgJIoIBCg9HZXRHYW1lUmVzcG9uc2USHQoEZ2FtZRgBIAEoCzIPLndlZXdhci52MS5HYW1lEiMKBXN0YXRlGAIgASgLMhQud2Vld2FyLnYxLkdhbWVTdGF0ZRIrCgdoaXN0b3J5GAMgASgLMhoud2Vld2FyLnYxLkdhbWVNb3ZlSGlzdG9yeSI0ChVHZXRHYW1lQ29udGVudFJlcXVlc3QSCgoCaWQYASABKAkSDwoHdmVyc2lvbhgCIAEoCSJgChZHZXRHYW1lQ29udGVudFJlc3BvbnNlEhYKDndlZXdhcl9jb250ZW50GAEgASgJEhYKDnJlY2lwZV9jb250ZW50GAIgASgJEhYKDnJlYWRtZV9jb250ZW50GAMgASgJIuwBChFVcGRhdGVHYW1lUmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJEiEKCG5ld19nYW1lGAIgASgLMg8ud2Vld2FyLnYxLkdhbWUSJwoJbmV3X3N0YXRlGAMgASgLMhQud2Vld2FyLnYxLkdhbWVTdGF0ZRIvCgtuZXdfaGlzdG9yeRgEIAEoCzIaLndlZXdhci52MS5HYW1lTW92ZUhpc3RvcnkSLwoLdXBkYXRlX21hc2sYBSABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrOhiSQRUKEyoRVXBkYXRlR2FtZVJlcXVlc3QiTgoSVXBkYXRlR2FtZVJlc3BvbnNlEh0KBGdhbWUYASABKAsyDy53ZWV3YXIudjEuR2FtZToZkkEWChQqElVwZGF0ZUdhbWVSZXNwb25zZSIfChFEZWxldGVHYW1lUmVxdWVzdBIKCgJpZBgBIAEoCSIUChJEZWxldGVHYW1lUmVzcG9uc2UiHgoPR2V0R2FtZXNSZXF1ZXN0EgsKA2lkcxgBIAMoCSKIAQoQR2V0R2FtZXNSZXNwb25zZRI1CgVnYW1lcxgBIAMoCzImLndlZXdhci52MS5HZXRHYW1lc1Jlc3BvbnNlLkdhbWVzRW50cnkaPQoKR2FtZXNFbnRyeRILCgNrZXkYASABKAkSHgoFdmFsdWUYAiABKAsyDy53ZWV3YXIudjEuR2FtZToCOAEiMgoRQ3JlYXRlR2FtZVJlcXVlc3QSHQoEZ2FtZRgBIAEoCzIPLndlZXdhci52MS5HYW1lItcBChJDcmVhdGVHYW1lUmVzcG9uc2USHQoEZ2FtZRgBIAEoCzIPLndlZXdhci52MS5HYW1lEigKCmdhbWVfc3RhdGUYAiABKAsyFC53ZWV3YXIudjEuR2FtZVN0YXRlEkQKDGZpZWxkX2Vycm9ycxgDIAMoCzIuLndlZXdhci52MS5DcmVhdGVHYW1lUmVzcG9uc2UuRmllbGRFcnJvcnNFbnRyeRoyChBGaWVsZEVycm9yc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiSgoTUHJvY2Vzc01vdmVzUmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJEiIKBW1vdmVzGAMgAygLMhMud2Vld2FyLnYxLkdhbWVNb3ZlInAKFFByb2Nlc3NNb3Zlc1Jlc3BvbnNlEi8KDG1vdmVfcmVzdWx0cxgBIAMoCzIZLndlZXdhci52MS5HYW1lTW92ZVJlc3VsdBInCgdjaGFuZ2VzGAIgAygLMhYud2Vld2FyLnYxLldvcmxkQ2hhbmdlIiYKE0dldEdhbWVTdGF0ZVJlcXVlc3QSDwoHZ2FtZV9pZBgBIAEoCSI7ChRHZXRHYW1lU3RhdGVSZXNwb25zZRIjCgVzdGF0ZRgBIAEoCzIULndlZXdhci52MS5HYW1lU3RhdGUiQwoQTGlzdE1vdmVzUmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJEg4KBm9mZnNldBgCIAEoBRIOCgZsYXN0X24YAyABKAUiVAoRTGlzdE1vdmVzUmVzcG9uc2USEAoIaGFzX21vcmUYASABKAgSLQoLbW92ZV9ncm91cHMYAiADKAsyGC53ZWV3YXIudjEuR2FtZU1vdmVHcm91cCI8ChNHZXRPcHRpb25zQXRSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkSCQoBcRgCIAEoBRIJCgFyGAMgASgFInAKFEdldE9wdGlvbnNBdFJlc3BvbnNlEiYKB29wdGlvbnMYASADKAsyFS53ZWV3YXIudjEuR2FtZU9wdGlvbhIWCg5jdXJyZW50X3BsYXllchgCIAEoBRIYChBnYW1lX2luaXRpYWxpemVkGAMgASgIIv0BCgpHYW1lT3B0aW9uEiUKBG1vdmUYASABKAsyFS53ZWV3YXIudjEuTW92ZU9wdGlvbkgAEikKBmF0dGFjaxgCIAEoCzIXLndlZXdhci52MS5BdHRhY2tPcHRpb25IABIsCghlbmRfdHVybhgDIAEoCzIYLndlZXdhci52MS5FbmRUdXJuT3B0aW9uSAASKwoFYnVpbGQYBCABKAsyGi53ZWV3YXIudjEuQnVpbGRVbml0T3B0aW9uSAASMwoHY2FwdHVyZRgFIAEoCzIgLndlZXdhci52MS5DYXB0dXJlQnVpbGRpbmdPcHRpb25IAEINCgtvcHRpb25fdHlwZSIPCg1FbmRUdXJuT3B0aW9uImQKCk1vdmVPcHRpb24SCQoBcRgBIAEoBRIJCgFyGAIgASgFEhUKDW1vdmVtZW50X2Nvc3QYAyABKAUSKQoGYWN0aW9uGAQgASgLMhkud2Vld2FyLnYxLk1vdmVVbml0QWN0aW9uIrQBCgxBdHRhY2tPcHRpb24SCQoBcRgBIAEoBRIJCgFyGAIgASgFEhgKEHRhcmdldF91bml0X3R5cGUYAyABKAUSGgoSdGFyZ2V0X3VuaXRfaGVhbHRoGAQgASgFEhIKCmNhbl9hdHRhY2sYBSABKAgSFwoPZGFtYWdlX2VzdGltYXRlGAYgASgFEisKBmFjdGlvbhgHIAEoCzIbLndlZXdhci52MS5BdHRhY2tVbml0QWN0aW9uIk4KD0J1aWxkVW5pdE9wdGlvbhIJCgFxGAEgASgFEgkKAXIYAiABKAUSEQoJdGlsZV90eXBlGAMgASgFEhIKCmJ1aWxkX2Nvc3QYBCABKAUiQAoVQ2FwdHVyZUJ1aWxkaW5nT3B0aW9uEgkKAXEYASABKAUSCQoBchgCIAEoBRIRCgl0aWxlX3R5cGUYAyABKAUyqwgKDEdhbWVzU2VydmljZRJfCgpDcmVhdGVHYW1lEhwud2Vld2FyLnYxLkNyZWF0ZUdhbWVSZXF1ZXN0Gh0ud2Vld2FyLnYxLkNyZWF0ZUdhbWVSZXNwb25zZSIUgtPkkwIOOgEqIgkvdjEvZ2FtZXMSXwoIR2V0R2FtZXMSGi53ZWV3YXIudjEuR2V0R2FtZXNSZXF1ZXN0Ghsud2Vld2FyLnYxLkdldEdhbWVzUmVzcG9uc2UiGoLT5JMCFBISL3YxL2dhbWVzOmJhdGNoR2V0ElkKCUxpc3RHYW1lcxIbLndlZXdhci52MS5MaXN0R2FtZXNSZXF1ZXN0Ghwud2Vld2FyLnYxLkxpc3RHYW1lc1Jlc3BvbnNlIhGC0+STAgsSCS92MS9nYW1lcxJYCgdHZXRHYW1lEhkud2Vld2FyLnYxLkdldEdhbWVSZXF1ZXN0Ghoud2Vld2FyLnYxLkdldEdhbWVSZXNwb25zZSIWgtPkkwIQEg4vdjEvZ2FtZXMve2lkfRJjCgpEZWxldGVHYW1lEhwud2Vld2FyLnYxLkRlbGV0ZUdhbWVSZXF1ZXN0Gh0ud2Vld2FyLnYxLkRlbGV0ZUdhbWVSZXNwb25zZSIYgtPkkwISKhAvdjEvZ2FtZXMve2lkPSp9EmsKClVwZGF0ZUdhbWUSHC53ZWV3YXIudjEuVXBkYXRlR2FtZVJlcXVlc3QaHS53ZWV3YXIudjEuVXBkYXRlR2FtZVJlc3BvbnNlIiCC0+STAho6ASoyFS92MS9nYW1lcy97Z2FtZV9pZD0qfRJyCgxHZXRHYW1lU3RhdGUSHi53ZWV3YXIudjEuR2V0R2FtZVN0YXRlUmVxdWVzdBofLndlZXdhci52MS5HZXRHYW1lU3RhdGVSZXNwb25zZSIhgtPkkwIbEhkvdjEvZ2FtZXMve2dhbWVfaWR9L3N0YXRlEmkKCUxpc3RNb3ZlcxIbLndlZXdhci52MS5MaXN0TW92ZXNSZXF1ZXN0Ghwud2Vld2FyLnYxLkxpc3RNb3Zlc1Jlc3BvbnNlIiGC0+STAhsSGS92MS9nYW1lcy97Z2FtZV9pZH0vbW92ZXMSdQoMUHJvY2Vzc01vdmVzEh4ud2Vld2FyLnYxLlByb2Nlc3NNb3Zlc1JlcXVlc3QaHy53ZWV3YXIudjEuUHJvY2Vzc01vdmVzUmVzcG9uc2UiJILT5JMCHjoBKiIZL3YxL2dhbWVzL3tnYW1lX2lkfS9tb3ZlcxJ8CgxHZXRPcHRpb25zQXQSHi53ZWV3YXIudjEuR2V0T3B0aW9uc0F0UmVxdWVzdBofLndlZXdhci52MS5HZXRPcHRpb25zQXRSZXNwb25zZSIrgtPkkwIlEiMvdjEvZ2FtZXMve2dhbWVfaWR9L29wdGlvbnMve3F9L3tyfUKcAQoNY29tLndlZXdhci52MUIKR2FtZXNQcm90b1ABWjpnaXRodWIuY29tL3BhbnlhbS90dXJuZW5naW5lL2dhbWVzL3dlZXdhci9nZW4vZ28vd2Vld2FyL3YxogIDV1hYqgIJV2Vld2FyLlYxygIJV2Vld2FyXFYx4gIVV2Vld2FyXFYxXEdQQk1ldGFkYXRh6gIKV2Vld2FyOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_google_protobuf_field_mask, file_weewar_v1_models, file_google_api_annotations, file_protoc_gen_openapiv2_options_annotations]);
+  fileDesc("ChV3ZWV3YXIvdjEvZ2FtZXMucHJvdG8SCXdlZXdhci52MSKRAQoIR2FtZUluZm8SCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIQCghjYXRlZ29yeRgEIAEoCRISCgpkaWZmaWN1bHR5GAUgASgJEgwKBHRhZ3MYBiADKAkSDAoEaWNvbhgHIAEoCRIUCgxsYXN0X3VwZGF0ZWQYCCABKAkiTwoQTGlzdEdhbWVzUmVxdWVzdBIpCgpwYWdpbmF0aW9uGAEgASgLMhUud2Vld2FyLnYxLlBhZ2luYXRpb24SEAoIb3duZXJfaWQYAiABKAkiZgoRTGlzdEdhbWVzUmVzcG9uc2USHgoFaXRlbXMYASADKAsyDy53ZWV3YXIudjEuR2FtZRIxCgpwYWdpbmF0aW9uGAIgASgLMh0ud2Vld2FyLnYxLlBhZ2luYXRpb25SZXNwb25zZSI9Cg5HZXRHYW1lUmVxdWVzdBIKCgJpZBgBIAEoCRIPCgd2ZXJzaW9uGAIgASgJEg4KBnBsYXllchgDIAEoBSKCAQoPR2V0R2FtZVJlc3BvbnNlEh0KBGdhbWUYASABKAsyDy53ZWV3YXIudjEuR2FtZRIjCgVzdGF0ZRgCIAEoCzIULndlZXdhci52MS5HYW1lU3RhdGUSKwoHaGlzdG9yeRgDIAEoCzIaLndlZXdhci52MS5HYW1lTW92ZUhpc3RvcnkiNAoVR2V0R2FtZUNvbnRlbnRSZXF1ZXN0EgoKAmlkGAEgASgJEg8KB3ZlcnNpb24YAiABKAkiYAoWR2V0R2FtZUNvbnRlbnRSZXNwb25zZRIWCg53ZWV3YXJfY29udGVudBgBIAEoCRIWCg5yZWNpcGVfY29udGVudBgCIAEoCRIWCg5yZWFkbWVfY29udGVudBgDIAEoCSLsAQoRVXBkYXRlR2FtZVJlcXVlc3QSDwoHZ2FtZV9pZBgBIAEoCRIhCghuZXdfZ2FtZRgCIAEoCzIPLndlZXdhci52MS5HYW1lEicKCW5ld19zdGF0ZRgDIAEoCzIULndlZXdhci52MS5HYW1lU3RhdGUSLwoLbmV3X2hpc3RvcnkYBCABKAsyGi53ZWV3YXIudjEuR2FtZU1vdmVIaXN0b3J5Ei8KC3VwZGF0ZV9tYXNrGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzazoYkkEVChMqEVVwZGF0ZUdhbWVSZXF1ZXN0Ik4KElVwZGF0ZUdhbWVSZXNwb25zZRIdCgRnYW1lGAEgASgLMg8ud2Vld2FyLnYxLkdhbWU6GZJBFgoUKhJVcGRhdGVHYW1lUmVzcG9uc2UiHwoRRGVsZXRlR2FtZVJlcXVlc3QSCgoCaWQYASABKAkiFAoSRGVsZXRlR2FtZVJlc3BvbnNlIh4KD0dldEdhbWVzUmVxdWVzdBILCgNpZHMYASADKAkiiAEKEEdldEdhbWVzUmVzcG9uc2USNQoFZ2FtZXMYASADKAsyJi53ZWV3YXIudjEuR2V0R2FtZXNSZXNwb25zZS5HYW1lc0VudHJ5Gj0KCkdhbWVzRW50cnkSCwoDa2V5GAEgASgJEh4KBXZhbHVlGAIgASgLMg8ud2Vld2FyLnYxLkdhbWU6AjgBIjIKEUNyZWF0ZUdhbWVSZXF1ZXN0Eh0KBGdhbWUYASABKAsyDy53ZWV3YXIudjEuR2FtZSLXAQoSQ3JlYXRlR2FtZVJlc3BvbnNlEh0KBGdhbWUYASABKAsyDy53ZWV3YXIudjEuR2FtZRIoCgpnYW1lX3N0YXRlGAIgASgLMhQud2Vld2FyLnYxLkdhbWVTdGF0ZRJECgxmaWVsZF9lcnJvcnMYAyADKAsyLi53ZWV3YXIudjEuQ3JlYXRlR2FtZVJlc3BvbnNlLkZpZWxkRXJyb3JzRW50cnkaMgoQRmllbGRFcnJvcnNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIm4KE1Byb2Nlc3NNb3Zlc1JlcXVlc3QSDwoHZ2FtZV9pZBgBIAEoCRIiCgVtb3ZlcxgDIAMoCzITLndlZXdhci52MS5HYW1lTW92ZRIiChpleHBlY3RlZF9sYXN0X3NlcXVlbmNlX251bRgEIAEoAyJwChRQcm9jZXNzTW92ZXNSZXNwb25zZRIvCgxtb3ZlX3Jlc3VsdHMYASADKAsyGS53ZWV3YXIudjEuR2FtZU1vdmVSZXN1bHQSJwoHY2hhbmdlcxgCIAMoCzIWLndlZXdhci52MS5Xb3JsZENoYW5nZSIkChFWZXJpZnlHYW1lUmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJIoYBChJWZXJpZnlHYW1lUmVzcG9uc2USEAoIdmVyaWZpZWQYASABKAgSFwoPZ3JvdXBzX3JlcGxheWVkGAIgASgFEhYKDm1vdmVzX3JlcGxheWVkGAMgASgFEi0KCmRpdmVyZ2VuY2UYBCABKAsyGS53ZWV3YXIudjEuR2FtZURpdmVyZ2VuY2Ui1QEKDkdhbWVEaXZlcmdlbmNlEhMKC2dyb3VwX2luZGV4GAEgASgFEhIKCm1vdmVfaW5kZXgYAiABKAUSFAoMY2hhbmdlX2luZGV4GAMgASgFEhQKDHNlcXVlbmNlX251bRgEIAEoAxIOCgZyZWFzb24YBSABKAkSLwoPZXhwZWN0ZWRfY2hhbmdlGAYgASgLMhYud2Vld2FyLnYxLldvcmxkQ2hhbmdlEi0KDWFjdHVhbF9jaGFuZ2UYByABKAsyFi53ZWV3YXIudjEuV29ybGRDaGFuZ2UiMgoQVW5kb01vdmVzUmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJEg0KBWNvdW50GAIgASgFIm0KEVVuZG9Nb3Zlc1Jlc3BvbnNlEi8KDXVuZG9uZV9ncm91cHMYASADKAsyGC53ZWV3YXIudjEuR2FtZU1vdmVHcm91cBInCgdjaGFuZ2VzGAIgAygLMhYud2Vld2FyLnYxLldvcmxkQ2hhbmdlIlIKFFN1YnNjcmliZUdhbWVSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkSGQoRZnJvbV9zZXF1ZW5jZV9udW0YAiABKAMSDgoGcGxheWVyGAMgASgFInEKFVN1YnNjcmliZUdhbWVSZXNwb25zZRIsCgptb3ZlX2dyb3VwGAEgASgLMhgud2Vld2FyLnYxLkdhbWVNb3ZlR3JvdXASKgoEdW5kbxgCIAEoCzIcLndlZXdhci52MS5VbmRvTW92ZXNSZXNwb25zZSI2ChNHZXRHYW1lU3RhdGVSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkSDgoGcGxheWVyGAIgASgFIo4BChRHZXRHYW1lU3RhdGVSZXNwb25zZRIjCgVzdGF0ZRgBIAEoCzIULndlZXdhci52MS5HYW1lU3RhdGUSGwoTdHVybl90aW1lX3JlbWFpbmluZxgCIAEoBRI0ChB2aWN0b3J5X3Byb2dyZXNzGAMgAygLMhoud2Vld2FyLnYxLlZpY3RvcnlQcm9ncmVzcyJTChBMaXN0TW92ZXNSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkSDgoGb2Zmc2V0GAIgASgFEg4KBmxhc3RfbhgDIAEoBRIOCgZwbGF5ZXIYBCABKAUiVAoRTGlzdE1vdmVzUmVzcG9uc2USEAoIaGFzX21vcmUYASABKAgSLQoLbW92ZV9ncm91cHMYAiADKAsyGC53ZWV3YXIudjEuR2FtZU1vdmVHcm91cCI8ChNHZXRPcHRpb25zQXRSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkSCQoBcRgCIAEoBRIJCgFyGAMgASgFInAKFEdldE9wdGlvbnNBdFJlc3BvbnNlEiYKB29wdGlvbnMYASADKAsyFS53ZWV3YXIudjEuR2FtZU9wdGlvbhIWCg5jdXJyZW50X3BsYXllchgCIAEoBRIYChBnYW1lX2luaXRpYWxpemVkGAMgASgIIl0KDkdldFBhdGhSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkSDgoGZnJvbV9xGAIgASgFEg4KBmZyb21fchgDIAEoBRIMCgR0b19xGAQgASgFEgwKBHRvX3IYBSABKAUingEKD0dldFBhdGhSZXNwb25zZRIiCgVzdGVwcxgBIAMoCzITLndlZXdhci52MS5QYXRoU3RlcBISCgp0b3RhbF9jb3N0GAIgASgBEhUKDW1vdmVtZW50X2Nvc3QYAyABKAUSEQoJcmVhY2hhYmxlGAQgASgIEikKBmFjdGlvbhgFIAEoCzIZLndlZXdhci52MS5Nb3ZlVW5pdEFjdGlvbiJCCghQYXRoU3RlcBIJCgFxGAEgASgFEgkKAXIYAiABKAUSDAoEY29zdBgDIAEoARISCgp0b3RhbF9jb3N0GAQgASgBIrwDCgpHYW1lT3B0aW9uEiUKBG1vdmUYASABKAsyFS53ZWV3YXIudjEuTW92ZU9wdGlvbkgAEikKBmF0dGFjaxgCIAEoCzIXLndlZXdhci52MS5BdHRhY2tPcHRpb25IABIsCghlbmRfdHVybhgDIAEoCzIYLndlZXdhci52MS5FbmRUdXJuT3B0aW9uSAASKwoFYnVpbGQYBCABKAsyGi53ZWV3YXIudjEuQnVpbGRVbml0T3B0aW9uSAASMwoHY2FwdHVyZRgFIAEoCzIgLndlZXdhci52MS5DYXB0dXJlQnVpbGRpbmdPcHRpb25IABIpCgRsb2FkGAYgASgLMhkud2Vld2FyLnYxLkxvYWRVbml0T3B0aW9uSAASLQoGdW5sb2FkGAcgASgLMhsud2Vld2FyLnYxLlVubG9hZFVuaXRPcHRpb25IABIpCgRoZWFsGAggASgLMhkud2Vld2FyLnYxLkhlYWxVbml0T3B0aW9uSAASOAoObW9kaWZ5X3RlcnJhaW4YCSABKAsyHi53ZWV3YXIudjEuTW9kaWZ5VGVycmFpbk9wdGlvbkgAQg0KC29wdGlvbl90eXBlIg8KDUVuZFR1cm5PcHRpb24iZAoKTW92ZU9wdGlvbhIJCgFxGAEgASgFEgkKAXIYAiABKAUSFQoNbW92ZW1lbnRfY29zdBgDIAEoBRIpCgZhY3Rpb24YBCABKAsyGS53ZWV3YXIudjEuTW92ZVVuaXRBY3Rpb24ipgIKDEF0dGFja09wdGlvbhIJCgFxGAEgASgFEgkKAXIYAiABKAUSGAoQdGFyZ2V0X3VuaXRfdHlwZRgDIAEoBRIaChJ0YXJnZXRfdW5pdF9oZWFsdGgYBCABKAUSEgoKY2FuX2F0dGFjaxgFIAEoCBIXCg9kYW1hZ2VfZXN0aW1hdGUYBiABKAUSKwoGYWN0aW9uGAcgASgLMhsud2Vld2FyLnYxLkF0dGFja1VuaXRBY3Rpb24SHQoVZXhwZWN0ZWRfZGFtYWdlX2RlYWx0GAggASgBEh0KFWV4cGVjdGVkX2RhbWFnZV90YWtlbhgJIAEoARIYChBraWxsX3Byb2JhYmlsaXR5GAogASgBEhgKEGxvc3NfcHJvYmFiaWxpdHkYCyABKAEijQEKD0J1aWxkVW5pdE9wdGlvbhIJCgFxGAEgASgFEgkKAXIYAiABKAUSEQoJdGlsZV90eXBlGAMgASgFEhIKCmJ1aWxkX2Nvc3QYBCABKAUSEQoJdW5pdF90eXBlGAUgASgFEioKBmFjdGlvbhgGIAEoCzIaLndlZXdhci52MS5CdWlsZFVuaXRBY3Rpb24iowEKFUNhcHR1cmVCdWlsZGluZ09wdGlvbhIJCgFxGAEgASgFEgkKAXIYAiABKAUSEQoJdGlsZV90eXBlGAMgASgFEhgKEGNhcHR1cmVfcHJvZ3Jlc3MYBCABKAUSFQoNY2FwdHVyZV90dXJucxgFIAEoBRIwCgZhY3Rpb24YBiABKAsyIC53ZWV3YXIudjEuQ2FwdHVyZUJ1aWxkaW5nQWN0aW9uIsgBChNNb2RpZnlUZXJyYWluT3B0aW9uEgkKAXEYASABKAUSCQoBchgCIAEoBRIWCg50ZXJyYWluX2FjdGlvbhgDIAEoCRIMCgRuYW1lGAQgASgJEhUKDW5ld190aWxlX3R5cGUYBSABKAUSEAoIcHJvZ3Jlc3MYBiABKAUSDQoFdHVybnMYByABKAUSDQoFY29pbnMYCCABKAUSLgoGYWN0aW9uGAkgASgLMh4ud2Vld2FyLnYxLk1vZGlmeVRlcnJhaW5BY3Rpb24ibgoOTG9hZFVuaXRPcHRpb24SCQoBcRgBIAEoBRIJCgFyGAIgASgFEhsKE3RyYW5zcG9ydF91bml0X3R5cGUYAyABKAUSKQoGYWN0aW9uGAQgASgLMhkud2Vld2FyLnYxLkxvYWRVbml0QWN0aW9uIn0KEFVubG9hZFVuaXRPcHRpb24SCQoBcRgBIAEoBRIJCgFyGAIgASgFEhMKC2NhcmdvX2luZGV4GAMgASgFEhEKCXVuaXRfdHlwZRgEIAEoBRIrCgZhY3Rpb24YBSABKAsyGy53ZWV3YXIudjEuVW5sb2FkVW5pdEFjdGlvbiJ0Cg5IZWFsVW5pdE9wdGlvbhIJCgFxGAEgASgFEgkKAXIYAiABKAUSEQoJdW5pdF90eXBlGAMgASgFEg4KBmFtb3VudBgEIAEoBRIpCgZhY3Rpb24YBSABKAsyGS53ZWV3YXIudjEuSGVhbFVuaXRBY3Rpb24yjwwKDEdhbWVzU2VydmljZRJfCgpDcmVhdGVHYW1lEhwud2Vld2FyLnYxLkNyZWF0ZUdhbWVSZXF1ZXN0Gh0ud2Vld2FyLnYxLkNyZWF0ZUdhbWVSZXNwb25zZSIUgtPkkwIOOgEqIgkvdjEvZ2FtZXMSXwoIR2V0R2FtZXMSGi53ZWV3YXIudjEuR2V0R2FtZXNSZXF1ZXN0Ghsud2Vld2FyLnYxLkdldEdhbWVzUmVzcG9uc2UiGoLT5JMCFBISL3YxL2dhbWVzOmJhdGNoR2V0ElkKCUxpc3RHYW1lcxIbLndlZXdhci52MS5MaXN0R2FtZXNSZXF1ZXN0Ghwud2Vld2FyLnYxLkxpc3RHYW1lc1Jlc3BvbnNlIhGC0+STAgsSCS92MS9nYW1lcxJYCgdHZXRHYW1lEhkud2Vld2FyLnYxLkdldEdhbWVSZXF1ZXN0Ghoud2Vld2FyLnYxLkdldEdhbWVSZXNwb25zZSIWgtPkkwIQEg4vdjEvZ2FtZXMve2lkfRJjCgpEZWxldGVHYW1lEhwud2Vld2FyLnYxLkRlbGV0ZUdhbWVSZXF1ZXN0Gh0ud2Vld2FyLnYxLkRlbGV0ZUdhbWVSZXNwb25zZSIYgtPkkwISKhAvdjEvZ2FtZXMve2lkPSp9EmsKClVwZGF0ZUdhbWUSHC53ZWV3YXIudjEuVXBkYXRlR2FtZVJlcXVlc3QaHS53ZWV3YXIudjEuVXBkYXRlR2FtZVJlc3BvbnNlIiCC0+STAho6ASoyFS92MS9nYW1lcy97Z2FtZV9pZD0qfRJyCgxHZXRHYW1lU3RhdGUSHi53ZWV3YXIudjEuR2V0R2FtZVN0YXRlUmVxdWVzdBofLndlZXdhci52MS5HZXRHYW1lU3RhdGVSZXNwb25zZSIhgtPkkwIbEhkvdjEvZ2FtZXMve2dhbWVfaWR9L3N0YXRlEmkKCUxpc3RNb3ZlcxIbLndlZXdhci52MS5MaXN0TW92ZXNSZXF1ZXN0Ghwud2Vld2FyLnYxLkxpc3RNb3Zlc1Jlc3BvbnNlIiGC0+STAhsSGS92MS9nYW1lcy97Z2FtZV9pZH0vbW92ZXMSdQoMUHJvY2Vzc01vdmVzEh4ud2Vld2FyLnYxLlByb2Nlc3NNb3Zlc1JlcXVlc3QaHy53ZWV3YXIudjEuUHJvY2Vzc01vdmVzUmVzcG9uc2UiJILT5JMCHjoBKiIZL3YxL2dhbWVzL3tnYW1lX2lkfS9tb3ZlcxJ8CgxHZXRPcHRpb25zQXQSHi53ZWV3YXIudjEuR2V0T3B0aW9uc0F0UmVxdWVzdBofLndlZXdhci52MS5HZXRPcHRpb25zQXRSZXNwb25zZSIrgtPkkwIlEiMvdjEvZ2FtZXMve2dhbWVfaWR9L29wdGlvbnMve3F9L3tyfRKCAQoHR2V0UGF0aBIZLndlZXdhci52MS5HZXRQYXRoUmVxdWVzdBoaLndlZXdhci52MS5HZXRQYXRoUmVzcG9uc2UiQILT5JMCOhI4L3YxL2dhbWVzL3tnYW1lX2lkfS9wYXRoL3tmcm9tX3F9L3tmcm9tX3J9L3t0b19xfS97dG9fcn0SbQoKVmVyaWZ5R2FtZRIcLndlZXdhci52MS5WZXJpZnlHYW1lUmVxdWVzdBodLndlZXdhci52MS5WZXJpZnlHYW1lUmVzcG9uc2UiIoLT5JMCHBIaL3YxL2dhbWVzL3tnYW1lX2lkfS92ZXJpZnkScQoJVW5kb01vdmVzEhsud2Vld2FyLnYxLlVuZG9Nb3Zlc1JlcXVlc3QaHC53ZWV3YXIudjEuVW5kb01vdmVzUmVzcG9uc2UiKYLT5JMCIzoBKiIeL3YxL2dhbWVzL3tnYW1lX2lkfS9tb3Zlcy91bmRvEnsKDVN1YnNjcmliZUdhbWUSHy53ZWV3YXIudjEuU3Vic2NyaWJlR2FtZVJlcXVlc3QaIC53ZWV3YXIudjEuU3Vic2NyaWJlR2FtZVJlc3BvbnNlIiWC0+STAh8SHS92MS9nYW1lcy97Z2FtZV9pZH0vc3Vic2NyaWJlMAFCnAEKDWNvbS53ZWV3YXIudjFCCkdhbWVzUHJvdG9QAVo6Z2l0aHViLmNvbS9wYW55YW0vdHVybmVuZ2luZS9nYW1lcy93ZWV3YXIvZ2VuL2dvL3dlZXdhci92MaICA1dYWKoCCVdlZXdhci5WMcoCCVdlZXdhclxWMeICFVdlZXdhclxWMVxHUEJNZXRhZGF0YeoCCldlZXdhcjo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_google_protobuf_field_mask, file_weewar_v1_models, file_google_api_annotations, file_protoc_gen_openapiv2_options_annotations]);
 
 /**
  * GameInfo represents a game in the catalog
@@ -137,6 +137,15 @@ export type GetGameRequest = Message<"weewar.v1.GetGameRequest"> & {
    * @generated from field: string version = 2;
    */
   version: string;
+
+  /**
+   * *
+   * Player whose view of the game's state and history to return.  When fog of
+   * war is enabled and no player is given only the game's metadata is returned.
+   *
+   * @generated from field: int32 player = 3;
+   */
+  player: number;
 };
 
 /**
@@ -468,6 +477,17 @@ export type ProcessMovesRequest = Message<"weewar.v1.ProcessMovesRequest"> & {
    * @generated from field: repeated weewar.v1.GameMove moves = 3;
    */
   moves: GameMove[];
+
+  /**
+   * *
+   * If > 0, the moves are only processed if this is the sequence number of the
+   * last move in the game.  This lets clients detect they are submitting moves
+   * against a stale game state.  Sequence numbers start at 1 so 0 skips the check
+   * and -1 requires that no moves have been made yet.
+   *
+   * @generated from field: int64 expected_last_sequence_num = 4;
+   */
+  expectedLastSequenceNum: bigint;
 };
 
 /**
@@ -516,6 +536,270 @@ export type ProcessMovesResponse = Message<"weewar.v1.ProcessMovesResponse"> & {
 export const ProcessMovesResponseSchema: GenMessage<ProcessMovesResponse> = /*@__PURE__*/
   messageDesc(file_weewar_v1_games, 16);
 
+/**
+ * *
+ * Request to verify a game by replaying its move history
+ *
+ * @generated from message weewar.v1.VerifyGameRequest
+ */
+export type VerifyGameRequest = Message<"weewar.v1.VerifyGameRequest"> & {
+  /**
+   * *
+   * Game ID to verify
+   *
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+};
+
+/**
+ * Describes the message weewar.v1.VerifyGameRequest.
+ * Use `create(VerifyGameRequestSchema)` to create a new message.
+ */
+export const VerifyGameRequestSchema: GenMessage<VerifyGameRequest> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_games, 17);
+
+/**
+ * *
+ * Result of replaying a game's move history
+ *
+ * @generated from message weewar.v1.VerifyGameResponse
+ */
+export type VerifyGameResponse = Message<"weewar.v1.VerifyGameResponse"> & {
+  /**
+   * Whether the replay matched the recorded history and the saved game state
+   *
+   * @generated from field: bool verified = 1;
+   */
+  verified: boolean;
+
+  /**
+   * @generated from field: int32 groups_replayed = 2;
+   */
+  groupsReplayed: number;
+
+  /**
+   * @generated from field: int32 moves_replayed = 3;
+   */
+  movesReplayed: number;
+
+  /**
+   * Where the replay first differed from the recording (unset if verified)
+   *
+   * @generated from field: weewar.v1.GameDivergence divergence = 4;
+   */
+  divergence?: GameDivergence;
+};
+
+/**
+ * Describes the message weewar.v1.VerifyGameResponse.
+ * Use `create(VerifyGameResponseSchema)` to create a new message.
+ */
+export const VerifyGameResponseSchema: GenMessage<VerifyGameResponse> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_games, 18);
+
+/**
+ * *
+ * The first point where a replayed game differs from its recorded history
+ *
+ * @generated from message weewar.v1.GameDivergence
+ */
+export type GameDivergence = Message<"weewar.v1.GameDivergence"> & {
+  /**
+   * Index of the move group in the history.  Equal to the number of groups if
+   * only the final game state differs.
+   *
+   * @generated from field: int32 group_index = 1;
+   */
+  groupIndex: number;
+
+  /**
+   * Index of the move in the group (-1 if not specific to a move)
+   *
+   * @generated from field: int32 move_index = 2;
+   */
+  moveIndex: number;
+
+  /**
+   * Index of the change in the move's result (-1 if not specific to a change)
+   *
+   * @generated from field: int32 change_index = 3;
+   */
+  changeIndex: number;
+
+  /**
+   * Sequence number of the move
+   *
+   * @generated from field: int64 sequence_num = 4;
+   */
+  sequenceNum: bigint;
+
+  /**
+   * Human readable description of the difference
+   *
+   * @generated from field: string reason = 5;
+   */
+  reason: string;
+
+  /**
+   * The recorded change
+   *
+   * @generated from field: weewar.v1.WorldChange expected_change = 6;
+   */
+  expectedChange?: WorldChange;
+
+  /**
+   * The recomputed change
+   *
+   * @generated from field: weewar.v1.WorldChange actual_change = 7;
+   */
+  actualChange?: WorldChange;
+};
+
+/**
+ * Describes the message weewar.v1.GameDivergence.
+ * Use `create(GameDivergenceSchema)` to create a new message.
+ */
+export const GameDivergenceSchema: GenMessage<GameDivergence> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_games, 19);
+
+/**
+ * *
+ * Request to undo the latest moves in a game.
+ *
+ * Only move groups whose results are all non-permanent can be undone and
+ * undoing never crosses a turn boundary.
+ *
+ * @generated from message weewar.v1.UndoMovesRequest
+ */
+export type UndoMovesRequest = Message<"weewar.v1.UndoMovesRequest"> & {
+  /**
+   * *
+   * Game ID to undo moves in
+   *
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * *
+   * Number of trailing move groups to undo.  if <= 0 the last group is undone
+   *
+   * @generated from field: int32 count = 2;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message weewar.v1.UndoMovesRequest.
+ * Use `create(UndoMovesRequestSchema)` to create a new message.
+ */
+export const UndoMovesRequestSchema: GenMessage<UndoMovesRequest> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_games, 20);
+
+/**
+ * *
+ * Response after undoing moves.
+ *
+ * @generated from message weewar.v1.UndoMovesResponse
+ */
+export type UndoMovesResponse = Message<"weewar.v1.UndoMovesResponse"> & {
+  /**
+   * *
+   * The move groups that were undone and removed from the history, latest first
+   *
+   * @generated from field: repeated weewar.v1.GameMoveGroup undone_groups = 1;
+   */
+  undoneGroups: GameMoveGroup[];
+
+  /**
+   * *
+   * The inverse changes that were applied to the world, in the order they were applied
+   *
+   * @generated from field: repeated weewar.v1.WorldChange changes = 2;
+   */
+  changes: WorldChange[];
+};
+
+/**
+ * Describes the message weewar.v1.UndoMovesResponse.
+ * Use `create(UndoMovesResponseSchema)` to create a new message.
+ */
+export const UndoMovesResponseSchema: GenMessage<UndoMovesResponse> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_games, 21);
+
+/**
+ * *
+ * Request to subscribe to a game's updates.
+ *
+ * @generated from message weewar.v1.SubscribeGameRequest
+ */
+export type SubscribeGameRequest = Message<"weewar.v1.SubscribeGameRequest"> & {
+  /**
+   * *
+   * Game ID to subscribe to
+   *
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * *
+   * Sequence number of the last move the client has seen.  Move groups in the
+   * history with later moves are sent before live updates.  0 replays the
+   * whole history.
+   *
+   * @generated from field: int64 from_sequence_num = 2;
+   */
+  fromSequenceNum: bigint;
+
+  /**
+   * *
+   * Player whose view the updates are filtered to when fog of war is enabled
+   *
+   * @generated from field: int32 player = 3;
+   */
+  player: number;
+};
+
+/**
+ * Describes the message weewar.v1.SubscribeGameRequest.
+ * Use `create(SubscribeGameRequestSchema)` to create a new message.
+ */
+export const SubscribeGameRequestSchema: GenMessage<SubscribeGameRequest> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_games, 22);
+
+/**
+ * *
+ * An update to a subscribed game.  Exactly one of the fields is set.
+ *
+ * @generated from message weewar.v1.SubscribeGameResponse
+ */
+export type SubscribeGameResponse = Message<"weewar.v1.SubscribeGameResponse"> & {
+  /**
+   * *
+   * A move group that was committed to the game
+   *
+   * @generated from field: weewar.v1.GameMoveGroup move_group = 1;
+   */
+  moveGroup?: GameMoveGroup;
+
+  /**
+   * *
+   * Move groups that were undone.  Clients should apply the inverse changes.
+   *
+   * @generated from field: weewar.v1.UndoMovesResponse undo = 2;
+   */
+  undo?: UndoMovesResponse;
+};
+
+/**
+ * Describes the message weewar.v1.SubscribeGameResponse.
+ * Use `create(SubscribeGameResponseSchema)` to create a new message.
+ */
+export const SubscribeGameResponseSchema: GenMessage<SubscribeGameResponse> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_games, 23);
+
 /**
  * *
  * Request to get the game's latest state
@@ -530,6 +814,15 @@ export type GetGameStateRequest = Message<"weewar.v1.GetGameStateRequest"> & {
    * @generated from field: string game_id = 1;
    */
   gameId: string;
+
+  /**
+   * *
+   * Player whose view of the game state to return.
+   * Required when fog of war is enabled.
+   *
+   * @generated from field: int32 player = 2;
+   */
+  player: number;
 };
 
 /**
@@ -537,7 +830,7 @@ export type GetGameStateRequest = Message<"weewar.v1.GetGameStateRequest"> & {
  * Use `create(GetGameStateRequestSchema)` to create a new message.
  */
 export const GetGameStateRequestSchema: GenMessage<GetGameStateRequest> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_games, 17);
+  messageDesc(file_weewar_v1_games, 24);
 
 /**
  * *
@@ -550,6 +843,20 @@ export type GetGameStateResponse = Message<"weewar.v1.GetGameStateResponse"> & {
    * @generated from field: weewar.v1.GameState state = 1;
    */
   state?: GameState;
+
+  /**
+   * Seconds left in the current turn or -1 if turns are not timed
+   *
+   * @generated from field: int32 turn_time_remaining = 2;
+   */
+  turnTimeRemaining: number;
+
+  /**
+   * Each player's progress towards the game's victory conditions
+   *
+   * @generated from field: repeated weewar.v1.VictoryProgress victory_progress = 3;
+   */
+  victoryProgress: VictoryProgress[];
 };
 
 /**
@@ -557,7 +864,7 @@ export type GetGameStateResponse = Message<"weewar.v1.GetGameStateResponse"> & {
  * Use `create(GetGameStateResponseSchema)` to create a new message.
  */
 export const GetGameStateResponseSchema: GenMessage<GetGameStateResponse> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_games, 18);
+  messageDesc(file_weewar_v1_games, 25);
 
 /**
  * *
@@ -589,6 +896,15 @@ export type ListMovesRequest = Message<"weewar.v1.ListMovesRequest"> & {
    * @generated from field: int32 last_n = 3;
    */
   lastN: number;
+
+  /**
+   * *
+   * Player whose view of the moves to return.
+   * Required when fog of war is enabled.
+   *
+   * @generated from field: int32 player = 4;
+   */
+  player: number;
 };
 
 /**
@@ -596,7 +912,7 @@ export type ListMovesRequest = Message<"weewar.v1.ListMovesRequest"> & {
  * Use `create(ListMovesRequestSchema)` to create a new message.
  */
 export const ListMovesRequestSchema: GenMessage<ListMovesRequest> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_games, 19);
+  messageDesc(file_weewar_v1_games, 26);
 
 /**
  * *
@@ -623,7 +939,7 @@ export type ListMovesResponse = Message<"weewar.v1.ListMovesResponse"> & {
  * Use `create(ListMovesResponseSchema)` to create a new message.
  */
 export const ListMovesResponseSchema: GenMessage<ListMovesResponse> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_games, 20);
+  messageDesc(file_weewar_v1_games, 27);
 
 /**
  * *
@@ -653,7 +969,7 @@ export type GetOptionsAtRequest = Message<"weewar.v1.GetOptionsAtRequest"> & {
  * Use `create(GetOptionsAtRequestSchema)` to create a new message.
  */
 export const GetOptionsAtRequestSchema: GenMessage<GetOptionsAtRequest> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_games, 21);
+  messageDesc(file_weewar_v1_games, 28);
 
 /**
  * *
@@ -687,7 +1003,136 @@ export type GetOptionsAtResponse = Message<"weewar.v1.GetOptionsAtResponse"> & {
  * Use `create(GetOptionsAtResponseSchema)` to create a new message.
  */
 export const GetOptionsAtResponseSchema: GenMessage<GetOptionsAtResponse> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_games, 22);
+  messageDesc(file_weewar_v1_games, 29);
+
+/**
+ * *
+ * Request for the cheapest path between two positions
+ *
+ * @generated from message weewar.v1.GetPathRequest
+ */
+export type GetPathRequest = Message<"weewar.v1.GetPathRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: int32 from_q = 2;
+   */
+  fromQ: number;
+
+  /**
+   * @generated from field: int32 from_r = 3;
+   */
+  fromR: number;
+
+  /**
+   * @generated from field: int32 to_q = 4;
+   */
+  toQ: number;
+
+  /**
+   * @generated from field: int32 to_r = 5;
+   */
+  toR: number;
+};
+
+/**
+ * Describes the message weewar.v1.GetPathRequest.
+ * Use `create(GetPathRequestSchema)` to create a new message.
+ */
+export const GetPathRequestSchema: GenMessage<GetPathRequest> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_games, 30);
+
+/**
+ * *
+ * The cheapest path for a unit between two positions
+ *
+ * @generated from message weewar.v1.GetPathResponse
+ */
+export type GetPathResponse = Message<"weewar.v1.GetPathResponse"> & {
+  /**
+   * Steps after the starting position in the order they are moved through
+   *
+   * @generated from field: repeated weewar.v1.PathStep steps = 1;
+   */
+  steps: PathStep[];
+
+  /**
+   * Total terrain cost of the path
+   *
+   * @generated from field: double total_cost = 2;
+   */
+  totalCost: number;
+
+  /**
+   * Movement points the move is charged (the total cost rounded)
+   *
+   * @generated from field: int32 movement_cost = 3;
+   */
+  movementCost: number;
+
+  /**
+   * Whether the unit has enough movement left to make the move this turn
+   *
+   * @generated from field: bool reachable = 4;
+   */
+  reachable: boolean;
+
+  /**
+   * Ready-to-use action object for ProcessMoves
+   *
+   * @generated from field: weewar.v1.MoveUnitAction action = 5;
+   */
+  action?: MoveUnitAction;
+};
+
+/**
+ * Describes the message weewar.v1.GetPathResponse.
+ * Use `create(GetPathResponseSchema)` to create a new message.
+ */
+export const GetPathResponseSchema: GenMessage<GetPathResponse> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_games, 31);
+
+/**
+ * *
+ * A single step of a path
+ *
+ * @generated from message weewar.v1.PathStep
+ */
+export type PathStep = Message<"weewar.v1.PathStep"> & {
+  /**
+   * @generated from field: int32 q = 1;
+   */
+  q: number;
+
+  /**
+   * @generated from field: int32 r = 2;
+   */
+  r: number;
+
+  /**
+   * Cost of entering this hex
+   *
+   * @generated from field: double cost = 3;
+   */
+  cost: number;
+
+  /**
+   * Cost of the path up to and including this hex
+   *
+   * @generated from field: double total_cost = 4;
+   */
+  totalCost: number;
+};
+
+/**
+ * Describes the message weewar.v1.PathStep.
+ * Use `create(PathStepSchema)` to create a new message.
+ */
+export const PathStepSchema: GenMessage<PathStep> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_games, 32);
 
 /**
  * *
@@ -729,6 +1174,30 @@ export type GameOption = Message<"weewar.v1.GameOption"> & {
      */
     value: CaptureBuildingOption;
     case: "capture";
+  } | {
+    /**
+     * @generated from field: weewar.v1.LoadUnitOption load = 6;
+     */
+    value: LoadUnitOption;
+    case: "load";
+  } | {
+    /**
+     * @generated from field: weewar.v1.UnloadUnitOption unload = 7;
+     */
+    value: UnloadUnitOption;
+    case: "unload";
+  } | {
+    /**
+     * @generated from field: weewar.v1.HealUnitOption heal = 8;
+     */
+    value: HealUnitOption;
+    case: "heal";
+  } | {
+    /**
+     * @generated from field: weewar.v1.ModifyTerrainOption modify_terrain = 9;
+     */
+    value: ModifyTerrainOption;
+    case: "modifyTerrain";
   } | { case: undefined; value?: undefined };
 };
 
@@ -737,7 +1206,7 @@ export type GameOption = Message<"weewar.v1.GameOption"> & {
  * Use `create(GameOptionSchema)` to create a new message.
  */
 export const GameOptionSchema: GenMessage<GameOption> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_games, 23);
+  messageDesc(file_weewar_v1_games, 33);
 
 /**
  * *
@@ -755,7 +1224,7 @@ export type EndTurnOption = Message<"weewar.v1.EndTurnOption"> & {
  * Use `create(EndTurnOptionSchema)` to create a new message.
  */
 export const EndTurnOptionSchema: GenMessage<EndTurnOption> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_games, 24);
+  messageDesc(file_weewar_v1_games, 34);
 
 /**
  * *
@@ -792,7 +1261,7 @@ export type MoveOption = Message<"weewar.v1.MoveOption"> & {
  * Use `create(MoveOptionSchema)` to create a new message.
  */
 export const MoveOptionSchema: GenMessage<MoveOption> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_games, 25);
+  messageDesc(file_weewar_v1_games, 35);
 
 /**
  * *
@@ -841,6 +1310,32 @@ export type AttackOption = Message<"weewar.v1.AttackOption"> & {
    * @generated from field: weewar.v1.AttackUnitAction action = 7;
    */
   action?: AttackUnitAction;
+
+  /**
+   * Predicted outcome of the attack with the target's counter attack
+   *
+   * @generated from field: double expected_damage_dealt = 8;
+   */
+  expectedDamageDealt: number;
+
+  /**
+   * @generated from field: double expected_damage_taken = 9;
+   */
+  expectedDamageTaken: number;
+
+  /**
+   * Chance the target is destroyed
+   *
+   * @generated from field: double kill_probability = 10;
+   */
+  killProbability: number;
+
+  /**
+   * Chance the attacking unit is destroyed
+   *
+   * @generated from field: double loss_probability = 11;
+   */
+  lossProbability: number;
 };
 
 /**
@@ -848,7 +1343,7 @@ export type AttackOption = Message<"weewar.v1.AttackOption"> & {
  * Use `create(AttackOptionSchema)` to create a new message.
  */
 export const AttackOptionSchema: GenMessage<AttackOption> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_games, 26);
+  messageDesc(file_weewar_v1_games, 36);
 
 /**
  * *
@@ -876,6 +1371,18 @@ export type BuildUnitOption = Message<"weewar.v1.BuildUnitOption"> & {
    * @generated from field: int32 build_cost = 4;
    */
   buildCost: number;
+
+  /**
+   * @generated from field: int32 unit_type = 5;
+   */
+  unitType: number;
+
+  /**
+   * Ready-to-use action object for ProcessMoves
+   *
+   * @generated from field: weewar.v1.BuildUnitAction action = 6;
+   */
+  action?: BuildUnitAction;
 };
 
 /**
@@ -883,7 +1390,7 @@ export type BuildUnitOption = Message<"weewar.v1.BuildUnitOption"> & {
  * Use `create(BuildUnitOptionSchema)` to create a new message.
  */
 export const BuildUnitOptionSchema: GenMessage<BuildUnitOption> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_games, 27);
+  messageDesc(file_weewar_v1_games, 37);
 
 /**
  * *
@@ -906,6 +1413,25 @@ export type CaptureBuildingOption = Message<"weewar.v1.CaptureBuildingOption"> &
    * @generated from field: int32 tile_type = 3;
    */
   tileType: number;
+
+  /**
+   * Turns of capture progress so far and turns needed to complete the capture
+   *
+   * @generated from field: int32 capture_progress = 4;
+   */
+  captureProgress: number;
+
+  /**
+   * @generated from field: int32 capture_turns = 5;
+   */
+  captureTurns: number;
+
+  /**
+   * Ready-to-use action object for ProcessMoves
+   *
+   * @generated from field: weewar.v1.CaptureBuildingAction action = 6;
+   */
+  action?: CaptureBuildingAction;
 };
 
 /**
@@ -913,7 +1439,202 @@ export type CaptureBuildingOption = Message<"weewar.v1.CaptureBuildingOption"> &
  * Use `create(CaptureBuildingOptionSchema)` to create a new message.
  */
 export const CaptureBuildingOptionSchema: GenMessage<CaptureBuildingOption> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_games, 28);
+  messageDesc(file_weewar_v1_games, 38);
+
+/**
+ * *
+ * A terrain action the unit can work on at a hex
+ *
+ * @generated from message weewar.v1.ModifyTerrainOption
+ */
+export type ModifyTerrainOption = Message<"weewar.v1.ModifyTerrainOption"> & {
+  /**
+   * @generated from field: int32 q = 1;
+   */
+  q: number;
+
+  /**
+   * @generated from field: int32 r = 2;
+   */
+  r: number;
+
+  /**
+   * @generated from field: string terrain_action = 3;
+   */
+  terrainAction: string;
+
+  /**
+   * @generated from field: string name = 4;
+   */
+  name: string;
+
+  /**
+   * Terrain type the tile will turn into
+   *
+   * @generated from field: int32 new_tile_type = 5;
+   */
+  newTileType: number;
+
+  /**
+   * Turns of work done so far and turns needed to complete the change
+   *
+   * @generated from field: int32 progress = 6;
+   */
+  progress: number;
+
+  /**
+   * @generated from field: int32 turns = 7;
+   */
+  turns: number;
+
+  /**
+   * Coins charged to start the work (0 if work is already under way)
+   *
+   * @generated from field: int32 coins = 8;
+   */
+  coins: number;
+
+  /**
+   * Ready-to-use action object for ProcessMoves
+   *
+   * @generated from field: weewar.v1.ModifyTerrainAction action = 9;
+   */
+  action?: ModifyTerrainAction;
+};
+
+/**
+ * Describes the message weewar.v1.ModifyTerrainOption.
+ * Use `create(ModifyTerrainOptionSchema)` to create a new message.
+ */
+export const ModifyTerrainOptionSchema: GenMessage<ModifyTerrainOption> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_games, 39);
+
+/**
+ * *
+ * An adjacent transport the unit can board
+ *
+ * @generated from message weewar.v1.LoadUnitOption
+ */
+export type LoadUnitOption = Message<"weewar.v1.LoadUnitOption"> & {
+  /**
+   * Position and type of the transport
+   *
+   * @generated from field: int32 q = 1;
+   */
+  q: number;
+
+  /**
+   * @generated from field: int32 r = 2;
+   */
+  r: number;
+
+  /**
+   * @generated from field: int32 transport_unit_type = 3;
+   */
+  transportUnitType: number;
+
+  /**
+   * Ready-to-use action object for ProcessMoves
+   *
+   * @generated from field: weewar.v1.LoadUnitAction action = 4;
+   */
+  action?: LoadUnitAction;
+};
+
+/**
+ * Describes the message weewar.v1.LoadUnitOption.
+ * Use `create(LoadUnitOptionSchema)` to create a new message.
+ */
+export const LoadUnitOptionSchema: GenMessage<LoadUnitOption> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_games, 40);
+
+/**
+ * *
+ * A hex a transport can unload one of its carried units to
+ *
+ * @generated from message weewar.v1.UnloadUnitOption
+ */
+export type UnloadUnitOption = Message<"weewar.v1.UnloadUnitOption"> & {
+  /**
+   * @generated from field: int32 q = 1;
+   */
+  q: number;
+
+  /**
+   * @generated from field: int32 r = 2;
+   */
+  r: number;
+
+  /**
+   * Index and type of the carried unit
+   *
+   * @generated from field: int32 cargo_index = 3;
+   */
+  cargoIndex: number;
+
+  /**
+   * @generated from field: int32 unit_type = 4;
+   */
+  unitType: number;
+
+  /**
+   * Ready-to-use action object for ProcessMoves
+   *
+   * @generated from field: weewar.v1.UnloadUnitAction action = 5;
+   */
+  action?: UnloadUnitAction;
+};
+
+/**
+ * Describes the message weewar.v1.UnloadUnitOption.
+ * Use `create(UnloadUnitOptionSchema)` to create a new message.
+ */
+export const UnloadUnitOptionSchema: GenMessage<UnloadUnitOption> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_games, 41);
+
+/**
+ * *
+ * Option to heal an adjacent friendly unit
+ *
+ * @generated from message weewar.v1.HealUnitOption
+ */
+export type HealUnitOption = Message<"weewar.v1.HealUnitOption"> & {
+  /**
+   * @generated from field: int32 q = 1;
+   */
+  q: number;
+
+  /**
+   * @generated from field: int32 r = 2;
+   */
+  r: number;
+
+  /**
+   * @generated from field: int32 unit_type = 3;
+   */
+  unitType: number;
+
+  /**
+   * Health the target would regain
+   *
+   * @generated from field: int32 amount = 4;
+   */
+  amount: number;
+
+  /**
+   * Ready-to-use action object for ProcessMoves
+   *
+   * @generated from field: weewar.v1.HealUnitAction action = 5;
+   */
+  action?: HealUnitAction;
+};
+
+/**
+ * Describes the message weewar.v1.HealUnitOption.
+ * Use `create(HealUnitOptionSchema)` to create a new message.
+ */
+export const HealUnitOptionSchema: GenMessage<HealUnitOption> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_games, 42);
 
 /**
  * GamesService manages the game examples catalog
@@ -954,7 +1675,7 @@ export const GamesService: GenService<{
     output: typeof ListGamesResponseSchema;
   },
   /**
-   * GetGame returns a specific game with metadata
+   * GetGame returns a specific game with metadata, its state and history
    *
    * @generated from rpc weewar.v1.GamesService.GetGame
    */
@@ -1020,6 +1741,49 @@ export const GamesService: GenService<{
     input: typeof GetOptionsAtRequestSchema;
     output: typeof GetOptionsAtResponseSchema;
   },
+  /**
+   * Returns the cheapest path for the unit at (from_q, from_r) to reach (to_q, to_r)
+   * along with the cost of each step
+   *
+   * @generated from rpc weewar.v1.GamesService.GetPath
+   */
+  getPath: {
+    methodKind: "unary";
+    input: typeof GetPathRequestSchema;
+    output: typeof GetPathResponseSchema;
+  },
+  /**
+   * Admin: Replays a game's move history from its initial state and reports the
+   * first place where recomputed changes differ from the recorded ones
+   *
+   * @generated from rpc weewar.v1.GamesService.VerifyGame
+   */
+  verifyGame: {
+    methodKind: "unary";
+    input: typeof VerifyGameRequestSchema;
+    output: typeof VerifyGameResponseSchema;
+  },
+  /**
+   * Undo the latest non-permanent move groups in the current turn
+   *
+   * @generated from rpc weewar.v1.GamesService.UndoMoves
+   */
+  undoMoves: {
+    methodKind: "unary";
+    input: typeof UndoMovesRequestSchema;
+    output: typeof UndoMovesResponseSchema;
+  },
+  /**
+   * Streams move groups as they are committed to a game.  Groups already in the
+   * history after from_sequence_num are sent first so clients can resume.
+   *
+   * @generated from rpc weewar.v1.GamesService.SubscribeGame
+   */
+  subscribeGame: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeGameRequestSchema;
+    output: typeof SubscribeGameResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_weewar_v1_games, 0);
 
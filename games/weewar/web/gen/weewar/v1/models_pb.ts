@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file weewar/v1/models.proto.
  */
 export const file_weewar_v1_models: GenFile = /*@__PURE__*/
-  fileDesc("ChZ3ZWV3YXIvdjEvbW9kZWxzLnByb3RvEgl3ZWV3YXIudjEiygEKBFVzZXISLgoKY3JlYXRlZF9hdBgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASCgoCaWQYAyABKAkSDAoEbmFtZRgEIAEoCRITCgtkZXNjcmlwdGlvbhgFIAEoCRIMCgR0YWdzGAYgAygJEhEKCWltYWdlX3VybBgHIAEoCRISCgpkaWZmaWN1bHR5GAggASgJIkYKClBhZ2luYXRpb24SEAoIcGFnZV9rZXkYASABKAkSEwoLcGFnZV9vZmZzZXQYAiABKAUSEQoJcGFnZV9zaXplGAMgASgFIm4KElBhZ2luYXRpb25SZXNwb25zZRIVCg1uZXh0X3BhZ2Vfa2V5GAIgASgJEhgKEG5leHRfcGFnZV9vZmZzZXQYAyABKAUSEAoIaGFzX21vcmUYBCABKAgSFQoNdG90YWxfcmVzdWx0cxgFIAEoBSKJAgoFV29ybGQSLgoKY3JlYXRlZF9hdBgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASCgoCaWQYAyABKAkSEgoKY3JlYXRvcl9pZBgEIAEoCRIMCgRuYW1lGAUgASgJEhMKC2Rlc2NyaXB0aW9uGAYgASgJEgwKBHRhZ3MYByADKAkSEQoJaW1hZ2VfdXJsGAggASgJEhIKCmRpZmZpY3VsdHkYCSABKAkSKAoKd29ybGRfZGF0YRgKIAEoCzIULndlZXdhci52MS5Xb3JsZERhdGEiSwoJV29ybGREYXRhEh4KBXRpbGVzGAEgAygLMg8ud2Vld2FyLnYxLlRpbGUSHgoFdW5pdHMYAiADKAsyDy53ZWV3YXIudjEuVW5pdCI/CgRUaWxlEgkKAXEYASABKAUSCQoBchgCIAEoBRIRCgl0aWxlX3R5cGUYAyABKAUSDgoGcGxheWVyGAQgASgFIoYBCgRVbml0EgkKAXEYASABKAUSCQoBchgCIAEoBRIOCgZwbGF5ZXIYAyABKAUSEQoJdW5pdF90eXBlGAQgASgFEhgKEGF2YWlsYWJsZV9oZWFsdGgYBSABKAUSFQoNZGlzdGFuY2VfbGVmdBgGIAEoBRIUCgx0dXJuX2NvdW50ZXIYByABKAUifwoRVGVycmFpbkRlZmluaXRpb24SCgoCaWQYASABKAUSDAoEbmFtZRgCIAEoCRIWCg5iYXNlX21vdmVfY29zdBgDIAEoARIVCg1kZWZlbnNlX2JvbnVzGAQgASgBEgwKBHR5cGUYBSABKAUSEwoLZGVzY3JpcHRpb24YBiABKAkifQoOVW5pdERlZmluaXRpb24SCgoCaWQYASABKAUSDAoEbmFtZRgCIAEoCRIXCg9tb3ZlbWVudF9wb2ludHMYAyABKAUSFAoMYXR0YWNrX3JhbmdlGAQgASgFEg4KBmhlYWx0aBgFIAEoBRISCgpwcm9wZXJ0aWVzGAYgAygJIo4BCg5Nb3ZlbWVudE1hdHJpeBIzCgVjb3N0cxgBIAMoCzIkLndlZXdhci52MS5Nb3ZlbWVudE1hdHJpeC5Db3N0c0VudHJ5GkcKCkNvc3RzRW50cnkSCwoDa2V5GAEgASgFEigKBXZhbHVlGAIgASgLMhkud2Vld2FyLnYxLlRlcnJhaW5Db3N0TWFwOgI4ASKJAQoOVGVycmFpbkNvc3RNYXASQgoNdGVycmFpbl9jb3N0cxgBIAMoCzIrLndlZXdhci52MS5UZXJyYWluQ29zdE1hcC5UZXJyYWluQ29zdHNFbnRyeRozChFUZXJyYWluQ29zdHNFbnRyeRILCgNrZXkYASABKAUSDQoFdmFsdWUYAiABKAE6AjgBIp4CCgRHYW1lEi4KCmNyZWF0ZWRfYXQYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEgoKAmlkGAMgASgJEhIKCmNyZWF0b3JfaWQYBCABKAkSEAoId29ybGRfaWQYBSABKAkSDAoEbmFtZRgGIAEoCRITCgtkZXNjcmlwdGlvbhgHIAEoCRIMCgR0YWdzGAggAygJEhEKCWltYWdlX3VybBgJIAEoCRISCgpkaWZmaWN1bHR5GAogASgJEiwKBmNvbmZpZxgLIAEoCzIcLndlZXdhci52MS5HYW1lQ29uZmlndXJhdGlvbiJmChFHYW1lQ29uZmlndXJhdGlvbhImCgdwbGF5ZXJzGAEgAygLMhUud2Vld2FyLnYxLkdhbWVQbGF5ZXISKQoIc2V0dGluZ3MYAiABKAsyFy53ZWV3YXIudjEuR2FtZVNldHRpbmdzIlQKCkdhbWVQbGF5ZXISEQoJcGxheWVyX2lkGAEgASgFEhMKC3BsYXllcl90eXBlGAIgASgJEg0KBWNvbG9yGAMgASgJEg8KB3RlYW1faWQYBCABKAUiZAoMR2FtZVNldHRpbmdzEhUKDWFsbG93ZWRfdW5pdHMYASADKAUSFwoPdHVybl90aW1lX2xpbWl0GAIgASgFEhEKCXRlYW1fbW9kZRgDIAEoCRIRCgltYXhfdHVybnMYBCABKAUipAEKCUdhbWVTdGF0ZRIuCgp1cGRhdGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgdnYW1lX2lkGAMgASgJEhQKDHR1cm5fY291bnRlchgEIAEoBRIWCg5jdXJyZW50X3BsYXllchgFIAEoBRIoCgp3b3JsZF9kYXRhGAYgASgLMhQud2Vld2FyLnYxLldvcmxkRGF0YSJMCg9HYW1lTW92ZUhpc3RvcnkSDwoHZ2FtZV9pZBgBIAEoCRIoCgZncm91cHMYAiADKAsyGC53ZWV3YXIudjEuR2FtZU1vdmVHcm91cCLCAQoNR2FtZU1vdmVHcm91cBIuCgpzdGFydGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASIgoFbW92ZXMYBCADKAsyEy53ZWV3YXIudjEuR2FtZU1vdmUSLwoMbW92ZV9yZXN1bHRzGAUgAygLMhkud2Vld2FyLnYxLkdhbWVNb3ZlUmVzdWx0Iv4BCghHYW1lTW92ZRIOCgZwbGF5ZXIYASABKAUSLQoJdGltZXN0YW1wGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIUCgxzZXF1ZW5jZV9udW0YAyABKAMSLgoJbW92ZV91bml0GAQgASgLMhkud2Vld2FyLnYxLk1vdmVVbml0QWN0aW9uSAASMgoLYXR0YWNrX3VuaXQYBSABKAsyGy53ZWV3YXIudjEuQXR0YWNrVW5pdEFjdGlvbkgAEiwKCGVuZF90dXJuGAYgASgLMhgud2Vld2FyLnYxLkVuZFR1cm5BY3Rpb25IAEILCgltb3ZlX3R5cGUiZQoOR2FtZU1vdmVSZXN1bHQSFAoMaXNfcGVybWFuZW50GAEgASgIEhQKDHNlcXVlbmNlX251bRgCIAEoAxInCgdjaGFuZ2VzGAMgAygLMhYud2Vld2FyLnYxLldvcmxkQ2hhbmdlIkwKDk1vdmVVbml0QWN0aW9uEg4KBmZyb21fcRgBIAEoBRIOCgZmcm9tX3IYAiABKAUSDAoEdG9fcRgDIAEoBRIMCgR0b19yGAQgASgFImIKEEF0dGFja1VuaXRBY3Rpb24SEgoKYXR0YWNrZXJfcRgBIAEoBRISCgphdHRhY2tlcl9yGAIgASgFEhIKCmRlZmVuZGVyX3EYAyABKAUSEgoKZGVmZW5kZXJfchgEIAEoBSIPCg1FbmRUdXJuQWN0aW9uIvIBCgtXb3JsZENoYW5nZRIwCgp1bml0X21vdmVkGAEgASgLMhoud2Vld2FyLnYxLlVuaXRNb3ZlZENoYW5nZUgAEjQKDHVuaXRfZGFtYWdlZBgCIAEoCzIcLndlZXdhci52MS5Vbml0RGFtYWdlZENoYW5nZUgAEjIKC3VuaXRfa2lsbGVkGAMgASgLMhsud2Vld2FyLnYxLlVuaXRLaWxsZWRDaGFuZ2VIABI4Cg5wbGF5ZXJfY2hhbmdlZBgEIAEoCzIeLndlZXdhci52MS5QbGF5ZXJDaGFuZ2VkQ2hhbmdlSABCDQoLY2hhbmdlX3R5cGUiYAoPVW5pdE1vdmVkQ2hhbmdlEiYKDXByZXZpb3VzX3VuaXQYBiABKAsyDy53ZWV3YXIudjEuVW5pdBIlCgx1cGRhdGVkX3VuaXQYByABKAsyDy53ZWV3YXIudjEuVW5pdCJiChFVbml0RGFtYWdlZENoYW5nZRImCg1wcmV2aW91c191bml0GAYgASgLMg8ud2Vld2FyLnYxLlVuaXQSJQoMdXBkYXRlZF91bml0GAcgASgLMg8ud2Vld2FyLnYxLlVuaXQiOgoQVW5pdEtpbGxlZENoYW5nZRImCg1wcmV2aW91c191bml0GAYgASgLMg8ud2Vld2FyLnYxLlVuaXQikQEKE1BsYXllckNoYW5nZWRDaGFuZ2USFwoPcHJldmlvdXNfcGxheWVyGAEgASgFEhIKCm5ld19wbGF5ZXIYAiABKAUSFQoNcHJldmlvdXNfdHVybhgDIAEoBRIQCghuZXdfdHVybhgEIAEoBRIkCgtyZXNldF91bml0cxgFIAMoCzIPLndlZXdhci52MS5Vbml0Qp0BCg1jb20ud2Vld2FyLnYxQgtNb2RlbHNQcm90b1ABWjpnaXRodWIuY29tL3BhbnlhbS90dXJuZW5naW5lL2dhbWVzL3dlZXdhci9nZW4vZ28vd2Vld2FyL3YxogIDV1hYqgIJV2Vld2FyLlYxygIJV2Vld2FyXFYx4gIVV2Vld2FyXFYxXEdQQk1ldGFkYXRh6gIKV2Vld2FyOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp]);
+  fileDesc("ChZ3ZWV3YXIvdjEvbW9kZWxzLnByb3RvEgl3ZWV3YXIudjEiygEKBFVzZXISLgoKY3JlYXRlZF9hdBgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASCgoCaWQYAyABKAkSDAoEbmFtZRgEIAEoCRITCgtkZXNjcmlwdGlvbhgFIAEoCRIMCgR0YWdzGAYgAygJEhEKCWltYWdlX3VybBgHIAEoCRISCgpkaWZmaWN1bHR5GAggASgJIkYKClBhZ2luYXRpb24SEAoIcGFnZV9rZXkYASABKAkSEwoLcGFnZV9vZmZzZXQYAiABKAUSEQoJcGFnZV9zaXplGAMgASgFIm4KElBhZ2luYXRpb25SZXNwb25zZRIVCg1uZXh0X3BhZ2Vfa2V5GAIgASgJEhgKEG5leHRfcGFnZV9vZmZzZXQYAyABKAUSEAoIaGFzX21vcmUYBCABKAgSFQoNdG90YWxfcmVzdWx0cxgFIAEoBSKJAgoFV29ybGQSLgoKY3JlYXRlZF9hdBgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASCgoCaWQYAyABKAkSEgoKY3JlYXRvcl9pZBgEIAEoCRIMCgRuYW1lGAUgASgJEhMKC2Rlc2NyaXB0aW9uGAYgASgJEgwKBHRhZ3MYByADKAkSEQoJaW1hZ2VfdXJsGAggASgJEhIKCmRpZmZpY3VsdHkYCSABKAkSKAoKd29ybGRfZGF0YRgKIAEoCzIULndlZXdhci52MS5Xb3JsZERhdGEiSwoJV29ybGREYXRhEh4KBXRpbGVzGAEgAygLMg8ud2Vld2FyLnYxLlRpbGUSHgoFdW5pdHMYAiADKAsyDy53ZWV3YXIudjEuVW5pdCKqAQoEVGlsZRIJCgFxGAEgASgFEgkKAXIYAiABKAUSEQoJdGlsZV90eXBlGAMgASgFEg4KBnBsYXllchgEIAEoBRIWCg5jYXB0dXJlX3BsYXllchgFIAEoBRIYChBjYXB0dXJlX3Byb2dyZXNzGAYgASgFEhYKDnRlcnJhaW5fYWN0aW9uGAcgASgJEh8KF3RlcnJhaW5fYWN0aW9uX3Byb2dyZXNzGAggASgFIuoBCgRVbml0EgkKAXEYASABKAUSCQoBchgCIAEoBRIOCgZwbGF5ZXIYAyABKAUSEQoJdW5pdF90eXBlGAQgASgFEhgKEGF2YWlsYWJsZV9oZWFsdGgYBSABKAUSFQoNZGlzdGFuY2VfbGVmdBgGIAEoBRIUCgx0dXJuX2NvdW50ZXIYByABKAUSEQoJaGFzX21vdmVkGAggASgIEhQKDGhhc19hdHRhY2tlZBgJIAEoCBIZChFhY3Rpb25zX3JlbWFpbmluZxgKIAEoBRIeCgVjYXJnbxgLIAMoCzIPLndlZXdhci52MS5Vbml0IvMBChFUZXJyYWluRGVmaW5pdGlvbhIKCgJpZBgBIAEoBRIMCgRuYW1lGAIgASgJEhYKDmJhc2VfbW92ZV9jb3N0GAMgASgBEhUKDWRlZmVuc2VfYm9udXMYBCABKAESDAoEdHlwZRgFIAEoBRITCgtkZXNjcmlwdGlvbhgGIAEoCRIXCg9idWlsZGFibGVfdW5pdHMYByADKAUSFQoNY2FwdHVyZV90dXJucxgIIAEoBRIVCg1yZXBhaXJfYW1vdW50GAkgASgFEhYKDnJlcGFpcl9jbGFzc2VzGAogAygJEhMKC3JlcGFpcl9jb3N0GAsgASgBIvwCCg5Vbml0RGVmaW5pdGlvbhIKCgJpZBgBIAEoBRIMCgRuYW1lGAIgASgJEhcKD21vdmVtZW50X3BvaW50cxgDIAEoBRIUCgxhdHRhY2tfcmFuZ2UYBCABKAUSDgoGaGVhbHRoGAUgASgFEhIKCnByb3BlcnRpZXMYBiADKAkSDQoFY29pbnMYByABKAUSEwoLY2FuX2NhcHR1cmUYCCABKAgSEwoLc2lnaHRfcmFuZ2UYCSABKAUSEgoKdW5pdF9jbGFzcxgKIAEoCRIdChVjYW5fbW92ZV9hZnRlcl9hdHRhY2sYCyABKAgSGAoQYWN0aW9uc19wZXJfdHVybhgMIAEoBRIYChBtaW5fYXR0YWNrX3JhbmdlGA0gASgFEhUKDWluZGlyZWN0X2ZpcmUYDiABKAgSGgoSdHJhbnNwb3J0X2NhcGFjaXR5GA8gASgFEhUKDWNhcmdvX2NsYXNzZXMYECADKAkSEwoLaGVhbF9hbW91bnQYESABKAUiYgoNTW92ZW1lbnRSdWxlcxIbChNwYXNzX3Rocm91Z2hfYWxsaWVzGAEgASgIEhgKEHpvY191bml0X2NsYXNzZXMYAiADKAkSGgoSem9jX2ltbXVuZV9jbGFzc2VzGAMgAygJItMBCgtDb21iYXRSdWxlcxJDCg9jbGFzc19tb2RpZmllcnMYASADKAsyKi53ZWV3YXIudjEuQ29tYmF0UnVsZXMuQ2xhc3NNb2RpZmllcnNFbnRyeRInCh90ZXJyYWluX2RlZmVuc2VfaWdub3JlZF9jbGFzc2VzGAIgAygJGlYKE0NsYXNzTW9kaWZpZXJzRW50cnkSCwoDa2V5GAEgASgJEi4KBXZhbHVlGAIgASgLMh8ud2Vld2FyLnYxLkNsYXNzRGFtYWdlTW9kaWZpZXJzOgI4ASKbAQoSVGVycmFpbkFjdGlvblJ1bGVzEjsKB2FjdGlvbnMYASADKAsyKi53ZWV3YXIudjEuVGVycmFpbkFjdGlvblJ1bGVzLkFjdGlvbnNFbnRyeRpICgxBY3Rpb25zRW50cnkSCwoDa2V5GAEgASgJEicKBXZhbHVlGAIgASgLMhgud2Vld2FyLnYxLlRlcnJhaW5BY3Rpb246AjgBItwBCg1UZXJyYWluQWN0aW9uEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSRQoPdGVycmFpbl9jaGFuZ2VzGAMgAygLMiwud2Vld2FyLnYxLlRlcnJhaW5BY3Rpb24uVGVycmFpbkNoYW5nZXNFbnRyeRINCgV0dXJucxgEIAEoBRINCgVjb2lucxgFIAEoBRIVCg11bml0X3Byb3BlcnR5GAYgASgJGjUKE1RlcnJhaW5DaGFuZ2VzRW50cnkSCwoDa2V5GAEgASgFEg0KBXZhbHVlGAIgASgFOgI4ASKeAQoUQ2xhc3NEYW1hZ2VNb2RpZmllcnMSTgoQZGVmZW5kZXJfY2xhc3NlcxgBIAMoCzI0LndlZXdhci52MS5DbGFzc0RhbWFnZU1vZGlmaWVycy5EZWZlbmRlckNsYXNzZXNFbnRyeRo2ChREZWZlbmRlckNsYXNzZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAE6AjgBIo4BCg5Nb3ZlbWVudE1hdHJpeBIzCgVjb3N0cxgBIAMoCzIkLndlZXdhci52MS5Nb3ZlbWVudE1hdHJpeC5Db3N0c0VudHJ5GkcKCkNvc3RzRW50cnkSCwoDa2V5GAEgASgFEigKBXZhbHVlGAIgASgLMhkud2Vld2FyLnYxLlRlcnJhaW5Db3N0TWFwOgI4ASKJAQoOVGVycmFpbkNvc3RNYXASQgoNdGVycmFpbl9jb3N0cxgBIAMoCzIrLndlZXdhci52MS5UZXJyYWluQ29zdE1hcC5UZXJyYWluQ29zdHNFbnRyeRozChFUZXJyYWluQ29zdHNFbnRyeRILCgNrZXkYASABKAUSDQoFdmFsdWUYAiABKAE6AjgBIpMBCgxBdHRhY2tNYXRyaXgSNQoHYXR0YWNrcxgBIAMoCzIkLndlZXdhci52MS5BdHRhY2tNYXRyaXguQXR0YWNrc0VudHJ5GkwKDEF0dGFja3NFbnRyeRILCgNrZXkYASABKAUSKwoFdmFsdWUYAiABKAsyHC53ZWV3YXIudjEuRGVmZW5kZXJEYW1hZ2VNYXA6AjgBIrcBChFEZWZlbmRlckRhbWFnZU1hcBJLChBkZWZlbmRlcl9kYW1hZ2VzGAEgAygLMjEud2Vld2FyLnYxLkRlZmVuZGVyRGFtYWdlTWFwLkRlZmVuZGVyRGFtYWdlc0VudHJ5GlUKFERlZmVuZGVyRGFtYWdlc0VudHJ5EgsKA2tleRgBIAEoBRIsCgV2YWx1ZRgCIAEoCzIdLndlZXdhci52MS5EYW1hZ2VEaXN0cmlidXRpb246AjgBIoYBChJEYW1hZ2VEaXN0cmlidXRpb24SEgoKbWluX2RhbWFnZRgBIAEoBRISCgptYXhfZGFtYWdlGAIgASgFEi8KDmRhbWFnZV9idWNrZXRzGAMgAygLMhcud2Vld2FyLnYxLkRhbWFnZUJ1Y2tldBIXCg9leHBlY3RlZF9kYW1hZ2UYBCABKAEiLgoMRGFtYWdlQnVja2V0Eg4KBmRhbWFnZRgBIAEoBRIOCgZ3ZWlnaHQYAiABKAEi/wMKB1J1bGVTZXQSLAoFdW5pdHMYASADKAsyHS53ZWV3YXIudjEuUnVsZVNldC5Vbml0c0VudHJ5EjIKCHRlcnJhaW5zGAIgAygLMiAud2Vld2FyLnYxLlJ1bGVTZXQuVGVycmFpbnNFbnRyeRIyCg9tb3ZlbWVudF9tYXRyaXgYAyABKAsyGS53ZWV3YXIudjEuTW92ZW1lbnRNYXRyaXgSLgoNYXR0YWNrX21hdHJpeBgEIAEoCzIXLndlZXdhci52MS5BdHRhY2tNYXRyaXgSMAoObW92ZW1lbnRfcnVsZXMYBSABKAsyGC53ZWV3YXIudjEuTW92ZW1lbnRSdWxlcxIsCgxjb21iYXRfcnVsZXMYBiABKAsyFi53ZWV3YXIudjEuQ29tYmF0UnVsZXMSNgoPdGVycmFpbl9hY3Rpb25zGAcgASgLMh0ud2Vld2FyLnYxLlRlcnJhaW5BY3Rpb25SdWxlcxpHCgpVbml0c0VudHJ5EgsKA2tleRgBIAEoBRIoCgV2YWx1ZRgCIAEoCzIZLndlZXdhci52MS5Vbml0RGVmaW5pdGlvbjoCOAEaTQoNVGVycmFpbnNFbnRyeRILCgNrZXkYASABKAUSKwoFdmFsdWUYAiABKAsyHC53ZWV3YXIudjEuVGVycmFpbkRlZmluaXRpb246AjgBIrACCgRHYW1lEi4KCmNyZWF0ZWRfYXQYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEgoKAmlkGAMgASgJEhIKCmNyZWF0b3JfaWQYBCABKAkSEAoId29ybGRfaWQYBSABKAkSDAoEbmFtZRgGIAEoCRITCgtkZXNjcmlwdGlvbhgHIAEoCRIMCgR0YWdzGAggAygJEhEKCWltYWdlX3VybBgJIAEoCRISCgpkaWZmaWN1bHR5GAogASgJEiwKBmNvbmZpZxgLIAEoCzIcLndlZXdhci52MS5HYW1lQ29uZmlndXJhdGlvbhIQCghydWxlc19pZBgMIAEoCSJmChFHYW1lQ29uZmlndXJhdGlvbhImCgdwbGF5ZXJzGAEgAygLMhUud2Vld2FyLnYxLkdhbWVQbGF5ZXISKQoIc2V0dGluZ3MYAiABKAsyFy53ZWV3YXIudjEuR2FtZVNldHRpbmdzIlQKCkdhbWVQbGF5ZXISEQoJcGxheWVyX2lkGAEgASgFEhMKC3BsYXllcl90eXBlGAIgASgJEg0KBWNvbG9yGAMgASgJEg8KB3RlYW1faWQYBCABKAUi/QEKDEdhbWVTZXR0aW5ncxIVCg1hbGxvd2VkX3VuaXRzGAEgAygFEhcKD3R1cm5fdGltZV9saW1pdBgCIAEoBRIRCgl0ZWFtX21vZGUYAyABKAkSEQoJbWF4X3R1cm5zGAQgASgFEiYKBWNvaW5zGAUgASgLMhcud2Vld2FyLnYxLkNvaW5TZXR0aW5ncxISCgpmb2dfb2Zfd2FyGAYgASgIEisKB3ZpY3RvcnkYByABKAsyGi53ZWV3YXIudjEuVmljdG9yeVNldHRpbmdzEi4KDXJ1bGVzX292ZXJsYXkYCCABKAsyFy53ZWV3YXIudjEuUnVsZXNPdmVybGF5IpoCCgxSdWxlc092ZXJsYXkSFgoOZGlzYWJsZWRfdW5pdHMYASADKAUSOgoKdW5pdF9jb2lucxgCIAMoCzImLndlZXdhci52MS5SdWxlc092ZXJsYXkuVW5pdENvaW5zRW50cnkSSgoSZGFtYWdlX211bHRpcGxpZXJzGAMgAygLMi4ud2Vld2FyLnYxLlJ1bGVzT3ZlcmxheS5EYW1hZ2VNdWx0aXBsaWVyc0VudHJ5GjAKDlVuaXRDb2luc0VudHJ5EgsKA2tleRgBIAEoBRINCgV2YWx1ZRgCIAEoBToCOAEaOAoWRGFtYWdlTXVsdGlwbGllcnNFbnRyeRILCgNrZXkYASABKAUSDQoFdmFsdWUYAiABKAE6AjgBInkKD1ZpY3RvcnlTZXR0aW5ncxITCgtlbGltaW5hdGlvbhgBIAEoCBIVCg1jYXB0dXJlX2Jhc2VzGAIgASgFEh4KFmhlYWRxdWFydGVyc190aWxlX3R5cGUYAyABKAUSGgoSc2NvcmVfYXRfbWF4X3R1cm5zGAQgASgIIo4BCg9WaWN0b3J5UHJvZ3Jlc3MSDgoGcGxheWVyGAEgASgFEjoKCHByb2dyZXNzGAIgAygLMigud2Vld2FyLnYxLlZpY3RvcnlQcm9ncmVzcy5Qcm9ncmVzc0VudHJ5Gi8KDVByb2dyZXNzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgBOgI4ASJJCgxDb2luU2V0dGluZ3MSFQoNc3RhcnRfb2ZfZ2FtZRgBIAEoBRIQCghwZXJfdHVybhgCIAEoBRIQCghwZXJfYmFzZRgDIAEoBSL8AwoJR2FtZVN0YXRlEi4KCnVwZGF0ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg8KB2dhbWVfaWQYAyABKAkSFAoMdHVybl9jb3VudGVyGAQgASgFEhYKDmN1cnJlbnRfcGxheWVyGAUgASgFEigKCndvcmxkX2RhdGEYBiABKAsyFC53ZWV3YXIudjEuV29ybGREYXRhEjsKDHBsYXllcl9jb2lucxgHIAMoCzIlLndlZXdhci52MS5HYW1lU3RhdGUuUGxheWVyQ29pbnNFbnRyeRIZChFsYXN0X3NlcXVlbmNlX251bRgIIAEoAxIQCghybmdfc2VlZBgJIAEoAxIUCgxybmdfcG9zaXRpb24YCiABKAMSMwoPdHVybl9zdGFydGVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIxCg10dXJuX2RlYWRsaW5lGAwgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIOCgZ3aW5uZXIYDSABKAUSGQoRdmljdG9yeV9jb25kaXRpb24YDiABKAkSDwoHd2lubmVycxgPIAMoBRoyChBQbGF5ZXJDb2luc0VudHJ5EgsKA2tleRgBIAEoBRINCgV2YWx1ZRgCIAEoBToCOAEieQoPR2FtZU1vdmVIaXN0b3J5Eg8KB2dhbWVfaWQYASABKAkSKAoGZ3JvdXBzGAIgAygLMhgud2Vld2FyLnYxLkdhbWVNb3ZlR3JvdXASKwoNaW5pdGlhbF9zdGF0ZRgDIAEoCzIULndlZXdhci52MS5HYW1lU3RhdGUiwgEKDUdhbWVNb3ZlR3JvdXASLgoKc3RhcnRlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiIKBW1vdmVzGAQgAygLMhMud2Vld2FyLnYxLkdhbWVNb3ZlEi8KDG1vdmVfcmVzdWx0cxgFIAMoCzIZLndlZXdhci52MS5HYW1lTW92ZVJlc3VsdCK8BAoIR2FtZU1vdmUSDgoGcGxheWVyGAEgASgFEi0KCXRpbWVzdGFtcBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMc2VxdWVuY2VfbnVtGAMgASgDEi4KCW1vdmVfdW5pdBgEIAEoCzIZLndlZXdhci52MS5Nb3ZlVW5pdEFjdGlvbkgAEjIKC2F0dGFja191bml0GAUgASgLMhsud2Vld2FyLnYxLkF0dGFja1VuaXRBY3Rpb25IABIsCghlbmRfdHVybhgGIAEoCzIYLndlZXdhci52MS5FbmRUdXJuQWN0aW9uSAASMAoKYnVpbGRfdW5pdBgHIAEoCzIaLndlZXdhci52MS5CdWlsZFVuaXRBY3Rpb25IABI8ChBjYXB0dXJlX2J1aWxkaW5nGAggASgLMiAud2Vld2FyLnYxLkNhcHR1cmVCdWlsZGluZ0FjdGlvbkgAEi4KCWxvYWRfdW5pdBgJIAEoCzIZLndlZXdhci52MS5Mb2FkVW5pdEFjdGlvbkgAEjIKC3VubG9hZF91bml0GAogASgLMhsud2Vld2FyLnYxLlVubG9hZFVuaXRBY3Rpb25IABIuCgloZWFsX3VuaXQYCyABKAsyGS53ZWV3YXIudjEuSGVhbFVuaXRBY3Rpb25IABI4Cg5tb2RpZnlfdGVycmFpbhgMIAEoCzIeLndlZXdhci52MS5Nb2RpZnlUZXJyYWluQWN0aW9uSABCCwoJbW92ZV90eXBlImUKDkdhbWVNb3ZlUmVzdWx0EhQKDGlzX3Blcm1hbmVudBgBIAEoCBIUCgxzZXF1ZW5jZV9udW0YAiABKAMSJwoHY2hhbmdlcxgDIAMoCzIWLndlZXdhci52MS5Xb3JsZENoYW5nZSIgCghIZXhDb29yZBIJCgFxGAEgASgFEgkKAXIYAiABKAUibwoOTW92ZVVuaXRBY3Rpb24SDgoGZnJvbV9xGAEgASgFEg4KBmZyb21fchgCIAEoBRIMCgR0b19xGAMgASgFEgwKBHRvX3IYBCABKAUSIQoEcGF0aBgFIAMoCzITLndlZXdhci52MS5IZXhDb29yZCJiChBBdHRhY2tVbml0QWN0aW9uEhIKCmF0dGFja2VyX3EYASABKAUSEgoKYXR0YWNrZXJfchgCIAEoBRISCgpkZWZlbmRlcl9xGAMgASgFEhIKCmRlZmVuZGVyX3IYBCABKAUiIgoNRW5kVHVybkFjdGlvbhIRCgl0aW1lZF9vdXQYASABKAgiOgoPQnVpbGRVbml0QWN0aW9uEgkKAXEYASABKAUSCQoBchgCIAEoBRIRCgl1bml0X3R5cGUYAyABKAUiLQoVQ2FwdHVyZUJ1aWxkaW5nQWN0aW9uEgkKAXEYASABKAUSCQoBchgCIAEoBSJnChNNb2RpZnlUZXJyYWluQWN0aW9uEgkKAXEYASABKAUSCQoBchgCIAEoBRIQCgh0YXJnZXRfcRgDIAEoBRIQCgh0YXJnZXRfchgEIAEoBRIWCg50ZXJyYWluX2FjdGlvbhgFIAEoCSJaCg5Mb2FkVW5pdEFjdGlvbhIOCgZ1bml0X3EYASABKAUSDgoGdW5pdF9yGAIgASgFEhMKC3RyYW5zcG9ydF9xGAMgASgFEhMKC3RyYW5zcG9ydF9yGAQgASgFIm0KEFVubG9hZFVuaXRBY3Rpb24SEwoLdHJhbnNwb3J0X3EYASABKAUSEwoLdHJhbnNwb3J0X3IYAiABKAUSEwoLY2FyZ29faW5kZXgYAyABKAUSDAoEdG9fcRgEIAEoBRIMCgR0b19yGAUgASgFIlgKDkhlYWxVbml0QWN0aW9uEhAKCGhlYWxlcl9xGAEgASgFEhAKCGhlYWxlcl9yGAIgASgFEhAKCHRhcmdldF9xGAMgASgFEhAKCHRhcmdldF9yGAQgASgFIu4ECgtXb3JsZENoYW5nZRIwCgp1bml0X21vdmVkGAEgASgLMhoud2Vld2FyLnYxLlVuaXRNb3ZlZENoYW5nZUgAEjQKDHVuaXRfZGFtYWdlZBgCIAEoCzIcLndlZXdhci52MS5Vbml0RGFtYWdlZENoYW5nZUgAEjIKC3VuaXRfa2lsbGVkGAMgASgLMhsud2Vld2FyLnYxLlVuaXRLaWxsZWRDaGFuZ2VIABI4Cg5wbGF5ZXJfY2hhbmdlZBgEIAEoCzIeLndlZXdhci52MS5QbGF5ZXJDaGFuZ2VkQ2hhbmdlSAASNgoNY29pbnNfY2hhbmdlZBgFIAEoCzIdLndlZXdhci52MS5Db2luc0NoYW5nZWRDaGFuZ2VIABI0Cgx1bml0X2NyZWF0ZWQYBiABKAsyHC53ZWV3YXIudjEuVW5pdENyZWF0ZWRDaGFuZ2VIABI2Cg10aWxlX2NhcHR1cmVkGAcgASgLMh0ud2Vld2FyLnYxLlRpbGVDYXB0dXJlZENoYW5nZUgAEjIKC3VuaXRfbG9hZGVkGAggASgLMhsud2Vld2FyLnYxLlVuaXRMb2FkZWRDaGFuZ2VIABI2Cg11bml0X3VubG9hZGVkGAkgASgLMh0ud2Vld2FyLnYxLlVuaXRVbmxvYWRlZENoYW5nZUgAEjIKC3VuaXRfaGVhbGVkGAogASgLMhsud2Vld2FyLnYxLlVuaXRIZWFsZWRDaGFuZ2VIABI0Cgx0aWxlX2NoYW5nZWQYCyABKAsyHC53ZWV3YXIudjEuVGlsZUNoYW5nZWRDaGFuZ2VIAEINCgtjaGFuZ2VfdHlwZSJgCg9Vbml0TW92ZWRDaGFuZ2USJgoNcHJldmlvdXNfdW5pdBgGIAEoCzIPLndlZXdhci52MS5Vbml0EiUKDHVwZGF0ZWRfdW5pdBgHIAEoCzIPLndlZXdhci52MS5Vbml0IrkBChFVbml0RGFtYWdlZENoYW5nZRImCg1wcmV2aW91c191bml0GAYgASgLMg8ud2Vld2FyLnYxLlVuaXQSJQoMdXBkYXRlZF91bml0GAcgASgLMg8ud2Vld2FyLnYxLlVuaXQSKgoRcHJldmlvdXNfYXR0YWNrZXIYCCABKAsyDy53ZWV3YXIudjEuVW5pdBIpChB1cGRhdGVkX2F0dGFja2VyGAkgASgLMg8ud2Vld2FyLnYxLlVuaXQitAEKEFVuaXRIZWFsZWRDaGFuZ2USJgoNcHJldmlvdXNfdW5pdBgBIAEoCzIPLndlZXdhci52MS5Vbml0EiUKDHVwZGF0ZWRfdW5pdBgCIAEoCzIPLndlZXdhci52MS5Vbml0EigKD3ByZXZpb3VzX2hlYWxlchgDIAEoCzIPLndlZXdhci52MS5Vbml0EicKDnVwZGF0ZWRfaGVhbGVyGAQgASgLMg8ud2Vld2FyLnYxLlVuaXQiOgoQVW5pdEtpbGxlZENoYW5nZRImCg1wcmV2aW91c191bml0GAYgASgLMg8ud2Vld2FyLnYxLlVuaXQikQEKE1BsYXllckNoYW5nZWRDaGFuZ2USFwoPcHJldmlvdXNfcGxheWVyGAEgASgFEhIKCm5ld19wbGF5ZXIYAiABKAUSFQoNcHJldmlvdXNfdHVybhgDIAEoBRIQCghuZXdfdHVybhgEIAEoBRIkCgtyZXNldF91bml0cxgFIAMoCzIPLndlZXdhci52MS5Vbml0Ik8KEkNvaW5zQ2hhbmdlZENoYW5nZRIOCgZwbGF5ZXIYASABKAUSFgoOcHJldmlvdXNfY29pbnMYAiABKAUSEQoJbmV3X2NvaW5zGAMgASgFIjIKEVVuaXRDcmVhdGVkQ2hhbmdlEh0KBHVuaXQYASABKAsyDy53ZWV3YXIudjEuVW5pdCKyAQoSVGlsZUNhcHR1cmVkQ2hhbmdlEiYKDXByZXZpb3VzX3RpbGUYASABKAsyDy53ZWV3YXIudjEuVGlsZRIlCgx1cGRhdGVkX3RpbGUYAiABKAsyDy53ZWV3YXIudjEuVGlsZRImCg1wcmV2aW91c191bml0GAMgASgLMg8ud2Vld2FyLnYxLlVuaXQSJQoMdXBkYXRlZF91bml0GAQgASgLMg8ud2Vld2FyLnYxLlVuaXQisQEKEVRpbGVDaGFuZ2VkQ2hhbmdlEiYKDXByZXZpb3VzX3RpbGUYASABKAsyDy53ZWV3YXIudjEuVGlsZRIlCgx1cGRhdGVkX3RpbGUYAiABKAsyDy53ZWV3YXIudjEuVGlsZRImCg1wcmV2aW91c191bml0GAMgASgLMg8ud2Vld2FyLnYxLlVuaXQSJQoMdXBkYXRlZF91bml0GAQgASgLMg8ud2Vld2FyLnYxLlVuaXQiigEKEFVuaXRMb2FkZWRDaGFuZ2USHQoEdW5pdBgBIAEoCzIPLndlZXdhci52MS5Vbml0EisKEnByZXZpb3VzX3RyYW5zcG9ydBgCIAEoCzIPLndlZXdhci52MS5Vbml0EioKEXVwZGF0ZWRfdHJhbnNwb3J0GAMgASgLMg8ud2Vld2FyLnYxLlVuaXQijAEKElVuaXRVbmxvYWRlZENoYW5nZRIdCgR1bml0GAEgASgLMg8ud2Vld2FyLnYxLlVuaXQSKwoScHJldmlvdXNfdHJhbnNwb3J0GAIgASgLMg8ud2Vld2FyLnYxLlVuaXQSKgoRdXBkYXRlZF90cmFuc3BvcnQYAyABKAsyDy53ZWV3YXIudjEuVW5pdEKdAQoNY29tLndlZXdhci52MUILTW9kZWxzUHJvdG9QAVo6Z2l0aHViLmNvbS9wYW55YW0vdHVybmVuZ2luZS9nYW1lcy93ZWV3YXIvZ2VuL2dvL3dlZXdhci92MaICA1dYWKoCCVdlZXdhci5WMcoCCVdlZXdhclxWMeICFVdlZXdhclxWMVxHUEJNZXRhZGF0YeoCCldlZXdhcjo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
 
 /**
  * @generated from message weewar.v1.User
@@ -296,6 +296,34 @@ export type Tile = Message<"weewar.v1.Tile"> & {
    * @generated from field: int32 player = 4;
    */
   player: number;
+
+  /**
+   * Player currently capturing this tile (0 = not being captured)
+   *
+   * @generated from field: int32 capture_player = 5;
+   */
+  capturePlayer: number;
+
+  /**
+   * Number of turns of capture progress made by the capturing player
+   *
+   * @generated from field: int32 capture_progress = 6;
+   */
+  captureProgress: number;
+
+  /**
+   * Terrain action being worked on this tile ("" = none)
+   *
+   * @generated from field: string terrain_action = 7;
+   */
+  terrainAction: string;
+
+  /**
+   * Number of turns of work done on the terrain action
+   *
+   * @generated from field: int32 terrain_action_progress = 8;
+   */
+  terrainActionProgress: number;
 };
 
 /**
@@ -353,6 +381,36 @@ export type Unit = Message<"weewar.v1.Unit"> & {
    * @generated from field: int32 turn_counter = 7;
    */
   turnCounter: number;
+
+  /**
+   * Whether the unit has moved this turn
+   *
+   * @generated from field: bool has_moved = 8;
+   */
+  hasMoved: boolean;
+
+  /**
+   * Whether the unit has attacked this turn
+   *
+   * @generated from field: bool has_attacked = 9;
+   */
+  hasAttacked: boolean;
+
+  /**
+   * Attacks/actions the unit can still take this turn
+   *
+   * @generated from field: int32 actions_remaining = 10;
+   */
+  actionsRemaining: number;
+
+  /**
+   * Units being carried by this unit if it is a transport.  Carried units are not on
+   * the map (their coordinates are only meaningful once unloaded) and are destroyed
+   * along with their transport.
+   *
+   * @generated from field: repeated weewar.v1.Unit cargo = 11;
+   */
+  cargo: Unit[];
 };
 
 /**
@@ -409,6 +467,41 @@ export type TerrainDefinition = Message<"weewar.v1.TerrainDefinition"> & {
    * @generated from field: string description = 6;
    */
   description: string;
+
+  /**
+   * Unit type IDs that can be built on this terrain
+   *
+   * @generated from field: repeated int32 buildable_units = 7;
+   */
+  buildableUnits: number[];
+
+  /**
+   * Turns needed to capture this terrain (0 = cannot be captured)
+   *
+   * @generated from field: int32 capture_turns = 8;
+   */
+  captureTurns: number;
+
+  /**
+   * Health restored each turn to units standing on their owner's tile (0 = no repair)
+   *
+   * @generated from field: int32 repair_amount = 9;
+   */
+  repairAmount: number;
+
+  /**
+   * Unit classes repaired here (empty = all)
+   *
+   * @generated from field: repeated string repair_classes = 10;
+   */
+  repairClasses: string[];
+
+  /**
+   * Fraction of the unit's coins charged to repair its full health (0 = free)
+   *
+   * @generated from field: double repair_cost = 11;
+   */
+  repairCost: number;
 };
 
 /**
@@ -465,301 +558,926 @@ export type UnitDefinition = Message<"weewar.v1.UnitDefinition"> & {
    * @generated from field: repeated string properties = 6;
    */
   properties: string[];
-};
-
-/**
- * Describes the message weewar.v1.UnitDefinition.
- * Use `create(UnitDefinitionSchema)` to create a new message.
- */
-export const UnitDefinitionSchema: GenMessage<UnitDefinition> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_models, 8);
 
-/**
- * Movement cost matrix for unit types on terrain types
- *
- * @generated from message weewar.v1.MovementMatrix
- */
-export type MovementMatrix = Message<"weewar.v1.MovementMatrix"> & {
   /**
-   * Map of unit_id -> (terrain_id -> movement_cost)
+   * Cost in coins to build this unit
    *
-   * @generated from field: map<int32, weewar.v1.TerrainCostMap> costs = 1;
+   * @generated from field: int32 coins = 7;
    */
-  costs: { [key: number]: TerrainCostMap };
-};
-
-/**
- * Describes the message weewar.v1.MovementMatrix.
- * Use `create(MovementMatrixSchema)` to create a new message.
- */
-export const MovementMatrixSchema: GenMessage<MovementMatrix> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_models, 9);
+  coins: number;
 
-/**
- * @generated from message weewar.v1.TerrainCostMap
- */
-export type TerrainCostMap = Message<"weewar.v1.TerrainCostMap"> & {
   /**
-   * Map of terrain_id -> movement_cost  
+   * Whether this unit can capture buildings
    *
-   * @generated from field: map<int32, double> terrain_costs = 1;
-   */
-  terrainCosts: { [key: number]: number };
-};
-
-/**
- * Describes the message weewar.v1.TerrainCostMap.
- * Use `create(TerrainCostMapSchema)` to create a new message.
- */
-export const TerrainCostMapSchema: GenMessage<TerrainCostMap> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_models, 10);
-
-/**
- * Describes a game and its metadata
- *
- * @generated from message weewar.v1.Game
- */
-export type Game = Message<"weewar.v1.Game"> & {
-  /**
-   * @generated from field: google.protobuf.Timestamp created_at = 1;
-   */
-  createdAt?: Timestamp;
-
-  /**
-   * @generated from field: google.protobuf.Timestamp updated_at = 2;
+   * @generated from field: bool can_capture = 8;
    */
-  updatedAt?: Timestamp;
+  canCapture: boolean;
 
   /**
-   * Unique ID for the game
+   * How far this unit can see (used for fog of war)
    *
-   * @generated from field: string id = 3;
+   * @generated from field: int32 sight_range = 9;
    */
-  id: string;
+  sightRange: number;
 
   /**
-   * User who started/created the game
+   * Movement class ("land", "naval" or "air") used by the movement rules
    *
-   * @generated from field: string creator_id = 4;
+   * @generated from field: string unit_class = 10;
    */
-  creatorId: string;
+  unitClass: string;
 
   /**
-   * The world this game was created from
+   * Whether the unit can use its remaining movement after attacking
    *
-   * @generated from field: string world_id = 5;
+   * @generated from field: bool can_move_after_attack = 11;
    */
-  worldId: string;
+  canMoveAfterAttack: boolean;
 
   /**
-   * Name if items have names
+   * Attacks/actions the unit can take each turn (0 = 1)
    *
-   * @generated from field: string name = 6;
+   * @generated from field: int32 actions_per_turn = 12;
    */
-  name: string;
+  actionsPerTurn: number;
 
   /**
-   * Description if game has a description
+   * Closest distance the unit can attack at (0 = 1, adjacent units)
    *
-   * @generated from field: string description = 7;
+   * @generated from field: int32 min_attack_range = 13;
    */
-  description: string;
+  minAttackRange: number;
 
   /**
-   * Some tags
+   * Whether the unit's attacks are ranged fire that cannot be countered
    *
-   * @generated from field: repeated string tags = 8;
+   * @generated from field: bool indirect_fire = 14;
    */
-  tags: string[];
+  indirectFire: boolean;
 
   /**
-   * A possible image url
+   * Units it can carry if it has the "transport" property (0 = 1)
    *
-   * @generated from field: string image_url = 9;
+   * @generated from field: int32 transport_capacity = 15;
    */
-  imageUrl: string;
+  transportCapacity: number;
 
   /**
-   * Difficulty - example attribute
+   * Unit classes a transport can carry (empty = any)
    *
-   * @generated from field: string difficulty = 10;
+   * @generated from field: repeated string cargo_classes = 16;
    */
-  difficulty: string;
+  cargoClasses: string[];
 
   /**
-   * Game configuration
+   * Health restored to an adjacent friendly unit as an action (0 = cannot heal)
    *
-   * @generated from field: weewar.v1.GameConfiguration config = 11;
+   * @generated from field: int32 heal_amount = 17;
    */
-  config?: GameConfiguration;
+  healAmount: number;
 };
 
 /**
- * Describes the message weewar.v1.Game.
- * Use `create(GameSchema)` to create a new message.
+ * Describes the message weewar.v1.UnitDefinition.
+ * Use `create(UnitDefinitionSchema)` to create a new message.
  */
-export const GameSchema: GenMessage<Game> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_models, 11);
+export const UnitDefinitionSchema: GenMessage<UnitDefinition> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 8);
 
 /**
- * @generated from message weewar.v1.GameConfiguration
+ * Rules that constrain how units move around other units
+ *
+ * @generated from message weewar.v1.MovementRules
  */
-export type GameConfiguration = Message<"weewar.v1.GameConfiguration"> & {
+export type MovementRules = Message<"weewar.v1.MovementRules"> & {
   /**
-   * Player configuration
+   * Whether units can move through hexes held by their own and allied units.
+   * Units can never end a move on another unit.
    *
-   * @generated from field: repeated weewar.v1.GamePlayer players = 1;
+   * @generated from field: bool pass_through_allies = 1;
    */
-  players: GamePlayer[];
+  passThroughAllies: boolean;
 
   /**
-   * Game settings
+   * Unit classes that exert a zone of control.  An enemy unit entering a hex
+   * next to one of these units must stop there.
    *
-   * @generated from field: weewar.v1.GameSettings settings = 2;
+   * @generated from field: repeated string zoc_unit_classes = 2;
    */
-  settings?: GameSettings;
+  zocUnitClasses: string[];
+
+  /**
+   * Unit classes that ignore zones of control (eg "air")
+   *
+   * @generated from field: repeated string zoc_immune_classes = 3;
+   */
+  zocImmuneClasses: string[];
 };
 
 /**
- * Describes the message weewar.v1.GameConfiguration.
- * Use `create(GameConfigurationSchema)` to create a new message.
+ * Describes the message weewar.v1.MovementRules.
+ * Use `create(MovementRulesSchema)` to create a new message.
  */
-export const GameConfigurationSchema: GenMessage<GameConfiguration> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_models, 12);
+export const MovementRulesSchema: GenMessage<MovementRules> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 9);
 
 /**
- * @generated from message weewar.v1.GamePlayer
+ * Rules that adjust the damage units deal in combat beyond the attack matrix.  An
+ * attacker's damage is always scaled by its remaining health and reduced by the
+ * defense bonus of the defender's terrain.
+ *
+ * @generated from message weewar.v1.CombatRules
  */
-export type GamePlayer = Message<"weewar.v1.GamePlayer"> & {
-  /**
-   * Player ID (1-based)
-   *
-   * @generated from field: int32 player_id = 1;
-   */
-  playerId: number;
-
-  /**
-   * Player type
-   *
-   * "human", "ai", "open"
-   *
-   * @generated from field: string player_type = 2;
-   */
-  playerType: string;
-
+export type CombatRules = Message<"weewar.v1.CombatRules"> & {
   /**
-   * Player color
+   * Damage multipliers keyed by attacker unit class.  Missing entries leave the
+   * damage unchanged.
    *
-   * @generated from field: string color = 3;
+   * @generated from field: map<string, weewar.v1.ClassDamageModifiers> class_modifiers = 1;
    */
-  color: string;
+  classModifiers: { [key: string]: ClassDamageModifiers };
 
   /**
-   * Team ID (0 = no team, 1+ = team number)
+   * Defender unit classes that get no defense bonus from terrain (eg "air")
    *
-   * @generated from field: int32 team_id = 4;
+   * @generated from field: repeated string terrain_defense_ignored_classes = 2;
    */
-  teamId: number;
+  terrainDefenseIgnoredClasses: string[];
 };
 
 /**
- * Describes the message weewar.v1.GamePlayer.
- * Use `create(GamePlayerSchema)` to create a new message.
+ * Describes the message weewar.v1.CombatRules.
+ * Use `create(CombatRulesSchema)` to create a new message.
  */
-export const GamePlayerSchema: GenMessage<GamePlayer> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_models, 13);
+export const CombatRulesSchema: GenMessage<CombatRules> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 10);
 
 /**
- * @generated from message weewar.v1.GameSettings
+ * Actions units can take to change terrain during play (eg building bridges or laying roads)
+ *
+ * @generated from message weewar.v1.TerrainActionRules
  */
-export type GameSettings = Message<"weewar.v1.GameSettings"> & {
-  /**
-   * List of allowed unit type IDs
-   *
-   * @generated from field: repeated int32 allowed_units = 1;
-   */
-  allowedUnits: number[];
-
-  /**
-   * Turn time limit in seconds (0 = no limit)
-   *
-   * @generated from field: int32 turn_time_limit = 2;
-   */
-  turnTimeLimit: number;
-
+export type TerrainActionRules = Message<"weewar.v1.TerrainActionRules"> & {
   /**
-   * Team mode
-   *
-   * "ffa" or "teams"
-   *
-   * @generated from field: string team_mode = 3;
-   */
-  teamMode: string;
-
-  /**
-   * Maximum number of turns (0 = unlimited)
+   * Terrain actions keyed by their ID
    *
-   * @generated from field: int32 max_turns = 4;
+   * @generated from field: map<string, weewar.v1.TerrainAction> actions = 1;
    */
-  maxTurns: number;
+  actions: { [key: string]: TerrainAction };
 };
 
 /**
- * Describes the message weewar.v1.GameSettings.
- * Use `create(GameSettingsSchema)` to create a new message.
+ * Describes the message weewar.v1.TerrainActionRules.
+ * Use `create(TerrainActionRulesSchema)` to create a new message.
  */
-export const GameSettingsSchema: GenMessage<GameSettings> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_models, 14);
+export const TerrainActionRulesSchema: GenMessage<TerrainActionRules> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 11);
 
 /**
- * Holds the game's Active/Current state (eg world state)
+ * A change units with the right property can make to a tile over one or more turns
  *
- * @generated from message weewar.v1.GameState
+ * @generated from message weewar.v1.TerrainAction
  */
-export type GameState = Message<"weewar.v1.GameState"> & {
+export type TerrainAction = Message<"weewar.v1.TerrainAction"> & {
   /**
-   * @generated from field: google.protobuf.Timestamp updated_at = 2;
+   * @generated from field: string id = 1;
    */
-  updatedAt?: Timestamp;
+  id: string;
 
   /**
-   * ID of the game whos state is being tracked
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * Terrain the action can be used on -> terrain it turns into
    *
-   * @generated from field: string game_id = 3;
+   * @generated from field: map<int32, int32> terrain_changes = 3;
    */
-  gameId: string;
+  terrainChanges: { [key: number]: number };
 
   /**
-   * @generated from field: int32 turn_counter = 4;
+   * Turns of work needed to complete the change (0 = 1)
+   *
+   * @generated from field: int32 turns = 4;
    */
-  turnCounter: number;
+  turns: number;
 
   /**
-   * @generated from field: int32 current_player = 5;
+   * Coins paid when work on a tile starts (0 = free)
+   *
+   * @generated from field: int32 coins = 5;
    */
-  currentPlayer: number;
+  coins: number;
 
   /**
-   * Current world state
+   * Unit property needed to perform the action (eg "engineer")
    *
-   * @generated from field: weewar.v1.WorldData world_data = 6;
+   * @generated from field: string unit_property = 6;
    */
-  worldData?: WorldData;
+  unitProperty: string;
 };
 
 /**
- * Describes the message weewar.v1.GameState.
- * Use `create(GameStateSchema)` to create a new message.
+ * Describes the message weewar.v1.TerrainAction.
+ * Use `create(TerrainActionSchema)` to create a new message.
  */
-export const GameStateSchema: GenMessage<GameState> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_models, 15);
+export const TerrainActionSchema: GenMessage<TerrainAction> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 12);
 
 /**
- * Holds the game's move history (can be used as a replay log)
+ * Damage multipliers an attacker class applies to each defender unit class
  *
- * @generated from message weewar.v1.GameMoveHistory
+ * @generated from message weewar.v1.ClassDamageModifiers
  */
-export type GameMoveHistory = Message<"weewar.v1.GameMoveHistory"> & {
+export type ClassDamageModifiers = Message<"weewar.v1.ClassDamageModifiers"> & {
+  /**
+   * @generated from field: map<string, double> defender_classes = 1;
+   */
+  defenderClasses: { [key: string]: number };
+};
+
+/**
+ * Describes the message weewar.v1.ClassDamageModifiers.
+ * Use `create(ClassDamageModifiersSchema)` to create a new message.
+ */
+export const ClassDamageModifiersSchema: GenMessage<ClassDamageModifiers> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 13);
+
+/**
+ * Movement cost matrix for unit types on terrain types
+ *
+ * @generated from message weewar.v1.MovementMatrix
+ */
+export type MovementMatrix = Message<"weewar.v1.MovementMatrix"> & {
+  /**
+   * Map of unit_id -> (terrain_id -> movement_cost)
+   *
+   * @generated from field: map<int32, weewar.v1.TerrainCostMap> costs = 1;
+   */
+  costs: { [key: number]: TerrainCostMap };
+};
+
+/**
+ * Describes the message weewar.v1.MovementMatrix.
+ * Use `create(MovementMatrixSchema)` to create a new message.
+ */
+export const MovementMatrixSchema: GenMessage<MovementMatrix> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 14);
+
+/**
+ * @generated from message weewar.v1.TerrainCostMap
+ */
+export type TerrainCostMap = Message<"weewar.v1.TerrainCostMap"> & {
+  /**
+   * Map of terrain_id -> movement_cost  
+   *
+   * @generated from field: map<int32, double> terrain_costs = 1;
+   */
+  terrainCosts: { [key: number]: number };
+};
+
+/**
+ * Describes the message weewar.v1.TerrainCostMap.
+ * Use `create(TerrainCostMapSchema)` to create a new message.
+ */
+export const TerrainCostMapSchema: GenMessage<TerrainCostMap> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 15);
+
+/**
+ * Attack matrix of the damage each unit type deals to each other unit type
+ *
+ * @generated from message weewar.v1.AttackMatrix
+ */
+export type AttackMatrix = Message<"weewar.v1.AttackMatrix"> & {
+  /**
+   * Map of attacker unit_id -> (defender unit_id -> damage distribution)
+   *
+   * @generated from field: map<int32, weewar.v1.DefenderDamageMap> attacks = 1;
+   */
+  attacks: { [key: number]: DefenderDamageMap };
+};
+
+/**
+ * Describes the message weewar.v1.AttackMatrix.
+ * Use `create(AttackMatrixSchema)` to create a new message.
+ */
+export const AttackMatrixSchema: GenMessage<AttackMatrix> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 16);
+
+/**
+ * @generated from message weewar.v1.DefenderDamageMap
+ */
+export type DefenderDamageMap = Message<"weewar.v1.DefenderDamageMap"> & {
+  /**
+   * Map of defender unit_id -> damage distribution.  Missing defenders cannot be attacked.
+   *
+   * @generated from field: map<int32, weewar.v1.DamageDistribution> defender_damages = 1;
+   */
+  defenderDamages: { [key: number]: DamageDistribution };
+};
+
+/**
+ * Describes the message weewar.v1.DefenderDamageMap.
+ * Use `create(DefenderDamageMapSchema)` to create a new message.
+ */
+export const DefenderDamageMapSchema: GenMessage<DefenderDamageMap> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 17);
+
+/**
+ * Distribution of the damage an attack deals
+ *
+ * @generated from message weewar.v1.DamageDistribution
+ */
+export type DamageDistribution = Message<"weewar.v1.DamageDistribution"> & {
+  /**
+   * @generated from field: int32 min_damage = 1;
+   */
+  minDamage: number;
+
+  /**
+   * @generated from field: int32 max_damage = 2;
+   */
+  maxDamage: number;
+
+  /**
+   * @generated from field: repeated weewar.v1.DamageBucket damage_buckets = 3;
+   */
+  damageBuckets: DamageBucket[];
+
+  /**
+   * @generated from field: double expected_damage = 4;
+   */
+  expectedDamage: number;
+};
+
+/**
+ * Describes the message weewar.v1.DamageDistribution.
+ * Use `create(DamageDistributionSchema)` to create a new message.
+ */
+export const DamageDistributionSchema: GenMessage<DamageDistribution> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 18);
+
+/**
+ * A damage value and the weight of it being rolled
+ *
+ * @generated from message weewar.v1.DamageBucket
+ */
+export type DamageBucket = Message<"weewar.v1.DamageBucket"> & {
+  /**
+   * @generated from field: int32 damage = 1;
+   */
+  damage: number;
+
+  /**
+   * @generated from field: double weight = 2;
+   */
+  weight: number;
+};
+
+/**
+ * Describes the message weewar.v1.DamageBucket.
+ * Use `create(DamageBucketSchema)` to create a new message.
+ */
+export const DamageBucketSchema: GenMessage<DamageBucket> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 19);
+
+/**
+ * A complete rule set as loaded by the rules engine
+ *
+ * @generated from message weewar.v1.RuleSet
+ */
+export type RuleSet = Message<"weewar.v1.RuleSet"> & {
+  /**
+   * @generated from field: map<int32, weewar.v1.UnitDefinition> units = 1;
+   */
+  units: { [key: number]: UnitDefinition };
+
+  /**
+   * @generated from field: map<int32, weewar.v1.TerrainDefinition> terrains = 2;
+   */
+  terrains: { [key: number]: TerrainDefinition };
+
+  /**
+   * @generated from field: weewar.v1.MovementMatrix movement_matrix = 3;
+   */
+  movementMatrix?: MovementMatrix;
+
+  /**
+   * @generated from field: weewar.v1.AttackMatrix attack_matrix = 4;
+   */
+  attackMatrix?: AttackMatrix;
+
+  /**
+   * @generated from field: weewar.v1.MovementRules movement_rules = 5;
+   */
+  movementRules?: MovementRules;
+
+  /**
+   * @generated from field: weewar.v1.CombatRules combat_rules = 6;
+   */
+  combatRules?: CombatRules;
+
+  /**
+   * @generated from field: weewar.v1.TerrainActionRules terrain_actions = 7;
+   */
+  terrainActions?: TerrainActionRules;
+};
+
+/**
+ * Describes the message weewar.v1.RuleSet.
+ * Use `create(RuleSetSchema)` to create a new message.
+ */
+export const RuleSetSchema: GenMessage<RuleSet> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 20);
+
+/**
+ * Describes a game and its metadata
+ *
+ * @generated from message weewar.v1.Game
+ */
+export type Game = Message<"weewar.v1.Game"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 1;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 2;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * Unique ID for the game
+   *
+   * @generated from field: string id = 3;
+   */
+  id: string;
+
+  /**
+   * User who started/created the game
+   *
+   * @generated from field: string creator_id = 4;
+   */
+  creatorId: string;
+
+  /**
+   * The world this game was created from
+   *
+   * @generated from field: string world_id = 5;
+   */
+  worldId: string;
+
+  /**
+   * Name if items have names
+   *
+   * @generated from field: string name = 6;
+   */
+  name: string;
+
+  /**
+   * Description if game has a description
+   *
+   * @generated from field: string description = 7;
+   */
+  description: string;
+
+  /**
+   * Some tags
+   *
+   * @generated from field: repeated string tags = 8;
+   */
+  tags: string[];
+
+  /**
+   * A possible image url
+   *
+   * @generated from field: string image_url = 9;
+   */
+  imageUrl: string;
+
+  /**
+   * Difficulty - example attribute
+   *
+   * @generated from field: string difficulty = 10;
+   */
+  difficulty: string;
+
+  /**
+   * Game configuration
+   *
+   * @generated from field: weewar.v1.GameConfiguration config = 11;
+   */
+  config?: GameConfiguration;
+
+  /**
+   * ID of the rule set the game is played under ("" = the default rules, for games
+   * created before rules were versioned)
+   *
+   * @generated from field: string rules_id = 12;
+   */
+  rulesId: string;
+};
+
+/**
+ * Describes the message weewar.v1.Game.
+ * Use `create(GameSchema)` to create a new message.
+ */
+export const GameSchema: GenMessage<Game> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 21);
+
+/**
+ * @generated from message weewar.v1.GameConfiguration
+ */
+export type GameConfiguration = Message<"weewar.v1.GameConfiguration"> & {
+  /**
+   * Player configuration
+   *
+   * @generated from field: repeated weewar.v1.GamePlayer players = 1;
+   */
+  players: GamePlayer[];
+
+  /**
+   * Game settings
+   *
+   * @generated from field: weewar.v1.GameSettings settings = 2;
+   */
+  settings?: GameSettings;
+};
+
+/**
+ * Describes the message weewar.v1.GameConfiguration.
+ * Use `create(GameConfigurationSchema)` to create a new message.
+ */
+export const GameConfigurationSchema: GenMessage<GameConfiguration> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 22);
+
+/**
+ * @generated from message weewar.v1.GamePlayer
+ */
+export type GamePlayer = Message<"weewar.v1.GamePlayer"> & {
+  /**
+   * Player ID (1-based)
+   *
+   * @generated from field: int32 player_id = 1;
+   */
+  playerId: number;
+
+  /**
+   * Player type
+   *
+   * "human", "ai", "open"
+   *
+   * @generated from field: string player_type = 2;
+   */
+  playerType: string;
+
+  /**
+   * Player color
+   *
+   * @generated from field: string color = 3;
+   */
+  color: string;
+
+  /**
+   * Team ID (0 = no team, 1+ = team number)
+   *
+   * @generated from field: int32 team_id = 4;
+   */
+  teamId: number;
+};
+
+/**
+ * Describes the message weewar.v1.GamePlayer.
+ * Use `create(GamePlayerSchema)` to create a new message.
+ */
+export const GamePlayerSchema: GenMessage<GamePlayer> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 23);
+
+/**
+ * @generated from message weewar.v1.GameSettings
+ */
+export type GameSettings = Message<"weewar.v1.GameSettings"> & {
+  /**
+   * List of allowed unit type IDs
+   *
+   * @generated from field: repeated int32 allowed_units = 1;
+   */
+  allowedUnits: number[];
+
+  /**
+   * Turn time limit in seconds (0 = no limit)
+   *
+   * @generated from field: int32 turn_time_limit = 2;
+   */
+  turnTimeLimit: number;
+
+  /**
+   * Team mode
+   *
+   * "ffa" or "teams"
+   *
+   * @generated from field: string team_mode = 3;
+   */
+  teamMode: string;
+
+  /**
+   * Maximum number of turns (0 = unlimited)
+   *
+   * @generated from field: int32 max_turns = 4;
+   */
+  maxTurns: number;
+
+  /**
+   * Coin economy settings
+   *
+   * @generated from field: weewar.v1.CoinSettings coins = 5;
+   */
+  coins?: CoinSettings;
+
+  /**
+   * Whether players can only see what their units and buildings can see
+   *
+   * @generated from field: bool fog_of_war = 6;
+   */
+  fogOfWar: boolean;
+
+  /**
+   * How the game is won
+   *
+   * @generated from field: weewar.v1.VictorySettings victory = 7;
+   */
+  victory?: VictorySettings;
+
+  /**
+   * Changes to the default rules for this game
+   *
+   * @generated from field: weewar.v1.RulesOverlay rules_overlay = 8;
+   */
+  rulesOverlay?: RulesOverlay;
+};
+
+/**
+ * Describes the message weewar.v1.GameSettings.
+ * Use `create(GameSettingsSchema)` to create a new message.
+ */
+export const GameSettingsSchema: GenMessage<GameSettings> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 24);
+
+/**
+ * Per game changes applied on top of the default rules
+ *
+ * @generated from message weewar.v1.RulesOverlay
+ */
+export type RulesOverlay = Message<"weewar.v1.RulesOverlay"> & {
+  /**
+   * Unit type IDs that cannot be built in this game
+   *
+   * @generated from field: repeated int32 disabled_units = 1;
+   */
+  disabledUnits: number[];
+
+  /**
+   * Build costs keyed by unit type ID, replacing the unit's default cost
+   *
+   * @generated from field: map<int32, int32> unit_coins = 2;
+   */
+  unitCoins: { [key: number]: number };
+
+  /**
+   * Damage multipliers keyed by attacker unit type ID
+   *
+   * @generated from field: map<int32, double> damage_multipliers = 3;
+   */
+  damageMultipliers: { [key: number]: number };
+};
+
+/**
+ * Describes the message weewar.v1.RulesOverlay.
+ * Use `create(RulesOverlaySchema)` to create a new message.
+ */
+export const RulesOverlaySchema: GenMessage<RulesOverlay> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 25);
+
+/**
+ * The conditions under which a game is won.  The game ends as soon as any
+ * enabled condition is met.  If none are enabled the last player with units
+ * left wins.
+ *
+ * @generated from message weewar.v1.VictorySettings
+ */
+export type VictorySettings = Message<"weewar.v1.VictorySettings"> & {
+  /**
+   * Win by being the last player with units left
+   *
+   * @generated from field: bool elimination = 1;
+   */
+  elimination: boolean;
+
+  /**
+   * Win by owning this many bases (0 = disabled)
+   *
+   * @generated from field: int32 capture_bases = 2;
+   */
+  captureBases: number;
+
+  /**
+   * Tile type of the players' headquarters.  Win by owning every headquarters
+   * on the map (0 = disabled)
+   *
+   * @generated from field: int32 headquarters_tile_type = 3;
+   */
+  headquartersTileType: number;
+
+  /**
+   * Win by having the highest score when GameSettings.max_turns is reached
+   *
+   * @generated from field: bool score_at_max_turns = 4;
+   */
+  scoreAtMaxTurns: boolean;
+};
+
+/**
+ * Describes the message weewar.v1.VictorySettings.
+ * Use `create(VictorySettingsSchema)` to create a new message.
+ */
+export const VictorySettingsSchema: GenMessage<VictorySettings> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 26);
+
+/**
+ * How close a player is to meeting each of a game's victory conditions
+ *
+ * @generated from message weewar.v1.VictoryProgress
+ */
+export type VictoryProgress = Message<"weewar.v1.VictoryProgress"> & {
+  /**
+   * @generated from field: int32 player = 1;
+   */
+  player: number;
+
+  /**
+   * Victory condition name -> progress (0 to 1)
+   *
+   * @generated from field: map<string, double> progress = 2;
+   */
+  progress: { [key: string]: number };
+};
+
+/**
+ * Describes the message weewar.v1.VictoryProgress.
+ * Use `create(VictoryProgressSchema)` to create a new message.
+ */
+export const VictoryProgressSchema: GenMessage<VictoryProgress> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 27);
+
+/**
+ * Describes how players earn coins over the course of a game
+ *
+ * @generated from message weewar.v1.CoinSettings
+ */
+export type CoinSettings = Message<"weewar.v1.CoinSettings"> & {
+  /**
+   * Coins each player starts the game with
+   *
+   * @generated from field: int32 start_of_game = 1;
+   */
+  startOfGame: number;
+
+  /**
+   * Coins credited to a player at the start of each of their turns
+   *
+   * @generated from field: int32 per_turn = 2;
+   */
+  perTurn: number;
+
+  /**
+   * Additional coins credited per base owned at the start of each turn
+   *
+   * @generated from field: int32 per_base = 3;
+   */
+  perBase: number;
+};
+
+/**
+ * Describes the message weewar.v1.CoinSettings.
+ * Use `create(CoinSettingsSchema)` to create a new message.
+ */
+export const CoinSettingsSchema: GenMessage<CoinSettings> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 28);
+
+/**
+ * Holds the game's Active/Current state (eg world state)
+ *
+ * @generated from message weewar.v1.GameState
+ */
+export type GameState = Message<"weewar.v1.GameState"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 2;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * ID of the game whos state is being tracked
+   *
+   * @generated from field: string game_id = 3;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: int32 turn_counter = 4;
+   */
+  turnCounter: number;
+
+  /**
+   * @generated from field: int32 current_player = 5;
+   */
+  currentPlayer: number;
+
+  /**
+   * Current world state
+   *
+   * @generated from field: weewar.v1.WorldData world_data = 6;
+   */
+  worldData?: WorldData;
+
+  /**
+   * Coins currently held by each player (player ID -> coins)
+   *
+   * @generated from field: map<int32, int32> player_coins = 7;
+   */
+  playerCoins: { [key: number]: number };
+
+  /**
+   * Sequence number of the last move processed in this game (0 if no moves yet).
+   * Sequence numbers are assigned by the server and never reused.
+   *
+   * @generated from field: int64 last_sequence_num = 8;
+   */
+  lastSequenceNum: bigint;
+
+  /**
+   * Seed of the game's random stream (eg for combat) and how many values have
+   * been drawn from it so far.  Together they let the exact stream be restored
+   * when the game is reloaded or replayed.  They are kept on the server and
+   * cleared in responses so players cannot predict the outcome of their moves.
+   *
+   * @generated from field: int64 rng_seed = 9;
+   */
+  rngSeed: bigint;
+
+  /**
+   * @generated from field: int64 rng_position = 10;
+   */
+  rngPosition: bigint;
+
+  /**
+   * When the current player's turn started and when it ends if the game has a
+   * turn time limit (unset otherwise).  Once the deadline passes the server ends
+   * the turn on the player's behalf.
+   *
+   * @generated from field: google.protobuf.Timestamp turn_started_at = 11;
+   */
+  turnStartedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp turn_deadline = 12;
+   */
+  turnDeadline?: Timestamp;
+
+  /**
+   * Player that won the game (0 while the game is in progress) and the victory
+   * condition that decided it
+   *
+   * @generated from field: int32 winner = 13;
+   */
+  winner: number;
+
+  /**
+   * @generated from field: string victory_condition = 14;
+   */
+  victoryCondition: string;
+
+  /**
+   * All the players that won - the winner and their teammates in team games
+   *
+   * @generated from field: repeated int32 winners = 15;
+   */
+  winners: number[];
+};
+
+/**
+ * Describes the message weewar.v1.GameState.
+ * Use `create(GameStateSchema)` to create a new message.
+ */
+export const GameStateSchema: GenMessage<GameState> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 29);
+
+/**
+ * Holds the game's move history (can be used as a replay log)
+ *
+ * @generated from message weewar.v1.GameMoveHistory
+ */
+export type GameMoveHistory = Message<"weewar.v1.GameMoveHistory"> & {
   /**
    * Move history for the game
    *
@@ -773,6 +1491,14 @@ export type GameMoveHistory = Message<"weewar.v1.GameMoveHistory"> & {
    * @generated from field: repeated weewar.v1.GameMoveGroup groups = 2;
    */
   groups: GameMoveGroup[];
+
+  /**
+   * State of the game before any moves were made.  Replaying all the groups
+   * from this state reproduces the game's current state.
+   *
+   * @generated from field: weewar.v1.GameState initial_state = 3;
+   */
+  initialState?: GameState;
 };
 
 /**
@@ -780,7 +1506,7 @@ export type GameMoveHistory = Message<"weewar.v1.GameMoveHistory"> & {
  * Use `create(GameMoveHistorySchema)` to create a new message.
  */
 export const GameMoveHistorySchema: GenMessage<GameMoveHistory> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_models, 16);
+  messageDesc(file_weewar_v1_models, 30);
 
 /**
  * A move group - we can allow X moves in one "tick"
@@ -823,7 +1549,7 @@ export type GameMoveGroup = Message<"weewar.v1.GameMoveGroup"> & {
  * Use `create(GameMoveGroupSchema)` to create a new message.
  */
 export const GameMoveGroupSchema: GenMessage<GameMoveGroup> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_models, 17);
+  messageDesc(file_weewar_v1_models, 31);
 
 /**
  * *
@@ -873,6 +1599,42 @@ export type GameMove = Message<"weewar.v1.GameMove"> & {
      */
     value: EndTurnAction;
     case: "endTurn";
+  } | {
+    /**
+     * @generated from field: weewar.v1.BuildUnitAction build_unit = 7;
+     */
+    value: BuildUnitAction;
+    case: "buildUnit";
+  } | {
+    /**
+     * @generated from field: weewar.v1.CaptureBuildingAction capture_building = 8;
+     */
+    value: CaptureBuildingAction;
+    case: "captureBuilding";
+  } | {
+    /**
+     * @generated from field: weewar.v1.LoadUnitAction load_unit = 9;
+     */
+    value: LoadUnitAction;
+    case: "loadUnit";
+  } | {
+    /**
+     * @generated from field: weewar.v1.UnloadUnitAction unload_unit = 10;
+     */
+    value: UnloadUnitAction;
+    case: "unloadUnit";
+  } | {
+    /**
+     * @generated from field: weewar.v1.HealUnitAction heal_unit = 11;
+     */
+    value: HealUnitAction;
+    case: "healUnit";
+  } | {
+    /**
+     * @generated from field: weewar.v1.ModifyTerrainAction modify_terrain = 12;
+     */
+    value: ModifyTerrainAction;
+    case: "modifyTerrain";
   } | { case: undefined; value?: undefined };
 };
 
@@ -881,133 +1643,384 @@ export type GameMove = Message<"weewar.v1.GameMove"> & {
  * Use `create(GameMoveSchema)` to create a new message.
  */
 export const GameMoveSchema: GenMessage<GameMove> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_models, 18);
+  messageDesc(file_weewar_v1_models, 32);
+
+/**
+ * *
+ * Represents the result of executing a move
+ *
+ * @generated from message weewar.v1.GameMoveResult
+ */
+export type GameMoveResult = Message<"weewar.v1.GameMoveResult"> & {
+  /**
+   * Whether the result is permenant and can be undone.
+   * Just moving a unit for example is not permanent, but attacking a unit
+   * would be (ie a player cannot undo it).
+   *
+   * @generated from field: bool is_permanent = 1;
+   */
+  isPermanent: boolean;
+
+  /**
+   * A monotonically increasing and unique (within the game) sequence number for the move
+   *
+   * @generated from field: int64 sequence_num = 2;
+   */
+  sequenceNum: bigint;
+
+  /**
+   * A set of changes to the world as a result of making this move
+   *
+   * @generated from field: repeated weewar.v1.WorldChange changes = 3;
+   */
+  changes: WorldChange[];
+};
+
+/**
+ * Describes the message weewar.v1.GameMoveResult.
+ * Use `create(GameMoveResultSchema)` to create a new message.
+ */
+export const GameMoveResultSchema: GenMessage<GameMoveResult> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 33);
+
+/**
+ * *
+ * A hex coordinate
+ *
+ * @generated from message weewar.v1.HexCoord
+ */
+export type HexCoord = Message<"weewar.v1.HexCoord"> & {
+  /**
+   * @generated from field: int32 q = 1;
+   */
+  q: number;
+
+  /**
+   * @generated from field: int32 r = 2;
+   */
+  r: number;
+};
+
+/**
+ * Describes the message weewar.v1.HexCoord.
+ * Use `create(HexCoordSchema)` to create a new message.
+ */
+export const HexCoordSchema: GenMessage<HexCoord> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 34);
+
+/**
+ * *
+ * Move unit from one position to another
+ *
+ * @generated from message weewar.v1.MoveUnitAction
+ */
+export type MoveUnitAction = Message<"weewar.v1.MoveUnitAction"> & {
+  /**
+   * @generated from field: int32 from_q = 1;
+   */
+  fromQ: number;
+
+  /**
+   * @generated from field: int32 from_r = 2;
+   */
+  fromR: number;
+
+  /**
+   * @generated from field: int32 to_q = 3;
+   */
+  toQ: number;
+
+  /**
+   * @generated from field: int32 to_r = 4;
+   */
+  toR: number;
+
+  /**
+   * The exact hexes the unit moves through, starting at (from_q, from_r) and ending
+   * at (to_q, to_r).  Each step is validated and charged its terrain cost.  If empty
+   * the cheapest path is used.
+   *
+   * @generated from field: repeated weewar.v1.HexCoord path = 5;
+   */
+  path: HexCoord[];
+};
+
+/**
+ * Describes the message weewar.v1.MoveUnitAction.
+ * Use `create(MoveUnitActionSchema)` to create a new message.
+ */
+export const MoveUnitActionSchema: GenMessage<MoveUnitAction> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 35);
+
+/**
+ * *
+ * Attack with one unit against another
+ *
+ * @generated from message weewar.v1.AttackUnitAction
+ */
+export type AttackUnitAction = Message<"weewar.v1.AttackUnitAction"> & {
+  /**
+   * @generated from field: int32 attacker_q = 1;
+   */
+  attackerQ: number;
+
+  /**
+   * @generated from field: int32 attacker_r = 2;
+   */
+  attackerR: number;
+
+  /**
+   * @generated from field: int32 defender_q = 3;
+   */
+  defenderQ: number;
+
+  /**
+   * @generated from field: int32 defender_r = 4;
+   */
+  defenderR: number;
+};
+
+/**
+ * Describes the message weewar.v1.AttackUnitAction.
+ * Use `create(AttackUnitActionSchema)` to create a new message.
+ */
+export const AttackUnitActionSchema: GenMessage<AttackUnitAction> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 36);
+
+/**
+ * *
+ * End current player's turn
+ *
+ * @generated from message weewar.v1.EndTurnAction
+ */
+export type EndTurnAction = Message<"weewar.v1.EndTurnAction"> & {
+  /**
+   * Set by the server when it ended the turn because the player ran out of time
+   *
+   * @generated from field: bool timed_out = 1;
+   */
+  timedOut: boolean;
+};
+
+/**
+ * Describes the message weewar.v1.EndTurnAction.
+ * Use `create(EndTurnActionSchema)` to create a new message.
+ */
+export const EndTurnActionSchema: GenMessage<EndTurnAction> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 37);
+
+/**
+ * *
+ * Build a new unit on a base owned by the player
+ *
+ * @generated from message weewar.v1.BuildUnitAction
+ */
+export type BuildUnitAction = Message<"weewar.v1.BuildUnitAction"> & {
+  /**
+   * @generated from field: int32 q = 1;
+   */
+  q: number;
+
+  /**
+   * @generated from field: int32 r = 2;
+   */
+  r: number;
+
+  /**
+   * @generated from field: int32 unit_type = 3;
+   */
+  unitType: number;
+};
+
+/**
+ * Describes the message weewar.v1.BuildUnitAction.
+ * Use `create(BuildUnitActionSchema)` to create a new message.
+ */
+export const BuildUnitActionSchema: GenMessage<BuildUnitAction> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 38);
+
+/**
+ * *
+ * Capture (or continue capturing) the building under a unit
+ *
+ * @generated from message weewar.v1.CaptureBuildingAction
+ */
+export type CaptureBuildingAction = Message<"weewar.v1.CaptureBuildingAction"> & {
+  /**
+   * @generated from field: int32 q = 1;
+   */
+  q: number;
+
+  /**
+   * @generated from field: int32 r = 2;
+   */
+  r: number;
+};
+
+/**
+ * Describes the message weewar.v1.CaptureBuildingAction.
+ * Use `create(CaptureBuildingActionSchema)` to create a new message.
+ */
+export const CaptureBuildingActionSchema: GenMessage<CaptureBuildingAction> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 39);
+
+/**
+ * *
+ * A unit works on a terrain action on its own or an adjacent hex
+ *
+ * @generated from message weewar.v1.ModifyTerrainAction
+ */
+export type ModifyTerrainAction = Message<"weewar.v1.ModifyTerrainAction"> & {
+  /**
+   * Unit performing the action
+   *
+   * @generated from field: int32 q = 1;
+   */
+  q: number;
+
+  /**
+   * @generated from field: int32 r = 2;
+   */
+  r: number;
 
-/**
- * *
- * Represents the result of executing a move
- *
- * @generated from message weewar.v1.GameMoveResult
- */
-export type GameMoveResult = Message<"weewar.v1.GameMoveResult"> & {
   /**
-   * Whether the result is permenant and can be undone.
-   * Just moving a unit for example is not permanent, but attacking a unit
-   * would be (ie a player cannot undo it).
+   * Hex being changed
    *
-   * @generated from field: bool is_permanent = 1;
+   * @generated from field: int32 target_q = 3;
    */
-  isPermanent: boolean;
+  targetQ: number;
 
   /**
-   * A monotonically increasing and unique (within the game) sequence number for the move
-   *
-   * @generated from field: int64 sequence_num = 2;
+   * @generated from field: int32 target_r = 4;
    */
-  sequenceNum: bigint;
+  targetR: number;
 
   /**
-   * A set of changes to the world as a result of making this move
+   * ID of the terrain action in the rules
    *
-   * @generated from field: repeated weewar.v1.WorldChange changes = 3;
+   * @generated from field: string terrain_action = 5;
    */
-  changes: WorldChange[];
+  terrainAction: string;
 };
 
 /**
- * Describes the message weewar.v1.GameMoveResult.
- * Use `create(GameMoveResultSchema)` to create a new message.
+ * Describes the message weewar.v1.ModifyTerrainAction.
+ * Use `create(ModifyTerrainActionSchema)` to create a new message.
  */
-export const GameMoveResultSchema: GenMessage<GameMoveResult> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_models, 19);
+export const ModifyTerrainActionSchema: GenMessage<ModifyTerrainAction> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 40);
 
 /**
  * *
- * Move unit from one position to another
+ * Board a unit onto an adjacent transport owned by the same player
  *
- * @generated from message weewar.v1.MoveUnitAction
+ * @generated from message weewar.v1.LoadUnitAction
  */
-export type MoveUnitAction = Message<"weewar.v1.MoveUnitAction"> & {
+export type LoadUnitAction = Message<"weewar.v1.LoadUnitAction"> & {
   /**
-   * @generated from field: int32 from_q = 1;
+   * @generated from field: int32 unit_q = 1;
    */
-  fromQ: number;
+  unitQ: number;
 
   /**
-   * @generated from field: int32 from_r = 2;
+   * @generated from field: int32 unit_r = 2;
    */
-  fromR: number;
+  unitR: number;
 
   /**
-   * @generated from field: int32 to_q = 3;
+   * @generated from field: int32 transport_q = 3;
    */
-  toQ: number;
+  transportQ: number;
 
   /**
-   * @generated from field: int32 to_r = 4;
+   * @generated from field: int32 transport_r = 4;
    */
-  toR: number;
+  transportR: number;
 };
 
 /**
- * Describes the message weewar.v1.MoveUnitAction.
- * Use `create(MoveUnitActionSchema)` to create a new message.
+ * Describes the message weewar.v1.LoadUnitAction.
+ * Use `create(LoadUnitActionSchema)` to create a new message.
  */
-export const MoveUnitActionSchema: GenMessage<MoveUnitAction> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_models, 20);
+export const LoadUnitActionSchema: GenMessage<LoadUnitAction> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 41);
 
 /**
  * *
- * Attack with one unit against another
+ * Unload a carried unit from a transport onto an adjacent empty hex
  *
- * @generated from message weewar.v1.AttackUnitAction
+ * @generated from message weewar.v1.UnloadUnitAction
  */
-export type AttackUnitAction = Message<"weewar.v1.AttackUnitAction"> & {
+export type UnloadUnitAction = Message<"weewar.v1.UnloadUnitAction"> & {
   /**
-   * @generated from field: int32 attacker_q = 1;
+   * @generated from field: int32 transport_q = 1;
    */
-  attackerQ: number;
+  transportQ: number;
 
   /**
-   * @generated from field: int32 attacker_r = 2;
+   * @generated from field: int32 transport_r = 2;
    */
-  attackerR: number;
+  transportR: number;
 
   /**
-   * @generated from field: int32 defender_q = 3;
+   * Index of the unit in the transport's cargo
+   *
+   * @generated from field: int32 cargo_index = 3;
    */
-  defenderQ: number;
+  cargoIndex: number;
 
   /**
-   * @generated from field: int32 defender_r = 4;
+   * @generated from field: int32 to_q = 4;
    */
-  defenderR: number;
+  toQ: number;
+
+  /**
+   * @generated from field: int32 to_r = 5;
+   */
+  toR: number;
 };
 
 /**
- * Describes the message weewar.v1.AttackUnitAction.
- * Use `create(AttackUnitActionSchema)` to create a new message.
+ * Describes the message weewar.v1.UnloadUnitAction.
+ * Use `create(UnloadUnitActionSchema)` to create a new message.
  */
-export const AttackUnitActionSchema: GenMessage<AttackUnitAction> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_models, 21);
+export const UnloadUnitActionSchema: GenMessage<UnloadUnitAction> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 42);
 
 /**
  * *
- * End current player's turn
- *
- * No additional fields needed
+ * A healer (eg a Medic) restores health to an adjacent friendly unit
  *
- * @generated from message weewar.v1.EndTurnAction
+ * @generated from message weewar.v1.HealUnitAction
  */
-export type EndTurnAction = Message<"weewar.v1.EndTurnAction"> & {
+export type HealUnitAction = Message<"weewar.v1.HealUnitAction"> & {
+  /**
+   * @generated from field: int32 healer_q = 1;
+   */
+  healerQ: number;
+
+  /**
+   * @generated from field: int32 healer_r = 2;
+   */
+  healerR: number;
+
+  /**
+   * @generated from field: int32 target_q = 3;
+   */
+  targetQ: number;
+
+  /**
+   * @generated from field: int32 target_r = 4;
+   */
+  targetR: number;
 };
 
 /**
- * Describes the message weewar.v1.EndTurnAction.
- * Use `create(EndTurnActionSchema)` to create a new message.
+ * Describes the message weewar.v1.HealUnitAction.
+ * Use `create(HealUnitActionSchema)` to create a new message.
  */
-export const EndTurnActionSchema: GenMessage<EndTurnAction> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_models, 22);
+export const HealUnitActionSchema: GenMessage<HealUnitAction> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 43);
 
 /**
  * *
@@ -1045,6 +2058,48 @@ export type WorldChange = Message<"weewar.v1.WorldChange"> & {
      */
     value: PlayerChangedChange;
     case: "playerChanged";
+  } | {
+    /**
+     * @generated from field: weewar.v1.CoinsChangedChange coins_changed = 5;
+     */
+    value: CoinsChangedChange;
+    case: "coinsChanged";
+  } | {
+    /**
+     * @generated from field: weewar.v1.UnitCreatedChange unit_created = 6;
+     */
+    value: UnitCreatedChange;
+    case: "unitCreated";
+  } | {
+    /**
+     * @generated from field: weewar.v1.TileCapturedChange tile_captured = 7;
+     */
+    value: TileCapturedChange;
+    case: "tileCaptured";
+  } | {
+    /**
+     * @generated from field: weewar.v1.UnitLoadedChange unit_loaded = 8;
+     */
+    value: UnitLoadedChange;
+    case: "unitLoaded";
+  } | {
+    /**
+     * @generated from field: weewar.v1.UnitUnloadedChange unit_unloaded = 9;
+     */
+    value: UnitUnloadedChange;
+    case: "unitUnloaded";
+  } | {
+    /**
+     * @generated from field: weewar.v1.UnitHealedChange unit_healed = 10;
+     */
+    value: UnitHealedChange;
+    case: "unitHealed";
+  } | {
+    /**
+     * @generated from field: weewar.v1.TileChangedChange tile_changed = 11;
+     */
+    value: TileChangedChange;
+    case: "tileChanged";
   } | { case: undefined; value?: undefined };
 };
 
@@ -1053,7 +2108,7 @@ export type WorldChange = Message<"weewar.v1.WorldChange"> & {
  * Use `create(WorldChangeSchema)` to create a new message.
  */
 export const WorldChangeSchema: GenMessage<WorldChange> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_models, 23);
+  messageDesc(file_weewar_v1_models, 44);
 
 /**
  * *
@@ -1082,11 +2137,12 @@ export type UnitMovedChange = Message<"weewar.v1.UnitMovedChange"> & {
  * Use `create(UnitMovedChangeSchema)` to create a new message.
  */
 export const UnitMovedChangeSchema: GenMessage<UnitMovedChange> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_models, 24);
+  messageDesc(file_weewar_v1_models, 45);
 
 /**
  * *
- * A unit took damage
+ * A unit was attacked.  The damage may be 0 so an attack that misses still
+ * records the attacker using up its action.
  *
  * @generated from message weewar.v1.UnitDamagedChange
  */
@@ -1104,6 +2160,19 @@ export type UnitDamagedChange = Message<"weewar.v1.UnitDamagedChange"> & {
    * @generated from field: weewar.v1.Unit updated_unit = 7;
    */
   updatedUnit?: Unit;
+
+  /**
+   * Attacking unit state before and after using up its action (not set for the
+   * damage a counter attack deals to the attacker)
+   *
+   * @generated from field: weewar.v1.Unit previous_attacker = 8;
+   */
+  previousAttacker?: Unit;
+
+  /**
+   * @generated from field: weewar.v1.Unit updated_attacker = 9;
+   */
+  updatedAttacker?: Unit;
 };
 
 /**
@@ -1111,7 +2180,48 @@ export type UnitDamagedChange = Message<"weewar.v1.UnitDamagedChange"> & {
  * Use `create(UnitDamagedChangeSchema)` to create a new message.
  */
 export const UnitDamagedChangeSchema: GenMessage<UnitDamagedChange> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_models, 25);
+  messageDesc(file_weewar_v1_models, 46);
+
+/**
+ * *
+ * A unit regained health by repairing on a base or being healed
+ *
+ * @generated from message weewar.v1.UnitHealedChange
+ */
+export type UnitHealedChange = Message<"weewar.v1.UnitHealedChange"> & {
+  /**
+   * Complete unit state before healing
+   *
+   * @generated from field: weewar.v1.Unit previous_unit = 1;
+   */
+  previousUnit?: Unit;
+
+  /**
+   * Complete unit state after healing
+   *
+   * @generated from field: weewar.v1.Unit updated_unit = 2;
+   */
+  updatedUnit?: Unit;
+
+  /**
+   * Healing unit state before and after using up its action (not set for repairs)
+   *
+   * @generated from field: weewar.v1.Unit previous_healer = 3;
+   */
+  previousHealer?: Unit;
+
+  /**
+   * @generated from field: weewar.v1.Unit updated_healer = 4;
+   */
+  updatedHealer?: Unit;
+};
+
+/**
+ * Describes the message weewar.v1.UnitHealedChange.
+ * Use `create(UnitHealedChangeSchema)` to create a new message.
+ */
+export const UnitHealedChangeSchema: GenMessage<UnitHealedChange> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 47);
 
 /**
  * *
@@ -1133,7 +2243,7 @@ export type UnitKilledChange = Message<"weewar.v1.UnitKilledChange"> & {
  * Use `create(UnitKilledChangeSchema)` to create a new message.
  */
 export const UnitKilledChangeSchema: GenMessage<UnitKilledChange> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_models, 26);
+  messageDesc(file_weewar_v1_models, 48);
 
 /**
  * *
@@ -1175,5 +2285,209 @@ export type PlayerChangedChange = Message<"weewar.v1.PlayerChangedChange"> & {
  * Use `create(PlayerChangedChangeSchema)` to create a new message.
  */
 export const PlayerChangedChangeSchema: GenMessage<PlayerChangedChange> = /*@__PURE__*/
-  messageDesc(file_weewar_v1_models, 27);
+  messageDesc(file_weewar_v1_models, 49);
+
+/**
+ * *
+ * A player's coin balance changed
+ *
+ * @generated from message weewar.v1.CoinsChangedChange
+ */
+export type CoinsChangedChange = Message<"weewar.v1.CoinsChangedChange"> & {
+  /**
+   * @generated from field: int32 player = 1;
+   */
+  player: number;
+
+  /**
+   * @generated from field: int32 previous_coins = 2;
+   */
+  previousCoins: number;
+
+  /**
+   * @generated from field: int32 new_coins = 3;
+   */
+  newCoins: number;
+};
+
+/**
+ * Describes the message weewar.v1.CoinsChangedChange.
+ * Use `create(CoinsChangedChangeSchema)` to create a new message.
+ */
+export const CoinsChangedChangeSchema: GenMessage<CoinsChangedChange> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 50);
+
+/**
+ * *
+ * A new unit was created (eg built on a base)
+ *
+ * @generated from message weewar.v1.UnitCreatedChange
+ */
+export type UnitCreatedChange = Message<"weewar.v1.UnitCreatedChange"> & {
+  /**
+   * Complete state of the newly created unit
+   *
+   * @generated from field: weewar.v1.Unit unit = 1;
+   */
+  unit?: Unit;
+};
+
+/**
+ * Describes the message weewar.v1.UnitCreatedChange.
+ * Use `create(UnitCreatedChangeSchema)` to create a new message.
+ */
+export const UnitCreatedChangeSchema: GenMessage<UnitCreatedChange> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 51);
+
+/**
+ * *
+ * A tile's capture state changed - either capture progressed, was abandoned
+ * or completed (in which case the tile changes owner)
+ *
+ * @generated from message weewar.v1.TileCapturedChange
+ */
+export type TileCapturedChange = Message<"weewar.v1.TileCapturedChange"> & {
+  /**
+   * Complete tile state before the change
+   *
+   * @generated from field: weewar.v1.Tile previous_tile = 1;
+   */
+  previousTile?: Tile;
+
+  /**
+   * Complete tile state after the change
+   *
+   * @generated from field: weewar.v1.Tile updated_tile = 2;
+   */
+  updatedTile?: Tile;
+
+  /**
+   * Capturing unit state before and after capturing (not set when capture is abandoned)
+   *
+   * @generated from field: weewar.v1.Unit previous_unit = 3;
+   */
+  previousUnit?: Unit;
+
+  /**
+   * @generated from field: weewar.v1.Unit updated_unit = 4;
+   */
+  updatedUnit?: Unit;
+};
+
+/**
+ * Describes the message weewar.v1.TileCapturedChange.
+ * Use `create(TileCapturedChangeSchema)` to create a new message.
+ */
+export const TileCapturedChangeSchema: GenMessage<TileCapturedChange> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 52);
+
+/**
+ * *
+ * Work on a terrain action progressed or completed (in which case the tile's
+ * terrain type changes)
+ *
+ * @generated from message weewar.v1.TileChangedChange
+ */
+export type TileChangedChange = Message<"weewar.v1.TileChangedChange"> & {
+  /**
+   * Complete tile state before the change
+   *
+   * @generated from field: weewar.v1.Tile previous_tile = 1;
+   */
+  previousTile?: Tile;
+
+  /**
+   * Complete tile state after the change
+   *
+   * @generated from field: weewar.v1.Tile updated_tile = 2;
+   */
+  updatedTile?: Tile;
+
+  /**
+   * Working unit state before and after the work
+   *
+   * @generated from field: weewar.v1.Unit previous_unit = 3;
+   */
+  previousUnit?: Unit;
+
+  /**
+   * @generated from field: weewar.v1.Unit updated_unit = 4;
+   */
+  updatedUnit?: Unit;
+};
+
+/**
+ * Describes the message weewar.v1.TileChangedChange.
+ * Use `create(TileChangedChangeSchema)` to create a new message.
+ */
+export const TileChangedChangeSchema: GenMessage<TileChangedChange> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 53);
+
+/**
+ * *
+ * A unit boarded a transport and left the map
+ *
+ * @generated from message weewar.v1.UnitLoadedChange
+ */
+export type UnitLoadedChange = Message<"weewar.v1.UnitLoadedChange"> & {
+  /**
+   * Complete unit state before boarding (on the map)
+   *
+   * @generated from field: weewar.v1.Unit unit = 1;
+   */
+  unit?: Unit;
+
+  /**
+   * Complete transport state before and after the unit boarded
+   *
+   * @generated from field: weewar.v1.Unit previous_transport = 2;
+   */
+  previousTransport?: Unit;
+
+  /**
+   * @generated from field: weewar.v1.Unit updated_transport = 3;
+   */
+  updatedTransport?: Unit;
+};
+
+/**
+ * Describes the message weewar.v1.UnitLoadedChange.
+ * Use `create(UnitLoadedChangeSchema)` to create a new message.
+ */
+export const UnitLoadedChangeSchema: GenMessage<UnitLoadedChange> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 54);
+
+/**
+ * *
+ * A unit was unloaded from a transport onto the map
+ *
+ * @generated from message weewar.v1.UnitUnloadedChange
+ */
+export type UnitUnloadedChange = Message<"weewar.v1.UnitUnloadedChange"> & {
+  /**
+   * Complete unit state after unloading (on the map)
+   *
+   * @generated from field: weewar.v1.Unit unit = 1;
+   */
+  unit?: Unit;
+
+  /**
+   * Complete transport state before and after the unit was unloaded
+   *
+   * @generated from field: weewar.v1.Unit previous_transport = 2;
+   */
+  previousTransport?: Unit;
+
+  /**
+   * @generated from field: weewar.v1.Unit updated_transport = 3;
+   */
+  updatedTransport?: Unit;
+};
+
+/**
+ * Describes the message weewar.v1.UnitUnloadedChange.
+ * Use `create(UnitUnloadedChangeSchema)` to create a new message.
+ */
+export const UnitUnloadedChangeSchema: GenMessage<UnitUnloadedChange> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_models, 55);
 
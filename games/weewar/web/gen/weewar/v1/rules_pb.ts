@@ -0,0 +1,212 @@
+// @generated by protoc-gen-es v2.5.2 with parameter "target=ts"
+// @generated from file weewar/v1/rules.proto (package weewar.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { AttackMatrix, DefenderDamageMap, RuleSet, TerrainCostMap, UnitDefinition } from "./models_pb";
+import { file_weewar_v1_models } from "./models_pb";
+import { file_google_api_annotations } from "../../google/api/annotations_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file weewar/v1/rules.proto.
+ */
+export const file_weewar_v1_rules: GenFile = /*@__PURE__*/
+  fileDesc("ChV3ZWV3YXIvdjEvcnVsZXMucHJvdG8SCXdlZXdhci52MSIjCg9HZXRSdWxlc1JlcXVlc3QSEAoIcnVsZXNfaWQYASABKAkiRwoQR2V0UnVsZXNSZXNwb25zZRIQCghydWxlc19pZBgBIAEoCRIhCgVydWxlcxgCIAEoCzISLndlZXdhci52MS5SdWxlU2V0IjMKDkdldFVuaXRSZXF1ZXN0EhAKCHJ1bGVzX2lkGAEgASgJEg8KB3VuaXRfaWQYAiABKAUirgEKD0dldFVuaXRSZXNwb25zZRIQCghydWxlc19pZBgBIAEoCRInCgR1bml0GAIgASgLMhkud2Vld2FyLnYxLlVuaXREZWZpbml0aW9uEjEKDm1vdmVtZW50X2Nvc3RzGAMgASgLMhkud2Vld2FyLnYxLlRlcnJhaW5Db3N0TWFwEi0KB2F0dGFja3MYBCABKAsyHC53ZWV3YXIudjEuRGVmZW5kZXJEYW1hZ2VNYXAiUwoVR2V0Q29tYmF0VGFibGVSZXF1ZXN0EhAKCHJ1bGVzX2lkGAEgASgJEhMKC2F0dGFja2VyX2lkGAIgASgFEhMKC2RlZmVuZGVyX2lkGAMgASgFIloKFkdldENvbWJhdFRhYmxlUmVzcG9uc2USEAoIcnVsZXNfaWQYASABKAkSLgoNYXR0YWNrX21hdHJpeBgCIAEoCzIXLndlZXdhci52MS5BdHRhY2tNYXRyaXgyvAIKDFJ1bGVzU2VydmljZRJWCghHZXRSdWxlcxIaLndlZXdhci52MS5HZXRSdWxlc1JlcXVlc3QaGy53ZWV3YXIudjEuR2V0UnVsZXNSZXNwb25zZSIRgtPkkwILEgkvdjEvcnVsZXMSYwoHR2V0VW5pdBIZLndlZXdhci52MS5HZXRVbml0UmVxdWVzdBoaLndlZXdhci52MS5HZXRVbml0UmVzcG9uc2UiIYLT5JMCGxIZL3YxL3J1bGVzL3VuaXRzL3t1bml0X2lkfRJvCg5HZXRDb21iYXRUYWJsZRIgLndlZXdhci52MS5HZXRDb21iYXRUYWJsZVJlcXVlc3QaIS53ZWV3YXIudjEuR2V0Q29tYmF0VGFibGVSZXNwb25zZSIYgtPkkwISEhAvdjEvcnVsZXMvY29tYmF0QpwBCg1jb20ud2Vld2FyLnYxQgpSdWxlc1Byb3RvUAFaOmdpdGh1Yi5jb20vcGFueWFtL3R1cm5lbmdpbmUvZ2FtZXMvd2Vld2FyL2dlbi9nby93ZWV3YXIvdjGiAgNXWFiqAglXZWV3YXIuVjHKAglXZWV3YXJcVjHiAhVXZWV3YXJcVjFcR1BCTWV0YWRhdGHqAgpXZWV3YXI6OlYxYgZwcm90bzM", [file_weewar_v1_models, file_google_api_annotations]);
+
+/**
+ * @generated from message weewar.v1.GetRulesRequest
+ */
+export type GetRulesRequest = Message<"weewar.v1.GetRulesRequest"> & {
+  /**
+   * Rules to return (empty = the default rules)
+   *
+   * @generated from field: string rules_id = 1;
+   */
+  rulesId: string;
+};
+
+/**
+ * Describes the message weewar.v1.GetRulesRequest.
+ * Use `create(GetRulesRequestSchema)` to create a new message.
+ */
+export const GetRulesRequestSchema: GenMessage<GetRulesRequest> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_rules, 0);
+
+/**
+ * @generated from message weewar.v1.GetRulesResponse
+ */
+export type GetRulesResponse = Message<"weewar.v1.GetRulesResponse"> & {
+  /**
+   * @generated from field: string rules_id = 1;
+   */
+  rulesId: string;
+
+  /**
+   * @generated from field: weewar.v1.RuleSet rules = 2;
+   */
+  rules?: RuleSet;
+};
+
+/**
+ * Describes the message weewar.v1.GetRulesResponse.
+ * Use `create(GetRulesResponseSchema)` to create a new message.
+ */
+export const GetRulesResponseSchema: GenMessage<GetRulesResponse> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_rules, 1);
+
+/**
+ * @generated from message weewar.v1.GetUnitRequest
+ */
+export type GetUnitRequest = Message<"weewar.v1.GetUnitRequest"> & {
+  /**
+   * Rules to look the unit up in (empty = the default rules)
+   *
+   * @generated from field: string rules_id = 1;
+   */
+  rulesId: string;
+
+  /**
+   * @generated from field: int32 unit_id = 2;
+   */
+  unitId: number;
+};
+
+/**
+ * Describes the message weewar.v1.GetUnitRequest.
+ * Use `create(GetUnitRequestSchema)` to create a new message.
+ */
+export const GetUnitRequestSchema: GenMessage<GetUnitRequest> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_rules, 2);
+
+/**
+ * @generated from message weewar.v1.GetUnitResponse
+ */
+export type GetUnitResponse = Message<"weewar.v1.GetUnitResponse"> & {
+  /**
+   * @generated from field: string rules_id = 1;
+   */
+  rulesId: string;
+
+  /**
+   * @generated from field: weewar.v1.UnitDefinition unit = 2;
+   */
+  unit?: UnitDefinition;
+
+  /**
+   * Cost of entering each terrain (missing terrains cost their base move cost)
+   *
+   * @generated from field: weewar.v1.TerrainCostMap movement_costs = 3;
+   */
+  movementCosts?: TerrainCostMap;
+
+  /**
+   * Damage the unit deals to each unit it can attack
+   *
+   * @generated from field: weewar.v1.DefenderDamageMap attacks = 4;
+   */
+  attacks?: DefenderDamageMap;
+};
+
+/**
+ * Describes the message weewar.v1.GetUnitResponse.
+ * Use `create(GetUnitResponseSchema)` to create a new message.
+ */
+export const GetUnitResponseSchema: GenMessage<GetUnitResponse> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_rules, 3);
+
+/**
+ * @generated from message weewar.v1.GetCombatTableRequest
+ */
+export type GetCombatTableRequest = Message<"weewar.v1.GetCombatTableRequest"> & {
+  /**
+   * Rules to return the table for (empty = the default rules)
+   *
+   * @generated from field: string rules_id = 1;
+   */
+  rulesId: string;
+
+  /**
+   * Only attacks by this unit type (0 = all attackers)
+   *
+   * @generated from field: int32 attacker_id = 2;
+   */
+  attackerId: number;
+
+  /**
+   * Only attacks on this unit type (0 = all defenders)
+   *
+   * @generated from field: int32 defender_id = 3;
+   */
+  defenderId: number;
+};
+
+/**
+ * Describes the message weewar.v1.GetCombatTableRequest.
+ * Use `create(GetCombatTableRequestSchema)` to create a new message.
+ */
+export const GetCombatTableRequestSchema: GenMessage<GetCombatTableRequest> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_rules, 4);
+
+/**
+ * @generated from message weewar.v1.GetCombatTableResponse
+ */
+export type GetCombatTableResponse = Message<"weewar.v1.GetCombatTableResponse"> & {
+  /**
+   * @generated from field: string rules_id = 1;
+   */
+  rulesId: string;
+
+  /**
+   * @generated from field: weewar.v1.AttackMatrix attack_matrix = 2;
+   */
+  attackMatrix?: AttackMatrix;
+};
+
+/**
+ * Describes the message weewar.v1.GetCombatTableResponse.
+ * Use `create(GetCombatTableResponseSchema)` to create a new message.
+ */
+export const GetCombatTableResponseSchema: GenMessage<GetCombatTableResponse> = /*@__PURE__*/
+  messageDesc(file_weewar_v1_rules, 5);
+
+/**
+ * RulesService exposes the rule sets games are played under
+ *
+ * @generated from service weewar.v1.RulesService
+ */
+export const RulesService: GenService<{
+  /**
+   * GetRules returns a complete rule set
+   *
+   * @generated from rpc weewar.v1.RulesService.GetRules
+   */
+  getRules: {
+    methodKind: "unary";
+    input: typeof GetRulesRequestSchema;
+    output: typeof GetRulesResponseSchema;
+  },
+  /**
+   * GetUnit returns a unit's definition with its movement costs and attacks
+   *
+   * @generated from rpc weewar.v1.RulesService.GetUnit
+   */
+  getUnit: {
+    methodKind: "unary";
+    input: typeof GetUnitRequestSchema;
+    output: typeof GetUnitResponseSchema;
+  },
+  /**
+   * GetCombatTable returns the attack matrix, optionally for a single attacker or defender
+   *
+   * @generated from rpc weewar.v1.RulesService.GetCombatTable
+   */
+  getCombatTable: {
+    methodKind: "unary";
+    input: typeof GetCombatTableRequestSchema;
+    output: typeof GetCombatTableResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_weewar_v1_rules, 0);
+
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
 
 	"google.golang.org/protobuf/encoding/protojson"
 
@@ -44,7 +45,11 @@ func (p *GameViewerPage) Load(r *http.Request, w http.ResponseWriter, vc *ViewCo
 		return nil, true
 	}
 
+	// With fog of war only the viewing player's view of the game is loaded
 	req := &protos.GetGameRequest{Id: p.GameId}
+	if player, err := strconv.Atoi(r.URL.Query().Get("player")); err == nil {
+		req.Player = int32(player)
+	}
 
 	resp, err := client.GetGame(context.Background(), req)
 	if err != nil {
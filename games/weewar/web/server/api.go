@@ -73,6 +73,11 @@ func NewApiHandler(middleware *oa.Middleware, clients *svc.ClientMgr) *ApiHandle
 	out.mux.Handle(worldsConnectPath, worldsConnectHandler)
 	log.Printf("Registered Worlds Connect handler at: %s", worldsConnectPath)
 
+	rulesAdapter := NewConnectRulesServiceAdapter(services.NewRulesService())
+	rulesConnectPath, rulesConnectHandler := v1connect.NewRulesServiceHandler(rulesAdapter)
+	out.mux.Handle(rulesConnectPath, rulesConnectHandler)
+	log.Printf("Registered Rules Connect handler at: %s", rulesConnectPath)
+
 	return &out
 }
 
@@ -142,5 +147,10 @@ func (web *ApiHandler) createSvcMux(grpc_addr string) (*runtime.ServeMux, error)
 		log.Fatal("Unable to register appitems service: ", err)
 		return nil, err
 	}
+	err = v1.RegisterRulesServiceHandlerFromEndpoint(ctx, svcMux, grpc_addr, opts)
+	if err != nil {
+		log.Fatal("Unable to register rules service: ", err)
+		return nil, err
+	}
 	return svcMux, nil // Return nil error on success
 }
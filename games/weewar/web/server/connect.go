@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 
 	"connectrpc.com/connect"
 	v1 "github.com/panyam/turnengine/games/weewar/gen/go/weewar/v1"
@@ -67,6 +68,9 @@ func (a *ConnectGamesServiceAdapter) UpdateGame(ctx context.Context, req *connec
 
 func (a *ConnectGamesServiceAdapter) ProcessMoves(ctx context.Context, req *connect.Request[v1.ProcessMovesRequest]) (*connect.Response[v1.ProcessMovesResponse], error) {
 	resp, err := a.svc.ProcessMoves(ctx, req.Msg)
+	if errors.Is(err, services.ErrSequenceConflict) {
+		return nil, connect.NewError(connect.CodeAborted, err)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +85,30 @@ func (a *ConnectGamesServiceAdapter) GetOptionsAt(ctx context.Context, req *conn
 	return connect.NewResponse(resp), nil
 }
 
+func (a *ConnectGamesServiceAdapter) GetPath(ctx context.Context, req *connect.Request[v1.GetPathRequest]) (*connect.Response[v1.GetPathResponse], error) {
+	resp, err := a.svc.GetPath(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectGamesServiceAdapter) VerifyGame(ctx context.Context, req *connect.Request[v1.VerifyGameRequest]) (*connect.Response[v1.VerifyGameResponse], error) {
+	resp, err := a.svc.VerifyGame(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectGamesServiceAdapter) UndoMoves(ctx context.Context, req *connect.Request[v1.UndoMovesRequest]) (*connect.Response[v1.UndoMovesResponse], error) {
+	resp, err := a.svc.UndoMoves(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (a *ConnectGamesServiceAdapter) ListMoves(ctx context.Context, req *connect.Request[v1.ListMovesRequest]) (*connect.Response[v1.ListMovesResponse], error) {
 	resp, err := a.svc.ListMoves(ctx, req.Msg)
 	if err != nil {
@@ -97,18 +125,15 @@ func (a *ConnectGamesServiceAdapter) GetGameState(ctx context.Context, req *conn
 	return connect.NewResponse(resp), nil
 }
 
-/** If you had a streamer than you can use this to act as a bridge between websocket and grpc streams
-func (a *ConnectGameServiceAdapter) StreamSomeThing(ctx context.Context, req *connect.Request[v1.StreamSomeThingRequest], stream *connect.ServerStream[v1.StreamSomeThingResponse]) error {
-	// Create a custom stream implementation that bridges to Connect
-	bridgeStream := &ConnectStreamBridge[v1.StreamSomeThingResponse]{
+// SubscribeGame bridges the Connect server stream to the gRPC streaming method so browsers
+// can follow a game live
+func (a *ConnectGamesServiceAdapter) SubscribeGame(ctx context.Context, req *connect.Request[v1.SubscribeGameRequest], stream *connect.ServerStream[v1.SubscribeGameResponse]) error {
+	bridgeStream := &ConnectStreamBridge[v1.SubscribeGameResponse]{
 		connectStream: stream,
 		ctx:           ctx,
 	}
-
-	// Call your existing gRPC streaming method
-	return a.svc.StreamSomeThing(req.Msg, bridgeStream)
+	return a.svc.SubscribeGame(req.Msg, bridgeStream)
 }
-*/
 
 // ConnectWorldsServiceAdapter adapts the gRPC WorldsService to Connect's interface
 type ConnectWorldsServiceAdapter struct {
@@ -167,6 +192,39 @@ func (a *ConnectWorldsServiceAdapter) UpdateWorld(ctx context.Context, req *conn
 	return connect.NewResponse(resp), nil
 }
 
+// ConnectRulesServiceAdapter adapts the gRPC RulesService to Connect's interface
+type ConnectRulesServiceAdapter struct {
+	svc *services.RulesServiceImpl
+}
+
+func NewConnectRulesServiceAdapter(svc *services.RulesServiceImpl) *ConnectRulesServiceAdapter {
+	return &ConnectRulesServiceAdapter{svc: svc}
+}
+
+func (a *ConnectRulesServiceAdapter) GetRules(ctx context.Context, req *connect.Request[v1.GetRulesRequest]) (*connect.Response[v1.GetRulesResponse], error) {
+	resp, err := a.svc.GetRules(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectRulesServiceAdapter) GetUnit(ctx context.Context, req *connect.Request[v1.GetUnitRequest]) (*connect.Response[v1.GetUnitResponse], error) {
+	resp, err := a.svc.GetUnit(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectRulesServiceAdapter) GetCombatTable(ctx context.Context, req *connect.Request[v1.GetCombatTableRequest]) (*connect.Response[v1.GetCombatTableResponse], error) {
+	resp, err := a.svc.GetCombatTable(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
 /** If you had a streamer than you can use this to act as a bridge between websocket and grpc streams
 func (a *ConnectWorldServiceAdapter) StreamSomeThing(ctx context.Context, req *connect.Request[v1.StreamSomeThingRequest], stream *connect.ServerStream[v1.StreamSomeThingResponse]) error {
 	// Create a custom stream implementation that bridges to Connect
@@ -34,6 +34,10 @@ export class GameState {
     private turnCounter: number = 1;
     private gameName: string = '';
 
+    // Live updates from the server
+    private subscription: AbortController | null = null;
+    private lastSequenceNum: number = 0;
+
     constructor(eventBus: EventBus) {
         this.eventBus = eventBus;
         
@@ -332,6 +336,7 @@ export class GameState {
                 const gameStateData = JSON.parse(gameStateElement.textContent);
                 this.currentPlayer = gameStateData.currentPlayer || 1;
                 this.turnCounter = gameStateData.turnCounter || 1;
+                this.lastSequenceNum = Number(gameStateData.lastSequenceNum || 0);
                 console.log('[GameState] Extracted initial game state:', {
                     currentPlayer: this.currentPlayer,
                     turnCounter: this.turnCounter
@@ -413,6 +418,131 @@ export class GameState {
         }
     }
 
+    /**
+     * Follow the game live via the server's SubscribeGame stream.
+     *
+     * Move groups committed on the server (eg by other players) are emitted as
+     * server-changes and the WASM singletons are reloaded so local options stay
+     * in sync.  Reconnects resume after the last sequence number seen.
+     */
+    public subscribeToServer(player: number = 0): void {
+        if (this.subscription) {
+            return;
+        }
+        this.subscription = new AbortController();
+        this.runSubscription(player, this.subscription.signal);
+    }
+
+    /**
+     * Stop following the game started by subscribeToServer
+     */
+    public unsubscribeFromServer(): void {
+        this.subscription?.abort();
+        this.subscription = null;
+    }
+
+    private async runSubscription(player: number, signal: AbortSignal): Promise<void> {
+        while (!signal.aborted) {
+            try {
+                await this.readSubscription(player, signal);
+            } catch (error) {
+                if (signal.aborted) {
+                    return;
+                }
+                console.log('[GameState] Subscription error, reconnecting:', error);
+            }
+            await new Promise(resolve => setTimeout(resolve, 2000));
+        }
+    }
+
+    /**
+     * Reads a Connect server stream using the JSON codec.  Each message is
+     * enveloped as a flags byte and a big-endian length followed by the JSON
+     * payload.  Flag 0x02 marks the end of stream message.
+     */
+    private async readSubscription(player: number, signal: AbortSignal): Promise<void> {
+        const body = JSON.stringify({
+            gameId: this.gameId,
+            fromSequenceNum: String(this.lastSequenceNum),
+            player: player,
+        });
+        const payload = new TextEncoder().encode(body);
+        const envelope = new Uint8Array(5 + payload.length);
+        new DataView(envelope.buffer).setUint32(1, payload.length);
+        envelope.set(payload, 5);
+
+        const response = await fetch('/api/weewar.v1.GamesService/SubscribeGame', {
+            method: 'POST',
+            headers: { 'Content-Type': 'application/connect+json' },
+            body: envelope,
+            signal: signal,
+        });
+        if (!response.ok || !response.body) {
+            throw new Error(`Subscribe failed: ${response.statusText}`);
+        }
+
+        const reader = response.body.getReader();
+        let buffer = new Uint8Array(0);
+        while (true) {
+            const { done, value } = await reader.read();
+            if (done) {
+                return;
+            }
+            const merged = new Uint8Array(buffer.length + value.length);
+            merged.set(buffer);
+            merged.set(value, buffer.length);
+            buffer = merged;
+
+            while (buffer.length >= 5) {
+                const flags = buffer[0];
+                const length = new DataView(buffer.buffer, buffer.byteOffset).getUint32(1);
+                if (buffer.length < 5 + length) {
+                    break;
+                }
+                const message = JSON.parse(new TextDecoder().decode(buffer.subarray(5, 5 + length)));
+                buffer = buffer.slice(5 + length);
+
+                if (flags & 0x02) {
+                    if (message.error) {
+                        throw new Error(`${message.error.code}: ${message.error.message}`);
+                    }
+                    return;
+                }
+                await this.handleSubscriptionUpdate(message);
+            }
+        }
+    }
+
+    private async handleSubscriptionUpdate(update: any): Promise<void> {
+        const changes: WorldChange[] = [];
+        if (update.moveGroup) {
+            for (const moveResult of update.moveGroup.moveResults || []) {
+                changes.push(...(moveResult.changes || []));
+            }
+            const moves = update.moveGroup.moves || [];
+            if (moves.length > 0) {
+                this.lastSequenceNum = Number(moves[moves.length - 1].sequenceNum || 0);
+            }
+        } else if (update.undo) {
+            changes.push(...(update.undo.changes || []));
+        }
+
+        // Bring the WASM singletons up to date with the server before notifying listeners
+        const response = await fetch(`/api/v1/games/${this.gameId}`);
+        if (response.ok) {
+            const data = await response.json();
+            const weewar = (window as any).weewar;
+            const encoder = new TextEncoder();
+            weewar?.loadGameData(
+                encoder.encode(JSON.stringify(data.game || {})),
+                encoder.encode(JSON.stringify(data.state || {})),
+                encoder.encode(JSON.stringify(data.history || { gameId: this.gameId, groups: [] })),
+            );
+        }
+
+        this.eventBus.emit('server-changes', { changes: changes }, this, this);
+    }
+
     /**
      * Initialize game save/load bridge functions for WASM BrowserSaveHandler
      * These functions are called by the Go BrowserSaveHandler implementation
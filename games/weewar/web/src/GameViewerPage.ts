@@ -290,6 +290,9 @@ class GameViewerPage extends BasePage implements LCMComponent {
         
         // Load game data into WASM singletons and create World object in GameState
         await this.gameState.loadGameDataToWasm();
+
+        // Follow moves made elsewhere so the viewer updates live
+        this.gameState.subscribeToServer();
         
         // Refresh unit labels in Phaser scene with the loaded World data
         if (this.world && this.gameScene) {
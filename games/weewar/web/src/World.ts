@@ -161,10 +161,23 @@ export class World {
             }
             
             if (change.unitDamaged) {
-                // Update unit health
+                // Update unit health and the attacker's used up action
                 if (change.unitDamaged.updatedUnit) {
                     this.setUnitDirect(change.unitDamaged.updatedUnit);
                 }
+                if (change.unitDamaged.updatedAttacker) {
+                    this.setUnitDirect(change.unitDamaged.updatedAttacker);
+                }
+            }
+            
+            if (change.unitHealed) {
+                // Update unit health and the healer's used up action
+                if (change.unitHealed.updatedUnit) {
+                    this.setUnitDirect(change.unitHealed.updatedUnit);
+                }
+                if (change.unitHealed.updatedHealer) {
+                    this.setUnitDirect(change.unitHealed.updatedHealer);
+                }
             }
             
             if (change.unitKilled) {